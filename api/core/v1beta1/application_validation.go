@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"maps"
 	"slices"
+	"strings"
 
 	"github.com/flightctl/flightctl/internal/api/common"
 	"github.com/flightctl/flightctl/internal/quadlet"
@@ -139,3 +140,143 @@ func (v *vmValidator) Validate() []error {
 	}
 	return nil
 }
+
+// applicationDependsOn returns the names of the applications the application depends on. Only compose, quadlet
+// and container applications can declare dependencies.
+func applicationDependsOn(app ApplicationProviderSpec) ([]string, error) {
+	appType, err := app.GetAppType()
+	if err != nil {
+		return nil, err
+	}
+	var dependsOn *[]string
+	switch appType {
+	case AppTypeCompose:
+		compose, err := app.AsComposeApplication()
+		if err != nil {
+			return nil, err
+		}
+		dependsOn = compose.DependsOn
+	case AppTypeQuadlet:
+		quadlet, err := app.AsQuadletApplication()
+		if err != nil {
+			return nil, err
+		}
+		dependsOn = quadlet.DependsOn
+	case AppTypeContainer:
+		container, err := app.AsContainerApplication()
+		if err != nil {
+			return nil, err
+		}
+		dependsOn = container.DependsOn
+	}
+	if dependsOn == nil {
+		return nil, nil
+	}
+	return *dependsOn, nil
+}
+
+// validateApplicationDependencies checks that applications only depend on other compose, quadlet or container
+// applications of the same device and that the dependencies do not form a cycle.
+func validateApplicationDependencies(apps []ApplicationProviderSpec) []error {
+	var errs []error
+	var names []string
+	appTypes := make(map[string]AppType, len(apps))
+	dependencies := make(map[string][]string, len(apps))
+	for _, app := range apps {
+		name, err := ensureAppName(app)
+		if err != nil {
+			// reported by the validation of the application
+			continue
+		}
+		appType, err := app.GetAppType()
+		if err != nil {
+			continue
+		}
+		if _, ok := appTypes[name]; ok {
+			continue
+		}
+		dependsOn, err := applicationDependsOn(app)
+		if err != nil {
+			continue
+		}
+		names = append(names, name)
+		appTypes[name] = appType
+		dependencies[name] = dependsOn
+	}
+
+	for _, name := range names {
+		path := fmt.Sprintf("spec.applications[%s].dependsOn", name)
+		seen := make(map[string]struct{})
+		for _, dependency := range dependencies[name] {
+			if _, ok := seen[dependency]; ok {
+				errs = append(errs, fmt.Errorf("%s: duplicate application %q", path, dependency))
+				continue
+			}
+			seen[dependency] = struct{}{}
+			if dependency == name {
+				errs = append(errs, fmt.Errorf("%s: application cannot depend on itself", path))
+				continue
+			}
+			appType, ok := appTypes[dependency]
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: unknown application %q", path, dependency))
+				continue
+			}
+			if appType != AppTypeCompose && appType != AppTypeQuadlet && appType != AppTypeContainer {
+				errs = append(errs, fmt.Errorf("%s: application %q must be a compose, quadlet or container application", path, dependency))
+			}
+		}
+	}
+
+	if cycle := findDependencyCycle(names, dependencies); len(cycle) > 0 {
+		errs = append(errs, fmt.Errorf("spec.applications: dependency cycle: %s", strings.Join(cycle, " -> ")))
+	}
+	return errs
+}
+
+// findDependencyCycle returns the applications of a dependency cycle starting and ending with the same application,
+// or nil if the dependencies do not form a cycle. Self dependencies are ignored.
+func findDependencyCycle(names []string, dependencies map[string][]string) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(names))
+	var path []string
+
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		state[name] = visiting
+		path = append(path, name)
+		for _, dependency := range dependencies[name] {
+			if dependency == name {
+				continue
+			}
+			if _, ok := dependencies[dependency]; !ok {
+				continue
+			}
+			switch state[dependency] {
+			case visiting:
+				start := slices.Index(path, dependency)
+				return append(slices.Clone(path[start:]), dependency)
+			case unvisited:
+				if cycle := visit(dependency); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for _, name := range names {
+		if state[name] == unvisited {
+			if cycle := visit(name); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
@@ -38,6 +38,13 @@ const (
 	// When this annotation is present, it means that the device has been selected for rollout in a batch
 	DeviceAnnotationSelectedForRollout = "fleet-controller/selectedForRollout"
 	DeviceAnnotationLastRolloutError   = "fleet-controller/lastRolloutError"
+	// The name of the EnrollmentPolicy that approved the enrollment of the device
+	DeviceAnnotationEnrollmentPolicy = "device-controller/enrollmentPolicy"
+	// The name of the DeviceRegistration that approved the enrollment of the device
+	DeviceAnnotationDeviceRegistration = "device-controller/deviceRegistration"
+
+	// The system info key under which a device reports the public key that secrets in its rendered spec are encrypted to
+	DeviceSystemInfoSecretEncryptionKey = "secretEncryptionKey"
 
 	// TODO: make configurable
 	// DeviceDisconnectedTimeout is the duration after which a device is considered to be not reporting and set to unknown status.
@@ -45,14 +52,27 @@ const (
 
 	DeviceQueryConsoleSessionMetadata = "metadata"
 
+	DeviceRegistrationAPIVersion = "v1beta1"
+	DeviceRegistrationKind       = "DeviceRegistration"
+	DeviceRegistrationListKind   = "DeviceRegistrationList"
+
+	EnrollmentPolicyAPIVersion = "v1beta1"
+	EnrollmentPolicyKind       = "EnrollmentPolicy"
+	EnrollmentPolicyListKind   = "EnrollmentPolicyList"
+
 	EnrollmentRequestAPIVersion = "v1beta1"
 	EnrollmentRequestKind       = "EnrollmentRequest"
 	EnrollmentRequestListKind   = "EnrollmentRequestList"
 
+	// The address an enrollment request was sent from, recorded by the service for the EnrollmentPolicies to match
+	EnrollmentRequestAnnotationSourceAddress = "enrollment-controller/sourceAddress"
+
 	FleetAPIVersion = "v1beta1"
 	FleetKind       = "Fleet"
 	FleetListKind   = "FleetList"
 
+	RolloutPreviewKind = "RolloutPreview"
+
 	FleetAnnotationTemplateVersion = "fleet-controller/templateVersion"
 	// The last template version that has been processed by device selection reconciler.  It is used for new rollout detection
 	FleetAnnotationDeployingTemplateVersion = "fleet-controller/deployingTemplateVersion"
@@ -67,6 +87,19 @@ const (
 	FleetAnnotationLastBatchCompletionReport = "fleet-controller/lastBatchCompletionReport"
 	// A frozen digest of device selection definition during rollout
 	FleetAnnotationDeviceSelectionConfigDigest = "fleet-controller/deviceSelectionConfigDigest"
+	// The template version that devices were returned to after the rollout of the current template version was rolled back
+	FleetAnnotationRollbackTemplateVersion = "fleet-controller/rollbackTemplateVersion"
+	// The time since which all canary devices have been online and healthy.  Contains an RFC3339 timestamp
+	FleetAnnotationCanaryHealthySince = "fleet-controller/canaryHealthySince"
+	// The time at which the canary devices finished updating and their soak started.  Contains an RFC3339 timestamp
+	FleetAnnotationCanarySoakStartedAt = "fleet-controller/canarySoakStartedAt"
+	// The time at which the current step of a progressive rollout started.  Contains an RFC3339 timestamp
+	FleetAnnotationProgressiveStepStartedAt = "fleet-controller/progressiveStepStartedAt"
+	// Indicates that a user paused the rollout.  No further devices are dispatched or rendered until it is resumed
+	FleetAnnotationRolloutPaused = "fleet-controller/rolloutPaused"
+	// The template version whose rollout a user aborted.  The rollout stays halted until a new template version or
+	// rollout definition is applied
+	FleetAnnotationRolloutAborted = "fleet-controller/rolloutAborted"
 	// The requestID related to an event
 	EventAnnotationRequestID = "event-controller/requestID"
 
@@ -80,6 +113,10 @@ const (
 	// WorkloadTypeVM is the value of AnnotationWorkloadType for KubeVirt VM workloads.
 	WorkloadTypeVM = "vm"
 
+	NotificationSinkAPIVersion = "v1beta1"
+	NotificationSinkKind       = "NotificationSink"
+	NotificationSinkListKind   = "NotificationSinkList"
+
 	RepositoryAPIVersion = "v1beta1"
 	RepositoryKind       = "Repository"
 	RepositoryListKind   = "RepositoryList"
@@ -95,9 +132,14 @@ const (
 	ResourceSyncKind       = "ResourceSync"
 	ResourceSyncListKind   = "ResourceSyncList"
 
+	SecretAPIVersion = "v1beta1"
+	SecretKind       = "Secret"
+	SecretListKind   = "SecretList"
+
 	TemplateVersionAPIVersion = "v1beta1"
 	TemplateVersionKind       = "TemplateVersion"
 	TemplateVersionListKind   = "TemplateVersionList"
+	TemplateVersionDiffKind   = "TemplateVersionDiff"
 
 	EventAPIVersion = "v1beta1"
 	EventKind       = "Event"
@@ -168,11 +210,23 @@ const (
 	RolloutSuspendedReason = "Suspended"
 	// Rollout is pending on user approval
 	RolloutWaitingReason = "Waiting"
+	// Rollout failed and the updated devices were returned to the previous template version
+	RolloutRolledBackReason = "RolledBack"
+	// Rollout is waiting for the next maintenance window
+	RolloutOutsideMaintenanceWindowReason = "OutsideMaintenanceWindow"
+	// Rollout is waiting for a user to approve a batch that requires approval
+	RolloutAwaitingApprovalReason = "AwaitingApproval"
+	// Rollout was paused by a user
+	RolloutPausedReason = "Paused"
+	// Rollout was aborted by a user
+	RolloutAbortedReason = "Aborted"
 
 	// The name of the preliminary batch
 	PreliminaryBatchName = "preliminary batch"
 	// The name of the final implicit batch
 	FinalImplicitBatchName = "final implicit batch"
+	// The name of the batch of canary devices in a canary rollout
+	CanaryBatchName = "canary batch"
 
 	// System-level resource name for events
 	FlightCtlSystemResourceName = "flightctl-system"
@@ -188,6 +242,9 @@ const (
 	// TPMChallengeSucceededReason indicates that a TPM Challenge attempt succeed
 	TPMChallengeSucceededReason = "TPMChallengeSucceeded"
 
+	// RequestExpiredReason indicates that an enrollment or certificate signing request was denied because it was not approved in time
+	RequestExpiredReason = "Expired"
+
 	// ResourceSync New Hash Detected Reason
 	ResourceSyncNewHashDetectedReason = "NewHashDetected"
 )
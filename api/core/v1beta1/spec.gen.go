@@ -18,459 +18,617 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9iXIbt7Y2+io4PKfK9t6kJjuOo6rU/mVJdpRYlqLB+b0j32ywGyQRNRsMgJbM5HfV",
-	"fYf7hvdJ/sLC0Ohu9EBNjpM+p2rHYmPGwsLCGr71xyBi8wVLSSrFYPuPgYhmZI7hnzt4cczZFY0JP12Q",
-	"SP0UExFxupCUpYPtcgGkv46JQDhFO6mg44SgnUyyOVY10HGC5YTxOXq8s3P8BC1MXRSxdEKnGYdSa4Ph",
-	"YMHZgnBJCYwDL+g5T6rdn80IoqkkPMUJ2tk5RjvHB+j85I1qQS4XZLA9EJLTdDr4NBzgTM4Yp79DH7XN",
-	"He1kcraFCoURSeMFo6msbTtKKEnlQdzYpi6EDvYamjglESeySzMCSgabiqlYJHj5Fs9JtaXvsjlOR5zg",
-	"GKvNMWVRiucETRhHckbcvgRbJ6mqaKY6wVkiB9uSZ2RY6uinGZEzohqkAjbH7TYVyDTidTBmLCE4VT0w",
-	"PsWpWXs1iWNOJvRjdSpH8A+coAUUgOGrjvz6MDGxhg7SiM1pOtV/I8wJIh8XTJAYYWEb+Cd8Dc7aDv4M",
-	"PoS2R1VBbAKkQ1JJI92/v5YkzeaD7Z8HGC8GHwKdiIgtNMUXm39DhVRNGwrQxZBkiJPfMiKACqgkc6ha",
-	"adX8gDnHS/ibXZLWAwCF2gj/03CgRkC5Ioefi2s0tKc2cPK8MXhnp3QG3HLkK8XGv5JIqjnsjAVLMkmO",
-	"sZxV53FCFpwIxdAUH8KmLJrQhKAFlrMqh1kE21Hr4WqrImrNsW6HpXBUxFJIMl9Db5kkSM6wRDhdIvKR",
-	"CqmoDYpe0yRBY4LYFeHXnEpJgMeRj3i+SNS81q8wX0/YdB0vFmsJmwZXuroGC/qOcAFDrTDm4wPzDcVk",
-	"QlNFLjOCrvRvJEaayyuigvPJ7YppolVknCLd1Ro6JVxVRGLGsiRWzPqKcIk4idg0pb+71oAkVTcJlkTI",
-	"nDVf4SQjQ4TTGM3xEnGi2kVZ6rUARcQaOmRc8fQJ20YzKRdie319SuXa5QuxRtl6xObzLKVyuR6xVHI6",
-	"ziTjYj0mVyRZF3Q6wjyaUUkimXGyjhd0BINN1aTE2jz+b04Ey3hEhH8crzbHROLNwXAwSeh0JiOZqM7y",
-	"n6uHdTj4OFLVR1eYA0dR7eQb8s5VzX97Zds+YKHP+/OFXKqOPo6mbFQ5xDuLRTvrUWuPF4vE8B5/jnDH",
-	"C3Usf8twnMD5UmuIaUr4YDiYkWQ+GA6u5p3nCuPZdc2aH350rbsSeSfmp+90X+avd/PBBz1BO25VhaRw",
-	"C+IkOZoMtn/+Y/A/nEwG24P/Xs+llXVDduuvaEJspU/D5rInJMGSXmnOoQoXOJj6scpvSuPbT6/eYa75",
-	"RoGLkPwDjmOqb6fjQpHqZV3Yzf30inKWztUNf4U5hTv6kixHcD7QAlMuhoimalwkRnGmmkE8SyWdkzWk",
-	"iOGSLOGk6RoERzM0z4RUDGhM5DUhKdqEAltfPUXRDHMcScKFx97ttMNMxy3DMeMBSUX9iuZ4sVADo6m6",
-	"lOdYoovBjAmpPm47slN/XQzQY7I2XRuii8GLjRcb2y82LgZPiuzR/K6YNpaKpQy2B//PxUX8z231P/8T",
-	"uq79YZpb6SUWgeOzy+ZzfUubTQIpAieJf5DggImAXJqmTHPM2+z5Dh9TyTFfojmROMYSI6/hNXSuhBTL",
-	"S5MlGi/hoAMHZAlaJDgldhELDOya8cuE4Ri4yRN0PSMpkhynQu2J2p7KFBFWbD1VQhoQ1EBd8Dg+SpOl",
-	"FfIqFIFzztR07iwD+zQcpEHB9KzIvLREail38///f/+/Ir2ihKXTIRISc4muqZwhjBKiyAMxjtJsPiZc",
-	"XzmG3lDK0LW6HMQCR6RdmrHz+tByCsoPJKomNacployrH8xZUP+0TLhmiQwz9RovMOnaWqZAsR4w9Joq",
-	"igEXS9tLoaaC4erFOle17b8rtP7JHRvzJHFLq2T9lHRg8IGVaePzgSG3VQmuZFul8lq2lS+tTelqOTHy",
-	"yRs6p1KEJFv9HSVQwL3YSrd+kU1FiyzA+I7PdSOKSUeMK+HrlebVnKgjARfMGCvmw9IKqyhy6I21r78K",
-	"seE5mTO+rHZ+CL+b/uHwMvuWU+LdLUay9dXzeVfxubLqTQsesVRIjmnaddUTt4UtbLFm79sGfSqxzERY",
-	"KNTfQIxHgqbTpMhazdslJldUc0IrJR5zssBG6DtVnFX/8yRLU/2vfc6ZkuTO08uUXSsuoI5mQiSJoQpb",
-	"LPJ/qSqdpcnitPyBVD56I6t8y4da+WTHXvmQT6byyZ9dYBx2uuFPMP/ipp0LwqtyI8/SHRG+ETNBYJms",
-	"eK/fm/Czfrn5+2oeaGOiJEKUqXtcyYRUICpQyqRuQbWG9XsQmlHnj6bwbnWXjQg8J9BjOrF/jxPyZA3t",
-	"af2Pe/eZUWHdEZ4qKZZnqVDdPZ6SlHAQYDhj8gmiExiSWJCITmhBF+SRSv4WOjcr4f88Epd0MbK8YwS6",
-	"CrXASlRpOz/vWJJpOSR/ZBTXf8+8nDHIIjG6ghpqlrESwnDazADCYs55Sn/LCPL31G/XbEaAu1SYKydR",
-	"gun8mCU0Wq7AZ/TETwq1y8IPjD0g+fzR8cI+mOMp0R0VBKS22/GQZam8QT3or7byh/I1GyhUOZR6Vxq0",
-	"cf7RMIULiriVtqOqqOtEvidlGnAq2cEJUUd5MKwh6hm79k7pDKdxAqRuiFE/FmYEseu0/FQAfdGcXekz",
-	"a+8O09+H5teYHrZmks331p2ctreVY1ZzlCaEkzQiIQHAfLJMLiaLhC1JjI52D0ZqaxOKU4mookD19FB3",
-	"0wRHEo1xdKmWrrHv0Lnzx9Py+hCn2XyO+bKjMFB81op6QeA7ghM5Ww6Ggz0y5TiGW656+b9l/lhWv+yL",
-	"w887rS3ijaa2TOCeLxYI3vfFIuWJqVXP5GwX7FRVXoELqtjmg+9KgiIATqtlRM30awo3GRgqhO2bQsS+",
-	"b7kJmWoKpbWNRFfRSpFCv2HTjR1ME9tURHiFaQK6rZrJrMBJMzlz6xdiosU3vVv94MHK5GxvmeI5jY68",
-	"pdgRgk5BJxdQtLdVQRj+KUA4AkmpuMr5uyaTM88iqth6QOWk2X2tteL706O3zlIBWiJVXstkRrjTkl/B",
-	"RkZjtQUTSrjVI/18MZhyli3ExWCILgYbF4MPirf9fDGIMiHZXP/M+PRi8OHJauanJuuevbsqt9YZWCWd",
-	"la8yAxCnnCKR8enIaBEbT4Tq/jSbdOteQMEu3Y9gXcLdy1YlfqFh7OjI586xJrjAXVuid6ktcTnRtFD9",
-	"CUtIR2ovFkXko+Q4kgJxpuSICWfzIEWjTIA4kVPq7WlcdbkO5GrIvUrEH+AvGJv7g+Bk/guOIiIMldvP",
-	"KxK0UE9Vq+3LiWi7QkWntiAQEePTbdWj1ZA/tvz90fajJ2voBNbRnFkrRriugDmLRQLqmxJPGYHdNNY7",
-	"YRtS7wqWyVIL04SNcQJaUiUXLMG+mSRFFnVDOoa5PRT9rsKua/h07AnGmlcDEetHdoGS1crpiZG4wtDV",
-	"PJt0wHbuDddZ8xU0HCwI13qEhhtRF6ltQkgsmwdxCiVqGqiqdOVK+twOHbQ30LxMXVpoXqVPdcTWXC1I",
-	"c41VUMQJlvD6MsezdL0odgGGPEWXVX7Z5UZVNdW9NOpytUJho5iJmm461+p937adR3Tvd689fN14Vy0J",
-	"1Ur8/tfcLUM7soRl5aL3HBLZYsFAP4rGTM7Q0cHeLnB47dkT9K670ePlkqaBt8QPNI0RBVqGdTF2aDcT",
-	"e5Wd7J+eIeuOobmsXiJv0rnryRwvEU0nVulpODPJHZS0rKs947Ix2EaMc5SSudfQLphU0ZigbBFjSeI1",
-	"dJCiXTwnyS4W5N4dT8C8OlJLFr5Prem3bQuOYI0OicQgehjNVdcHklaH1T+KzKZ6wzF9tNGxetw10zI8",
-	"/4AuEvsQ9C9VcXd06SS3mvdnpds7eGf2p+GznAa1p/osrEbTesfbiLqLSR/jRS3FlLynh4PLF6Ku8A8v",
-	"RKkwU4S6VcsHgJmXq9C4VqZT10C5+IKkYkYntWb/owVJT1WBki6+LPwVHD87C4GVEbWJbIE5t1apmUHL",
-	"WS/tXFv58uYpybHWMdbqEru8tUuPbP+Jot/Z5adI48Pl7p4mpbF3f0+UKt7dO6LScOf3Q7lmHVdofK8E",
-	"d6+phlMLqud283MTfI6NFV+vc0FObX8PhGVe3wJZkHuvZ0RRlRuXdV+2dHZ/srWhoq5qgco8m7euy4EL",
-	"lcy3yi6/INJqOIRVmbSevJLJX9UNL5iVj0CBosMVVB8wiEJvK7r930Zjs+LO6NmFtuMlFgSMtfup1J5B",
-	"Ya/JCU5EJaRkB0XqlWO8gYzJjaiGPIsCuDKgo90DxMmUCsmX1dVfJUImwWOSIDFj1ykylvnzg/zBuUtS",
-	"eXRa9+SEIYa7gVXQekzP6G/HnHcQkVQyMRozJqN1/w/T5xx/fEPSqZwNtre++mo4mNPU/r0ZOqh4GjK8",
-	"koREEuarCph3NxVmjXOFqpCc4Pk3WmGq/9jcqOhMvTFtbr0oj8nz4v354uL6g/qftdGHPzaGm1tffwr6",
-	"885peqAb32wx8eQrbuYapkIZBbTL8DOI6ykiCYHDT1M0hp+FEqBTbSYNeHqFj9Ycf6TzbG78URHjaEG4",
-	"2kQwFk+M5RUOuJbELYlBn6qrbhfhsWsVrr45TVW3/mrRVJKperp8AJW12m4tADTK14r2T21hVTEDffnZ",
-	"jBMxY0nc1oA/rk91G3FqVrZmQ+znQiSLZZKwTnoBxwSRjyTKJLhLN+yXqO1vp9iu7pE6vW6nh6KmrWaa",
-	"VQKTYmTTVr+dE5YkLJOntniZ3F07ITLfVXOe0AhLckqnKU2nJ/oVGHAHrSta0EHZV6S2ByMjd0Z53fwt",
-	"urvTa5r+ZpqmWhqyz0bh3H5u1oyuflf6q9p+wsqsxuJFzVZt0QdTcjWOoBMbq+cdvfLrr6r8aj7AVbch",
-	"jhcLsIeyLI0R1lYabcyK0e7pyRDNWUwS7d9ymY0JT4kkAlEGi4kXdM27O8Ta1eZa4xCqx4d8XFBt9zgl",
-	"EUvjoAc/1NfRci689QonNKZy6SxM3kBUN9oor+Wmp1uDqhg1HIDLQ1PcV/e3WSkIUDWMsNTERZwvdu5e",
-	"bdcYLlq1zgu2yBL9MtLRYTvHB0jAiVFrD+Xh3a1O5HyegagfCPnThBSUEM5AKhXk+bMRSSMWkxgd7x/m",
-	"//5h9/S/NzfUcNbQoZXKZgQcvNec3EBJAtIZ9umhSfjQXKGwJeOlJKGDA+IIr3ltpbEmMvPKsjSh6+hA",
-	"MWBVv2U4AX90hwXQ8qDKaID1nR/sPcCueYMQeBrSJ5zD787JXpuY4Ya4JEuka3mrYR4fVIisKNetpmqw",
-	"QQvN/owPsDAlxmhpu0AqqzHCGsflnLzwYsHZFU7WY5JSnKxPME0yrjV3mTvKMEsvOFHUrDuikxxQIOQO",
-	"mBetgUfRTVYl9WG+cIip15Vb805nTTFb6iJfyzG19pv2Ntaqae/craEfUnadosgryAnagaUj8RDtkZSq",
-	"/6oVeoWpQQrpJrfYNludQb0pBGlgRqLLE7JggkrGl0cRBY2N94JaQXNl+ZlkKFLtwr4i59BwtHtgNC2K",
-	"B8ETm1pdVoMaq0G7BHsPLaqDuN6sZmrTMqFdNh/T1ISnFBuYMcXWrRCWr5dj3UMjpzE+11Q+yZLEjM35",
-	"ubtx/JbhJUhZd6n1qlURddv3EyLAU2XFHVeVDJKGr400BPBY4qk+1jB/xs2S2N2nCZXLJ4EHg6OOej9u",
-	"6TZfCTd4WqUqfwvDntyEc8Z3WRxSkJ6dHVt+pi5/xInMeJpz68J0IZLE710gWLA1tDNWon0eamJZpQlW",
-	"wylSPY0SJVkjGI8hk5TIa8YvIUadZdJXvfrymapxSIS65KqTgCgBNNefLXCVepFcz5bBBYQhuWl0iB/P",
-	"N6obmZ3h6b0wFz0RR26ik4b8C2Yt3C/4IPwFNM1NC6UW3+0OePO5g28H9s3aV9Wu70J53qwfD9NmNdy+",
-	"MxZLHfJGq0W2iq+yQpWakMEVghXrIB1aIw/ThKb1tT98Ci+wFVI6r6ur4lZzEQAZ6diGdtTo5rD4YVgn",
-	"3uXCa0wkpok2G7GUIKyYj7TCbpRxDtoYCZ6tBnJKifQn7nnnL0oYqUX9mkuMSEiegYoFTViSsGvFun/I",
-	"n5SqdV/vgs4FMTAharnBjhrHPpsEKyhJs3nAwIOFPOM4FXrxaB1XVOXgUrIALWas0tUlsWZoapHMDapG",
-	"kjJ1bxcE7xhLMlJthVVENbca2C6Rs13a643q54laI7tVeMwyaUbshhf24R3Dyyt+DQHcMog3pma/ZnVM",
-	"a1NXMo8Gz1fjGgt4hOrIp2yhu/UVLs+fBS90TrAIgp2hx2NOyeQJ0iVynY7t85HoNNOO+mnbao0+2rQy",
-	"DJGNm0S+h438oT1QtjDPIRAWm6AznpEhegXSAjLxjr49X30fDAdQwIvo7BbAWRqdaav0q2269LPryZ9l",
-	"DbKZcUvIKYf6alpvNvbhOBgOzo4P3xEOChwd32k/6CclzFm9J6tFc3Gt9IdlUseYCw1usUwj+Mc7nFD1",
-	"X2OkO1C8f8qJUJt/voixAalQN4Etepglki4ScnSdEi5gXFc0InskYvM5FYKytDtsxn7KWZLMSSqNCOjN",
-	"t/KtON1aDYfXRG0Zt5a1Jdwi15YoDicX7oJLr1a89kNlf/yPbq9eJYRIuwvwR2jX9G54e6d/8HdQ/9J1",
-	"HzWZT+i07FTaTTR5TWWgeqs/orsHNZbmDQSaG/T6nZSLUDWzBlVopT+5TAlhHreXQQPvqg4oA/rd4Nxw",
-	"HDBLGJyW8RBYlA/FdyNoCkD4C+h3uY+XtCK6kQi/SMKCZ+hirNBR0ehSWoIirp9bxgKehUZ0mIOZsYoM",
-	"+8WtbXXRFpktcchSxWUtE8qPX3HSc12sHXE0t9oyZCq1a0b81oMYM834ndWZaBbDWbr/caEYeVBEVd8R",
-	"cQVs2K0iC9V2nCVgj6ZzItYuUjVJU4IK9J9/IPP//9lGI3RI00wSsY3+84//oLmxdW2MvvpmDY3Qdyzj",
-	"lU9bT9WnPbxUi3bIUjkrltgcPd1UJYKfNre8yj8Rcllu/fnaRXqqw75IjNRGYsnUIEaq4LYzx+F0aWzw",
-	"xqFPNUNTNFNDdu2RKwLKl4w/Uf3+Z/SfbXSC09wN8D8boxf/gYXb3EI7h2rvX6CdQ116+J9tBF4ItvDm",
-	"cHPLlBYSNPqbW3KG5rCGus76f7bRqSSLfFjrto4eTLnGqfaGLs7lRb4kioO+8KpcpPsaIU6tHNoYvRhu",
-	"Ph9tPTVbGuSpu4BzoG/1g3TCmgy95ecI2MG1t1qMNGCCRRg1GxDGbS+Z7rxGaKqJEYxe8HIr4rZUzvwe",
-	"WZA0Jmm03J2pvdsjEiBj9/Tzrwphot+F7Sdel4O9KcQSoEXGF0wUkZXrRhFECZrQdEr4gtO0xvqckmvk",
-	"FTKqMxC4JDr9bueJew9BZ7Fi7Kb7GtQfzUp+IMtwh467XZKlBclYWq+VvHFjxDSdWoXelMrt+XLEyYKt",
-	"zzFNwy7CJdbo7UJxfMXl+dC440rm1YLYCZnkL8gVNMqNbfkegVpfm8aEk7iwN9ZBEM6pDlB13vCPBCIf",
-	"DTR4cYtKxs2CMNktkqHUldkN9WVKJWIcTAq2lNldVT/svt1Kkv6UzQjcckQakdoMQXWfk+oQiRne+uq5",
-	"qgQjGrN4OUQ/vBAmsYNTjRlPnvD4EizkuXZi2pFddFL+eB3B0jWyViZpO/hrLKyb1JOu+qmqnbW8je30",
-	"e8zZmOhX5OdiWaVhBHkW2JjCvZOCgcmZMSbQmCLQMXkArmS6uy+mpOffvp13wIXCzEcs02jGWQ6VkBO4",
-	"MJaWMqehxCDM6etziCK8kJk6sVX06xBDOiGT0IOA8JH+PnLMxz9tSvCBs2j8/FUPQ9CDOvunHo8ZQvdH",
-	"RTPj74QrqMWclbfHDNfzD1/MloJGsNoOUs5i8xadXetyLWhXUjuiojckhOLAekwSAmjVFkXCoYoPJs+3",
-	"4sn42eSreCuKx+Nvnj795unzrfFXk80Xk62IbD1/EX/91fNn34zj6MXGxsbTyQbZeLb1zRb+mkxeRE9h",
-	"fXqv9b+R13qu4etuAjB1buCP/qH29FUwhEMwg6tC7ZP5mMRxE+ZfGeSXCmQruVgkxqRxIwj7iqT1cXS5",
-	"LcqX0tqhbXFcc/vZSKqJD1Z8PaPRDHzIoCbqjKAL6QMC3Pyt68WWQdYMVof+HbBX3RGsMxWIZ4ABZiCd",
-	"DyZonOD0chjaPZ6lFt4ZoJ6hTfODBnstQzHfOfJy12MURjP/NKzH3s3tXqaIw4ctr9rNoXgbLs4gVKsi",
-	"VY+WhrkB0J2+YWM2icr5L2KRhjQMQhew5DMD5NQSKHEA3rWkizZqjcZj62setCBobyiQZgpo/HdhXW0G",
-	"t62xtdavqhaH6hZy1/NMyM2pRg7T0lwgdNs88GqTb53YF6BJt1Xbbpu3crGfxkkKFnIILHwui86R+Tli",
-	"aUoiY2B1mx0KmwTF6cFemKWZz+hgz7e/l3oIE4aueehd8SV6d0Kp68WlyjGsHrJKarf2bwu5kiKcglQj",
-	"tBcyhHPihP6u38Mucxbh6lGYDN2YdUCpqjZEREZ121VMjlMgzdKsht4C1m+lb0AM5c2wCYJAB2ifMCgu",
-	"mh39vIAlLyHMpzq/ZLt44w/lDOqF3YZ0k92m5LVT5e0uSEEfFqF6qExtTuSMxcUj5b/fz1MClm+w9EeS",
-	"8eUJEYXxNVnUm0bstdxUrNirW4UDJRdwKpfg+FnHkOrLVh6+BZZFbQ3jY7ggXJ0IHXl1wztgFLwDcu1z",
-	"uU89oluw/vrJ34z317bU4k6zwmLmVGchxc9TYS0xvrOJ83VYhQ5DE8h7airjj6G+nBtdfZF83NVlrXVO",
-	"MsJJHYlWzm5ZrQe9gGJLLm9ONBAmu6qIk5M3iDf5oFuEG1XarVX1fqRzJR7OF3bupcavoGYuuHbzArzR",
-	"qTL5afQWWXlbLua3WecbH8zqYDofzdoLwPMqcvQdPp43OoqlY1EzpbqT1XKGq8c3P3ZvsJCnhKR1l4b9",
-	"Xr4ogNSE+iB9KsS15y+p7ahqTzDyh3bpJKkNAFEvZVqK2uxuMHADqKegN3RComWUkO8Yu7SEYyngJZkw",
-	"7jtx7Uwk4d7fusAJGTPml8h/WIUyCkOpdB0oUx5NbTP+AOva8cZcXZwbPXsSW/sOHoxlU3Xe+F1JC6W5",
-	"3kxQCDVSx4j8XLuhFatKBNoT03CDontg8ZcVWVJp1GWmUvpcGEXge2hoLcWK7CkImpF/KyJk6N8fDvTV",
-	"66+jTQXMDz3UxZ8N6mI4MKqvbjtoZYu7w8gIuf9+Luea+pEEjdXgHUXTKXg/NxwWMK5Z5MEZltqtqiRu",
-	"dUUDaLIllwbUdblPiGDJVcNyW6RKKF7juAFzdNCpWCCmCqPHKUQnT1DK9C+gHVc/Ygi5LSRB9l23HmiD",
-	"7dyDG7zg5IqyTByustFmj23dZGm86OIbbrh2EUiy+sCO70zquYilk4RG2smEm4n5C6Dd/GA2kGzM/gvm",
-	"tUd0Ys4PK7sveGOrJ7kjEQa98b/asGGjstKaMHR06hSgtVqXsBf4WaGRPMYWMY7OT96sdQvubJ7UTUTC",
-	"o9POU3hXVHnbadSDY+7RaS3cTAzfym0ZZxbtQLWNN9bW1p50XZpipw0LBYdtRhfab/GzcPbyGIJHPiXX",
-	"DVwuJdeGr2l+57ibyd/YjblZ1tDQkS0S7i1lKenSVf3Brd8pF+yzEmE7L/s2ZZTJnd0uaRTHYRUrMRWX",
-	"t6mfJ9C+WQtlV7xFNnCNmtF1XdpmGheFaAC92EWizrM7/oS5eWLscipphJNAcslVXkLFgfq5K6tf885D",
-	"X70BhT7bQYa++ZGN7jukaO2G6YDTpYnFKOpCfATWD5+Gxc+Ap+V9/tCADcFhOA5y1iUeNADHphGE03id",
-	"cYPUZX9dQzsSJQQrbpySvPA8E/C8MC5vccnhqzj67QFJryhngC797YKzOAOj4FBSwr+dcHA5jQcVB6zi",
-	"JEPWcDscPUtIGV9AifVgds0qaEUVNfPU8eGe04QJ/cDCjykvLonIIZpd4LOiy291Z5tDo+FYzLAg//Xt",
-	"MUljmtbmJiqt1N3OUfvNdZpjkRi8OV6S5aa2rG4OL8ly67/0H1u1HqT1TAUOhViwVJDVQXWgmn4KwzR1",
-	"TLt73XvEp1chNg/BwfbTT1VLfrFEvReQW1wlKl8TTpBBQp5k4EajGwq5AVWM+oUu65lvk/RZkj3rVbm+",
-	"N0i3RNY3SIxTC5xReRhELn1ueCAl532xCuhXNVo11L1oh93HkaRXue+CMdqvqjqyLhlBsMeipm1lYzyA",
-	"MnQch3nGlAMLS9xFDa1wgZsQvWJqse5rUArSC2bNBI+3eEUGcOZ85WJrZBCl2MNSJKN6Mh5rzBzRBPit",
-	"dRwGXac40worNrk4zDiylGptyVADjDCeZ9uELHZDpINhZiRJRkIuE51403YG44fe8RTTVEgLmJIsUcJw",
-	"THQXogJL9LyIBrQx+gaPft8Z/Xv74mL0y9oF/N/PFxcf/uviYnRx8Y+Li399+Ofj/9Wt3JN/Pb64WPtZ",
-	"Fwx9/p/6LCBNnuZaD5nnq2+n4HOvhstfVsc0bxZmkFf1jWdhQ4bwUtQbtotM3TkGMzFNtK9lJDOc5KA3",
-	"t+XS5i3uM2tfzF6BN1U9jQPnE1f98FZuveTH2B020u2Czk4DnrfWp1GtZBBVCIeUVTeEivTvqk7MPncy",
-	"BA7vR2SsFr/h+y4Yq/CNLPzWKeFuLLno8dujs/1tbYdwoawGFa8M/7dzfNA1Vsx4FP8qWDqi05Rx4lyI",
-	"nVXtRobAFe9IV6dz+H1Q+7CqeaJyPvSdYuONOzSQly/eqWEeUriyVuYeurP4PKWynm8YQ9MqvD2u8SPx",
-	"mEVhZYrMaRDmVf5W+mfJnWygj3y8+c75pNcgn9/YRds7bTPM42vI4ZbauH31njHRAG4C9+O6bcZgYcju",
-	"wnk7sDQ3s8hXm2hxDKr6AR0Bjg0oa6Ycay98q7/xPSuOmXrPxUeTScFRaOcaUwlwRcZ7WWNZgcHiGGdi",
-	"RWN9YULe0CrfvNEGvhYVUIVPVW+RwufCNAPfy+4DhY+hxQgUK69Pvp0FttYNRuFoYV2+9Wnw8PDJxwUT",
-	"+X0DUS1rF+k+jmYQFBsxzkFTEGt4vfwZo4+FiQiN8AJrnN21i7QdkEFPonCqIpYkYG/NbfO1Qp4aZG3I",
-	"gLqPdyA4x8QMBA+hb26vacMrAUCo3ANRr8BF5C0r0gk59r9kTKKDvVWa0ngXXa6wCsTGJ8hJqpmgXu3w",
-	"LI8cpzy1nLLj8MpeAP6CulWojmJY3L56vlV5rLR4uS+gJJiF5jjF01ybZTw2xBDRNEqyWEMTk9T+jsSM",
-	"ZUmMxgTF7Do1D0V1jxjM9YBjrSl3quFuWgUrPRlX2l3uN63/qWXZ4hsZJ/WY7tRZzb8ebbj23V2Phcne",
-	"7HqsNrGCu1q+YM5XbXHG9jAA/R9l8mhi/u35KN7EKlMYpNdF4Kvfa7ByyVmy+LVieHmXJSnhhrfvXhHP",
-	"elteJAMiGxcgbBeAvAOLtftuH135zSFyFQYKi67IQUD0Vg0YkAbq8Eh23+2Ptja2no02t54+e7KGDg/O",
-	"TvaNakh9e//+/fuRTVPnVR8i6zKT+x5CVpVEEq5z2jgfck9V9PxZQVOkevh5Y/TNhz+efbL/GIbzLt6j",
-	"ebu4Se/2azCBuJAHTX4COo2m8RRwUApq1dVTFuqr0elbGuI3qLCL93hGhWScRjhZx1lMTX6aIfIdDGrc",
-	"C/yxnagj3xJD47wXckzyuxntqgAemk7reYuv7Wl501iriI3BA6u/0wbA9CbE0KsDAwLnnUYlWJsW748u",
-	"YMEWGWL7j0/VNLJjTvClug4bZzJeogt/XBeDqtdyvnqi/CD8EwzejKl54JJJnNQcb/XJC7kP9dQRvNmI",
-	"Dn+m1TFP/6bVKcc6wlINA8Ra3v/ShIPHjYrLVlzGlaEQh38yLMeg9Gv01yD26gbgSqPiUuekCsBwYjmr",
-	"cxLjYKte6lzK+eDtbeS12TwX6COAQ6r3imfQ68ssNhG0JStCqUQxWy2kMGEThJOEXStpw5XWbJJrLGJE",
-	"gU4XBpC4ugxTzrLFy2W9hk/b7y/JEl6+JnIRQTW1xM4xMe9/DMMtKAE9WeHxzzujf+PR70pK+Hnk/v3L",
-	"+tqHfzz5l/exgz0IZJLz1KXqrkmZrHMXe1zH7pGX5Nse6jgDyjHLZ7K11aY+hq87Ld2XMjZPUJZW+3X7",
-	"uFL/wQcQiy4J38k0ea9itoKKRmjEmZwpIdE7WF5+FxqMtMjkrAuazFFEd2zRT0qOFOKa8bjGD9B8hbw5",
-	"7JLoobiMLsVhFm4O124wu11dPrkClkpLVy2qADtHrztvtkEGnjWlQ7CE5LJOWppx+DEac0EypFY9IZKs",
-	"IWBotkL+wrf5+8BRHSPASqdXJhyScJMCQ+s/sLbpZCmVayhHhXU/Qq61bfQfoQFWhc6bOUT/mesfNGaq",
-	"+mGmfwB0WKAfjy38a/vnzdE3Hy4u4n88+dfFRfyzmM/CPGA/jVhM02mXoH9iyuo7CTAbgIljiXOboNtQ",
-	"+55YJJimg+FAZ6fsjJ2vuzo2le3fL00jn3wI/V1nDCylH3UlRsZQ1naa8jZPTYUyIQbaDBFfBd8/kOKq",
-	"XKQhl7fJWqioUQ+gYE69GYZbdYjFiB+D2Lb5NH7+dCt+8fzp108jjEmMnz+L8bONr7Ym33z19QTjr59t",
-	"TaKvN77a2Nh6/vWzF+Po6282nn8VvXix+U28Od7wgb4iwQfbg5H6v5f7rw/eot39k7ODVwe7O2f76GT/",
-	"x/P90zP4epEeHhy8fPnr7kv+48HLnb2Xbw7PL69Prt/vvfvxx739jZ2Ph1s/bh3+/v3l0d7739/+/vbX",
-	"9z+9Sv79en/r7euT2du9nc2L9HD+/qu3Z/H8/U/7T9/ufT9//3t0/fZs5/rw1/dP3+7N6Pvfo68O995v",
-	"vv99+uzwLLk8/Ong+vDV5fX+9fvvfmD/PrhIf/91Y3fnx/cH6q/ff93Y2/kx2vtxurP/3cvD3acbb0++",
-	"P/v+6dufjhJCv3n/0+XLw/XD39nbvdfLw5Mfst/3N9Yv0uiHy+X/fvc9+fjdbxsfD9Ktrfe7b98+/ffe",
-	"248fr396/ib5cfqU/vo6vTqVPx6Nn+/sHO6w17u7v70+PXz2zcudw92LdGdjunO4f7578OPeKf9In1/y",
-	"ePeH6M3uLD58+fT664Pf5nvJv2cn+6/H3x3u7p++S58LcbxzMP33m3/+yL+X1xfpi5N/8mcLit9f/ftS",
-	"cnH5dLl7kP3+dHbwdcLez//38dP4xbcXKSz7/tu9hi3pwff+buB7FRaxGg5ftfrdpoivSbASei7XFs2z",
-	"ZIWVzY71ei4sKL8E6rF8sM3U0pCN9tpD+bP8foYFGhOSIttAGNQvB9use6q32MveQAPqRoGUwwXoFHSg",
-	"xPpFgiNiitm0kOixed4/GRq/ZcD1mxM+tUkCwRZjUVZjW8o7dpW1C3YHISh+HyBvYAuOpUUyNKEadkoi",
-	"8E8BVVao/5pk2l6fep+M6iIo0u+q48uSfNuqCwAiLjTqHh+WgGCW97uGqy4ZmKOCPanK2uEnSH6V82tI",
-	"faVD6imbOulT6k97VZHR0mnbofe8CG97/OuAv0Hgx9JgY/oM4BqLwtnvhjZja7xctqOwm7Id9Edeq0N/",
-	"Sh3yELZtwQ1cOQMLnx+vIK2FYQ+CxYoICJUiD4aFEOy5kwNYVaDvARL+bAAJd4VzEJbM2ikdYlOoDkpx",
-	"BfUZq5R9JGy4MzjxBmyqoibe9Hj/cATKAhKj4x92T/97c6OQNl/oXHM+9wxIK0WX8e6Az8MBWJxP2oBA",
-	"z/x0EGEwUCBZg3W49qtgKXpsQXUbAsVuI5btaHFsYm9il6HTuvpeU/X6XyySpU5GllsgQVWtzpDHJqkI",
-	"yZE19hO1n92IrcYTpKbgary+E+vN5fwbiQyey3tOlu20bOAovDphH6smN/pqtl1yC57f4CRf767bvMen",
-	"uaqsbndNkSYxasauje5UsWA49VqyRa9AK4WMNO0Tq4dLVlWG59rilZV4oL7/NPR1dxkd2VsovO3nJ2/s",
-	"7pwf5KdQQ3RnQsc16dwR6vcfT3SufcgjQVOTRhz6y3N/1Drl3VQ7WaekLK1X3kHtGnQiCWsGaSELVSwn",
-	"De+OL5lBfKLRCYluQBq66ZF3JEdhlOJdKOglPN3DEufD9I85KLSB9WM7dNU+mtBEmzHO3pyGD74ezCVZ",
-	"Ng7iB7JcqfNLsmzru3zYa1alOsROG9+dJXTgDBZuOp1q79+bbLo3L0VUjFNZu+R52R1btH71fSnFtez/",
-	"KmoPcAh8Q0vCoM5QzCOOORHOQ7J14uixFWpnTEj1gtteMC47uBQ1LJAbbHDnlfQb2OYr/eTyzBPGXQjc",
-	"7TR7ZBHEfLm8FNoxPMDMwzH05UcqJEYAC5heC82COZ1OQV6Dd4TqXFvl9HsFZCPAOyAT+lEb3AgFXY1q",
-	"bhs9BosZOJmqH8QTrwfzFWeSzdVbw0WwhiW9mz7/4tzbsZHXq7lZz0gIN7sCACatxO2m6nVpa/uH350/",
-	"/Goy/O9o6sodC0vPrDI2uFrHhcnGf4fK/fpc/GLGuByiOY5mNCX5OM32wykr4maVsvbrQ+fZd62f0y4n",
-	"JlSr8AtlqYPbtR/OXVRX8ZdKQYsiVvrFb7MagF/zc6nG7vF5BU5m9/i8DECze3z+Vl1geaFDwOep1NU/",
-	"l6vrX0st7FFxWamvfizXVr+V6vpJswvRRt6HSpCS960Mv7NHhbmQfUDkQLhSKXqo/LNDvvM+lFrd1UkL",
-	"K77m5veql7mrEPQvL+1n2WG5ssDlApURlwuUd+PoFNyJLd7XcLUE//bpWRp2DUBkM7RiQ+Z79ctBemV+",
-	"OzDBVGdYXLqO/R+PCZ/jFNAMvMNXk+3f/nyQ4uIHc83EeZH8hFcz++fD8xP95+zD//VUYl791Q210IAx",
-	"cJR/f4llNNujYqFxE0tfzaqRxK57parfrgsohlR+8zmV3o75H0srl3+orF3+6RhzUWlI/UjTaYUzQjD6",
-	"Ui164EePxmoy/DYk9BwMTSzdCRGS8RpkO91hJynmVBd1CoomL1dPrDtK4RfN/4bInEb/5nGs0Z7UD+32",
-	"jGZ9a1HIcvdofuGbDtz8h0acrRWma4Nb4OvIuI5FNsBlqC5iG/TiMMCMlL1cwFuoEMKhUVQg0b36Z+2G",
-	"t6IbhBNRN9FJJ8CEQKbYFtbXqONtRvZt4ZortFwGsa1DnmyBLqjBqay7cZpbq4ubauRZNS3W12ho1WOi",
-	"XZvNq4TbXWmgLWMssfIODRZrhFttJvZqyXAr9h7r0IwpmrcTuMRrmqmWDLdSvfU7NFiplLfdJAHURkfU",
-	"VvHbLVy3zZQSLFxtq3VchWLeC9ziquhM537U1qfhgKVkhZCQSuOdcFBqmEm32s2M8yZtlFlkWxv1xLlK",
-	"zVoqbGukkTzaK7dSa1sTDUd8laqrTbqRe65SuYaZr9zErQYRZtfdaLfu8myv3Swgda9fIw19+rASrDKI",
-	"jTVuLfZTyZWlJiL6vvxXXHfdnFZA49w7qvxlHVW8F2Dw5edGoXWPFHINLFgKL+Sq1rGc8t9UbrcnrNhP",
-	"i33F9Rua8yuaWN1V3Zzho/Z3mNBQMqeoqT6E1CBJPkr0+Pzs1egF2DF0gE1uyso7gcha003IW0GVsxE2",
-	"7UZoL2AoGDOnpl+fLVZ9dflha0Ioa5KC0IQ8EjpacugFXRkLD8Re2XwMaTYnnEboYG8N7WlvW7DYXww4",
-	"Y/Ji0JhUuyV79pzFpHGEC8KNzhmpsmvoPcuAx+gxaxCcueIIEzynCcUcsUjixHpIJARDmNXvhDML8bzx",
-	"/Nkz2GWsnbciOjcVdKrZUJ1nWxtPFJOTGY3XBZFT9R9Jo8slGptIM+Ry2YEHsWJibmF1/vLSZOCkqHkK",
-	"FHvrqoYXTrOeidokL3q1ICfBve7nTZKk1xH2kbXW+CntIqe7NJkbPOS6bvFuhaY9Vaj/84lru/CzfdB8",
-	"MCNcLUrd51Wtsph/sNsK74whlQs5xuB880c1ltuxnpqobhD9Vgy7fWVALnxLNfEB2O9ODuoFlC8ihgko",
-	"YrW4JV3lbmOVoM2w3O4+FeV2nR7qweT2vLtOcrs+nL3c/leV29tf7pVw6zHk/wpe9fAJpJUiGFEOzPAw",
-	"2FYNivIgvpVRjgbfFg6BQpcqI9nAlDui75hsFceERySVtcnHTDElkplyVri/QWeTLGmbWF7yNpOTZL5I",
-	"sCSNfvj+S+2sWME631JhyEg93YxfLfiPsyD9SDon8VEm2yYJ5aCh28zxxiBN3XtpQg0rr/HQHMYQaQ0d",
-	"TpJHCY7WvYXrxBaqOsG/BF/wTF0hxvBZaPomBNC2h+1c/d7Xu5kF3+FKF2hLrbgNiQUYk1sueNtCh3XX",
-	"D7/aJSNp8NZTxd/WAvr4i62X1EVHmEAkRdUEkNKJxV8Oru/d7W5D15BwK43Jqhucr8Lqm13U8D/8Jhuj",
-	"7oOeJyMF3f9JKhnPHn51rS0/tLzcFuFYkmkgFtu0oZ5BUMJ59eROTYA9//Leb5/ilXPr+6Y88w7bGIwh",
-	"rJZZLXywIkGU9Oo6/u5lm0xiBLY815NmK6pdLS7WZJMLz9np5FbUZDWE68I8W0N0zTp0y+h0UigMYS15",
-	"xsPG52chPaJHoTfIH2arlrI0V1FNSzia96ZA8hL+VZDAw9qeMiypXYzaI3GjxFhezRuckM5psaD0EBHQ",
-	"z+MkWSKaP2K8EzfDVwTsBxBXpa9ewNlL8ZQUoppoijC6ntWZvVYLnXXkcPucUnEFYLmdLFzpnPd30pwV",
-	"meCKsbqvqQykVKxchFMqg9DXOu7dwlxDDN5rKovJBJEOElsF6dXiu9rc8XRqj2zuMxMUArn73H6T5U05",
-	"LWFYsASueEKuaFPsv/6qBp3ZrKWt461kDHWDr/Q6rMOsHQ7STuJ1KeNm+2iMicvsfA3tfJeND1LJmTrR",
-	"gJEchI6oKZgD5wJ+KPW/o0yoO1LXRDvHB+jx8dHpGVr3M0Ct/6EVsr/Q+NM6NPLES317tJPJ2ZZP10Z/",
-	"e6CzZ+g/TknEiYZGfIkFjRCEHKvv5ydvgBCrhFvve16cQ1kem1I5y8ZBOSzjSQE1amBVxHhB13S9tYjN",
-	"B6FrzlukMRYQb160bIbbgjnruurPIRpnEkU4RWOCdGoX+ruB5TQ97KeS8AWnghi1eTsVyTrno9eKrhbs",
-	"BtKMYjD5UbHGXoP+anFQBUoZRN2ix4tsnNBIV3kyRN+dnR2vq/85he+QsvP09Dv4AzLXM6kzBueTUOu3",
-	"a3OJCTEz//5QwTD0CrZw7u/ykp/8NtsSs7mCjSEQ3vLAE6XwKClRZEersrdfSm5/rSr6dBsgSn8Y6jBJ",
-	"hqKEpZo7FsBGB55BxFDnuvm4rhpRVKsRl22ii802wlMDG9aT33ckmXuhbN2N3IEkyC+xIAE4dkgCEXi1",
-	"+dclcOYZ5tKIqFSgGUnmfu7P4J0E27LAdY5QRpB3pXIkYi+naEwWCVvObQim24v5coQXi1HeRaB/MLk1",
-	"SJkAH1fFvPOEAt1CaGDeGcZ8TCXHnCZLlBIBkdQ2pqWcBNwtty8DDNIpTT/CdTodbA8217Y2dQQ0oK5r",
-	"vws8BsOMHvKMCSmACNS/Btu2B8N81X2gP2vhZbBuftQ6gsExRIsPPn0ArwNOYFK7LEvlYPtpAZxDTXCw",
-	"/WLDLe5ukglJ+MFx+O2n1+sVDWa1toZXu6jgU6OkMcD2MeiB3n4jaAecdjhJMCBMw9T8tFIgXOt80Twm",
-	"HI3JhGkgQJ6D/OkeC1vxsxnrKM/7v7bEc3UczQd2RTinMRFry3mijqcTuNvzDPtnXG95EECueuAZu9yJ",
-	"qme9dGYnjdlqQdkxzwSYeedEBhC+xwSRjyTKpEZJ6vSUUGNrfE5IOieszv70p4YfR4/EoyL6+KP5oyL6",
-	"uCK5R7NHt0cg/xTKStEtmiKnjpMstce3+GMAEvzqHea3AejaT68oZym8aK8wpxA9f0mWI+3RsMCUQ1a4",
-	"X7X62aYgyFK1xmEY0iytdY2dq4UuUqifcg6nS4T5NJvD01+L30LiNMY81hnNkVimEn9UxEOFTklkff4E",
-	"mpuADtuTQAu6AJ35FBBOh4qiKLxFluia8HwQKEsVe8FKeJ2hUaS9TT+GTXbXjF/u0RovQPVRpxexiUL0",
-	"dAG7VGffyNLU+oeYgXZ4l2VhTXLx2G6vQmuu2jGWs6NFqwdcoc7+x4W6vYBXtI7LK1yFcEgRcZ895kYU",
-	"/UEuPAZAsGrrnB4hzPNM/hESB3ctNOXKeWI1vroO1OKxeIJU//rukuCnTBJ2rX1LQWWgpiCwpMJ4xsKv",
-	"bujdPZIK3pkBhlyvusDGV9HpMLRXNgLTgCNLt9Sg6op0FNYtlzmU42aoVjVII4WXygqvr6IUpwap3lI6",
-	"a6LiBAE9HF6LeODu0vkXkPUx54xJtLsTpJ+OqUgM5o72AgiMq1MKEnFJF/p1+45w97AM4DFd0gXiZM4k",
-	"MRoudOVVCENty0R0WoyzN6caJ8x6tncaumr9kiy7t35Jlt0bZ5d1+eXz/C+3Xv0VEsA09dUuGXgnoFn1",
-	"qZ6mHXWfqR5JN+2n4grHQTaifrX6Tq1IfqRlepsgWTIP69nGZpRz88NQBFF0mct315xKSdJb6055VXdq",
-	"VZ8GI1ws0wg1aFVFNlEvpcDkuYszAaWBYpURmyuWP5EG4D5Xcx1olZUWYwj6LSOQHozjOZGECySyaIaw",
-	"2EYXg3XFEdclW7cunf+C0t9C6ZDbf6N+1m3fw6tkLUXW8fUb6tWAYJy7q6jkb3MJHgv0XSXsmyrB7kCd",
-	"pbruqM/yF0o93r+Dqk0aLVgfq8fCSRLWYHn6gvXI6gwbFVfwLDbpXE9rToXqVp8YLcyyNNG5pG1VJcBr",
-	"j06jVcrXDFTiXKA5QASqI2rPlhbh4aUHt6+ZnJWYx0tLovocC6SoST0LYCREmJcAQOXNSLLQ3FhqDD0z",
-	"I0vsan0cdbWTeov6DtCTAqq4asDKzXRyR7sHJomrumi4pBMcyaAWbYGjy045B1dRVsD0DlmWyncsyeak",
-	"PL3i6HUZbXPKBz5X1ZVQ6YVh1dgz3Ko0Rs6rQrqrHIVnrlVbzTV1JZhOzarYhmrX4jhLktzxILeSHEze",
-	"Mnms7dUV28iRCccqGkMe+XUeraGf1LtQEAnfdpJrvBSPdLiaXkcq0CIDTw11ly5Bv1Gq9VZ9KVQC2R4n",
-	"nOB4ichHUM+lJehey7R0n4NheTLQakduptbHtaP+KLWlfjLt2SUNU1bA+mG25tNdUU3Hc1EYZl3U714B",
-	"XtAIImyiRLGj3YMR6LsoTmX1MAfM0QUaa52UR5IwI4uP2cxc2gemfRpsdkPDYud4qdiyQ5ol3KuYMp35",
-	"xzijKRZgGwOtS8LU7SCQMcwzPhdVPlc0o3WQhex8gzuXJjS9EX+GiiGwSRvK5PNeI/p2ftZ7A8rjFFtU",
-	"zHpAHdk2FO7yqGifp9Ph64DQKvvorMiwAWtlHcb9Cqm1CxcCIXpYB8wACFLIJE84Z/ywDp1V9Q4lkMFz",
-	"s1CnVr04wTTJePjxwzid0hQnDiO5E6wFJ5Ivd+2NWxzO20IQimaHEovLPAeYqk0LiqNO4SCFVSiPvG13",
-	"axF2Hn6jqzhV97DnC9vJn2X3r7GwG2/td9r5dI75pdY4LvKFMY7XtyQRb6Bd6OX7a9nBhShUqoP/0Pc/",
-	"nflvEXiffP/TD6ehvBAxDd/f+x8X2v5ii6AowXRuja1GUfP9T2ch2IOsgzdSgZu3ZtamQmQhGAQ3TF3A",
-	"H+QtxqgbC5Lxr9eX4rzusawWGT3+/vToLfqJjNEPZIlOiXyS6xfg/elrFYybjk1obnYNBg3JUrAz+tcs",
-	"0er+WL9ey3acTwPSaWcbIuEfXojmF1qpgIeKjdEP2ZjwlEgi1o8WJD2d0Yl0122brgUvaO0WUMP9vB7A",
-	"R+z85E04Go6KRYKX4Wid70pQ5LoscspY4H71MsIw97Pwnm8hL5GfXB5LKtAPL0S+FFQg00hYt874FKf0",
-	"d1ipHaFIZt6BvyqSPwrXLLWpFsb4d1SWxz41TboAtyR+fVgsY3nXK6A+6yVUc/uo7i/NknUj/0SPTMFH",
-	"2nopSNgoapeo/fospU3xd8weissXIhyOMsbRWxFu/uTlzm7J2yjHegmfWc4SstounRRrmDbqNGZuR4za",
-	"TDKAC1hoNYlxtlFN6nHrBU4BBZj+bsIzzDdQoGnrEli5R5wkBAviedRAfU78doVxY7erkuPz6g4NsI5L",
-	"rD3C8Zymo4tsY+Np5GrBn6RDmo4CDQwtYwhyK8cOtO9r80vlrl4Jw4GA3rq6kXtMS1f8QvGdslTe0MpT",
-	"yOip18Cz5Bj1Xq13YPue5cu6qnthftLbm/pyMZsCj1rfJzLf2taoHVM7PwChYwmBT2FUl1wrEFMhaRpJ",
-	"k9JvaNgOwdEMKRESkiuDnVXqq+RicEmW34IUeDFYu0iLjnokd0D6NvfWAxl+Sln6bSZGBAs52oRYfsK/",
-	"HePokqTxKj57ZmanXvxXZXaqALIRYga6Bn7T9jx2Bf5BBn3JGhyFvks5EXCVTtAcy2hmsj7jNNZ/5/4v",
-	"2h9t5+0eidfQ/nwhl+tpliSl3oWupmTkmcGIL0WHlVptu7oOy+UVW8hHeqv8jnO8UBP/45Ish7DHn7TT",
-	"WDg/Y5XkLNRL0KFUffEkVRsVZ5xslqmcEQl+9dY65BxafLcyRbl6O64wpywTLn4MhiHW0E5uQp7jpb41",
-	"wb7FNGr/H3mc3RDZgX0KwxzSNAvwrEOtPYXU3l5GKMhbjRI6p047n2NrAHk7o7r2UqQu23ohlSbhoGUB",
-	"FD5YIXyFaaIkVT+hFKTnwb9lxNDm0tnZJNPPLKfJtQmJjZLWgyDCOvQNAuKo0GwBotLVE/9KW/ZS8lHa",
-	"s+JGki/3rl4msBiqe1tQAf4D0JYalkExWjCdWcIumZlp0blBzdt6LynxSC2BnOEUYTQh19bHU+/pAguh",
-	"jp45tLDjNopYWyKdCwMIY/oFD/O0W1vKzUVjLcsmdqUKr90J5UJaZE4yRFmaECHQkmV6PJxEhLqlND4s",
-	"kCwvLWp5arwl5pimNJ0eSDKvUcuUIXDGQm1sKg1xmXHCwuubXjEotfzGncHkP7MbbaeiE7TbmpZYrGXA",
-	"JF5HgK0ILpqWs4GBqkznbh52UAJlKSS9BTrVC6masYuekIlEWWqTvrM5lZ5zqiCcKgnaePL7A/VQMtBj",
-	"c8mPSYQzQRCFzxDyOctScOJk+VdYApP4LsHCFHqSz4cTs3SaAstz0hNxPqs3monFCWNJDK9TnKKrzbXN",
-	"r1DMtD6FSK8PTeVKoEjVNqpJOM+ZMt2omf2DCEnnYMf/hz5t9HcTNBuxJNH6izWkcz4KKwaqfjkBTlnX",
-	"tjbnAzfgzvnXmL+6wARV7ozSdVZ9MAQd0M5g5xRZXpKlzz3Nla9DDkQdAJN2Aa1L9eccRPOQB2AgcMuW",
-	"8rwcKOnmLZPw3/2P6nIaDAd7jIi3TMLfwcdvHu8SmFcx+EIy3fEqWr2SvKiW0Jv0h/ZtEE1CoxZock/f",
-	"7sh85c3+BK4sB7rqZlXS0ynKLDz+IUupZK02v7ku1q688D3NTKX2d7Hf+odQgEAXoH9/JhAa0Nk34y2e",
-	"kxhdaQ8NeDJUVXoBm7sxilds7rf2t6j3s9DK34KSPaBVqRbKtfDOE7Soda3MtynpkAmRrZlZXcTxEFS5",
-	"NZWCBobhgE+ir58/36rdev25WrOavkOulrijvuHminWTb6sXnP+nehJoJuiAjcDTZqfGhtBdga0zyupb",
-	"tlaVbRotFC6YEhpSKB/EjW0aSM+DvYYmtJ6sSzMNipA/oXq9vFdtGnZaZg6N6CgBftJgvvLW0hiwtHQ/",
-	"oYSjx5lVwJa+ufTfmvPU5Ne9D8vAnercmSqzVYcCdWs9uYjYoils1Ky7Lqbfk/CmWM0wCTvQdoT1BdF6",
-	"dNX7nKYT1tacLdetRXWcdhNM54Vj8vNgAbpnTuJfXPjBh/LBAVOmj0viYhG0odU85tyA7GMN9JgukHai",
-	"mxBkqq0Gxgjw80VgDBeDD/BFCfWJ/UNk44vBhye3EC7LhoIyA/Y2srgPHkMtMcbaE1Yh3+Ctc7C323Ln",
-	"lEqUbpyDvd3O903LnaCauvWN4DXyhd0HhZVsvQ2aOLlqyfDq85M3ls4dEEkUKTlUrE0Zm2pn+S+Vc9M4",
-	"+nx8W63yLbn2A/HF7386M7z/T84PDVXfG7PLMeOqbM59U2evqG/HSYIWhIOyNg7r3LUK0agOBdQwSl/Y",
-	"E1NWu5MGBPE0ZRI7uLQbmiTywqBzMqFoBvYiGLEemcTuZ3ROhMTzGoMuoAqAR4WG8L/GwkwlLqiyYizJ",
-	"SBUO4z3rhO+r9mX0hbHOIN+9vylJvQwvlfT5oAyOnDK2kMAAO4dslLdidYgxEYp6DVAjOmaLLFEr4dYb",
-	"DMhr6ITgeMTSZNkRejxptUjN8UcbyPT86bCNGg61ecqkaQDPLm0I0ooyiOzTeFPWDmKOlraRRFiSqZJN",
-	"CHoMXA5+1TrDJ86gMbhx/J0urxrwprX1VWheYKQObaKXXwJLxK4BJEQnBIPfh4oBXkC6hHXNxIx9tsao",
-	"UDCLBCP2jRHJLKqGX7UvJeFZah6J3APsyphZdGREPu8OYbKaKZ3UhzfslD03fDC9kmq4z+dxd/k8utG4",
-	"25u4cdsL2med2sNe91WKiKgSVwKUUBSXlJx6tHtgQ1modt5sUv7FLLpUx6wGJRO+QtdVHZwS1VZLoOs3",
-	"1zDNlaXE8LStvGimGJIYjyJ6w8hd1V0eGGRDh6ohPaUbH+JFD10GOhtTp26NSizdjg4udWnbNG3pjrbR",
-	"BVTSvA1xe+ugx4ssSZ4MzeefOJXEL3OtftCFgLMvMjF74i+WGYmrHFy2MRYEArLCMK9wL1pLiOQZyE8Q",
-	"Pw46duGZyK2xNY+9gig/w1t0eB/0hF5mFMyAhhctqNpUJDI+wZFmwkJxGth9JfDqO0vb4RdyJRPMSzu9",
-	"/VRqbNiyBH8H+BosP9KNKj1T7JN2EFJrVPP8y+l/iWZMSMVMhujVj3tvAW/x4BjhOOaKosAlnzn3Wcal",
-	"fQT8luHlGmXDfD84iWdYwm/zpfs1YvPtrzY2NoZo85uttc3nL9Y21zbNLz9vb29+gH+H35cwMxJA3qwc",
-	"AIjAhtJAwBFLUxLpu4kVTkMlHn1oWvzw4GAjtw+oZxHtGIHqcS/FMo9UxWrQoCGahshu5wPfohIKFSvp",
-	"hZw3PSgLe5NEoCnwVt5VAgpLjhOckvr5utU0teDG4SxBC1XvS4oqCIRZ3ErX9QBWi1VjDwpxB48XnP0K",
-	"bybjzn6QRmyuWJf2RMcAxlaNPoBtAGaMHrFoMXqE/olsU3VxCOojODa+ookMrdjBxA89AjHBVBMWPoIK",
-	"4ytiH97gpRYTbr3HSv6iuVO09fyCFxZ6dEmWj9R188j5wD4ClyToVRW8JEuI7zdolkMAwTPDsaPBxtkW",
-	"PeZkinkMTmTW3eNJ7otmXLZMwLamJmGY9UgNH01gSdS6T8C5SapTZBC9cFqDk3O32soFSYWi/FqV5d82",
-	"nOLLs5I16TGDN6vHE6puW33q2r906lp/84P5Rxqze7aRUzhsoVyimJPW//pwqWkrvXZ6hBWOTp+o9q+a",
-	"qLZySBpJuvri8KWuKkW3S8LIScIgeokZu7YZRXh4rchHreENvSj2zTd0sOc03qUBdtD/HmMZzU40/QBy",
-	"iT0vjfqpFZFd1SSNIOSLKzjWefAXiQ650hnxIWVYjZ9uGJd1B4GV8lhHeDkQrLCXb3io+uhKhnAMkQ5m",
-	"UGsV4mOLplwtZcbRlKY3/2Z93w0bMeJtgY94eXx1qeAEj11QbmiR8pBd7dMJdiiD9+22SiCWNir585L1",
-	"XDjQqpHfLgZTIi8G6h/qotD/0oY+/W/Ns/S/Ia2q/qe2zel//8MoGcEC6np4spqcZidYp0Ax3NoN22R8",
-	"0iOATFKiOhpnt33SBWHJDGDoL2mIqPJdDd/DbtWdptMLztZjAxYTgMTKy9U36zeWd+F5A3S+Zj3ybLXa",
-	"eyMLrcmPGY4TIu88xUfHevsGG36FKucCjLCdywe8z7vj3TfiJ7YNohnd69OHoDnFWRDjPCXWuZY/HhYU",
-	"qGEg4VfxzWBxQXFwjYUTslZLiun1Gl5NnbUjHFp+kqfww3mCD4gtDwNA1l2b1bo21Mi6GLxl0ti+cWqQ",
-	"DuGKUuWtaoRdEe4BD+eYqYJH6zSNyce1X0U3acRXMQfn7b7aO9PSSAkTtZQDaWhV9d0V3uVsSMXBfaeb",
-	"q6rE9W91BFXISedtYimbEqD+oQJgbykbjv98GjiliJLfrzbHROJNKxr7fQ6Kwre2MNtWR6p//7np2w89",
-	"G51vGxoYG47noxFRaMNlqfSzNP4MFoNeL/E30kvkxGeDa3LS6FgvnPey5RFYk27VP51hYar4vajScN+M",
-	"0f9BNBq81GknScs7870646+qziidrQZSroCSFaP8i/dmS/heQ/iac28x120DLLxXVF0Z9Q4JruBt4/L8",
-	"8bWm4/FH2Fa4MMiWfapJZF4usVqS5uL23TJJcrGx22ZKXi1ZsQ3H3UkIlyeZlnTKTwZvBlWBdlYyOBcz",
-	"oQO2u2o7bMnO6nx59+xBsTInnWup1wNwwleE46l6EBuFDhsbJA+Dywkd03S6hl7Bfm43Z19rz6vWlFPt",
-	"4iL+Z10ateFg0aCVOtMwp1bZxCZmRjqmn9PpVHH10EpqN2eNBHZFOJXtSeT9/T41lbSXXzl7um3R26bC",
-	"PIoOAa3EVeis6o1jvlZoxj4pfsI81Q+HXU4BoWQwHBykE9b5bVEzlrzh2iJej7Vl9FC8Sf8QvPFP3CWu",
-	"7jjnI3BFMUx75/jAn/Suol9wbiCndKqGadXGw8F+ylmSzEkq89901vOByVs/GBYfIrbv02WqLoEzk/g+",
-	"vwl3Mjmziofgw70UvG9U8LVX1+7xeS0DW2QhJIDhYI+Ky1r/Uiouw7U0SkIt5kIthkL1hvPBDTpfdDWz",
-	"abvGmsbV4mlbsxLqMqyFaqhuYFiIOa3kqTHN6CiKej01tpdICDvDRidBIcRVqTV0ZEGp9K8LgJAynIAK",
-	"q9ReQQYv32YBUVyotzdNpwBlfYWThstnTOQ1IamdP4KqatwPcJ+4BJ0NuTnrtnrob0Vgxk3MGrhDLd9S",
-	"X4t6lEKoAuCLGdAqDbdvUi/kSjym81iBs4fhhdo04uzHN9W5FLhbg9ZF9e8/pm3eYWdKKCoLS+qa4UCn",
-	"gj4hV9QMbI5p2qtgehVMhQ8pWlxVCePVvGs1TN70rkENqzcUaAi6VnB8XUxofJo4i2zEHBWowDI0BawF",
-	"Y+TURlP5HRYBhbn61cqEGqcMCodfE/dj2wisWn2ig9YFg1ICQggyxY5XX7AmG4e3lMPCFhaG10YdVk33",
-	"QMo2w3WXabTyRX9qWHmvbvuLqttKfLRRLimp3KQBRH4snjipQ4NRNqpv6vMQa2PdJJh+mKaVFIEHqqQr",
-	"oWOd8grGw9rEmGn/6ZBApH2mU6ZIx9amSgTdx9HMgFsXm9LhzLYBNWBfKsuhfgt2w4Lw48fubjx78dCJ",
-	"TVtSO5bFr1D/Npybm1KB/SlMHyS44sSfPWsfiblqunKqoJ6lkAK1NLcGt6eAoNB8OG6g5fTr31LPiW/G",
-	"5xv0nMOBVfftwqVXh5DpZAY0U7KEcyJQ46gBe7cNv24AG3CNe1gCgba7AILeQF3rqKkQZjcxWp92vEcR",
-	"kDjmOMVTxVl8NHhoEtFSghtfQLKdRljihE1XVMfZieQKq+Lvu7ZVb/Kfycel0HlQAkzJ9VEY1QB4IrnW",
-	"yPzoMXVJ98aJDp1IswScvGysVSBohVxRlomGDmyRW/RiBJBXlCRxg8wGgLwGbuKacCe45Gw25+bunLuI",
-	"GZiBg8YwLxb9nzUbgWT/lkZJGVzvRsNHQS4uzit4suowJKtctaZkh9xZJ692kaqr+GIaYx5D4E5rNisN",
-	"3eEFKbpk7XlwUpU/3zSFk0XxDK14bSZnN7PQ5FeLujHZobKaXCsnLElYJrWqW6dACFuQnCA4Y9cgAEJZ",
-	"kxhEO2ly3VabCfYlltHs1GDL1EeV+4WqimUhOZZkuuyuVS612LAYfh7fAqn6n60pzUwaLfSvRkoDNh7w",
-	"sNd3geZ6Z3ROWNaKvGXVp/pNXtmmRmkpvLk66JVnMK+XWTwl7YMol4cE+eBfdTbjRMxYEndwnrXGrrDr",
-	"nB7tqd3Z4Mmw+661JYyaZE56YQxRGgm1uDP+mSySQuhknopZniF+BaCL3YJbghrZ6el3SHKcigXjoZy+",
-	"nF5hSX4gy2MsxGLGsaizabrvWg0uZseubkE2UgWvGY8HDx3OXxhSK9yDmTks0GXnKYQIp05gN0I61Xp1",
-	"mfHUqCgAHwEniblzY5Y+kraETnDhYTfdjdomcigmhRFm0ykBwA9wlTRDiHIME2qzkQzRhpMbSQUc/+lW",
-	"UBXY623uVG9Tk3S1i9NG/g7U62jjJWpAtrAIe4fMcTSjKant6nq2LHWgNtqIkReDVzrn68XAjMekv6Ai",
-	"zwBD5gu5NBkrIOFF8WGb543ZQScwTBQlmGtoL+vxayYLZDzO1PkiOnUGuyKc05igGpWzaD7INkbMLR46",
-	"ggQ82+hicKovo4sBYtyf6b2TjRLLRjiNR2ZJWwWykPrOTNywCUcBOdGFpJVT8HCPdyJJr4haIlL/SJvR",
-	"6WyUqEkhNVuEVSW9pxqjzw9qgwZhFAnDsX500tT9rHPwDoYD2wgUiEnhzzmG3CI4NXFxEyUk6E8me0vH",
-	"p211ljt2INVPJ96Iq18P8jlUP76ys6rp0E6s+nmvOPNqgcPCWoRG7a1O9fO5Xa98z/cBvKFlzzXCQ9E5",
-	"DjZ/QpPChluMEodWMuJZaiAjE5pewif9D+8LTijW6k2hS+h/eCVUzzTSrwHbA0212nXgwCfhZ5CQqAYp",
-	"HQOmlqWS4WA1QvGWZt/Nq/bbiRtstcgbO/W6T02Vd8zqVL8c2vWq+9TU7Kld0uqnvXyRqx8P8mWvfnzt",
-	"bUSAwLytqX59icO1zt32BdZe3TE+Ob9hOG4hZnWuO5CykNlYESvDMUwnZXI0YRkw2TGOR4JIc0zBgAcc",
-	"lk898r0pf3JTONUjKP/8xo6o/OEtk6/MAMufXuL41I23/HHfjL/8+6GdT+VDie7chwB/OU+pzKXqMiqf",
-	"40xtInDNDVWGYQ1eWPUilcVdUgRQtDsAbtLpd/bFEmMyZ2knCwzJqbPjpMos+JOmulWaKJI9vKjHrn7o",
-	"CFzrK3wIUzcpQi3ITH6Nu+XgWZra2zhHxX1W9IvCo983Rt+MPvwz6GirOgqPBo5fDsA0RBfq/RevGSjl",
-	"i8GTUiid97FVRoJui1RS3CN/sYcFkvRWMSQ0ld00q3MrFih6Z/kwtcgqU+/ukdi/174If6QSiazmklSu",
-	"fLdeSaXWwxFigULFMLFSgYcLFQt13MmyWT7WvRPLX9WJJXT42ii8Ej1W4ONGd1zPzrVNNpwiHAy61zMm",
-	"8gYsRu8E4kxYu0ZAt99lso7DdMOJMIp/6wJ/y8AqvU5342xgqHpHNiQ4KKSXd4t7jYX2FPBgC7okO1jF",
-	"M6CSQyS4D6t5f7gJGNpbg/2lc/JvlpYcD94wHR1TGoNak99ZSjwET2E85DXy887bHQu9s3Oyv7P+5mh3",
-	"5+zg6K1Nwq5+LMozOm2x2mnGEYsITnUeaVvT+SiowgvMJY2yBHMkqNoJKmfUuGlgTvAQJHMj8aEdyIeP",
-	"19+S61/eM345RPuZor/1Y8ypjVXIUjwf02nGMoGejqIZ5jiSimvauWrsT5EtFoxLEqPHF4PXh2cat+b8",
-	"bNdImRX2dMYuSephQq0CVe4DHHIXCxRK0vQLjevSD1rmnm9VmyuWelwyzYljMiXpiHyUHI8knmoexPh8",
-	"sO11/KnWqLBTQPx1xoQCEPAv8POUY43L0OzJ0HFoLCZDNle8QT3v7fh+0XajkJfF8Q+7+3p8tsxdjsV1",
-	"XBoUTPqXsDnfbB4UqVrytZruFyCNcmIyWNCwyqB9uN6QNJ/SyppfMk5rx2gLofOTA/TYsrbGnUZ0Ukjc",
-	"XYSGNrT+5K72wJ9FaQuKKxlwtIPPNuM5gJl5Fe6WbAtNl8YJSKq1OwBf72oY0Fih+9KF5dHI0GMDQalB",
-	"cz+d3u927M+0Ec7MULd/FnlcZ2SAQmFsQFBh1VXXGe7VotVX/qVRj1RoyPtUA1S4oJyIX2hIJ6DRblUJ",
-	"45qo7iea2li0cCAGjWsX6GBvFx3smVV+/P1PZ0/W0LG+ljUwsXZwgnIm/wBJaZyTXMBm2HikHNPwTlaw",
-	"HfhSwx31MpTZ4kuCeTDANWSq154vp9GMxCY6vuxmlKdqFqaU5WlMyVcRitl1aqw8IKtoOVAMDWuDBxud",
-	"268ucYPU3jaBp2yr88suZ2kxx7iQmMvXHEdkz4u57+rFIz2pr/FRa8tVHk9yEBxDiBmcC8IP0gm7KT9Q",
-	"JGjbqGcINUd5v/kMhzMEvcqSBDSLraDzgZeLGmoBRfTuMHQDyQarIo0t47IMhg3d2TjkDKIVCkWZscOh",
-	"8jQxxV25qlNyvqbSfwOHU+DVvJxsoyFieze/czzDkrtUNk6omB0zLhvUSDMm5Eiy0VQJNDpli/E/FM56",
-	"8O7QBH2QVPKlTj3oPabMO+pioNpS3W1DY+pf1seg+mV9wZlkEUsuBiYtwcXgxcaLje0XG7aS+XNdRgvz",
-	"eHG06WvlN0bffPjntv7P4/XHMlr8nyxe/B8RycWTJ/8Kquor7rvl3fnTQC+WvVreHaJrxi/BxKfd5l2i",
-	"wFcQpLwrE4SnJJU6u8K7Qz8iZ46XJvchvYIAQELBhUun8TzaPdB5lNbV63WCI3jqYvViVwO1Lt3mqZRK",
-	"6OQV4/a7Ba0XOuJogaNLPCUmU6UJEcLoh2xM3lEukfqfDCeH2k8Hvd85fKOjihQriNHVfG2J50kwJaCG",
-	"yzwMRzzCzyXQI42LegXVugZe6XYg9Mp4BeVpuUDQME9tnaNjUQ0iH6wTGa2nU5p+XFfDWYu3OWuH/g/H",
-	"3QDSSpRxKpenkLkIRj4GgcKme9J/vbIanu9/OhvkWZHM17x/QG3St0RdDpvz8zDcdCFBoud0j9AhXkD0",
-	"RglAO8+AumYZvRIeB79lBKKPTJw841MlqOcHdEF/IEbAp+mEGV2cxBHsOySPHWwPJMHz/+XH6OctnrmD",
-	"gUxmHHRG8Nx4eZv8UNvr64XaldyXPxeb+PA4VO2J0Y3ry8G42kYJ5hp1U5/WOSiQJloZCuouEk+Jcw1X",
-	"YpicEcrdKRdrFym4lETESCRmZjsLHM0I2lrbqEzm+vp6DcPnNcan66auWH9zsLv/9nR/tLW2sTaT80QL",
-	"WBJotbRIO8cHg2F+KQ4s6MEnADBO8YIOtgdP1zbWNk2oGJDjunomr0fOG3ga0gW/JrKc3qSSxMn5rSnK",
-	"BC2NcTEGLGeQq6DDrY0NSxMmMbPHpNZ/Na6BmuF2SdtsegGCKwl3P6i5P9t8cWf9OXNWpS/IcJWynImR",
-	"GDrf+uYBOj9jDB3idImMTlAb3PQT/OdBceN0VjC96yV46dqth3j6VhBrQGLK+zJCYpg0XhN57HV+jyRS",
-	"AucOrF4jPDds4sbmA2zieWoVVoZ0/pZ0Oxx8dYebX9/1gU3Nq22aSPsbdTs2iqzt1RY8M8VXpUMIRsec",
-	"fbRJgo0+0uK058tfl0dKg+BITsmVhnX3rTLhU2aHcJ/nq/IAD5F2abT9oeoPVflQXeGExsY5LHio3pkC",
-	"Sk4tHRGn76seAVsLRB6O50QSLuCNWBWdQ62qU2eH5kTgGcExiOVWrvMtDVZ+x4E0Fxpx7Z5OYhNJqJnA",
-	"NPTRe4hOX+LYkuDDnfczE1Caz7U/8H/SA/+HvdjUIfq07jT7CxbMZVZIzPdRoxWFrlbfsC1WuF0fH+8c",
-	"mjyhT6pmRmNnTti11iOAbdcoE8KM58yYURu5zlsP+aTh2s9EzntA1+A4j7+GA18poU11LYwIFukli5d3",
-	"RioFzwS1135TH0fX19cjJQWMMp6YQMkbt/2pPN1P98hbizbHWsbjDAh3zGVbuy8w2y7Hzyn+au9beBb5",
-	"IK9FNKAixavCflnRRvk7qZd03BZUtA7qJYc+BMgizr9QO75rLal2ZDRnB1pQDYDico6lcasqFHqk3YEy",
-	"8khDUlgVoUPCgCeu3cI6fZdtpPGaH1ZjG122XI1lKTmNig9rHR1LYhuca3TElOv0tyWoFXJF+FLOTKKx",
-	"0ECh1qmHkPFAo9VwH0PLHWk6NSGNjKslviTo0bePhujRt+p/cRqjR//17aPcy/6SLDd1ruDN4SVZbv2X",
-	"/mPLmhMCM4UebzZTRUlz/JHOszlKHeyeJTw3SUMpRQIBMjSxGJBqRxhVSR2hFaojOilSOeTu0Y3a+oZ+",
-	"5xjWNkVjkqPqQKZod3BATS+ysYCof6lPUS1l0DnEH+TrVAm2Nmsy2N7c2NgAzy7950YAk+jDPSv4LE+p",
-	"098YNd9fV6itPGI3nj5Ar68YH9M4Julnl2QfYranxgRwnjo1YOUiXTi080/DGjF1FzIlqidq8OasXpy6",
-	"QgFL/X4ks0IXnaSnzXvsO7RqNgwXut+DfJKFin5uS1i7uFqmKHU4w8v/OKY9ZvHyv9etZWsdvqsBvSay",
-	"ubNpqcCNezrRmUube+OBQjfs8VPPHO+bOW48BHPcZekkodqY3rPjMjv+OMoTxg6KD5zKk2f9D1A5aO6t",
-	"WEjIUU/9vgIf32vjRT+3IZ8GO1Lytx5jjQLgZg//B9dA9jLaQ7ChZw/Q5VsmkY7o7/lQgA/Vu090ZiWv",
-	"GyWNm/ORqQbW+rMzkTZhsWclPSv5e7wwsYwCPo7HoN3szk6g/L0wFBjgnbKUrs/eEXT9zxU9gVSdz2Q/",
-	"6Jna35Op9S/Dz89Gs4BEpgO1VuCiJ60KmZvz0Tx7z4Mz0vvUHz409/wcGsueafdMu2faD67Oi/JUt0Kn",
-	"urUeP83uDLUpctt8G2or9o4OvaND7+jQOzrclnfWMpje66H3evhs93LtPdvBBaLDZVvnDtGUyf4+3jb1",
-	"/T2wo0TLQDp6TdS3UuNC0bTeN/enWGEY06bSt/W0WGEcvK3GjceiFQ61De8slICr08sXh5R1rNh7h/Te",
-	"If1zssu1VXhbNrwkmx+aHZxIYuNE4t+EyBxflHOUkCNJVw7UqnRsv4R7F5Oel/V24S+VmQV1XZzgWOuR",
-	"3CM6amAoFfeTB+Y+d+aYAgkdfsvIgcacAmn987zaewbVM6ieQbV7sdxISQB1H5hH9b4uPVPsmWJvQ/1i",
-	"2XAWlBNB3VUSFXc7i4onq6nL7ogVfxHuMrdUKX9WbvzZNdr9jdDfCP2N8CWpQdexZ8AI3jXaUEEQQKym",
-	"yybRvyrxn9/ICHKL+0YyhIsD7u+bXvrveX3P6//KvD7n4orpa4BrHAEY+DonItMpIcJuHyfw3aFij7Eg",
-	"MWKp9unL3exwGq8z4zvnfg2526vWdII/cU9eH7p13dNnYpbFIdTDe/V8snf2uncWUjjvgw/ANcY4sknR",
-	"oQ399tZ4wpafmCNvOcSnMr8pf3espcVZWx+ONs/snEf0bti9G3bvhv3Xd8MOkM+YsYTgFE0SPFUkZNJA",
-	"IpYmSxjofI75spjpV6yhn9QkYRUZgnebTY2iVwwW2WbBgaaAKZjGfPR1ne4V9oJdp4Q/0oRWOBKP8uUr",
-	"p32FnHiPTMOqqUeIChhR3ZJ6ZUMEaNYjtFivaKI20MlpS7T7bh8d7Jk5aBIU7rvO/Xx0qrMMoZhO1fN4",
-	"hkVJaXyVJSnheEwTKpdr6FDxxTFBGB0enJ3sj4RcJn5mX/R4993+6P379+9HmoQiMkSQUkr9vrWx9Wy0",
-	"ufX02Ve1ZzC6IgdxYepz/NFmn33+bOinm1JNQq6pP559sv8YfgpkmbpXXwF9UfXu/L2E95klvC6++yXZ",
-	"q85RXxe71/fZQ7vg+7128LeP2NykijEVAy72lTI39iLXzqH1PXlfb+O5X9fB1H26fetvsJCnREO+1/Ti",
-	"ity+N3Nm6vsyBW7T0wlJY8JJ3LB6pSK3jWyo64kXPt9NL3UryAOF+liEPhahV8xW7tyQVsRXh6yAS9l+",
-	"Qe/VXwatdrFS432EQM9hegfcL4LF1MNPtnOM1zXS3U3YxReCNVkv7Pe8oucVf3UVQLNnfiu/gIJ3xjF6",
-	"B/uea/Vcq/en+RPyySYAyXY2edKgjLkJo/wi3N9X0d0+HGN8WD1xz4l7Ttxz4s+gQFv3TS61DulqZHGW",
-	"EM8lQCu6vLpVpVqLLedmqrW80S+Crfur0Mu+PcftOe7fiuMW2WuA/SZYSGFMu7UKSXBQw0IiVRJJOidC",
-	"4vmihk82aCtrrMQ31FrWjmui3dS/DI2mW5MGUfhZdV/eMrRrBtGz0l75+bdjbI5xBZgaN64brUzNFjQy",
-	"ZZBzNfqB3IZzlTq3Dpp6ne+ShwU9m4FvXqbsOnUDeUd4Qa4tuXFC4ZNi2cGf1RrU88xe/OzFz8/OpR0n",
-	"DnBp4bzUGnm0Lqb46Sp28aB3W28d75ldLyD+zazjK/MQz1Z+Z1ykt5j3nKznZD0nu439emVGdtLq7t/b",
-	"tHvW1bOu/sX5F3pxmlelem+SlLMkmZNURiyd0GnjUzMvXIgWD70w913RXd3uCkwVdwTO1FAXE0DhQVSI",
-	"rIgLv4YOJsjkWYyHDgBDLZyOhJ+R6BLRNug0EzAvwp1AYDzgE1CBIiyIi9WnVoNpMBDKK7KGDlKEkwQx",
-	"OSMc6upBeqvsd6ShEGDkY4LIfCFrAQoiwT+b0rGy8T2n74XUvwnfzU9uDlZWZLLd0rrmZ6hjOtdKhR4/",
-	"qMcP6vGD+jSud3eb9+lbe7yXP+P92gb9kjbcpnUwMJUa94QIU+3ngcFhagbQihNjwK+r1StwGriu5C0x",
-	"Yzp0HdcUvA0mSodup6FSd9pnA/hLXdnbYqZ0mDevK3nnfbdAt9zxGvQoLj2Ky9/kJi0oCwOP1vBbdgWY",
-	"l9Uu471ODLzVPlPfZQ8E0zOp3nLS88U2vliPQrMaQ3vdLhrekpt9IZ54nd4dPVfrrQR/Iy1GI3rNanwG",
-	"Kt0zp+m99Xpu13O7Xob7YvhrE+rNauz1pJum65YM9ovwIbyhBvuz8NbPpjjv+XrP13u+/mfUWd4gzWvg",
-	"qqjeEDvdrF43uCG+uESulSm45Laf+6awA+n1qr0GouekrZy0mEy1nqWuHrJ8eyXqzQJ3elVqz8h6RvY3",
-	"U6XeiveEFav3wX169WrPAXsO2D/D/wrq1Vux3JNVnPp6lWvPb3t+20ucf7ansx9wfaVGUvs8PiGSU3JF",
-	"BMIu1ktXWbtIw7F/usG2eL+/TUjZKeMSMR4TDqHhJgM6hHipCVkA3GI43yPVxiP0OCXX6lKYUC5k7eCg",
-	"8cKgYt0UBB2IaDAckDSbK3LB8Bf8+GF403A4vf9639QW2Xi2tlDJe89T/5eOIb1XpY3a0T6Urg+l+3z3",
-	"mKLAwN2lLxN1UU0SQtoC1V+pMm3B6a90Q31Aeh+Q3gek/x0C0iuLemAgcdSI5nPMl/YEGkAiux7AcuoG",
-	"iWMDLy5OdSOhjR0zlhCc3vP1DRytv7776/uzXd9wUjpEv5du6LqAdyh1T0Huuu0HDmz3Om0NZtdhhrpG",
-	"TRC5XZ+bB3HXND+1X27ddkNQuP/9xv0odndG5osES2ISGwR6S0Klyn1q4l0hArxm8bj/9bZR5o2LyKtl",
-	"+mjyPpq8NwmVb6PCY9K8IPPH5Pof8N9P69KwiCuPkQRfmSAh29LoKuco1WdmC9sJmobYdaoFfCV9Vrqp",
-	"MQRNvMvyhsll+sdu/9jtH7s9+loLRy6xtP7F2b84/5x3fPVC73Dpd8CNiQ1uTOVursGKKR2YW4sA9ycB",
-	"lB1TOvbcA9L0HKn3/vgTMMHga4UTHGtR3ckprYzrNZE913pIrlVe7Z599eyrl+HaZLjOEH+tFoe9Wo16",
-	"q/dusekeva/nNj23+WKFJcDPa+UWr8MGshuwijuM5/x7ODj0vKrnVX9Df4pGHL5WfgXl7ohj9TGgPcPq",
-	"GVYf9/mnY5FNUHqtHPKk3mvnBjzyiwjZXMEF7sFY4oN62/UsuGfBPQt+QD+rTlBMYK7IA/OLhgvLn8PP",
-	"8ZtF39/ro7x/D/e8rX8PP+x7uITsscLr+K4YSP9G7plYz8R6JnaDF6sJ6lhRAjppCwXpH7E9z+p5Vs+z",
-	"7sNFw8MR0mERnXCEYiokTSPpwhd0XQePk7O8nCktF6QOcOiN7rkD11OtmIgCx+u4GZgbBGfzOovoJU3j",
-	"RtZnYXa03bQTxM4OmtDERNuUx8LSZKnjbRykBZIz7MfUTOkVSXV5FyZyLzEodzBKHX7RNso7jx/JyU2P",
-	"93PjFt1MMUA+4vki0TX0RPb1L8AFjIF+e2B+zANb1KFK7AmBCBYNG3ZFOUvnJJXfLjiLs0hqT09OppSl",
-	"32ZiRLCQo83BcCAp4d+OcXRJ0njwQTGHbkwHzmUfI9LHiHy2ywvovnp5meOgbi3Gpzilv8OwVgPBK9Rc",
-	"Q+hIcUHNV0Txo2aGitFkgnA0wwLhKCJCcaIwQtFRYVR/VyS9+1Sg+ivcs6ieRT04i8pvbAAuY6UTbzmY",
-	"/3uVkRVrKX7GyYIJKhmnpAUq7cSWXLbhpZ34bfaoaX0geR9I3geS345f5synv3z7y/ezvQ/cbbnsAl0W",
-	"uDHr8MvyovcEYuZ18MBIZuWeW+HM7IroFTtdplEVzyqqlqmsm2KR6r/epnWAtxqa+C5v2DWYaoU9uzn4",
-	"WVNH08Ln2yKENfXEK0V6fLAeH6z3Wwvy/cKbqvCCKj+pVok77XRd7DWznlbbbaCTPgy15z29RfWLYT4N",
-	"saidOMjrBpnipuzjC/GCbRZFe/7R84+/w6O1OT60Ew8xXqB3zEV6V9iek/WcrA9Y+hPzzsbA0U6s86RF",
-	"0XJT5vlFuOCuqoV8WIb58FrPnkv3XLrn0p9dPbcezUh0OWIRHdE5noKSrsa2owoiOkHYWmAjdLR7gKAa",
-	"otZRi44Tom2xnEypkHyJIpZO6DTj2mIbvizA6JvX4CQmqaQ4EWAfj1iaEnC7RIJISdOpQBgMxzjOfSPU",
-	"hOJg6wFvaJhOXvYoogcw/zu6kow3qb8GZgZ/8nuqZl0+k7BfHc0J+Ar8X/auoLdxFoj+FeR7a/X63ap8",
-	"VbWnrTaV9tI9UJu41rpgAUm3WuW/rzA4xjYG3DRp08y1GQ9g4L0Bv85A6H8WpIIunBssZ0QgyqQWjAAP",
-	"zOCBEd6HeUHiYh4raEaQuNDz02TQw7Qhi1PjhHtcACO43grwAfAB8MGX4gOF85oNjMT5lWZBYXSnQgpL",
-	"oztb0EaDNhq00aCN3v+qscMUUEeDOvoD6bbjzDh9tIM4pxXSPq3vu2+k46ukh21Hln3266Tzsc1+WmVf",
-	"Y8XAYH+9sq817jACzTJoluGjyAQaD44/9vlmdOKZp1uOgvH/Q1AUcankaAjUy4BCoD48IRjy6pejkOTW",
-	"G2e8HUZORsXsDxUBSQBJzuN4GVIyR6GJkfEeAE9AzwyYBpgGWrlPjqIBTXMUiP4IXsa8HUZPRNk89+7w",
-	"2OD5EbeVgNmA2YDZR7/Ka0v1T8kW1GlbmDatwvejU3ZXz/9g2BVRxB4+H57HKm9XbZMxOH0R6eYqNeWH",
-	"Wz2m1S2R/sV1bWSajAqmExs71/v3mlCE0U/yuGTZbyKReQAJIlSTKsrAFFneEV9T2sgytCxB5+d2bhL9",
-	"03X37ML0Zmb8o/30YqyDlHoetmuPWrJWkWlSzTp6YN76/p1ok6s7JoPVhF6ixZpzQmX1qjOGPySC8BJX",
-	"DwkqRSudIblHhKTc3quuxKRg187HKdgV2iqbiw3mynWzVhed86V5bnzpd6Wha7ALXkqZPamldceZZBnT",
-	"iYVNmBQT1UQhVzhmCFN8kJGjoMUxrm9UEk5xhZZaGXTDOePa2tG1WyzJC35F9+UzYWvZw4x8lzf/zwV/",
-	"xM1nYpyZB4um+onFlC2a9GCkBY/tkFf91lMQ9R5YFIU4nwtmvs7aP+2lHVzNtoEW5ulVs+ZV8l+S4rpM",
-	"N1eJmk/TEccC5iZP+YpxpGaAUGk2yKXFE70fkjEHWI4YRddr+XTH2abMCe+raC1/tTEIelsQLsuVapss",
-	"y0IxuZk5p+ussxbamu9Wnr+dwW6ynZr583pQL1DbIT21Ywfm78Ge3FDOquqZUOkbKdlZRY1Q/69Gk8te",
-	"7VqyUaGe7U79Idi1fr0o+3ldLGZOF0xJDpxxJgTKy9WKqBjB6V1n85/j3c7y7nTZS68dGvdUxmzjy1Kn",
-	"hz1NScx3vqwTYsSIM1I2A3acAo3HNhrf/tr+CwAA///irzIBJ1UDAA==",
+	"H4sIAAAAAAAC/+z9iXIcN7IoDL8KTp+5IWmmuUm2R9YfjrkUSckcmxJNUvLxmLo2ugrdjWE10AOgSLV9",
+	"FfG/w/eG35N8gcRSqCrU0txkWXVOjMUubAkgkUjk+vso4YslZ4QpOXr2+0gmc7LA8OcuXh4LfklTIk6X",
+	"JNGfUiITQZeKcjZ6Vq2ATOmESIQZ2mWSTjKCdnPFF1i3QMcZVlMuFujh7u7xI7S0bVHC2ZTOcgG1Nkfj",
+	"0VLwJRGKEoADL+kbkdWHP5sTRJkiguEM7e4eo93jQ/Tm5Hvdg1otyejZSCpB2Wz0YTzCuZpzQX+DMRq7",
+	"e72bq/ljVKqMCEuXnDLV2HeSUcLUYdrap6mEDvdbujgliSCqTzcSaka7SqlcZnj1Ci9Ivadv8wVmG4Lg",
+	"FOvNsXURwwuCplwgNSd+X6K9E6Yb2qlOcZ6p0TMlcjKuDPTjnKg50R1SCZvjd5tKZDsJBphwnhHM9Ahc",
+	"zDCza68ncSzIlL6vT+U1/IEztIQKAL4eKGwPE5Ob6JAlfEHZzPxGWBBE3i+5JCnC0nXwNyiNztoBfwYF",
+	"se3RTRCfAuoQpmhixg/XkrB8MXr28wjj5ehdZBCZ8CWR9e6/p1Lpri0GmGpIcSTIf3IiAQuoIgtoWuvV",
+	"fsBC4BX85hek8wBApS7E/zAeaQio0Ojwc3mNxu7URk5eAENwdipnwC9HsVJ88m+SKD2H3YnkWa7IMVbz",
+	"+jxOyFIQSZgCOoRtXTSlGUFLrOZ1CrOM9qPXw7fWVfSaY9MPZ3BU5EoqsthEr7giSM2xQpitEHlPpdLY",
+	"BlWvaJahCUH8kogrQZUiQOPIe7xYZnpeW5dYbGV8toWXy82Mz6IrXV+DJX1LhARQa4T5+NCWoZRMKSMS",
+	"oL0030iKDJXXSAXnU7gVM0ir0ZghM9QmOiVCN0RyzvMs1cT6kgiFBEn4jNHffG+AknqYDCsiVUGaL3GW",
+	"kzHCLEULvEKC6H5RzoIeoIrcREdcEETZlD9Dc6WW8tnW1oyqzYuncpPyrYQvFjmjarWVcKYEneSKC7mV",
+	"kkuSbUk628AimVNFEpULsoWXdAOAZXpScnOR/rcgkuciITI8jpc7E6Lwzmg8mmZ0NleJyvRgxef6YR2P",
+	"3m/o5huXWABF0f0UG/LWNy2+vXB9H/JY8cFiqVZ6oPcbM75RO8S7y2U36dFrj5fLzNKecI5wx0t9LP+T",
+	"4zSD86XXEFNGxGg8mpNsMRqPLhe95wrw7Plu7YcffO++RjGI/fStGcv+ersYvTMTdHDrJoTBLYiz7PV0",
+	"9Ozn30d/EWQ6ejb6762CW9myaLf1gmbENfowbq97QjKs6KWhHLpyiYLpj3V6U4FvnywJSwlLHPEokZIU",
+	"SuXryKl8BXdPfaOkoyYpuaSJpSOLXCpNNUTOmCYl+vToa3uFJmTKBTEHN+gFUYmkwkKRdBOdzUm9jC+X",
+	"JC2aV2CgClnYEWfrXClx4uR7PmCXb7GIrBQpCnCaUnOZH5eq1Hmb0oIesEsqOFsQptAlFhRYmguy2gBy",
+	"gpaYCjlGlGmoSIrSXHejV1TRBTGLdEFWsLSmBcHJ3K/8hKgrQhjagQqPv3yCkjkWOFFEyM1RbdJdy/Ce",
+	"JMeCTyJnGD6bu6qojyarYu+RpnwaCsokTQniDA48VRL5UyzNhJa6MyTzJCEklYgabHPtyXvd5oqqucYV",
+	"lUu0Xb8NbeU4sXE96f/pvrCY5XoDZDvKLCg7NIU7dZakoETRIYE55W4itirwPzkrzY6yTXRiz7Obebik",
+	"ImcSLQz26wuOBf118zduWd61b/S3BGdqbva0jvQZvSSMSOlRoY1YBb2a+gARTukNeuhA0285v4iAPXef",
+	"ew72PZ2SZJVkxPTXOapSy7UOB2AwZujbs7Nj9PLgzLHChkNbcqHQMp9kVM5JWqaubadEELnkTBJ3NhKe",
+	"EkSlpwSPt7cB7598/fU6PKQucfjrWHa0bx5PwDSdj7bOR/F3BxcNz0GYY+Xe4EgSlobjIMV1vwv8ni40",
+	"J/DVl18++RJOo/ldHEbKFJkRYd4hc7Jo4DVMWcdsNOtmJuT4D/1FsxlKLWV/FqOGHqcw+rems/YKcvSu",
+	"9kDRi9lxekuI61guN4tjQd4sU6zIaKz/PlVcw3HMpTpVuNR3z4mVRgt7b6ljR22sUUDTMrPIK3M3UcAH",
+	"ANGcEabQkggtrZEIKwTvQImoQbjM9WWfCiHXqc/XCsl8CRiqa0tNv7HtHkuHrkUnQF0iJ8pPpRFYC6J+",
+	"xk8VEQHwlhWq3QETkuBcEkQVusIS4TTVFEKgHJY+Ld1ibcROr6KBIvbIXtp96gF4nB8LOTbFNTtiAdSw",
+	"CrLglyQd20nrJ+fSIY9b6E10aLYGfqIpppkcx0ZhXLmRbm/yFpGvP30ugLugCzwDEuy2Z51Z3eaWdlxg",
+	"x1E6rb+iBV4uNRNHGdJTxkoTSC6VLnzm2Q/963yEHpLN2eYYnY+ebj/dfvZ0+3z0qCwrsN/1WcFKEaGH",
+	"+T/n5+nfnun//CV2h9R4gPquoDkwLfZaLFEAylOa4CxbGe4JzzBlUtUO/cF7nKhs5ThTTejHSCVLZLhO",
+	"kni2WhJVP+q6xhrsRcFMfxiPNBLkgpzNBZFznjXwrSxfTIjQsCWcSZLk+hWIbFtpT9LVnCZzJwOdAOLp",
+	"2jQlgqRQmaTly+7J5qjrOoW7r//cCl7ow3hEGVUUZ/skw6uIMJdfoYyzmQbkClMVEsGiQ08K3XMiOHJT",
+	"KqQyky3Pa1tulnHs4T+e/byz8fW78/P0r4/+cX6e/iwX83dRhDNYEweXTxVhIe8eGXvnJoOrZJ3FPkuK",
+	"tVZ0QXiueixzICifGLbLMJJuZanqgTk7155kFy3SUJ0QmWeRuZwZNjfPQKKMSwd/E71hF4xfMaRfyFkw",
+	"RYMmnl2GKwgncyKBSNtDhJQ7gSHnZ7scjUenurmUo/HohWmwPr8UTK3oN15ejBYv9zBEFu8U2P/1Fi/C",
+	"CdWo3IJIiWdNWhlUaGWI0heb47IzLJVfZPvNbFjsAAi/8+s8DC2+eNRarzUwylV2Wxk9gIXnXQ+k7aFd",
+	"KSNsgGff24f1aDw6cU/kayKYBiPoLlYcDFGbByhBnmMZmcoeXyyMUshiBZASnGUleq1BjrDDmDFuBPQ3",
+	"kZntiglVAosVWhCFU6wwCjreRG8kSb3oPltpMZSTuwieoWWGGXFsSklefsXFRcZxCsLrR+hqThhSAjOp",
+	"uR6QYlWniLBCgrCUCAQCuZGRbrxm2crpFGsogwtBeAeCGpwcjxhuesuGAOlankXZ+X////9PWd4H9H9s",
+	"blIrfEAZUYoIxIXlLYyGw3J0iHHNTSgilzgh3cIlN6/uY1LWx1M9qQVlWHGQnllu04rxQDjfsERWdh90",
+	"XtIJNLayFcrtQH/QxFaTbFGu7XQQDQ2sEqHc5rKx/7el3j/4Y2M14H5ptWqZkR76hMjKdKkVIiB3NYmu",
+	"ZFej6lp21a+sTYVYnVh12Pd0QZWMKVJNOcqggud7Wi+5ZJlHCN/xG9MJogwlXBC5iV6Y15Ag+kiAgH6C",
+	"pRHXVUlF+Q20vfn3L2M334IsuIiwyUfw3Y4Ph5c704GcUXUDSB5/+dVibf7MrWrbgiecSSUwZX1XPfNb",
+	"2PPerux9D6CdJKY8sDAFxzyjyWq98YN2PYcvRqkZm7CyekDW+TGw+rDghgIj0NcrQ73n/AottDzF1jOm",
+	"IopnRGBVMPgRgYMgS276lSgRWM43Ms41KY6wgfj9CVGCEtn1VPVQaKylDO1sowVluYo8WFvA2dPgfG+g",
+	"gVkWMuMHsqwExBLtk5nAafW18mXpnbsde+cub4QCjkTbKfd98doNsa2csszriLQmVe9ubTp39fiqz6kB",
+	"WbmDuRNvN9FudoVXssAGNScLYLDIZRmFX7MX7pnAspVVa5hCx7UwzjZ+I4Kbj6Dh4AKw/IJmmRYqvoJe",
+	"U06MFC0AcxEy3Aao0XjkBx2NR9B2fba7tGq+46YK4YBNdSwg5c0x77r4rpgy88aQlM2yijyypEMKluFY",
+	"kCW2kzy1OKjfIEbWMhqPDoTgYjQOHsKav8iIIik0Acmr+8s0eZ7x5AI+hkd3/WU1cwohrBUGINfKijnU",
+	"itykagXRZ7kpCqcdgcOtQ7yoAY5iperDlZaujAhe7NNfF8+XxOriz/aO9XllxMigb6RxDPpJMNNvD43E",
+	"tpuYRmQ9daDt/hpawGuozt5Io8Avgyxytttwz+WSiFC3b4zp4HPdusVanxmLGJQzbVOJznQtK+0vNE1G",
+	"hwXd2HtT3+hlElu9Mh/Sqfs9ycij8m3hu4P7pBCPG4m4RA9nhBFhhOScq0d6dzVIckkSOqUlQ9fg6BaG",
+	"Xm/sSoSfN+QFXW44TnUDFHBEmIdx1y30lmf5gpQtqMrrv2/NAjG8fFN0CS30LFON7V2SrPij+g2j/8nL",
+	"9hphv3YzIrxsRIiVZJgu1uYqzcRPSq2ruAywR3D5957Pw0OtkzIDlZ7jXW+xI54zdY12MF5j43fVR12k",
+	"Uu1Qml1pMTUOj4at3FuNVsfDdbVpsV0M7c1HJ0Qf5dG4Aak1F1+c0jlmaQaobpHxyr0W+BWrCqaodNrV",
+	"8JK3471r164ZsJuExyGDcSun7VXtmDUcpSkRhCUk9ty0RY7IpWSZ8RVJ0eu9ww29tRnFTFktLLCJik5x",
+	"otAEJxd66VrHjp27EJ6O+0Se5osFFqueXFtZiCqbOTZjoLUajUfuqRPl0l7xEJb1ma8y+MWgjVUCaBrr",
+	"RPiucoUo/1WuUp2YXvVczffACadOK3DJzrz94PuaH8butDpC1I6/tnKb90QNsUM/D3kQuqXE/FBKtY0D",
+	"iGliRPClceN+KQ6YNrKpkfAS00z33DSZNShpDoaEZv1iRLQsQfarHz1YuZrvrxhe0OR1sBS7UtIZWNDW",
+	"Z9XZBGH4UwJzBJxSeZULKVpuVDbW3UuT9YiCw5D7RjO6f56+fuXdMIB51vUNT2aZO8P5hUAgmuotmFIi",
+	"nNbi5/PRTPB8Kc9H2tJi+3z0DnGhPye5VHxhPnMxOx+9e7Seb02b65K7u0bjyNwCF6baDICd8oYhXMw2",
+	"rFVI64nQw5/m037Dy3zac/gNWJf48KpTfVfqGHs8CqlzahAuctfG1YsF0nRg/QnPSE9sL1dF5L0SOFES",
+	"CZ4RiaaCL6IYjXJpXogeU2+O43rILUBXi+51JH4HvwA2/4PgbPELBg24QWdXvCZCS7LEwumWCiR6VsOi",
+	"U1cRkIiL2TM9orN4emibogfPHjzaRCewjvbMOjbCDwXEWS4zUBZUaMoGOIWlZidcR/pdwXNV6WGW8QnO",
+	"QN6p+YIVPNSzrNSdvCYew9zuC3/XIdfxuigNGGNDqwGJzSO7hMlYuIkZw8PaarVpHN3cW66z9isIbIeM",
+	"HKHlRjRVGruQCqt2IE6hRkMHdQWiWkt72GOA7g7al6lPD+2r9KEJ2dqbRXGutQlKBMGgr3DHs3K9aHIB",
+	"bjcaL+v0ss+Nqlvqe2mjz9UKla1gJmm76Xyvd33b9obozu9ed/j60a5GFGrk+MPSwufUSFvjvHI5NICz",
+	"KgcjRq7m6PXh/h5QeOO2HA0dcK3HywWN+T99R1mKKOAyrIv1GvMzcVfZycHpGXK+pobKmiUKJl341Wqf",
+	"WMqmTuhpKTMpvK8Nr2vc/vMJaOKt44XUkl20BwY8gY249ndHe3hBsj0syZ171WoskBt6yeL3qTM06tqC",
+	"17BGR0Rh3Uoue5gEBwhlxGHNjyK7qQE4dowuPNaPu3Zc1jUMXmTuIRheqvL28NJzbg3vz9qwt/DOHE7D",
+	"RzkNek/NWVgPp82OdyF1HwMyjJeNGFMJDTMeXTyVTZW/eyorlblG1MeNdACIebUJTRt5On0NVKsvCZNz",
+	"Om00Mnu9JOxUV6jI4qvMXymqRW8msAZRF8sWmXNnk4YZdJx1vFyrfnXzPrwrY2NpfZwssc9bu1yn9EQx",
+	"7+zqU6T14XJ7T5MK7P3fE5WGt/eOqHXc+/1QbdlEFVrfK9Hda2vhxYL6ud3+3ATluTW3MOtc4lO73wPd",
+	"HuNhC636ESSAy8VmcXh2d7y1xaK+YoHaPNu3rs+Bi9UstsotvyTKSTikE5l0nrzyHkHb+II5/khXsbGY",
+	"FLdAlEZbM6bRTSQ2a+6MmV1sO7TNPyhrD5gSq2Yb/SnOZC1e1i5KcmtjiCWxKjeiOwo0CmDKoJVzSJAZ",
+	"lUqs6qu/TvivDE9IhuRc+/xYzfybw+LBuUeYen3a9OQEEOPDwCoYOWag9HcwFwMkhCkuNyacq2Qr/GHH",
+	"XOD33xM209LSx18aixX3eyd2UPEspnglGUkUzFdXsO9u6pxLC4GqVILgxddGYGp+7GzXZKYBTDuPn1Zh",
+	"CkwKfz4/v3qn/7O58e737fHO479/iHqstYTGqGBgseJ2rnEsVElEugyfgV1niGQEDj9laAKfpWagWULq",
+	"2AR2xfGjZc2JvLmqQEsi9CbqVeVTq3mFA244cYdiMObmqO9FeOx7hauvzWrpXbFgu0tNoHAkzNnhFIFz",
+	"iZOvZzxXYExqvXGsqQ/XYmPBL0kBs7c25VkGLr0K8VyNEbkkzFl0LQW5pDyXtsWCKPCRk8YdrewjV9cx",
+	"SsBVw720Pg70wT11lXVD03/JCbbvon5owqJTixYN2OSKSzHGHIWH6ZvdnxDwAM4VeBa1IJtsHG+33K8Z",
+	"kXqhdK9XrjkYXbFopBJYkVmn0dGJQZxTV716Vn0/sTO6hxmOeSqY74BfUqOWCzj3nqTuYk5MFXu4xshg",
+	"jLEjAXI+Nt6axurQoXfCmaIsJ96cTRCznPrvaUaIMhbDNqBAllXGQXN8CRwaGIewjDJiDIqNO96qbHrh",
+	"JJuS4wuUNsb1vD6yc3yxb7vtauzr3WRvb3y+opgRHPfKnKI4oxduqteYnNIZo2x2YsQeETRqqloSuvoY",
+	"MYbq2YdWUrQthC97u4No9TMTrTbikJOTSG/ndr1uTPPbEtg2jhOX3rZWL4tyG6vem1S3FYJeV19jD4O0",
+	"908r7W0/wHU7OYGXSzAA4DlLLRe8YbS3Kdo7PRmjBU9JZgy6LvIJEYwoIhHlsJh4STeDu0NuXu5stoIQ",
+	"CwKzpOYCPCUJZ2nUQRLam2COPljtJc5oStXKMx4BIHoYY4ViHgpPHo9izmtg49PmVt9fGFGJUak7RlgZ",
+	"5Crifxb+BG6N4aLV67zkyzzDlqfTX3VccwknRq891Nczh5CHi0UOb9tIREqDSFEO4QyeNJJ89cUGYQlP",
+	"SYqOD46Kv7/bO/3vnW0NziY6cpz8nIBHw6bnGyjJgKPHIT60MR+GKpS2ZLJSpAC9WFVgR0SDeIGlBsnC",
+	"IHQkNSyM9cMHUvWfHGfggOEje3dIEHIaIX1vDvfvYdcCICSexQRob+C79yoBWmweBTqKqWkVrIZ9bVMp",
+	"8zJft55szXnptBvw3sPCVAijw+0SqqxHCBss9Qv0wlaIsJUSRnG25YKwSG927mcZxH6QDeuO6LQIDx6z",
+	"fy2qxk+s7bLOqY+LhUOcJaRY815nTRNb6gOLVEOWuDLzxjO6mFLwx+8gZlASVBQEGfkLScdonzBKUrNC",
+	"L0wgpN58i+uz0/o5mEIUB+YkuTghSy6p4mL1OqEgogxeUGuIak+KWKOJ7hf2FXkLHi2e9XHrjA0mdcLb",
+	"FrltizgV9h561Adxq12u2iVWRXt8MaHM+mOVO5hzqQomrFgvT7rHlk+DsJC6zjTPMgubd+zwcPwnxyvg",
+	"sm5TzNsoE+2370VgrLV23Ad9KovfLQI8VHhmjjXMnwu7JG73aUbV6lHkweCxo9lxQfnN50ILsOtYFW5h",
+	"XKxIhOBij6cxjYCOoBvGuxVE5YIV1Lo0XXCdCkeXCBZsE+1OJGGq8K1ypNJ6Z9pYvRuZ5qwRwGPRhBGl",
+	"QwchG37t0WacP9MtjpriZ4FbDLLhtVwaGv0iuZqvogsIIPlpdF82Rd2eaHaGZ3dCXMxEPLrJXiqhT5i0",
+	"iLDivdAXUK20LZRefL87YL7qD74D7OvNL+tD34a2qF0hFMfNejSj3pkVmgKbfRj3bufC/6/R5Bo+srUI",
+	"5ms0KOVzWNMNuCk0V6dPL4jQG1u/+xDfSccN9d5A38Rv2zISLK5nH81R/iI5M8ZNfGTBJbswh/oMcUYQ",
+	"1lTOayWSXAgbyFkRn6lGvx1O/DsyXJR4xD39tWBNkVQiB1kOmmoZ/5W+I74r3q6691DAo6Ph2cAperlB",
+	"HZOmIT3W00aE5YuI6hRLdSYwk2bxaBP51fXg9nMRISysyrclqaGcepHsVa0hYVzNjf2F5/BTrMiGooYg",
+	"1GVR/cJP2nqImneQXiO3VXjCc2Uh9uDFreMn8MRLXxJGCk1NffabTpi1OfM1izgLxWroYN2SKOtTmC85",
+	"K02cMvXVF1HOQRAsY4PvoocTQcn0ETI1CuGRG/OB7DXTnoJw12uD4Nv2Mo6hjZ9EsYet9KHbBb00z7GL",
+	"2nwGavEXwJa4WLChpYwuh/CtGeT8sTV6ukZXoLN9Vb66riuf/UjhLBuihVqDnwJzaCgPDmbjXqij8ejs",
+	"+OgtESApArdwRp0btqvhv5kXbK3NbsEgVn44anWMhYSqpyuWwB9vtdhS1zDqxkN9CcyECToKAdRtVKEl",
+	"SVzVozxTdJmR11eMCAlwaf3sPtGCbCol5ax/pKADptXDC8KUZTqD+dbKytOtFfv1aZS2BJ031unuxS9/",
+	"Y40yoAWjGd0UvReNBbWdCwv9Lr7ICFFuf+BHbD/NPgW7aj6Ee2u+9N1hcxKmdFa16O7HvbykKtK80xjY",
+	"X5UmS981eJ5rjKojol+jWQxEu2z1wJt/cJYY3LLuj4UOUxit0c4FibxXbj3y1O0R6QTqFaaAbWmgTBYe",
+	"2Z7e4VrhcXQHMZG7CCOErhnPU8YfiXEWPcZC1M7GcSW/XSVcWhjJ2i9jKaaOiSrjk4TVQ5p9WmtbX7Rl",
+	"7moccUYV97S4ICnlSS9Mte5454UinSPbqFtYFfYejXPVniCxPhNDNoXOu7EURMZzjupyRHwF5/qv0UL3",
+	"neZgMqnogsjNc6YnaWtQiX79K7L//+sztIGOTIzTZ+jXv/6KFlb9uL3x5debaAN9y3NRK3r8RBft45Ve",
+	"tCPO1LxcY2fjyY6uES3aeRw0/pGQi2rvX22es1PjekpSpDcSK66B2NAVn3kNqVbuGLMIa1Ssu6EMzTXI",
+	"vj9ySUAelotHetxfN359hk4wK0yRf93eePorLNzOY7R7pPf+Kdo9MrXHvz5DYBjiKu+Mdx7b2pCXJUU7",
+	"j9Xcxok1bbZ+fYZOFVkWYG25NgaYaotT45FRnsvTYkk0BX0aNDlnByYmsl45tL3xdLzz1cbjJ3ZLozR1",
+	"D2KtGObmkE15m+69+nAD0wRjdJoiE7TFxdS3GxAdsqpNDTqhzCAj6CHhjVuOHVU/8zBq57Ff+6SN/2Ck",
+	"ovKAtGKPwnXfdmDinngQXHo+Y/ZrTO/n5qhTJhXOslqQzFiU5Ld6K+OzNqjp5QTWBn0pKAsUzcHwVCJh",
+	"U7+C3dDhFOVMEjUOm4PNQGHEHiyfqdCeTqCUptIgpV2dMcpNZES84GwWqeDf5HYx0CGsFc6k4VbCrsNJ",
+	"MbSVS7GV0cmWz8WwZbrecDiQ+qUXOUNY2SMv4bwaK+GgS0iBAMtYOGbZ1VEcSZXy3BCZIqYwaI22oyeu",
+	"MaVO6D8QWvJgALI201p+IADBpWvIjGE9Zi4G6NJY5zNFxCXOXHz3ZwieghoDrHjICgENwjyQD8xlZWyP",
+	"xujBwnwwRE5/mJsPQM4r8aN98OiWuNE9glDqi9ZzuKu9ub4W9okCC+99I4OLpRzWBd0UwtSDKZRcJdEy",
+	"F0suy1mxm6CIBkGcUjYjAtCm4WyQKxRUsooSeNIqdPrt7iN/cGCwFKV++KYkN0BpviOrxjQ9UAFMY2wM",
+	"sJWzUSw6tyYrdlCnvplR9Wyx2hBkybcWmLK4B1RlR4NdKMNXXp4Y112stZYqmKfuCZkWYrw19IetfYX2",
+	"30Y7x2yCqmBvnDk4nH0Tf8M7+z2QiLy3ad3LW1RNIRw+1/s5alaGsruhS2ZUIS5Msldby+6ubh/3TutE",
+	"yXDKfFpejsRkE7cgzKgKUHWM5Bw//vIr3QggmvB0NUbfPZVIgsTB6yes3WYcPi3mNVkS013VRzEQwusR",
+	"lm6SzSpKO+C1xNwaxT7qqySoW9VUt7Ebf0FuYOR0H4tkVcCI0iwiBG9IOE1K5gReaW2yuLWn3rpFqmSH",
+	"uyuiZObfvZ23QIXixEeuWDIXvIgEVSC4tHr1KqWhxAbQNXzSGCV4qXJ9YuuppGIE6YRMY7IGbegM5Rue",
+	"+ISnTfMacBbNaYIRxqCM8tYuBh4LQn95RTvh7xU22byg1t4eC27gDbScryRNYLUdQ+4T3ZRdG0alvF+X",
+	"OxOi8I6zTnedj8q27+CaBusB3maaCtkgWZ6nHk2/epxOJ19Mv0wfJ+lk8vWTJ18/+erx5MvpztPp44Q8",
+	"/upp+vcvv/ri60maPN3e3n4y3SbbXzz++jH+O5k+TZ7A+gw+Sp+Rj1KhQ+mvh7VtruF99K7x9NVyT8Si",
+	"KK+bt25iUls8X8UNr2NZDGQ9bYIhnTYaNlZgmquxZUWUzwer6ZsgOF2Z12AtC7XJlwR2xZBOBos1TcjJ",
+	"YkJ0nu12I8fKuK6R9xrnXFn7t7iRoybRB++pits5mneuT3kzLafd8fYlIRBB9iCg/3HLRF1yRgQwJiAr",
+	"jpsb/DhfXWNIpGzPJB3bOxFIMgKLxzF6/froO2qkKQL5BCvRY8iaA0IUph8hP96Vo2Fp9EOxJGbwUrfd",
+	"hAk7ZWzS15LuB2lao0J+nK565IF2CUFMGi2THwynK9Q7SwUcjsgavKqn8HIGMU353OqYdVupU4zoR590",
+	"mzblcIomGWYX0czpICWSRToV6BPLIKFCNd3JrWc36UvL46mdPoyb81sUFjC2is/BcBtYWUoA0eWx4NMh",
+	"aFQNcGlcmAJ5ujluzQ9au4TK8f5jEnRpKlTOaDnxh+yS0FIrtm+lKKFk3bxGHJsE9K1E/G7Dzqo9gUSD",
+	"1VXzqhqevGkh9wIbxbwixLWSivqyOSlDwJlWCaipgC5NjcZ+uxykyuO0TlLymA9Cqbj6fkvs5yCHVbDZ",
+	"9XlLoxg83I+TNFuMDvdDS7zKCHHEMC2PAj6zgu/+ZeRH8cmPLanXcFtPum8MW7/EVMixS8sFZEJxZBPy",
+	"09+MUMbJKew1nY09zIq7ZmNEVNK0XeV0xyXUrMxqHCxg81aGdkKxTKh21kbH5d7RKC1bF3nXrtoeKixm",
+	"RPXjsUNQzqBd9AjaLvtNKejnWYNyyqonUyL1CLWpLYia87Sibynlqydg2wamfoniYnVCZAm+NpO6NoiD",
+	"ntuqlUf1q3DIFJkJqlbga9JEkJrr1qQvJZJFXQvr1rAkQp+Ibi1dyx2wEb0DCu1qdUwD0Q1If/Pkr0f7",
+	"G3vqMKxdYzELrHNpe94w6SwNQmtTb9K4Dh7GJlCM1FYnhKG5noeuuUoBd31ZG82ULXPShKJ82oqS5vsh",
+	"SFfV6vpIoxFhbRanQG9gbwqgO5gbXduvVf1+pAsiFV4s3dwrnV9Cy4Jx7ecPcK1TZXNymi1y/LZaLm6y",
+	"ztc+mHVgeh/NxgsgMB72+B0/ntc6ipVj0TClppPVcYbrx7c4dt9jqU4JYU2XhiuvXhSAalIXqBALceP5",
+	"yxoHqiu1TB/WuYMwZ7ihX8o0IX1RuYI/HoBmDPqeTkmySjKi7U8d4jgMeE6mXIS22rtTRUTw21Q4IVok",
+	"FdQoPqyDGSVQakNH6lShaewmBLCpnwDm+uJc69mTuda38GCsmmIVnd8Wt1CZ6/UYhVgnTYTIvxgaVqzO",
+	"ERhfC0sNyl4A5S9rkqQK1FWiUikuQREpj4HWUa1MnqJxuoqyclAu8/3+EisE4/VU7On6Q3StP1x0rfHI",
+	"ir767aDjLW4vLFfMy+djWXg1QxK1mADrX8pm4OTUclhMPFEb3VsrNKBhhd3qqz1qM2ioANR3uU+I5Nll",
+	"y3K7aPBQvcF6COboKiIsdTJhbbTEICDKFDFuvoB0XH/EEOXDyHkipsn3tMFu7tENdrGLj9bZaLvHrm22",
+	"MttN0mtuuLFTyfJmF89vbXpnLQjNaGIsnYSdWLgAxowdZgMJfd1fMK99Arqy7rjvNRuaALZmlHst43H2",
+	"wlIXqcSKrIwkDL0+9QLQRqlL3MvprNRJEdYDcYHenHy/2S+eRPukrsMSvj7tPYW3ZZG3m0ZzAPp9OmuM",
+	"cJdCWbUva1FlrPie4e3Nzc1HfZemPGjLQsFhm9OlMZ79KJS9CkP0yDNy1ULltNmuoWuG3nnqZnOk9yNu",
+	"jjS0DOSqxEdjnJE+QzUf3OadOjEBahoiFNTraFA3TFQbImQhQreh4alCRsorrWm9d5T24ZbLZ4O6KHP6",
+	"FskV1y+fROtWb2qAVZpYmVWy9laSbWxvb++E1jaFmZakSteZGmn0hqkFWmbnrbMUPM0TdUoExZl+4Lyy",
+	"3Q12WJ+lHVaIcNexySq1v93o0PUB2p6b1Xqxp2dY5/6eod7MtDL4Gm/SElkY3qd/1ujPDUeyB8brekXW",
+	"fL3l4Y0HGlXdBKwGC/5Vp22jcFIkUShfGtPo8DbcVSgjWCoXbEYtFwcXQRQP6LC4Ybx3Fzjp6TLMYrcp",
+	"VDMPTJ22whs5SqJioml3vTW5mnY4jUIqCgmucERV1HnozBpHogURszAInhk2CM07WRXmVGOk8IU+P0tB",
+	"EpKaAJKXcCbIIup7Wr6GrzmTU+gEzoY5zLLwpmxaZkGWXKgxkrlebfNosVwAksAGWPO6KNjVDY9zgmEY",
+	"asJSLqTJVaQ9JsJYuiUu6oFEZ8dHjRwXAA8ZTJZYSvsyDXVmujeqoBO3a1RWQybXmc0+p65VUV+viQRJ",
+	"uEhldR/4tOkgbqLd0oVQpMM3HVKJloSllEVMkYgV6jZ5IIGeBitrHxnEczQjecY1jjT9NZGkGk6o21ss",
+	"ss0ef6NAju0smvxqG1bTNpcmPw7gRTC9NXDChg5a6/3sblLZpfNOlnnf6z+Ew+lvjcvwTXpIqby4SfsF",
+	"WXCxukkPNo7sTbpYCp4QKW/ShSILCA+RC3L9bqpeeMt85FfILvW7npjWLlmQpcABBvfKogQTCkgP+yMW",
+	"VrGzJyi8UbVuCpzI1g0FFwO0GChWWgweKw0AihU7IGNlYWQ5X54vekcGx2xlQz2UNdBhxqp3H8blYkic",
+	"EBS/awkCLAAcn4fLhD/kzKVutJ1oBmmLC5uSwX2tMF2+smOtrLdbWhE1lKF/NiLskgoOeTO/sdc95Wys",
+	"KBHfTAVnirB0VHvzlycZs0F24JhZKkETVUohFyQQtKtg+CZq52nicwam6uayQViGMT3LSyKL5JM+8KTG",
+	"y2/MYDtjq1dezrEk//XNsbk5m1JgVlbqducInfebYxkZgjlekNWOsWfdGV+Q1eP/Mj8eNzqPNhMVOBRy",
+	"yZkk60dPh2bmgQfTNDFFvU41QD4o1gJTKBw9e/Khbj9drtHse+EXVzMGV0QQl4ZRh5Ze2QVPY84XNVPq",
+	"0pDNxLftkVWR+Dcb0IQ2+C25/ota10n53xi4uCYRMPbmzYBU/PblOtkd6jH9YsPL7oTCOIHAHrayM/Ne",
+	"VzjiDOGjWX3K9g1rm0DrTnhPOKzyqBqurEJdNGilC9yGfLE7kq8rIKoEJIqtgnl6pmsSgDPvoZQ60y5Z",
+	"CVNUjf2jkvmxia4i27KBQkVk47CUZ1pt4rKMWzhyRo2OemxefFwUD6d8OqXvx8jEwZiTLNuQapURNMv4",
+	"xA0G8MPoeIYpk8oFrM5WKOM4JWYIWYs//1U5bsz2xtd447fdjX89Oz/f+GXzHP7v5/Pzd/91fr5xfv7X",
+	"8/N/vPvbw//dr96jfzw8P9/82VSMFf+lOb95m5O5ka4d84wmPXn0N0ELg8vNl8s1IwwUTUOTxbj5WPGs",
+	"8mQX2bZaCKmEVq7pijhROc6KoOM3pdJOWFZULrHZa9CmupNx5HziuvfT2r1XvMf65wfyuwArafwdnSeZ",
+	"XsloVHccMxG4Zk6g8K7qRewL1y6g8GEwhvVCNxS9eAvja9lVO1Pw27GfRQ9fvT47eGakFj5Ank1/Us3z",
+	"snt82DdMjPXj/LfkbIPOGBfEO256W8ZrmV+ueUf6Nr2DekaFMesahdXOR1kg26ODon75To3TkNKVtTb1",
+	"MIOlbxhVzXTDqk/Woe1pg44uIBallSkTp1GcVoVbGZ4lf7IBPwp4i50LUa+FP7+2Y2xw2uZYpFdYGI2F",
+	"iQaq3zNmroXM7m4cZi0M9kK7FZfZyNJczw663kWHO0bd+8LkFgdhzUxg4/vs5DehPfsx1++59PV0WnLP",
+	"2NV5/CEWvPUZNbkEwEzsGOdyTRPp0oQC0GplAbSR0rIAqlRUt9EvFZemGSmvGm2XCmOLEalWXZ9iO3vp",
+	"mcr7+9p69LvTECQ+Je+XXFaUXzpyLE7mRrvDhQBJQWrSmxTPGHMsbDCoBC+xSai2ec66w7wGei53qhKt",
+	"84Cc+V7j3MjkaSAbHbX1fbyrazhP7eghDJXIDX0ENaySrVinWhDaomeNOjF36uecK+1HvUZXRgPQ5wqr",
+	"Be7Vd7Yjgma147N87SqhU0cpe4JXVYqHC+pXoQ7FuLx9zXSr9ljp8C1eQk0THBQzPCukWdYOQY4RZUmW",
+	"pyYHHWHuO5JznmcpmhCU8itmH4qg3TbJNSPujLbeqQmi3clYmcn42v5yv277Dx3Lll7LJNTAdKsuQuH1",
+	"aLq/zeuxNNnrXY/1LtZwEioWzHsILc/4PoaMrq9z9Xpq/w48w66jlSkBGQwRKQ1HjTauuKiVS2uKl7d5",
+	"xoiwtH3vkgQ2s9VFskm80lIKsSXE84bF2nt7gC7D7hC5jKcfSC7JYYT11h14GxgXinTv7cHG4+3HX2zs",
+	"PH7yxaNNdHR4dnJgRUO67KeffvppQ2rVDUtI0HyMnKNC4fEF6bMzRYRJXu49dwNR0VdflCRFegQtBXr3",
+	"+xcf3B/jD38Z3a83QXmT3h40hAMWUh22WWdDobPP9lEU9arrpyy019CZWxrMbKh0i/dwTqXiQiv8tnCe",
+	"UpuIfIxCs+4Go+4QthMyrQNWiVzgbcaL5JO3A+26sTsNnjbTllDa0/GmcVoRF/kEbK29NACmNyUWX30c",
+	"YHCZaBWCdUnxfu+TrM0FhXz2e42R20UTQfCFvg5bZzJZofMQrvNR3Ve0WD1ZfRD+AYC3MLUDrrjCWX23",
+	"4Xhz5S2xQjVYOFLP5HmWdfgjrY59+retTuUgmaUaR5C1uv+VCUePG5UXQ9qHIO2DNkdBubROOuVZLLG+",
+	"yOKuOQJ01Suk6wTAu9so6LN9LjBGQyR9KkUOoz7PUxu3qKJFqNRAJiGIjXwAuaq1jNpmCUh9bUMmhUn0",
+	"ZtJaoKXN9lZfhpng+fL5qlnCZ/T3F2QFL18bLwZBM73E3h2sGH8C4JaEgAGv8PDn3Y1/4Y3fNJfw84b/",
+	"+5etzXd/ffSPoLCHPgh4kjcMX2JqfW9i+7mgDJI5sCDwodkj5Fv6Q53mgDl2+YDtMc3DfMwB6VhQttsx",
+	"PH5fGT5n9XH9Pq41fvQBpGOzit1czZupYlxtBQ0t04hzNSdMhQcrSORNo/7tuZr3CST7OqG7rqq2oMBS",
+	"XnGRxlfPlSKNZ/yCGFB86u4ymKWbw/cbQSMXo7IjgmXHUB2iADfHYLhgtlECnrelo60lJXE4484gNpHu",
+	"FEeJDbdqzI59g+KFb5MEG/dg3J59BBudTs6o2kRFrin/USIsdHYl+Ws5Scmvi1/LSUp+nf/amKTk4T+e",
+	"+Twlj/5xfp42JSvRQScSrqUXfUKtEVvX3EkQKQ+IOFa40AmaDbUW2olYLWF6uoH1Gaiorigr0jE4mfeS",
+	"JEWeOaNnNJltgh552SxcCxa1eCUpPEWkiSkMSaScBZzpO3zuLDNMmZYuYUmAo/Fj9M6ualbl2Hbkfj93",
+	"HboPB0XHH8LMqntelxkz2tY1NuxidRGDos9T26B6jiJ9xs5O0VFhC1DGjGqNwn46brRdihCg0VzkGZG3",
+	"5QlZgzfqB+l8HDPKyEbJHdLFqnc17EUyGnc4SsJ0oBB4sz2aCsCJne3Nne3N7c3trZ2vYIGXiyLoF2hQ",
+	"B9/Jz8x3soqi3ljmNvweq53HvR5jtco+j5Ua9B4j7wQkYxmM3Uv1XTv+g7/jn9XfsbrVR44Ct6M6VENz",
+	"nlmPK3v7NHsaSqyonK5seF/vAAUcnFELGQ8i28Tmy6RTRF1b6lOA6sE8CyOJqpjrQ3HgBXmH7oyF41Qw",
+	"VRsfv3BjBPtzOAgNsjRwATTm17IpzkPEW9DzACu77IZNjLgeet/ROah0gWGQhYJ3vTAzpbs5Bqz1KAJl",
+	"Hto73D9BjBs+dmwg9mdTgw6bxBSc6vUAUXRBfqQs5VfrErSzouWHCjdRk7hPEcTMqO9zX8fIZK6xgUM/",
+	"NtNsIghoObRafo6zjLB4co4PPU5sXPEaqxUY0RuXvhhb6Y5jwYBqgOc2dJAXL6qg3HgNo3xZP2mWEexy",
+	"SoRqBjf4FZOloUJaUSiR7ePR1pkRFToMh70+kN6/Y4wkLwrsZ1n1F654qdyR13PrvO7FG9rz2uscHHM9",
+	"1MSi8LXP9XJWOrLtKFvURdTaDxBBOTxCDf7WcdWhcR0TGVcQuLPFYTdnima26+I8iOK66u+Yy7gysURb",
+	"hgM25hZGaycSjY7BtSrltGaO1EFWS4AIZxqTDc0omR/f9I3pQIw+MneepF89eZw+/erJ358kGJMUf/VF",
+	"ir/Y/vLx9Osv/z7F+O9fPJ4mf9/+cnv78Vd//+LpJPn719tffZk8fbrzdboz2Q7fE4kUo2ejDf1/zw9e",
+	"Hr5CewcnZ4cvDvd2zw7QycEPbw5Oz6D0nB0dHj5//u+95+KHw+e7+8+/P3pzcXVy9dP+2x9+2D/Y3n1/",
+	"9PiHx0e//fPi9f5Pv7367dW/f/rxRfavlwePX708mb/a3905Z0eLn758dZYufvrx4Mmr/X8ufvotuXp1",
+	"tnt19O+fnrzan9Offku+PNr/aeen32ZfHJ1lF0c/Hl4dvbi4Orj66dvv+L8Oz9lv/97e2/3hp0P967d/",
+	"b+/v/pDs/zDbPfj2+dHek+1XJ/88++eTVz++zgj9+qcfL54fbR39xl/tv1wdnXyX/3awvXXOku8uVv/z",
+	"9p/k/bf/2X5/yB4//mnv1asn/9p/9f791Y9ffZ/9MHtC//2SXZ6qH15PvtrdPdrlL/f2/vPy9OiLr5/v",
+	"Hu2ds93t2e7RwZu9wx/2T8V7+tWFSPe+S77fm6dHz59c/f3wP4v97F/zk4OXk2+P9g5O37KvpDzePZz9",
+	"6/u//SD+qa7O2dOTv4kvlhT/dPmvCyXkxZPV3mH+25P54d8z/tPif46fpE+/OWew7Aev9lu2ZMhT9/m+",
+	"8S2JWC88Ur357UZHqvW/a+lkD2LrqrrXQpMDjie9gctHQ8iImoUB3CIRjpalmksFLrDIImc7QnMs0YQQ",
+	"VrqGYknj7jIizOFUH6UMJ8RW0wcHZ5Kgh1Yd/mjs+KEqqwTsUCGgtrWCY1dbu+hwhukPxgD5N3YpfIwK",
+	"A02pSY6jEPhzgOlHbPwoB1Ya0+yTVfVHVWB7+vjyrNi2+gKASgg69co6h0Awy7tdw3WXDMw3oyPpxrCg",
+	"cfSrnV+L6msd0sA4o5f9QfNpryv+OwbtOvSB191Nj/86EWqgKzDNWp/hdS2er7pfe7au2Izb4HVHl6x2",
+	"GIk2aeJZhXF23PxKKpIxolOEWTxZPunU2lQBqWlxbg5GA64/X43G4SZHDDnGayLlNZxBY+I+T3Cip69L",
+	"kh5UaxKlnzTj/J1I0qMjrylHty0HQfpnIEgPedVuTD+FsIEmrIWvaM5Yre4D6cJU66MYC2clRXd0uOPv",
+	"9k7/e2e7FBVO0lk1gV0DZT69Rrbo8Qhs1k+6Ejga6XVrEkdAWZujblM70qKHLhnqo7sR4u0aBnXqeBMv",
+	"9HcWF1dUy0OWy2xlrCgKG2Yby1GGZJLKGGfdYIGp97MfsjX4kjRUXI/W9yK9xcvnWkxUgSrJOpEObRqB",
+	"9oiDHY74Vc/68kW+Ls1vcbNvdvht3+PTwlqlaXdtlTbGEkT4HFkSDKfe8ProBcjpkH1fhMga5JOqm9MV",
+	"9mZr29GAAWCJw9rI6Ya7heLb/ubke7c7bw6LU2hSK+fSREZZCneL/XBiMpbrR0ZG2YXJAg7jubuzxa3v",
+	"ugZCTXZClfUqBmhcg14o4QwpO9BCVytQI7jjy2CVkAbkvtdBDdP1RnAkN+LZZfegYhDYdB8rXIAZHnPd",
+	"gSH92IGu+0dTmhlDyLPvT+MH3wBzQVatQHxHVmsNrq3jOsauHvaGVamD2Gvj+5OEHpTBpQlmM+M/fJ1N",
+	"D+alkYoLqhqXvKi766o2r37QM/I9h19l4wGOKXsMJ+wUSjhNBZFeYdg5cfTQMbVzLpV++T1bcqF6OCW1",
+	"LJAHNrrzmvuNbPOleXIFChvrcAQOe1aVmkDUmNQ5gxiNf4SYx6PwVR+3kNCeC78WMIYSdDYDfk3N7eBG",
+	"NWveK8AbQcREMqXvjc6SUJBe6e6eoYdgcwtuqvqDfBSMYEvtS5kUMbDinN51n39p4S/ZSuv13JxvJQSs",
+	"uYTEOUas3U/4feK84YaH360//KSMJsbZRfOya2LlmVXN6azX0fjDNzg/X0/dIQiWsSfPLpJziA2+wNpz",
+	"kBRw2u2HU1bOd2T68pb15tAFJtjOU2pPEBvspfSFcubTpLqCNz4uTPlLraLL/lT5EvZZD+HX8LnSYu/4",
+	"TS0g7d7xm2oI273jN6/0BVZUOoIIv7W25nO1ufla6UE7p9Xa64/V1vpbpe0rY+1Ua26/V3uwnyudnBXh",
+	"j2sdBWXVzoKiSofHJiRzrTP7vdqR/VzpxISsqG8KfK7tC3yt9BCERysHcwkKajFggrJqdON9Ki23EtQ/",
+	"jESDqQRnqX726dyCgkqve8ZXoubKb7/Xnfh9g6j7fgXZq/7gtUWuVqhBXK1Q3Y/Xp+Ct7ZJYNWpC2spw",
+	"5sGu1Th4vwTmZhwydKdGjlSr0pozsT3b4CiMEfsWZ7T85ZBd2m+HNtLNGZYXHuzw4zERC8wg1GRA18C9",
+	"iIvVLkS4pZOMlD4fMlwusDd4WlQpiCd4ezsY4UcBHvw8Ma5zBWUOv54qLOpfPailDqw2rfr9ubbE2qdy",
+	"iSGVYKXUrhrJ3LrXmjb1ewLpDp7j5KJS4M9XqbYJ+1v5ujtxmfF9HLkVS/b0daACXAgLK3tSFNR2pSg6",
+	"xkKSNPJRJ2asXme6TP8v+jE4si5moTlNJcwNwxkeCz4pTvorrrxB6D7JqDZe9oUm1s4JkYqLhhxzBppe",
+	"fOmpqepFTm2ezwGj/tqYBRuiPUaWhIS8hKfntqw77WOXBL3MNnvOqGDh7AB+/mP7QGl8HjUGPIHSDetO",
+	"mLigJ2Mki0AoPi68fTetlvC6LYX1MJF1l0sbvrgRGzojXpbrO7DbkKhXEM2gQdhnC8Vtldq359jtINZr",
+	"9FxNJ9uUA7IjnGVDxsima7K9t6ZYOnFK1tBVpGq8nwrJ7dFduUVLr8Ed0Lfbokm837UA7YCxchP16LDc",
+	"It5r+6Gp14z3Yq+0Hr2YmvFe3B3Yo5sTFyU/2k9x8/bpyteO9+ZYjB5d2apFPxH+qqGbes14L3WGrEeH",
+	"tUZF323MWWNUkcYmYb8lfqX9FEQr1/vqhKtULZA7uXjEr4x9dBDtSAcUZGSNUCq1znvFD24guP1at18u",
+	"1+mjeo109dGMnOu0bMTCrk5a0aO7cSe2dnXRcsTXabrepFtvhnUaN1xUa3dxIyDiV9E6PdTJ9Dqty9fO",
+	"WuOWb5p1mlYYmX4ntYmd6m7dzjL3b9/AH394V360dKS8hodEg+maK6qYqzXETbwrGzU/XD/DNF19MEb7",
+	"8xqjBTKBqCzAQ+H9qE0IaRCo1DULFWWva9ytM1xznA4dqh83NucXNHMi2KY5Q6GxaZrSLKbGbmsPkW2Q",
+	"Iu8Vevjm7MXGU9BVmjg3hbq6GETPzA0Ts0jS9Vwsm25DkyCs0IcPDdM/ChCuDL8uRT5xWzzQWnzWegYP",
+	"pImpNg5CM1ktLkRoMjsutFs6ETRBh/ubaN/4GOiTis5HgnN1PtpsyoihP27IC7rccMZ8G0ACiPAJMhY8",
+	"Ja0QLomweiWk626in3gONMbAbHxlF1wQNMULmlEsEE8UzpwVVEawXmH0GxHcJYLb/uqLL2CXsTHQTOjC",
+	"NuC5amjzxePtR5rIqZymW5Komf5H0eRihSY2HhWSLlAV+E1oIuYXduw8XcPJwEnR85QoDdZVg7cZjz8p",
+	"mzxd7WpxzTvf6X6Ono3eFKHF+m1zE2K/dhpZE8fJiCYTL0QXZGH1GPYi6BlqqtR1IJMPP5/4vkuf3fPt",
+	"nYVwvViWIa3qZMLCg91VeXcieZYrcozBwO73esRHT3oaYj++cB77awTne2FD4YbWKCRM03h7fNDAoHwS",
+	"npuAEet5a5omt+uhCX3G+XZfVObb4fP98e3FcL34dqg+8O1/Wr695cFdC2d4J8HqIwBEVZOKLJYZVqTV",
+	"lSN8CJyVG6CrOZfEBwIGn0Mz3Jrx3auAdK0qSH+a/bQbqwbhbjzUE13BPieKADb6vNkEQoEvXJWeTOJx",
+	"tmDZfCxevXBmEMUdAGOEIRvKFSvF+H4gkQX7kB3bgMrIexhsokMbtMgEjAw6bgO5I7pvuCmTxhAw3bK3",
+	"Gmq3LI6BWnPg5TD8RUji+8nq0DyreGYHq95o329TqxrDHabcM+68zdN8TERCmIoaROohbTW09PVK6Lbe",
+	"YNM865pYUfMmk7sx0bFOI1RaNKISOX8QfZ4Vj+KPoguSvs5V52nV9aCjm8zx2ukJ+o+yDj0d28MYQ62x",
+	"zxAQYILH9WDhepGFulT/T0EXimndzUV6HZy+DgJ07WE3Vb/z9W4nwbe40iXc0ivugltAAO875mCsd0o7",
+	"72IroQTUJ9JbufvJOeAL7iXqF9LjvRMZdtc0rLHFiVpjgrtJc/CHIpi8whfERY9xhx2BggxJxZcSpfb8",
+	"AW/DUutfrH9NcwEiL0dHTfi3cHGMuFprzDaRUZ2BfJeyHPjAJYYAJ35YYJjtsGE3GpNnnKf/Pz8UoNIV",
+	"EQThTBCcrtxLCF0QsoTGjFxVMXAME4CeyQJTViTGMlJFxpXvx0wGx7pBXHjQbPw8KsM8eu5swTIak4Uc",
+	"hHgwwb6ytaYddb02VvDDNdawcHxosyn6eGSoDEecHdTVXzXmeGgIjRm4wWtyT/T+S+JScvJ4PJXbInst",
+	"QytuT9WalK9YhfWpYFmde/+bbMa/34vGPg/u/oopGxjc/+I2ZRy+G8GDIeN3vKQVy4v7X1MLwH0tqrs3",
+	"73hVa3Y0678ZTAxtKiVJTVxs+zZWc0HknGfpx3k8FFOLbtlSkEvKc3l2a+TdcQ4hR+GzGzY9je8AcWDe",
+	"aIKTixu/XJoWqe+bpmIfd/+H1gIQxQDhqgisyCwSnMz2gaSt4Z0bCt8OSMv+/M6fp+U36Y23tTrzHtsY",
+	"DY5Tr7NeXJyaiKFiTGICyzzvIkBWomOrZyvLXtmDUF6w1uRqhdLtGurbljhUTsDcHnvKrkMRKq/VkLVU",
+	"GeI1mBjtnToXPCHZqascYOiac4bjaZsWeQIbEn6WJ3p3WtPCq7F2JBpUnJVafjEaj0RXStIWBLnuCdlE",
+	"tqlWHCqB7SMbJyrHWSGVsLXHiOi5Uh0WEdFCylnUMIkXGLeZNswTBFLQMTwjpXAdlCGsQ1w22HqtFxPK",
+	"o8NN40C5UGph7uFutPC1C9q/jmimQbPeEYTqJbXp1I5NhFafs7ViP0ZVNCu0CejmMkBDcJmXFPZtSmc+",
+	"8R/olddJgupSnxoLQ92XO7KFWXyUXxG+uPsmK7ryqvFon4YqnpBL2hbUzpRqoHNJCp15K7yVrQqAr406",
+	"bkrnOh6xXmIGu4w2EG+PB6a167I734A73+aTQ6YE1ydaDxyPidhQscgpC6k1aViOcu3Oi0xLtHt8iB4e",
+	"vz49Q1tBrmK59buxQviFph+2oJNHm+iNtOLQ1zr40OMQr63RwmFqcrTAj1OSCGJEeM+xpAnSraBcxyPT",
+	"i15H3GYX3PIcqvzYjKp5PonyYbnISgGiR84uAi/ppmm3mfDFKHbNBYs0wRICqZXN+eJ9wZxNW/1zjCa5",
+	"QglmaEJQArEe6G8kDWqhA6aIWAoqibUV6cYi1WRx/1Lj1ZJfg5vRBKY4Ks7C0SZGdSlCJWIcwkmhh8t8",
+	"ktHENHk0Rt+enR1v6f+cQvkYcYFOT7+FH3o+jAPZDSeh189QydF4JOXc/v2ulq4gqNhBub8tan4I++xo",
+	"duortnqCB8ujK5UfJRWM7CnuDfZL8+0vqZHPeryNIGUIhj5MiqMk44z4TFA+r8gosAKy2LllC7d0Jxpr",
+	"jbnC94TN1Dw0WGhAPA3YuBn9viXZIghD0t+yM2jkSItOXBrJVE4XUfX8SXhdAmWeY6Esi0olmpNsgQIq",
+	"F72TYFuWuMn63zLyvlaRpLfoF6VkmfHVwsUW8nuxWG3g5XKjGCIyvkl21nxwIVJ8Pbx9wBSYHmKABWcY",
+	"iwlVAguarRAzOZG8a7+sZKbxyx3yACM2o+w9XKcznWtm8/GOCe0F9jMjMDbGk8xlGR2P5lwqCUig/xo9",
+	"cyNY4qvvA1NsmJfRlv1oZASjYwiDpg1t39n4/zTBezxnavTsSSnqpJ7g6NnTbb+4e1kuFRGHx/G3n1kv",
+	"bSvcYm3oFlXXsiZF2colCgj2G0E/VgKUYUi+DFMzIfuNpgiYa83QIi5SItCETLkglfTARdY7vxU/W1g3",
+	"bE47vaUrvNDH0RbwSyIETYncXC2y0buA4e5ICVc542bLo5HR6wee84vdpH7WK2c2wuN6Rt+l47OZBxdE",
+	"RZJfTwgi70mSW0u3Xk8JDVvrc0LRBeG5+gQzc6MH8kE5MfeDxYNyYm6Ncg/mD26enDtybH7v6TBdYMdJ",
+	"zjqN8Iva+hp3p73ytfaGmtuv/XuOCgqgm3YM122PiJrztMxTag46ykFqdkjj85yn3n6gCIvsmIWXB7q1",
+	"7eT4Dfx392zvWx2K4+D7g7ODnqxEDNCXRF/wsZJjLhuL8sYSa9MUKzMR7kb1LYs/XnaZWR67IOaRm/EE",
+	"ZyYby4KrwjS6nKATNA0khTSnJQNq4yOXSuea9Pj9eydWSXgakWVMeNrwotUl1T1Db7Gg+loDZcdcn+W/",
+	"/I70ZNEHDfRffrcXwAd7BZiMMlFOY05wSkTLVd8ZX/1b6EGH1N0w5ulLTIU0OUF97sMI6PbFb++0BkAL",
+	"9F94hO9/wOwhgROmhBf62/OyPe40B9RE0mpt3aZTiUxnqbsxAxqs68pNtJtlCCtFFkslXU5ZQ5lDCzdL",
+	"9d3umi703C3FHz3b2Q5seLdj5oYalBXENfDm0I4a7MgoLfC3guJI2wyjCVFXhDAP8J/z8mh5ymiKq4+N",
+	"/leGYcqLU9994LTDVuW8oYxeEIfm2ulBTwbEoCJnwXZ3P3TarwN9pdVuI8Iu3+IbnesDdkkFZyCPvbTz",
+	"r57yMaLs38aIxHKhImd6k6NHWOSs0ZsZFqfMX+nOkyxPjeXXCmExyxcguDbCI6kwS7FIkZyTLENyxRR+",
+	"r7GXahaZZKlz09Rn0EQccSNJtKRLUIvOiJoTAUb5hoqvjF2XAwLlLCUCYS16maONxDgIv49bpOrAoPu0",
+	"wXFTFwKfTgUoP1wubbBBg0wTImfMufRYQLvxQy9qE34UTOezdTgl30wj9etlL37Jtzl4vxREGvepTriC",
+	"yrGLmfjigDUnGv+wMu9rkRO9dV4KHufYl0RoTUfDFRibcu088Qb3ah9r+KEOfc3s2wwrcC0nmU27bATe",
+	"c+xyoa+Kr4WPR28nspJDbeQ50Sx4x9a91EvgjSM94iJES7/UoKgxJqjpDZe5grXWlZU3sLolOdsassOy",
+	"DEIDCbydEphJTQkiWiS8mYjIy+s5hAVALiyA4Fyhvd0o/iyxlFdcpE26DlOKbCh0Y+Qegcub/fv+ImNp",
+	"52sjm30bZCuvj3x6QZeOeTX6mVJ683hOSJXJXotx9v2pSd/gghH0Al33fkFW/Xu/IKv+nWvtQJPbhdY+",
+	"3Mrq584fPjqQK+0cq/tdG5yAdsWd5ll6au7sO6ef7k5TheMoGdFfHVNj1KAPjETKpicAvtInJXThNLwh",
+	"ks15DqBIovGyYDCvBFWKsBtr/kRd8+cUdzaZpVyxBLXoBGU+1XK+yOSF59dB5K1JZcLhoTBVNhNroaQ5",
+	"NAoXw8YQ9J+ciBVaYoEXRBHhmcln6Hy0pSniluJbjun8B9T+Bmqfj+Jo06hd9Nt3/wpFh5FNdP2aWiFA",
+	"GP/ALimFTHANYn0ESvhdR+zrqnBuQRlTkey0ilCChdKi52+hadsjBtbHaWFwlsX1L4G0eytxGq9WtQsI",
+	"dWkKq3nacCr0sObEGGaWs2wFm+KaagbeGmXyygkFha6QaAGZW/QRdWfLsPDYOOxy5SbnOObJyqGoOccS",
+	"aWxiMwsJkfYlABlM5iRbFsKIYkYO2eEJ6J97oxsqnyBue0SRVI8xcj2N0uu9QwR1IbKNUHSKExXVAS1x",
+	"coFnpHtG64jaYXpHWunxlmf5glSnV4be1DEWEwXgC92cpAgHkXMatPF+VVpDO+pKZqgilPbCKGbaW5pG",
+	"MJ2GVXEdNa7FcZ5lYYZZJ4E5nL7i6thYW9VkMa+XhvKVVfkPwjYPNtGP+l0oCYglH+xmV3glH5gIQ2Yd",
+	"qUTLHOwM9V26AgFLpdUrXVJqBLy9c1wi70G5xCoZ1RzRMmPquK/lyUCvPamZXh/fj/5R6Ut/sv25JY1j",
+	"VkR3b7fmw21hTc9zMR7V29ZQf7+U9cUyIloAxPRJ2NAAZRQzVT/M9VOwLOFY56QClIQZWQrSQVy6ATMW",
+	"ecJkal5ZEqvtAicE+QRgRAQNGTcp6q0ptSYBrjOQumRc3w4SWbMyLhayTufKRiA9eCE33+jOsYyya9Fn",
+	"aBjLAeSiz4S017K+vZ/1AUBFaKkOBakBqCfZhsp9HhXd8/QaaBPDq04+egsyXIyhqgzjbpnUxoWLRcm+",
+	"X/eB+vhRgzIiBBdHTUmz9OhQA9mkDC4DlRMvakVFLuKPHy7ojDKc+dR1vSKRgiZiz924ZXBeVZQqGgKF",
+	"5QWaY4kmhDCnUtlcM9pBaRWqkHftbmMI6Pvf6Bood7HnSzfIH2X3jZ9ZSZdmXCcWWFwYieOyWBiriLsh",
+	"igSA9sGXf16pHgawsVo9rF//+eNZ+BaB98k/f/zuNJauN6Xx+/vg/dLoX1wVlGSYLpxa1Qpq/vnjWSxS",
+	"Zd7DlrZEzTvsd8YjKmVORAuYpkII5A1gNJ1F0fjfVxfyTdNjWS8yevjP09ev0I9kgr4jK3RK1KNCvgDv",
+	"z1CqYI1ML8gKrj27awA05LDG3mStYYnWtyb+95XqTtajDJK72cZQ+Lunsv2FVqkQJCvE6Lt8QgQjisit",
+	"10vCTud0qvx12yVrwUvauAXUUr9gBLBw1nKzqL8mlcsMr+I+999WMkSausgLY4H6NfMI48JKMHi+xWwc",
+	"f5wTw81qtve7p7JYCiqR7SQuW+dihhn9DVZqV2qUWfSgrxrlX8dbVvrUC2OtE5/93vDUtFlc/ZKE7WGx",
+	"rOrfrIAuhq8wt/dLLi1JNp38DT2wFR8Y7aUkcaWoW6Lu67OSzTrcMXcoLp7KuDPlBCevZLz7k+e7exVb",
+	"2SI8b/zMCp6R9XbppNzC9tEkMfM7YsVmiiM9+NKISaypqO7SwG0WmEEqL/qbdS60ZSBAM9ol0HJvCJIR",
+	"LElgDwrtBQn7ldYJy61KkWTLDGhjIU8hoXKisg2cLijbOM+3t58kvhX8JD2yJ5dwYOwIQ5RaeXJgPDfa",
+	"Xyq39UoYjySM1tcJqoASmYafaEjunKlranmwCrQ8Zg0CTY4V7zXatnfvWbGs6xrH++IeXX26YbYjj9rQ",
+	"or/Y2k6fU9u6OACxYwluu/FAvIVUIKVSUZYolOnacmzJDsHJHFGNNBQcAhZYKXOVnI8uyOob4ALPR5vn",
+	"rGxmTgoDpG8KW3Pg4WeUs29yuUGwVBs7enkpEd/omAOEpetYnI9HZYfk2Ox0BeT8m220Yfhm9Hn8UuOD",
+	"C5jtFI7SmYpKuEqnJnAnDGas8OF3Yf9iDOJ2X+2TdBMdLJZqtcXyLKuMLk0zxLia20SPFd/mSq9dV9dR",
+	"tb4mCwWkNzAf20ULvNQT//2CrMawxx+M0VjENiymAvfReaPuELok4FSdT7c1slkxNSeKJsV2FAYtoVmZ",
+	"xlyzHdrCjefSez8DGNqU03cBYk7dgdFvcZN68/fCS3yMHGAf4pkpKMsjNOvISE8lUc6OWFMl+I1RRhfU",
+	"S+cL21RAb69UN2aSlKWac3LRyQzlA8sPLWWBxAmwQvgS00xzqmGef8iajv+TE4ubK69nU9w8s7wkNzCU",
+	"rkSNxsZxm6SGPwayoLh94l8azR4j75U7Kx6SYrn3zDKBxlDf25JKRZgyfWmwbODpJTe5Y92S2ZmWjRv0",
+	"vJ31EhdmCdQcM4TRlFw5I1Ozp0ssJUnNkrgddzEwjCbSrbZhxswLHubpttYuJSgcJwTR1PCymVup0mt3",
+	"SoV0huKSjFHOMiIlWvHcwCNIQqhfSmvDoplDzMpSngZrCRtf7lCRRYNYphrhdSL1xjJlkcvCCQtvbnoX",
+	"os4cH+NAVGy0mwq84X1LhyxOM5BagsaFXVVP2UBBVcVzPw8HlEQ5u2A6eLILpWe6cYuekalCOYPDw1LE",
+	"F1QFxqmSCKo5aOuHFgIaBIFED+0lPyEJBCKkUKynnsxzBkacvCiFJaBGRpFhaSs9KuYjiF06g4HVOZmJ",
+	"UHmTmbjQ7jxL4XWKGbrc2dz5EqUc4JZEBWMYLKdMEaa3MZeeVarjjZ7ZX4lUdAF6/L9CNUl/gyb6iGaZ",
+	"kV9soj2QGEnHBupxBQFK2dS3UecDNRDe+Neqv/pEwa3dGZXrrP5giBqgnc2JRcsLsgqpp73yjcOcbAqi",
+	"ZExAuegwEC0c9oCALGxQy7JI2GSdhn8PtGIW8uByIl9xBb+jj9/CWzMyr7LroOJm4HWkehV+US9hMOl3",
+	"3dsg25hGACew9O2fTKG62R/AlOXQNN2pc3pHZMHFyuVvPOKMKt6p81uYat3Ci9DSzDbqfheHvb+Lubf1",
+	"yUQZzgQ81XrbZmihUYouoaZ5s9VFehGdu1WK13TuN7a3aLazeEWUtp+3Uz0iStCk4UozNdEC6pjTjDMi",
+	"FBI5mGbDEVRcE0HB89l8aQLFghkQnc2LyP32CjYEWmAmNSsmgMMyDjC14OsZZRdILgnw8EJw4S8GOccC",
+	"qmmLHKJkcb9z4TvX9B2UAMS0hZcFtNZvIrgxrKdPSDSKaWiiAS172mXEFrXUW6yCG6HYlM4TtTYSj/9g",
+	"p7BiW10zPrQdGK7VISDs1RQnEXc/X9QtB6n1FsxsDIPbFzMiar4dvZ80Vp5qpIyPBvhawmDK0NGEqq3g",
+	"JaGKsxKgPZWFf7niZeGH8l0LYr2/rO1vaWHaUlSA05896G04FCUOdVsJt+a+23gCrVdc+d09pewiQjYr",
+	"NVBKMgr8l0+GKN2ClmTsxrMCLjyqQJ6miLCGN+S9VYiY4A6V6Adh+qKRl8rqbDqXOxOi8I5LVFOHblRO",
+	"iGPEbyO+lBsy09Epg9hmBiD9l+4LaISJXaWXKqsKSipyAkmV7lgb9nCx2tixO4AlZ0FP+1QmnDETWtnl",
+	"+A9MX4C8AHVx/tRg8uotWOecX8hNgBysWG3IBbl1tr29vb31HP77P//zP/8T8DGnZp4fhhxmn1UOs+pB",
+	"iLrbXzczWbXzg4U2OYCj200wgsqAMQydHoE/hDA3PlAJmkkblc9SFCyI8aq2xNg84+xpopzB63g5Ezh1",
+	"fMTp2e7JmfanKUtJvFskVRAeCVTsuprHLqmw8OIoXVf3/cVXX0YcRQRfNPiapakwcXiJm42bgAsQVPdG",
+	"57JBUqFLwnsxWLBOp6zWiEsg9v6OrLwhJLTmzg3KKoO/fPr3mDM1LE0cIu/E/dWXXz75suuiU7x1EUl0",
+	"GRUvvZU6Rd093ZcUDzWx3uo8RFGNXSA/AQHFGLFSE4uLa7gDzk0QCEAmWIw+R+6Fv63aT5upZ5/zpTsa",
+	"Ixle35tol5kic0kT8AmmzmmTEmmtrBNBFRFheFxJdP4I051LL2uvd9e7jttiB64fI3vfxvYFijw7Aash",
+	"EWWXPLt0Mjg/IcXdcOthRu1uXyuEq7/k45pTKHQTWAfQspYMjLROoLeojKITX7QTTV8KXdS1BLrsfwTb",
+	"HpDlJZcmkYLmgWFaq1uMDqKPFheWibweQbuHMB+g5gvCytT24wYBGK4dJaG6r3EVZqxWOa1otcb9ZRhl",
+	"wchIuqF7CciqQA+JR/+0iUejvG4nnlfM/Yy6vnIpBhRbC8KMKsh+o6q4O2vngWhuZV0UDdnoD+PgQbpO",
+	"J5YxCN6Q67QOronAenOdHnyS/16RUqOtW4VcNvxj40ZtaqtW/VCGe0kaygylCEukzV7HCN7Ethx7i3Gn",
+	"nATdix7KVKMs4Qt95q9Mv3KM9jKepwdmxNgouIjkmAaVjaoadhlJoi9BDFyte9NU3kJ+Jg5gzMLepCGI",
+	"yK6zuUi8UmnsxinVIWY4VymUpNqxRmMrMRiPoIPReBQM2Ve2GtnVov9YqRszVubgiJWVYPswHhk76ZI9",
+	"esQAsV6pMFj3QRPKBso11UDJx8FIhJZLvSLPfnexkBtOTFNo6TFYPTc0itrij0dimvz9q68eNwqYTXG9",
+	"ZSEOslbFZls/jHtGymnpuL1h0+S72kXnH3X1qpvKN2FAk+E3s+b2/W29Qx610erbdlqqXLK6j16rzhWh",
+	"tU9TSdvgNXdheOM+3bTYDP4BLdGre9VljE6rxKFV8BahJy2eHsFamirWEGZKiUAPc2erXClzUgNmKI98",
+	"1OCbdPtG9Ldqns51ncdN6X5ubFIuE75siw9s191UM6ZX/jnWXxgAO9B1hKFS99HNJRGaH+/qztXr16M+",
+	"Tnvag6h0TLSZOZkSIUj6i6ult6Liq6W9fsIEFK6q9UmizH8FgJxdEzA1PmLy1HQhycwY2Ft7+Z/PIzCc",
+	"j95BCXAe7ofMJ+ejd49uYIdRtamvEuBgI8v7EBDUCmFsPGE19I3eOof7ex13TqVG5cY53N/rfd903Am6",
+	"qxvfCEEnn9h9UFrJztugjZLrnkwFEMxYPPcZJ5KE5/qNP+N8ZuLKfKqUm6bJx6PbepVvSLXviS5qh0dD",
+	"+//g9NBi9Z0Ru0IdWSdzvkyfvbJpulYCLImQFATGUfN0I2KxVrZGbeDE/rmJlaTrmsgLEUacMa6wz4t1",
+	"TWlvURlJopzlhlW2R6W7AA/l7IwuiFR40eD7BBGAC00G+ICbqaQlq88UK7KhK0dJLsnIdcayprXQfJ3x",
+	"ZoS5cJsRS0ZjN514u+WSlBT72CWo6MXJt1MiNfbajHzomC/zDAeWMsbXSucux+mG9jroZRhr1Tmt27/A",
+	"713Mr6+ejLuw4ch4cphi4wRtfCaMTekcmzh3gcuAPVrGeC7Bisw0b0LQQ6By8NXo4x552//RtUPVmfpW",
+	"6eqm9fjL2LzAnyu2iYEQGyvt9iXNVeq+jxFl2l+JsnTLEDErJWuwvy95EEQGZM7fwi4qDOtfSjJQ1z+Q",
+	"hbP0penPBhEq5t0joqQhSifNkYB2q06OYda0urZyUBrcktKgH477vUlbt71kqG30B+66r2NEQjW7EsGE",
+	"Mruk+VQdislGfaJEdgn/Up5cENHEI+1DKQxdl8FpVu1sLTlc2F3LNNfmEuPTdvyinWKMY3yd0GsGudTD",
+	"FTG07MCrevSryo0PoRWPeErK4ef0rVELO7cLldGCp8UDxA2k45DqRoa2IeFuHZ1jLcsejW3xj4IqEtbR",
+	"cVuJqQSUfZnL+aNwsSwkvnF02SZYEohdFs/nCfeiU8cqkQP/pNuYEGEy8CZzfklFmDIIiGdpi4mEByOh",
+	"5zkFjxlLi5ZUbyqSORiKAhGWBBEGu4+wtHcWDGJcc/srY5+76R0wZZKAVjn4WwhFzYsj3SrSs9U+jEdu",
+	"jRqefwX+r7wJ1hi9+GH/FViOHR57My+wAeA+0gQXyj0C/pPj1Sbl42I/BEnnWMG3xcp/Tfji2Zfb29tj",
+	"tPP1482dr55u7mzu2C8/P3u28w7+jr8vYWYkkmKxdgAgWCnUBgR25nNs5t49Hp5q6Nax7fHdvcflvnns",
+	"WZ7QnoqrgHppkvlaN6zH17NI0xIE1YeL6RAJxapV5EKuihEWDiqJSFeaHArtPCt4dpxhRprn61fTtoIb",
+	"R/AMLXW7TykATyQi0Y1kXfegtVg3TE/YFj1cCv5veDPZyC+HTi8Pv8ESLRaoR5caYowe8GS58QD9Dbmu",
+	"mkL26EIw9GuysjychlG6gE2wzaSLtEyldat0D29w6E6JcI7WldAKRfyQsh3CgwuyeoC4QA98uIgHYH0K",
+	"o+qK2m+T+mhM4BDvwXHQYBuXAj0UZIZFCv7WzjPykYfReTfb2KYGm6Ql1hsafGvYqdd9Cn7AShHhrF0x",
+	"awgpf7vSyiVhUmN+o8jys4089OlpydrkmNGbNaAJdQ/nwanlT+3UEm7++g4tXegUN4+t1iibxoal92cW",
+	"Wxu11yMsbDVYw/5prWFrh6QVpesvjpDrqmN0NyeMPCcMrJec64AlJgONiK+V8/6MvSgObBk63I95lfaU",
+	"/0LK1BODP3oMf15a5VNrJkHTk7SMUMiu4DQdmXTZxv9WkAW/1H8o0hDSIp7CbBeMV9GxCYbm80XEA2LE",
+	"QYUiDSZOU5PgFYDarCEfX9rU31EMqxKOY+8UXR/2uOQwbdzrHPe4wAFx0N8C72pTKzrBYx+/MrZIRXRL",
+	"Y9Op+3VJo/1WScRZq5C/qNlMhSO9Wv7tfDQj6nyk/9AXhfnLKPrM34Zmmb+XGjfNn0Y3Z/7+qxUyggbU",
+	"j/BoPT7NTbBJgGJKC7BNtENpIJhmhChZh8Y1k4/6JCOwAIzDJY0hVbGr8XvYr7qXdBY7bVLtYyAx9b0M",
+	"6jV3G3ZWDBFYA/S+ZgP07NTaB5BF10RwI93uDPyxNDV7BP4wmUcSOI4729vuzGnFuybZ3HflMwa5MLgu",
+	"Q6eWchftIC7aFYUrF2LMuHMuiARRAVqYz+bEuO5JdYCGqCKKK5zZLkKq6qYxcqFt+qZjiSxp0Fes2PVf",
+	"bMgQ9KMU9MNuaf3k9YtgEcXy3rpBh67F9DUq+bAblKEtXWXr5yVN3wHf1ztRRmukjGPBZ4JISS9jt15R",
+	"iASmklQC4Vie1UaX9f6hQHGRVGSpjSDgX7in9EqleUY20YH2M4GCDIP3ikIZwdLG7NLfXZQcHyNO8Czj",
+	"uTILRVIfn8QEIzRjZCvnqOkgchBaYRTUWxBiB8ptQrO5IHLOs7S+9bpBmJq7VYmZF+lcdbsGZ1q/en6p",
+	"HkgPr/NFdnBzltjgqwC6C28HSZsRZfoyliaWXUoqsWR2/tcY7Wz/rzH6chv+2v5f69B+xwl1BeSSSmBF",
+	"Zp0ZjU7M9p266rqpWf4zt/rrQFXBdA+FW/lxeedit9IPOU4zooK4Jf2JXyTxkNbwdbp/BO0ObHLvNZro",
+	"GMPr1I+ED1uj9bcEZ2p+LPiErAXlCYHIE2sNpf3R1mmwT5aEpYQl1MLWz8ygNalf1/DtKac+vIsaLnhb",
+	"nfTEq/nemLN9v5lqWgCJy5+vl6sV6NcV9gSs+5IqJXoJRo2vpglUFY93fuIFNPAmMlVNwPN4VsKmB2q9",
+	"rYt/6Yz5XnFlrcwws+n34DGo6zslBL8kIsiGWyTylCLZoiwl7zf/Lfu9+0NlbnTevtRxrQ5HKok6A4SY",
+	"UWW14aPxGqrlcLCX0EUtx+l4VFc+m29NCFWUBVI2hNFLqkLk4qIWxeH6IbZOwly7keBattcNPX4o2A0t",
+	"dQJrmNAKY2StJYrN1eur+4AIETaUtJHY6EV9N4S1+sw0AAXyOTfWAjV6tjP1bysUVtFxXGxRLi8rD3yZ",
+	"Na+7F92BqAzai68NzvygOPizKg4qZ6sFlWuZssqh58v3ZoejfIujuDckdbEsmnOVB1X1ldFs+ucr3tQD",
+	"PoSviwMuQdhVuQRkxz552te4U1AjZA4oM5J3vVEmwp6NeafrQVjz8vbVMjn46zdipyrg2CmsGnioXsRm",
+	"z43RKT8NoIkvlCEquxkR6iQ3nE71yRDMoM7QziumXUWxmx8IWeM2Y3mT14yTd3ieky4M1xtkFcKXRGgx",
+	"aC6t6oRPbHoJmywSBtYqEvQC9vMZgoXW3Ld1hkFTbjUUkxV6IB/Ag0cSvWhyjB4szAebCGKMHszNhznP",
+	"bUQbrBQRGuD/c36e/u1nuZi/+0tspssW/c+Zyb0ZSHXNjExwXUFnMxc61qykno2L+ztGiixAa5ALMvaS",
+	"PvCqyaXiCyfxk0iVhynH5i37ELids20fOKF5sInGl0lPTZJLIqjqltKEqHZqG0VD4fgeAwwpLWHZ6q8T",
+	"r0uD1U1ubWkNXX3YFyyYebPsCQoZO3S6czblvZ81DbAUHTdWCUZsrGNACSb9XZTZOPH8g75evSHgJcUw",
+	"7d3jw3DSe0RYC0ZySmcaTKcbHo8OmBaYLiDcnvtm4wCPRy8yQtzTzb+B3NinK6bvnzOyWGZYkeIS1uZQ",
+	"TuYRlRlUVAJWz954a+4dv2mkncs8pl8Yj/bgwDQ2g9J4y30qLxolt1RexFtZpUlDu+ZsBD6weke863hb",
+	"q0ro0DTE254VtKapfVAlrsWpshShnqU3Z9Gwh118Q/OadrVs2sWudi1b0dW0cyV7qYyu07QZ1T+8K1Pq",
+	"kpKsfkrjTHKHqqzZ4gA7JiWWMMT5mQcq3U302mXiMl+XRCB3ucC7y1z+a7zxqtxS5KnnkjGEqp0G5mZC",
+	"1BUhzM3f5HEg8l74lZ93Nr5+d36e/rWJaWnRh47DrYjMuO1Ghiug8XLSpWU5XcnpVG+ly9SlBaISUVZi",
+	"x3UlqftQvHgwGyMXbwl4XZle6Qprkerp8UNhjREFj7YcPLIsjK6IA8cjE77vhFxSC9gCUzaI+AYRX40O",
+	"aVxcV8gXtLxtMV/R9Z7VJTcrokzevYbUc698gjdTTZpIg2meuNgHVKJwPIsBm9FoB3qjqfoWy4hCRn/1",
+	"uU0gORtUjr9W70Z3Flm1KAcMSX86FwxqSXAGzTU5Xn/B2nRowVKOS1tYAq8LO5wY+J6EuWZgTZXXvuhP",
+	"LSkfxLl/UnFuhY628iUVka6yWaAfykee64DNaRcPxhXDZ3OnDNbiRd0tAvIhwPNt5WyMgo61fxsuahiv",
+	"9aKB9ZWz0QKM/VKMITImhoxr1HGtKZHWjAoAqXSl5mEHGuCQKyvyG5f00iXmJ4zCsv3F09uzEAgN/XIT",
+	"ks9o1lmCikfFgjI3/E5k7Cr7FRvfBeYRtlZkf0rTBw6uPPEvvuiGpEds6RBBo8I0EcphKnNrMWCPMArt",
+	"h+MaUvSw/Q3l6Ph6dL5Fjj4eOXHyHlx6TWlBPc+A5pqX8EYqGo6GDPeu45ctYaN850FUqEjffbKgXkMd",
+	"4LGpFDBhakV73Za2MsJxLDDDMyLLWeCgS0SnJgut8xcOGSQ3aIIVzvhsTZmrm0ghlSx/33O9BpP/SDZU",
+	"pcGjHCAjV6/j8amAJpIrBOGr0EM6NTlpE62SARcEnSte/3Be8xH3Y3JJeS5bBnBVbjCKZUBeUJKlLTwb",
+	"ZCG2BqtXRJCqqW0oIvLn3K0kQDfyQc7si8X8s+l8yd1vZSXR0fVuVayV+OLyvKInqykaeJ2qNtQs4q9H",
+	"Yq+bdOMnL/aQbqvpIkuxSMEF21yCkXjeLkiFURcF4SaMr0jJzbxOn5ujjpRB0/VCTHfx2GMr3pgCxs8s",
+	"Nvn1/KeV3bKGjDDWmPi5cYdLbIqwmGeQLnNZru3DDU10M/PRGpXHLjZbe1e1xCXEKlB1mm59w/6RCaeY",
+	"Zk2JPYs06hUbezManD7THvgp/2DsEWCwn1/CxGWaroFtzbeP19KaNs+iRENs39M86x0vsWhy/ZW8KQx6",
+	"h9PXuboWBFdzLt3ogF4psqjZZ2Su8LUmfh0GxfqVhGfEQVDaB4/awcrE8KbliBuVpUlUFjdC8G+9Ob+C",
+	"Nx7U9XOFAGfFKW/TRwI5ObWBQJtDgIWVxqM9zHCzgtCWVtxsGpVBvkpdBScDx4l++rcypJ36JANpD41V",
+	"AWTLvr3ANMsFOeYZTSLvwx/1aVMcpdwEJ8ERoowWVEoiEVUy4qmDjnEuCXpoX7qaWX2EZC6XkJAmcBja",
+	"RBqkiU48EyuGe1UQlQsmS55DBUHAmSA4XXnCYC0xPKtV0ZSXXHt03Fc4OM7Fx6NyyQtQz2U0HjlI+zLQ",
+	"kbUOu6qWFd0XG/U6Vwlvugi4KfSveLtksEuOK3PxTMP5HDKHJ/qEeImpGZSkz02SnN0J+LaNxqPTfEmE",
+	"JClJ15u5Bb40XLmoOnhRUgKlXFQAVv4eglmsYBOOl4q9N6n5iJbmawlV6kEKDF6b54aOk8xztY4TWlon",
+	"nj1ctKok9wNQTZHDvJ7nqc292KGJL9fXEkRMmSIMs4T8SFnKryJvC1uAKLN8lX4vTWwazzJtwCuIAk0l",
+	"+J2T1ArDrqAHBLGVkMt7ThfERcD+Fatf4chLhVcS6sEV8Sv4LL0UOCFuBX/dRK9zBcGidC+mZzn28GBB",
+	"0JzoWFc6j7UJT0kWS7UqqtSgRNZjRIPUThp6iUYMZpxat8qofIRZCtBz88t0++ZOek33w7EmnuSq+dSY",
+	"cgi7Ya52XCY/CWYp1bN33qZLkqArEIJWOADKFC82jaXQmxHEOixFE0BT217NBVcqg21f1E+l7SpOMQNk",
+	"DY+7fRODl6b+awXYI4AEOS6vn9KjtDxwxce2PWdmEbSEBFYhxjiF9xxM3GogCrD0idHo6jnFa8D4pgJK",
+	"56PdrW9sFu86McosSQdaQSWjBYrwHUtTKUaPG9bSPZpkERQ+WNyAxQ/wc70IGFUK/FLwPOZg/G0jbpd8",
+	"s+uIHn8cGOMcSXwecdPcSTlwfZxrYsl+bH6xhVjj1TpGWJpg9pT5zS2Eq0ALilzOvVzp0954GJ9QB15G",
+	"G5U8FGbwhU/LGGZcypULjVOgYQ0LoP3zlYmiKNdF8fYHrCVyMMTmNXMadCQxOKtNsTyh0tdN9L356E9h",
+	"BWyzmClnD0zaDiDKfEFV2XG12OIFfr/HWWIUG2uukRmsAKW2NbbbbGVM3qTNb+leHbad1FgO7MOtyQGs",
+	"fL9j9yoHwktubWeBBKC8St1HpXZDdJySan1DyA0c611nZdzvR1rMOA0ZIyCxeeS1uwqaNkHXmwjZYVoW",
+	"9jQIylCfjRMlGNsdrkEC/YeBTjouNKSa5rkSPvEa5CBlSUdMfNwULNl8R5rHlEgSZo0qi6w7VCKpuCAp",
+	"IiwRq2WYY8XktAGKDlp0m0+3eKw7lDdCtqqfl7VelIjewKHYpySKmB3qnxMsyUYiCMQdxpkMDclc9SWW",
+	"8grE2SP5JBFP1KhICjV6NsLL5ejDYEn4mVkSGsRaP1Boix2g6fI7smrJJVOvY06Kj7xj8AJYZx27Gf4w",
+	"jQq0oKy4tdojMl6QBnJl+y5GdH02x1LvR8krsPamvxrS5kWN+08XZWXfafP9/tymZTFeLy7dwDeY1/1p",
+	"zevMBlfzO1yHMTKXanuKANmSKaGSuap6Oo18LyAGpi+NbDbKimln7PKmBdkBGC3NWPCcKTDiGxfxXYFj",
+	"mKxA0QCGCfXTOIu/3s6seeEDafjmcWEegwiFJAcQD9Ask9AsOBE00dkkShY25yPBuTofbY5i0u8Z39Af",
+	"N3R+kg2XnWVjaQKoGjMOjUJ6avHAOPoroixghB5Is04mnk1Zj23XtQjkDz03mEndFqU1bE7rCsPW3OkK",
+	"68wyjtnqu/ANIff8ZtTPXLyFPRnNR7TBT8uXVaxegW+GK7m4JytPfUtvbvAUd4MUaDNGVqGKiDuuisPJ",
+	"WmZgmEreK3MnbKK3GB7yWBDEyCURVg1XsPW7x4fjQFpqovxCKUYLLC9IiuCTfjPA3YC9YkASpkAwj3Jm",
+	"bODhKXBByNJAa14SBpJR1x41xl0/lfM9IHtrJgnbKz1A9Ovr9PRbpARmcslFZLOWgl5iRb4jq2Ms5XIu",
+	"sGyyt/Dl0K+U82PftqTs92+N+06FVAKpM1WWnTks0EXvKcRUD00msua74VoMClquRa9fgjMnQTKCIlsD",
+	"4sWGeS9vh31LfAa4EoT5bEYgWRoEv7IgJEX+N3gb61mM0ba31CQ145Enj6NCo4GVu1VWTsqoMVSfMByF",
+	"5bVZRxdrei1x0y5a4GROGWkc6mq+qgygN9pyCOcjqwQ8H1l4wI8B6tsHmDSKTl1dwE/Gy6bkLvL0JtpF",
+	"JwAmSjIsTFpUF8PNThbQeJLr80UkYC6/JELQlKAGJy/ZfpDtWhaLh14zgvhUp0Y8NerM8xHiIpzpnaON",
+	"XJJkA7N0wy5p52MzxtHbiVsy4TGgQLroHQU8XrqbKHpJ9BKRZrPoOZ3NNzI9KaRni7BuZPbU5DcOEwJA",
+	"hwBFxnFqeCnK/Gdv++U6gQopKf0MrAKgp6kgcm6KcnbB+BXraRFSn+WuA6RedBJAXC89LOZQL3zhZtUw",
+	"oJtYvXif4PYKR6W1iEEdrE69+I1br2LPDyDxVceem+xY5XBHsPma7w433FRMRz7T24bImTVqzSi7IKn/",
+	"IyjBGcXGoUiaGuaPoIYemSbGOM+NQJlxdBr5xN3wGTgkahK8T3AaYMl4tB6iBEtz4OfVWHbiga1X+d5N",
+	"vamorfGuXZ16yZFbr6aitm5P3ZLWi/aLRa4XHhbLXi98GWxEBMGCramXPsfxVm/89kXWXt8xITp/z3Ha",
+	"gcz6XPdAZanyiUZWjlOYDuNqY8pzILITnG5IouwxBZdZoLBiFqDvdemTn8KpgaD6+XsHUbXgFVcvLIDV",
+	"ouc4PfXwVgsPLPzV70duPrWCCt75ggh9ecOoKrjqakZjT5k6hYzxG6r65IxeWM0slctZqRGg7OkHOSdP",
+	"v3UvlhSTBWe9fB5JgZ09J1UlwR8M1q3TRRntwSZr4tvHjsCVucLHMHUrwnAJ+opr3C+HTYhRXoCvvihH",
+	"IsEbv21vfL3x7m/R0Gl6oDg0uiRIXqmTsEg5Tzetyu589KgMTFjYySPBsGUsKe9RuNjjEkoGqxhjmjoC",
+	"+3y2WTL0gQqi2VlT4DnRuSXRb5xFIgIt8PtSTKro/IIuKUMpmQlCJNojmaR5YMAR1muMk1eW/OlmiY3Q",
+	"hiBLpnln2uuhCvuCMrrIF6Gzb/BS1rUaZhD0E+zO2EkpqZKB5zcciJylRKAtuZJbSYal3HJ9PAzTONiP",
+	"v+iOtx8hbroChCjVe//0q1+WF7Nf9BL1yGgEM4mnBKlGfqvPt1yhHAvosrCCL4zEb09AMsgqPgmddQVF",
+	"1guAU218uzFwKr3vgXQ4JkVxcmPwdC2b/yBZIpKNDhFlnNcI1JbRzg5hxrPSmGqv4GCIhQZL8MUmesNC",
+	"60xoqb3PcarTHzdn4QtjWfiW5fwxv+Ii1YX8WVOsb67I5N0m1Uk0ft1EBxkx6YH5FNTZ8IeuliJQN4LQ",
+	"3mfgdkJ9KqDO2NjqAGnUjRFxvflqJkVCDG0Vv71VVLxxDU2GQ7OKfcI8RBErGu8B9qQl735zT+1Jpy3K",
+	"mr9hIuGjZzc1T4sTMy8d3ZKnsDc93zBRsFyv0cJiqGhxMX590vt0Oo1PN6VTb7DlovM1HU0jDbziNQyQ",
+	"dpHinjdmHRvMYEt0QbYRhrGx+DfI73KQ9LK7iBOpiBkGTKBBb5qZRFdYad2pjB6E+L3QTKhCrW4HaVrn",
+	"5PbrVvFuvsaFooApwIBjv5s9jlrceidSqWzGU6lwf/Y8sYGvg2CDhc+f1sKnstPWRLhf2IROr8+qfS2f",
+	"wvPE+r4ewjG5IEsV+mHb9xwEf6pbHTvr5NtxvIKRvKP6uOyI5d0MwcagFLlhHReWMAxF5BhdI5SEg54w",
+	"zcVZ/sRkNg2Ljc5uaQ2u+wec4IXPb4/pOQ9hw76La03ENuwLYi27nxu2gH3s0aEHzsdtZyKVSr42pZes",
+	"xdPmB23nRWxMz/0BAjrnDWr7Xmx9JuvfWP1EVNbfNy6ZWjtRRuEecfPgXhZ71rzKygSuCGjUH2v9HulX",
+	"AAT4CrLZXQN/WwN6vRt3oOU1grbVn6IaTeiC/MvLr1y8sO+5yVxQgUGvCYiy/ANOSBvYGkY73H2163Kf",
+	"754c7G59/3pv9+zw9auxDuggCHwsC4b0xUr1ziEuEE8IZuYR6Fp6yz5deYmFokmeYYEk1TtB1Zza6GpY",
+	"EFx+qu6C0R/eekWufvkJMm4c5BqNt46xoC7EeM7wYkJnOc8lerKRzLHAiSICKTdX82KV+dLm3H14Pnp5",
+	"dGYSh78527Oi6ho1PeMXhAVJ+dcwAzPxnmy8JOHzNFSOIHA9v9DIZW3bmxrBVnVFUNSvO26YmJTMCNsg",
+	"75XAGwrPDCnjYjF6Fgz8odEySQPAhTXqLyyScPj5F/g8E5ip7gBkPUHjKRnzhSYxS7Xy8P1ijM9ihqTH",
+	"3+0dGPhcnduExQ9cAQom/Us8CpfdPKhSD8BldP2/AGqMxqP6go7eXQ/cACRDp4xU+pdc0EYYXSX05uQQ",
+	"PXSkrXWnEZ0iypIsT43/bamew/VHt7UH4SwqW1BeyUh8TCi2ZxC41LDB7aJtqesKnDLhLVgCpbcFBnRW",
+	"Gr5yYQU4Mg7IQJT5MNRPLjmT5Gbkz/ZRezmDxVbT/tk+TCXTVZRKGz1+U3MoBfLQ3PiXVmV0qaOgKN7f",
+	"+yUVRP5CY8oVWA2oYc4K3E+UuRQScb9fmjYu0OH+Hjrct6v88J8/nj3aRMfmWjbBl0xcQqinr1kIikLT",
+	"AuUihoetR8oTjeBkNUiDLghroI5mGapk8TnBIpp8KGbvW4mOEomAYWM1zolPTe9oGtf8VYJSfsWsqRjw",
+	"KoYPlGNL2vRnRReu1LkIIGVi9USkQJ3M7J7g7OD9EjxoXSr5amSadWIAqYDra2WiXb2a3EGNojDEiIH2",
+	"ntCZrq5LDzQKuj6aCULDUT5oP8NxT5UXeZaB9DHaJvRYjDyANKglr8b+4rfXQavY02cJL0NB0l8K998a",
+	"S+PqIFenIU7jJGZRDptW4Rl7HKpAiFnelcsmbbFO4nxZDo1m7Tu6H72u0xiyvV3ceur+8oyW+SSjcn7M",
+	"hWqRwM65VBuKb8xyIhXSTwfnAiO9CdLbI+u+RpgSK7TIpQofU/YddT7SfenhnkFn+i9nqFwv2VoKrnjC",
+	"s/ORMa1B56On20+3nz3ddo3szy2VLO3jxeNmaNqzvfH1u789M/883HqokuX/zdPl/5WJWj569I+ovU8t",
+	"gE/dqOQPkpG/qtR9e4R0si+wEzTRrr270Qtw799TGcIzwtQmOoOtCwPpu4BePrxA4IPG0Ou9QwTa0S39",
+	"ep3iBJ66WCIKgKJAoQqvYcJsokhb7lwnpUkUsMTJBZ4Rgy4usj9G3+UT8pYKhfR/cpwdGWN/9NPu0ffG",
+	"q88oYS8Xmyu8yKKBTN7yLF+Qo3iiEvhciZEA1yK6hGZ98yUceWdLJ3a1kyDCMBr2qQ2d86UnoEFGAqKS",
+	"LTaj7L0Wy08302eCd9KMxnD5P2qB4cFlNHRLUVZOCGz0qeBY6nQZEFZIKkFglScrqwRy3gbSMFUPrnSP",
+	"DxCVzhGnvGQWrAb5u9eaYOXUjQZT9g++Pzg72Ldxi4zrKlUSFdqBMdLKAeBHnHpgE9RrINwgFuMOTk5e",
+	"n7heQBQJKh0rbLJLoPmaKxu7BKZjBdI1jCr7UP5bcrZ5gq+OrFNCT/V5sQVRnbl9jdgR2/e3W1VuNxaj",
+	"2MaXlOb7+wf7WlX+ev/wxSH8afdAJ9nUq9hTeV6GzmnNy1+9PrxasE9cEMvyd2PdC8nFJElyQdVK87sL",
+	"qzABbllz48WvF058+c8fz0b61alrj57Z0mJnIVO1YYEOG9REb94c7ntnm4CfqaicCw9udISXYJOHWamB",
+	"LGMqXFR6kP/kBELKGPZHg6JfocXts6TfEft61TJRK69W2JwrssA0Gz0bKYIX/zsM3VL0eOapPtrjTAme",
+	"oTOCFzby+LORUxSWWtc8YX8ud/HuYazZI6sztZFqTMAd7RNlrBbNVbQgxjARZP0gyyXprFCu6TNtbGPc",
+	"FSY3zxk4XSTEstt2ZrtLnMwJery5XZvM1dXVJobiTS5mW7at3Pr+cO/g1enBxuPN7c25WmTm9aCAEFcW",
+	"aff4cDQuOL6Ri4Wj8WVJGF7S0bPRk83tzR2bvgTQcUvLgLYS7y87i+lLXhJViWpfvok0cnhae5haEaR1",
+	"wh2P3KMBBny8ve1wwhL94Abe+rd1njP0p1MrX4wCCFd5uXyn5/7FztNbG88bvdXG0pCAm5xbFwJaji8e",
+	"f30Pg59xjo4wWyEr8DZmeUa+9POovHGGLpldXxKxoPCkla1bDzneLGftvQhR0Nrz1MFY9gUUR42XRB0H",
+	"g98hihTDgBVJZPW+b5sZbOL2zj1s4hvmpLEk/Xzxdjz6cnv7HoaGjKtaKmRsXZC5s/sdG43W7mqLnpmy",
+	"yMRZy2gBH39PibuAYcreGN4vf5XQuveHrimIEpRcEjhZocoxfsocCHd5vmrSpRhqV6AdDtVwqKqH6hJn",
+	"EDm68VC9tRU0n1o5Il6YXT8CrhWwPAIviCJCggCkzjrHetWnzoHmWeA5wSmw5Y6vC9Voo3GwjtVH8bs7",
+	"PIltKKFnAtMwR+8+Bn2OU4eC93fez2ySo2Kuw4H/gx74393Fpg/Rhy2vtlpyqRrVV8rq4aykIHK1hlYb",
+	"co3b9eHx7hGiUuZEPKrr0K0RhZYNg5AMDBespCxOeM6sjUAr1XkVWFi3XPu5LGiP9VKwlCdcw1EomjF6",
+	"6A5CBIv0nKerW0OVktmN3uuwq/cbV1dXG5oL2MhFZkMJXbvvD9XpfrhD2lpWqDcSHuFr3C6V7Ry+RGz7",
+	"HD8v1W68b+FZpDHZye/LGWrLGK8rh3VlF+bvskIx6ytqXAfxks+IC/6i3u7cuIYbFUDJrQh60B2AVH5h",
+	"c4dVKj0wtm45eVB2Q/Ipg+CJ67awSd7lOmm95mvuu7vI5U80bLyumZQf1j7Omg1fVfhc2bDwJQdacknE",
+	"SumwI02AQqvTIGvjPUELayvHjjpqcbXBFS70El8Q9OCbB2P04Bv9Xy08e/Bf3zwo/NAvyGrnG9i3nfEF",
+	"WT3+L/PjsdOVRWYKI15vphqTFvi9di4OQrE7xPOTpKyYvEcQdOZREl3RLIOMDG2IVmquTbFKWE7eU2nV",
+	"W669xV+t39LHGELo+UCLWAYHB3RQMp9ITQOYMqeoETPogqrSOtXCkdk1GT3b2d7eDvyvtyMh6N/dsYDP",
+	"0ZQm+Y0V8/15mdraI3b7yT2M+oKLCU1Twj46J3sfsz21KoA3zIsBaxepuzPBMTLOpu4JYp+o0ZuzfnGa",
+	"BmHl0d1wZqUhenFPO3c4dmzVXKAqGN7o1koNn/1eWbu0XqfMdXjFy1880Z7wdPXfW06ztQXlGqCXRLUP",
+	"NiPqdkY6MRFT20cTkUrXHPHDQBzvmjhu3wdx1HqujCZqIMcxcvx+w9HY0bNSqRzVnjxbv4PIwVBvTUJi",
+	"VqgZWYuO73fRop+7PKyjA0E6Vui6QQBwvYf/vUsgBx7tPsjQF/cw5CuukIl5N9ChCB1qNp/oTUpeEnUn",
+	"dMTlAfyDE5EuZnEgJQMp+TxemPGkqcf68xrkBOrfCUFZ+gS0t0VS+j57N2Dov61pCWQiQnwU/cFA1D5P",
+	"oja8DD8+Gc0jHJnxQlyDip50CmSuT0eN/+JHIaR3KT+8b+r5MSSWA9EeiPZAtO9dnJcQ7cqnoSSSzhhl",
+	"M2fx027OsFe0OzXt7Fp02TY0NhwMHQZDh8HQYTB0uCntbCQwg9XDYPXw0e7lxnu2hwlEj8u2yRyiseUd",
+	"2UY0j3fPhhIdgPS0mmjupcGEom29r29PsQYYM6LuAAb7Zl8DDtHV4tqwGIFDY8e7S83g4qwOUt6z4WAd",
+	"MliHDM/JPtdW6W3Z8pJsf2j2MCJJrRFJeBMie3xRQVFihiR9KVCn0LH7Eh5MTAZaNuiFP1ViFpV1CYJT",
+	"I0fyj+ikhaDUzE/umfrcmmEKpDz8T04OTUA1XfkjvdoHAjUQqIFAdVuxXEtIAG3vmUYNti4DURyI4qBD",
+	"/WTJcB7lE0HcVWEV93qziifrictuiRR/EuYyNxQpf1Rq/NEl2sONMNwIw43wKYlBt3CgwIjeNUZRQRCE",
+	"WGWrNta/zvG/uZYS5Ab3jeIIlwEe7puB+x9o/UDr/8y0vqDimuibANcYEsDKLUFkbvKdxM0+TqDcR8We",
+	"YKlt5pix6SvM7DBLt7i1nfNfY+b2ujeTBFPekdWH6d2M9JGIZRmE5vBeA50cjL3unISUzrvOn/B+Q0ww",
+	"pOg1H701ChxIT09MO08hPlTpTbXckxZBZhQSS7cGIAfDbXdQigZdFtv1FoOp9mCqPZhqD6bat3VpF5Rl",
+	"sNEeru2PfG2Hd2kf4+yWC7XJKrve5I4Z82Cge7bDboJgEFcPJrSfNVWJMPZlLr6Bu18j5Np6pMm0ipKm",
+	"tUTBLYMOBrIDpRrszz45UtUciW09CvOSqDsnL59IaLZ+bNFAZwY68zk9tNriC61Haqw91Z2Tm0/Cguq6",
+	"r8CPQ+6G1+dAawcF+mf94O2lwuqnthpUVYOqalBVfR6qqgj6TDjPCGZomuGZRiHKkixPCeIsWwGgiwUW",
+	"K3cwLfXZRD/qScIqcgRMm8v0b1YMFlmvDabMdKWLXWdhMmH02pU+4FeMiAcG0UpH4kGxfBJhQRzCkhRd",
+	"aTge2I51Vw8QlQBR05IGdWMIaNcjtlgvaKY30JsdrdDe2wN0uG/nYFBQ+vKrOZcEvT5FdIF1Dn86I1Kh",
+	"OZYVH4jLPGNE4AnNqFptoiNNFyeanz86PDs52JBqlRFEU8KUri/Qw723Bxs//fTTTxsGhRIyRvpIamg2",
+	"Hm8//mJj5/GTL75sPIPJJTlMS1Nf4PffEzZT89Gzr74ANzpFhG75f3SXP29vfP3u9y8+uD/GH/4yGnef",
+	"1x+BtOvsliaBpk+QaapNSFrcT7DnUgmC4fZY6BODkZueRj5GrjLKyEZK4EiQFEH/B5f6CFnCZk9kLoT+",
+	"KJV+FVm8hXtG07diSCqR1PWmVEilB9zd3z/Y19SaKdm0dle6n3a0uXuhyPc0mvCSpet1VizgIFkZ9Mkf",
+	"kRHur0PuqTe+U13xx9EP9w7KlfDFgkpJObMNI3G4anWuHWoqVIk1Rfy66RheKB6N5XVrvX+PpTolhLWM",
+	"4qvcfDR7ZprHshVuMtIJYSkRJG1ZvUqVm4Y/axpJlIpvZ5SmFRSRSkPAssHaYhA+1e7cmOl0KGRa35Ki",
+	"p/XE9XQMg5XEQGEG7eWnRWI6LSP6WEPcArn4pKweBlox0IrPUQTQHr6rk15AxVujGEMUroFqDVRrsBn4",
+	"A9LJHlZgfS2/boFQfkIWXn80wni/cuKBEg+UeKDEH0GAthWqXBqjVmnI0jwjgaGFEXQFbetCtQ5dzvVE",
+	"a0WnnwRZD1dh4H0HijtQ3M+K4pbJa4T8ZlgqaVW7jQJJMPvDUiFdEym6IFLhxbKBTrZIKxu0xNeUWjbC",
+	"NeXiVonz3ZosuTVpYYW/qO/LK472LBADKR2En58dYfOEK0LUhDXd6CRqrqLlKaOUq9UO5CaUqzK4M3s1",
+	"63ybNCxqLw5084LxK+YBeUtEia+tGHhC5ZNy3dEfVRs00MyB/RzYz49OpT0ljlBp6a3UWmm0qabp6Tp6",
+	"8ah126AdH4jdwCB+ZtrxtWlIoCu/NSoyaMwHSjZQsoGS3UR/vTYhO+k09x902gPpGkjX8OL8E7047atS",
+	"vzcJEzzLFoSphLMpnbU+NYvKJR/82AvzwFfdM/2uQVRxz+x6JoDIFFJ1ICplXk4evYkOp0gnCaEpScc+",
+	"rAhNXHyBOUkudHCG9vxKNgyBjA8CrucQ9YFKlGBJfAQE6iSYNrJEdUU20SFDOMsQV3MioK0BMljlcCAT",
+	"YAIgnxBEFkvVGPYhkeKjCR1rGz9Q+oFJ/UzobnFyi4xGZSK75BlNaFf8peIMHev6q65ITJX6dAjKNARl",
+	"GoIyDflDbvEyN4RoyB4yRHv5A9yucIuu+sR9abxJmyLAVBvcUSyY2jD3HBUmPv5g9z9EsfiMaUlJVhLh",
+	"2eOs/DpRLtYgR6ZNhBytJZtuHHCIgTHQp0GU8IkRqJZoGGtQlpJ09g7IyidiDdSHBRqoy0BdPp+nVKtf",
+	"+BoExurU75TIfBIa9uu98j4GiRvelgN1HRT+n/NzVrhJ9dRMVRXynaopv2qDampQTQ2qqUE1dVtchiUs",
+	"g25q0E39oSw/upRTrOU27VZP2RZ3rp9ay8Nh564B6MxgsLvUFn+RdaoFesdNNW+YzaDH0GlDxZtE6+8x",
+	"7IyoOx6zJS1BU92bRvPvMW/RVPPWx+5IKnDLazDkFxg0s5/JTdrwlhUB+JG37Bqq2fUu4/1eBHwNCWfM",
+	"vH1Qzw5EahDxDXSxjS42a4TXI2gvibpjavbJaYVb3h0DVRvUwp+RFKM1r8J6dAYa3TGlGeJIDNRuoHYD",
+	"D/fJ0Nc2u5v1yOtJP0nXDQnsJ2Z788enrR9NcD7Q9YGuD3T9jyiz3DLqKZw15nuwmi7EBUoJW0WvivoN",
+	"sdtP63WNG0JxhMsgfWo3xK5b8o99UzhABrnqIIEYKGknJS1oZTtJXT+Y7s2FqNcLKTeIUgdCNhCyz0yU",
+	"eiPaExes3gX1GcSrAwUcKODwDP8ziFdvRHJP1jHqG0SuA70d6O3Acf7Rns5hKOBLDUnj8/iEKEHJJZEI",
+	"e18v02TznMV9/0yHXf5+n41L2SkXCnGREgFBi9W8cPGarIrUjGV3vge6jwfoISNX+lKYUiFVI3DQeQmo",
+	"1HQFTgcyGY1HhOULjS4YfsHHd+PrusOZ/Tf7prfI+bN1uUresp/Z+DP3If0RQNI4xciV2xSN7VIJgmF2",
+	"C4T1wTVegAlAzchVRhnZSAlsB0kR9AOn1p20TfSaZSvXZWLUhghPYTnnBF3ByFdYIo27k4zKuS4XejWZ",
+	"apoltIpNccJ5RjCLzRHguMQZTZHiMwIxs6+omqMH0NsDs2/ORWLTUT2bg8/tEmT0g9kgQRJCL0lqEAAz",
+	"RLDIqO4VHrjQXcO8sevDTRRNyFTjlB5CbwFnRI51cG9+pUkSRklGdTVD4PIFQdgtHVVznisE6Wc19RJI",
+	"kCXBQMoseW1YxcoUP17Ebw2l9b4cl/p5v8HS9foqMHBg3QZXzo/DRwH21Xkn/dkwStOMkK5ACS90na7g",
+	"CC9MR0NAhCEgwhAQ4XMIiFBb1EObLERDtFhgsXIn0KZqcesBJKcJSJzaxMvy1HSyJmtVsI/JHLMZgVNj",
+	"gNDVJiQtCNltsZWwd7kQ+qNUWHnSAxRJn4RiSCoNkwVvHz3g7v7+wX4Hd9SDx7xLpghI+8AUDUzRn4Mp",
+	"AvrTJ+B6me9pCmMBte4odIXp+57DVQSDdoaoMM7DpkVDaAi3PtcPzdDQ/YyoW+q7JdRDWH7tcTTtPCOL",
+	"ZYaVe+FGRstitapjGuRdI65Dw+KJsPSmsSNaF1HU6wwxIoYYEYOit3oblZ7o8Dl8om/9Dv9+2FKWRFwG",
+	"hCT6dod3h6uNLguKUn+8d5CdqMKXXzEiHKdbG6ZBvTsNLst++t3xIEIYRAiDCGGIqbgmRa6QtCGi4vDi",
+	"/GPe8fULvcel3yMaVOoS9VTv5oYIUJUDc2MW4O44gKq5Wc+RhzBTA0UabLr+AEQw+loRBKeGVfd8Sifh",
+	"eknUQLXuk2pVV3sgXwP5Gni4m/FwWymdTrd+52pOxIdGYc4eXyyxIIE+1RFKsw5g9K+ueF0SowtwoXSt",
+	"sH50Ov2UhD9dRBQpjhK7VFr4c1tE9UaAKN4ABuz5H5W4a9QYCPxA4AcC30ng+yfN7VIp7zeqTDudrspd",
+	"D0GXB2ozUJtP9jVsEuF2UYuXRN0SqbjFMBx/CLvAOzeHG2jVQKs+Q4O51vDJnfQK6t0SxRpCdwwEayBY",
+	"Q7iOPxyJbM083kUhT5rNMq9BIz+JSBtr2DjfG0m8V3PqgQQPJHggwfdoSLtmUGIIAsGzjOcKTYDTtQS3",
+	"0FQb0gvO9FQifIUpWC+6IRpDF0O7E9P3c+vkdQ2afzXnsgpjEcL4U6D/4Rp8rNDFA5s80OiBRn9EHUsp",
+	"9HGZWFva1kirj3EuydgFJOEC4QkXqkS640Q74tXHmRI8C8nSbVBl0AdDz58QPbZrMVDigRIPlPgzosSO",
+	"3DYSYvB8IldAj6NO1MemAprzK4RDGoxRgllKQR6iaXETM33F8yy13kROUzT2IaaWREgqDZPNCm+mirjZ",
+	"wHBzSq44shMu3ShTLj4FWn66JMl9U3C73HYHBlI+KMs+M8IKdHWCEwAjsW1n1vyxgdwu/Wlppcq+Wo04",
+	"dyQFARP7IkR0hOo2WhhcLw70ndoZDCr+gWoNVOt+VfyVGPNrKPxvi4AMav+BiA1EbCBi11DC20BEa3JA",
+	"J13hiwa9/ECzBpo10Ky7kMMFGS1MKJ9eGS1SkIwlyofcMW19ooaC5BVEabUkTakvvjcj96B6uhcbBcfT",
+	"OmEB80CEXnkVI+8LytJW0ucSPhhT8F7JHnbRlGY2QlQVFq7j8GuAggCtoMQv4kDN6CVhpr4PbXQncZNu",
+	"AUoTMqgLyluPeVSgm4H3Y2fQuJ5ggLzHi2VmWpiJHJgv+oN1XBg9G9mPfk5wqDJ3QiDqkklgc0kFZwvC",
+	"1DdLwdPciIA0ZDPK2Te53CBYqo2d0XikKBHfTHByQVg6evfhQ7gQbUQHzuUQ12iIa/TRLi/A+/rlZY+D",
+	"vrUYV4WLugsa25xp4FVQ/ZSyi66kA9X6Q/6BIXjgEDxwCB54U5JYpSvDLTvcsh/tlq3eoX1C1zdepE1R",
+	"7KsN7iigfW2Ye45tHx9/8MsYoo1/xrSkxLzXOfYoH79O0JM1iJFpEyFGa0ncGwccoqIM1GmQhn9i5Kkl",
+	"QMoalOUlUXdKVj4Ry6Y+DNBAXQbq8vk8pFr99dcgMNZQ4E6JzCdhOHC9N97HIHHDy3KgroMP02f3mOVi",
+	"hhn9zXztZVHh1A+llpsIQSJ/I66X5UKjodcEPZdEoDmWCCcJkdJGA67rsl6XoOrSY/1ZVSV3yfuGKzxI",
+	"9AeJ/r0TrsKMBLTdvHLiHV0Lv9dpWrmVpmeCLLmkigtKOvTrJ67mqkuzfhL2OSjVB6X6oFQflOo39An2",
+	"xGe4fIfL96O9GvxtueqjSI/cmE0q9KLqHSnPgwHuWW1eHbkzL7xbEbNipyuW1BODJ/U6tXXTJFL/G2xa",
+	"jzzhY6tHDMBuSE5f2rPrZ5FvG2hG1G2MYsWLbSOJWpUh0fpg+jBIi6J0v/SmKr2gqk+qdUwdel0X++2k",
+	"p1NFEBlkMGwYaM+gevxkiE+LSUMvCvKSqFsnH5+IAUM7KzrQj4F+fA6P1vY8LL1oiA1NcstUZIjPMlCy",
+	"gZINZgJ/YNrZavDVi3SedAharks8PwnzrnWlkPdLMO9f6jlQ6YFKD1T6o4vntpI5SS42eEI36ALPSHNo",
+	"6j1dUatssU+hj17vHSJohqgz1KKTjBhdrI7ZIZVYoYSzKZ3lwmhs45cFKH2LFoKkhCmKMwn68YQzRiAW",
+	"CJJEaYW6RBgUxzgtbCP0hNJo75EQPTCdou7rhB7C/G/pSrIhTsI1sDP4g99TDevykZj9OjQnYCswsP6f",
+	"xaWCNqIHLOVEIsaVMRgZ7oE17oEave++FxSerXcrmBtB4ZnZH8hUjRlcFp/anXCGZ8ONEFuV4T4Y7oPh",
+	"PvhT3QeazpvbwNSUK5Z0GkYXVkjdptFF3cE2erCNHmyjB9vom4saC5oyWEcP1tEf8bot7sx+9tGRi7PZ",
+	"QrrN1vfWD9L9W0lXx+60k3amgG120mm9zs1sldsGmxF1OyN5HVnbaCJSabBZHmyWB6VIAzWuPH+KUll/",
+	"8axnt9yLjO93kaIeQqXIQIP18kCFBuvDT4gMtdov96IkL4m6EzLyyVgxt7OKAyUZKMnn8bzssmTuRU2s",
+	"Ge8d0JPBnnmgaQNNG2zl/uBUtMOmuRcRPekUxlyfjH4ils3ryg7vm3h+DGnlQLMHmj3Q7HsX5UmSCKI6",
+	"zBZOoVKXwcKp7WowVRhMFQZThcFU4YYkEKjJYKQwGCl8tLvU3I19zBMqF2STYYKpdkcmCbbzezZGCEcd",
+	"GPtBYf7ZUYYSf22+lzjrddTjnWTE1PRkZC2hSaXzQRk+UJhBhfVJkJgWNXgnxXhJ1K2Ri09E6d3Mkgy0",
+	"YqAVf/aHSquKppNcWOXMrZGMT0Ihs87L6f7I1PBKG+jioH75Ez4ML4mQ1IDTyNlJO46tG+Xr3tp+7pBG",
+	"uSFaeKlBGPp5YLbD2nf649aV3Lrc2UqJbuJDYARgya3f8XJpPiecSZ6RRnx/vSRah/EjmZzy5IIoZBsg",
+	"SaQeUvMRmKGgdyRyxkC9ZNQr+wBH9JCYot2i7Z6FZk3exvRT4pxug6MZd40bzlpxFwTDZveLQGBX/eZA",
+	"6EoaiMhm8CVhm2gvF4Iwla0Q1+kJz0eSCIqz85GObWVVgCRtUabqbs9Wy3ZYCcsXhrDqzkfvxhXoNZ3V",
+	"dTYusdBdA67uFZ2f2nb1J+eOIV2VU3BFVaJ1q+hYcMUTnsmANerDyfSiXN18Qve13nkL9yItkXkdMkWE",
+	"1s6fGg3ngRBcmNoR0F5iRa7wCp3RBeG5KtEMQx/0pr3fEBMMnnk4sQ1nVhPi70hHTUpkxBGPD9Ubtb12",
+	"E4m6DVrUi+L8scjMnwf3P23U7sTmsIIxMDBYk4ts9Gy0hZd063Jn9OGdBySCwMKmhtXRk/QOEKbsAdkM",
+	"7olSwejDuKUjztBurubHgl/SlIiyHVDQ39JW6OxtjwibMJec0pm+ye3ORbtOitrS1BYe89rHqZymsFO7",
+	"fx/GHQto6iGztfUO7PeekJyYWFaWh2mESgTVOns+YIJn2YIwdcwzmqyi/RJfaQmV1ui1bWeKbnvtiAnn",
+	"BemONZUhl4SpUnf6QydoLzJC4uBMdclaIBj7KoQTwaVEKZ1OiSAs3jvU7YSuMa9+2FUto/Q6MIfphaO9",
+	"l/K6dsHblKrV9hWEReruqSm2ke8rME3s6i1mcmj7sW/sHmuWEApLFnlN274u3QP33Yf/bwCx75AH2YIE",
+	"AA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -26,16 +26,51 @@ const (
 	AppTypeVm        AppType = "vm"
 )
 
+// Defines values for ApplicationHttpProbeScheme.
+const (
+	ApplicationHttpProbeSchemeHttp  ApplicationHttpProbeScheme = "http"
+	ApplicationHttpProbeSchemeHttps ApplicationHttpProbeScheme = "https"
+)
+
+// Defines values for ApplicationLifecycleHookType.
+const (
+	ApplicationLifecycleHookPostStart ApplicationLifecycleHookType = "PostStart"
+	ApplicationLifecycleHookPreStop   ApplicationLifecycleHookType = "PreStop"
+	ApplicationLifecycleHookPreUpdate ApplicationLifecycleHookType = "PreUpdate"
+)
+
+// Defines values for ApplicationProbeResult.
+const (
+	ApplicationProbeResultFailure ApplicationProbeResult = "Failure"
+	ApplicationProbeResultSuccess ApplicationProbeResult = "Success"
+	ApplicationProbeResultUnknown ApplicationProbeResult = "Unknown"
+)
+
+// Defines values for ApplicationProbeType.
+const (
+	ApplicationProbeTypeLiveness  ApplicationProbeType = "Liveness"
+	ApplicationProbeTypeReadiness ApplicationProbeType = "Readiness"
+)
+
+// Defines values for ApplicationRestartPolicyType.
+const (
+	ApplicationRestartPolicyAlways    ApplicationRestartPolicyType = "Always"
+	ApplicationRestartPolicyNever     ApplicationRestartPolicyType = "Never"
+	ApplicationRestartPolicyOnFailure ApplicationRestartPolicyType = "OnFailure"
+)
+
 // Defines values for ApplicationStatusType.
 const (
-	ApplicationStatusCompleted ApplicationStatusType = "Completed"
-	ApplicationStatusError     ApplicationStatusType = "Error"
-	ApplicationStatusPreparing ApplicationStatusType = "Preparing"
-	ApplicationStatusRunning   ApplicationStatusType = "Running"
-	ApplicationStatusStarting  ApplicationStatusType = "Starting"
-	ApplicationStatusStopped   ApplicationStatusType = "Stopped"
-	ApplicationStatusStopping  ApplicationStatusType = "Stopping"
-	ApplicationStatusUnknown   ApplicationStatusType = "Unknown"
+	ApplicationStatusBlocked      ApplicationStatusType = "Blocked"
+	ApplicationStatusCompleted    ApplicationStatusType = "Completed"
+	ApplicationStatusCrashLooping ApplicationStatusType = "CrashLooping"
+	ApplicationStatusError        ApplicationStatusType = "Error"
+	ApplicationStatusPreparing    ApplicationStatusType = "Preparing"
+	ApplicationStatusRunning      ApplicationStatusType = "Running"
+	ApplicationStatusStarting     ApplicationStatusType = "Starting"
+	ApplicationStatusStopped      ApplicationStatusType = "Stopped"
+	ApplicationStatusStopping     ApplicationStatusType = "Stopping"
+	ApplicationStatusUnknown      ApplicationStatusType = "Unknown"
 )
 
 // Defines values for ApplicationVolumeReclaimPolicy.
@@ -95,6 +130,7 @@ const (
 	ConditionTypeDeviceSpecValid                      ConditionType = "SpecValid"
 	ConditionTypeDeviceUpdating                       ConditionType = "Updating"
 	ConditionTypeEnrollmentRequestApproved            ConditionType = "Approved"
+	ConditionTypeEnrollmentRequestDenied              ConditionType = "Denied"
 	ConditionTypeEnrollmentRequestTPMVerified         ConditionType = "TPMVerified"
 	ConditionTypeFleetRolloutInProgress               ConditionType = "RolloutInProgress"
 	ConditionTypeFleetValid                           ConditionType = "Valid"
@@ -210,75 +246,95 @@ const (
 
 // Defines values for EncodingType.
 const (
-	EncodingBase64 EncodingType = "base64"
-	EncodingPlain  EncodingType = "plain"
+	EncodingBase64    EncodingType = "base64"
+	EncodingEncrypted EncodingType = "encrypted"
+	EncodingPlain     EncodingType = "plain"
 )
 
 // Defines values for EventReason.
 const (
-	EventReasonDependencyChangeDetected        EventReason = "DependencyChangeDetected"
-	EventReasonDependencySyncProbeFailed       EventReason = "DependencySyncProbeFailed"
-	EventReasonDeviceApplicationDegraded       EventReason = "DeviceApplicationDegraded"
-	EventReasonDeviceApplicationError          EventReason = "DeviceApplicationError"
-	EventReasonDeviceApplicationHealthy        EventReason = "DeviceApplicationHealthy"
-	EventReasonDeviceCPUCritical               EventReason = "DeviceCPUCritical"
-	EventReasonDeviceCPUNormal                 EventReason = "DeviceCPUNormal"
-	EventReasonDeviceCPUWarning                EventReason = "DeviceCPUWarning"
-	EventReasonDeviceConflictPaused            EventReason = "DeviceConflictPaused"
-	EventReasonDeviceConflictResolved          EventReason = "DeviceConflictResolved"
-	EventReasonDeviceConnected                 EventReason = "DeviceConnected"
-	EventReasonDeviceContentOutOfDate          EventReason = "DeviceContentOutOfDate"
-	EventReasonDeviceContentUpToDate           EventReason = "DeviceContentUpToDate"
-	EventReasonDeviceContentUpdating           EventReason = "DeviceContentUpdating"
-	EventReasonDeviceDecommissionFailed        EventReason = "DeviceDecommissionFailed"
-	EventReasonDeviceDecommissioned            EventReason = "DeviceDecommissioned"
-	EventReasonDeviceDisconnected              EventReason = "DeviceDisconnected"
-	EventReasonDeviceDiskCritical              EventReason = "DeviceDiskCritical"
-	EventReasonDeviceDiskNormal                EventReason = "DeviceDiskNormal"
-	EventReasonDeviceDiskWarning               EventReason = "DeviceDiskWarning"
-	EventReasonDeviceIsRebooting               EventReason = "DeviceIsRebooting"
-	EventReasonDeviceMemoryCritical            EventReason = "DeviceMemoryCritical"
-	EventReasonDeviceMemoryNormal              EventReason = "DeviceMemoryNormal"
-	EventReasonDeviceMemoryWarning             EventReason = "DeviceMemoryWarning"
-	EventReasonDeviceMultipleOwnersDetected    EventReason = "DeviceMultipleOwnersDetected"
-	EventReasonDeviceMultipleOwnersResolved    EventReason = "DeviceMultipleOwnersResolved"
-	EventReasonDeviceOSImageChanged            EventReason = "DeviceOSImageChanged"
-	EventReasonDeviceSpecInvalid               EventReason = "DeviceSpecInvalid"
-	EventReasonDeviceSpecValid                 EventReason = "DeviceSpecValid"
-	EventReasonDeviceUpdateFailed              EventReason = "DeviceUpdateFailed"
-	EventReasonDeviceVulnerabilityCVECritical  EventReason = "DeviceVulnerabilityCVECritical"
-	EventReasonDeviceVulnerabilityCVEResolved  EventReason = "DeviceVulnerabilityCVEResolved"
-	EventReasonDeviceVulnerabilityCVEWarning   EventReason = "DeviceVulnerabilityCVEWarning"
-	EventReasonEnrollmentRequestApprovalFailed EventReason = "EnrollmentRequestApprovalFailed"
-	EventReasonEnrollmentRequestApproved       EventReason = "EnrollmentRequestApproved"
-	EventReasonFleetInvalid                    EventReason = "FleetInvalid"
-	EventReasonFleetRolloutBatchCompleted      EventReason = "FleetRolloutBatchCompleted"
-	EventReasonFleetRolloutBatchDispatched     EventReason = "FleetRolloutBatchDispatched"
-	EventReasonFleetRolloutCompleted           EventReason = "FleetRolloutCompleted"
-	EventReasonFleetRolloutCreated             EventReason = "FleetRolloutCreated"
-	EventReasonFleetRolloutDeviceSelected      EventReason = "FleetRolloutDeviceSelected"
-	EventReasonFleetRolloutFailed              EventReason = "FleetRolloutFailed"
-	EventReasonFleetRolloutStarted             EventReason = "FleetRolloutStarted"
-	EventReasonFleetValid                      EventReason = "FleetValid"
-	EventReasonInternalTaskFailed              EventReason = "InternalTaskFailed"
-	EventReasonInternalTaskPermanentlyFailed   EventReason = "InternalTaskPermanentlyFailed"
-	EventReasonReferencedRepositoryUpdated     EventReason = "ReferencedRepositoryUpdated"
-	EventReasonRepositoryAccessible            EventReason = "RepositoryAccessible"
-	EventReasonRepositoryInaccessible          EventReason = "RepositoryInaccessible"
-	EventReasonResourceCreated                 EventReason = "ResourceCreated"
-	EventReasonResourceCreationFailed          EventReason = "ResourceCreationFailed"
-	EventReasonResourceDeleted                 EventReason = "ResourceDeleted"
-	EventReasonResourceDeletionFailed          EventReason = "ResourceDeletionFailed"
-	EventReasonResourceSyncAccessible          EventReason = "ResourceSyncAccessible"
-	EventReasonResourceSyncCommitDetected      EventReason = "ResourceSyncCommitDetected"
-	EventReasonResourceSyncInaccessible        EventReason = "ResourceSyncInaccessible"
-	EventReasonResourceSyncParsed              EventReason = "ResourceSyncParsed"
-	EventReasonResourceSyncParsingFailed       EventReason = "ResourceSyncParsingFailed"
-	EventReasonResourceSyncSyncFailed          EventReason = "ResourceSyncSyncFailed"
-	EventReasonResourceSyncSynced              EventReason = "ResourceSyncSynced"
-	EventReasonResourceUpdateFailed            EventReason = "ResourceUpdateFailed"
-	EventReasonResourceUpdated                 EventReason = "ResourceUpdated"
-	EventReasonSystemRestored                  EventReason = "SystemRestored"
+	EventReasonCertificateSigningRequestExpired EventReason = "CertificateSigningRequestExpired"
+	EventReasonDependencyChangeDetected         EventReason = "DependencyChangeDetected"
+	EventReasonDependencySyncProbeFailed        EventReason = "DependencySyncProbeFailed"
+	EventReasonDeviceApplicationDegraded        EventReason = "DeviceApplicationDegraded"
+	EventReasonDeviceApplicationError           EventReason = "DeviceApplicationError"
+	EventReasonDeviceApplicationHealthy         EventReason = "DeviceApplicationHealthy"
+	EventReasonDeviceCPUCritical                EventReason = "DeviceCPUCritical"
+	EventReasonDeviceCPUNormal                  EventReason = "DeviceCPUNormal"
+	EventReasonDeviceCPUWarning                 EventReason = "DeviceCPUWarning"
+	EventReasonDeviceConflictPaused             EventReason = "DeviceConflictPaused"
+	EventReasonDeviceConflictResolved           EventReason = "DeviceConflictResolved"
+	EventReasonDeviceConnected                  EventReason = "DeviceConnected"
+	EventReasonDeviceContentOutOfDate           EventReason = "DeviceContentOutOfDate"
+	EventReasonDeviceContentUpToDate            EventReason = "DeviceContentUpToDate"
+	EventReasonDeviceContentUpdating            EventReason = "DeviceContentUpdating"
+	EventReasonDeviceCustomCritical             EventReason = "DeviceCustomCritical"
+	EventReasonDeviceCustomNormal               EventReason = "DeviceCustomNormal"
+	EventReasonDeviceCustomWarning              EventReason = "DeviceCustomWarning"
+	EventReasonDeviceDecommissionFailed         EventReason = "DeviceDecommissionFailed"
+	EventReasonDeviceDecommissioned             EventReason = "DeviceDecommissioned"
+	EventReasonDeviceDisconnected               EventReason = "DeviceDisconnected"
+	EventReasonDeviceDiskCritical               EventReason = "DeviceDiskCritical"
+	EventReasonDeviceDiskNormal                 EventReason = "DeviceDiskNormal"
+	EventReasonDeviceDiskWarning                EventReason = "DeviceDiskWarning"
+	EventReasonDeviceIsRebooting                EventReason = "DeviceIsRebooting"
+	EventReasonDeviceMemoryCritical             EventReason = "DeviceMemoryCritical"
+	EventReasonDeviceMemoryNormal               EventReason = "DeviceMemoryNormal"
+	EventReasonDeviceMemoryWarning              EventReason = "DeviceMemoryWarning"
+	EventReasonDeviceMultipleOwnersDetected     EventReason = "DeviceMultipleOwnersDetected"
+	EventReasonDeviceMultipleOwnersResolved     EventReason = "DeviceMultipleOwnersResolved"
+	EventReasonDeviceNetworkCritical            EventReason = "DeviceNetworkCritical"
+	EventReasonDeviceNetworkNormal              EventReason = "DeviceNetworkNormal"
+	EventReasonDeviceNetworkWarning             EventReason = "DeviceNetworkWarning"
+	EventReasonDeviceOSImageChanged             EventReason = "DeviceOSImageChanged"
+	EventReasonDeviceProcessCritical            EventReason = "DeviceProcessCritical"
+	EventReasonDeviceProcessNormal              EventReason = "DeviceProcessNormal"
+	EventReasonDeviceProcessWarning             EventReason = "DeviceProcessWarning"
+	EventReasonDeviceSpecInvalid                EventReason = "DeviceSpecInvalid"
+	EventReasonDeviceSpecValid                  EventReason = "DeviceSpecValid"
+	EventReasonDeviceTemperatureCritical        EventReason = "DeviceTemperatureCritical"
+	EventReasonDeviceTemperatureNormal          EventReason = "DeviceTemperatureNormal"
+	EventReasonDeviceTemperatureWarning         EventReason = "DeviceTemperatureWarning"
+	EventReasonDeviceUpdateFailed               EventReason = "DeviceUpdateFailed"
+	EventReasonDeviceVulnerabilityCVECritical   EventReason = "DeviceVulnerabilityCVECritical"
+	EventReasonDeviceVulnerabilityCVEResolved   EventReason = "DeviceVulnerabilityCVEResolved"
+	EventReasonDeviceVulnerabilityCVEWarning    EventReason = "DeviceVulnerabilityCVEWarning"
+	EventReasonEnrollmentRequestApprovalFailed  EventReason = "EnrollmentRequestApprovalFailed"
+	EventReasonEnrollmentRequestApproved        EventReason = "EnrollmentRequestApproved"
+	EventReasonEnrollmentRequestExpired         EventReason = "EnrollmentRequestExpired"
+	EventReasonFleetInvalid                     EventReason = "FleetInvalid"
+	EventReasonFleetRolloutAborted              EventReason = "FleetRolloutAborted"
+	EventReasonFleetRolloutBatchCompleted       EventReason = "FleetRolloutBatchCompleted"
+	EventReasonFleetRolloutBatchDispatched      EventReason = "FleetRolloutBatchDispatched"
+	EventReasonFleetRolloutCompleted            EventReason = "FleetRolloutCompleted"
+	EventReasonFleetRolloutCreated              EventReason = "FleetRolloutCreated"
+	EventReasonFleetRolloutDeviceSelected       EventReason = "FleetRolloutDeviceSelected"
+	EventReasonFleetRolloutFailed               EventReason = "FleetRolloutFailed"
+	EventReasonFleetRolloutPaused               EventReason = "FleetRolloutPaused"
+	EventReasonFleetRolloutResumed              EventReason = "FleetRolloutResumed"
+	EventReasonFleetRolloutRolledBack           EventReason = "FleetRolloutRolledBack"
+	EventReasonFleetRolloutStarted              EventReason = "FleetRolloutStarted"
+	EventReasonFleetValid                       EventReason = "FleetValid"
+	EventReasonInternalTaskFailed               EventReason = "InternalTaskFailed"
+	EventReasonInternalTaskPermanentlyFailed    EventReason = "InternalTaskPermanentlyFailed"
+	EventReasonNotificationDeliveryFailed       EventReason = "NotificationDeliveryFailed"
+	EventReasonReferencedRepositoryUpdated      EventReason = "ReferencedRepositoryUpdated"
+	EventReasonRepositoryAccessible             EventReason = "RepositoryAccessible"
+	EventReasonRepositoryInaccessible           EventReason = "RepositoryInaccessible"
+	EventReasonResourceCreated                  EventReason = "ResourceCreated"
+	EventReasonResourceCreationFailed           EventReason = "ResourceCreationFailed"
+	EventReasonResourceDeleted                  EventReason = "ResourceDeleted"
+	EventReasonResourceDeletionFailed           EventReason = "ResourceDeletionFailed"
+	EventReasonResourceSyncAccessible           EventReason = "ResourceSyncAccessible"
+	EventReasonResourceSyncCommitDetected       EventReason = "ResourceSyncCommitDetected"
+	EventReasonResourceSyncInaccessible         EventReason = "ResourceSyncInaccessible"
+	EventReasonResourceSyncParsed               EventReason = "ResourceSyncParsed"
+	EventReasonResourceSyncParsingFailed        EventReason = "ResourceSyncParsingFailed"
+	EventReasonResourceSyncSyncFailed           EventReason = "ResourceSyncSyncFailed"
+	EventReasonResourceSyncSynced               EventReason = "ResourceSyncSynced"
+	EventReasonResourceUpdateFailed             EventReason = "ResourceUpdateFailed"
+	EventReasonResourceUpdated                  EventReason = "ResourceUpdated"
+	EventReasonSystemRestored                   EventReason = "SystemRestored"
 )
 
 // Defines values for EventType.
@@ -294,6 +350,11 @@ const (
 	FileOperationUpdated FileOperation = "updated"
 )
 
+// Defines values for FleetRolloutAbortedDetailsDetailType.
+const (
+	FleetRolloutAborted FleetRolloutAbortedDetailsDetailType = "FleetRolloutAborted"
+)
+
 // Defines values for FleetRolloutBatchCompletedDetailsDetailType.
 const (
 	FleetRolloutBatchCompleted FleetRolloutBatchCompletedDetailsDetailType = "FleetRolloutBatchCompleted"
@@ -309,6 +370,13 @@ const (
 	FleetRolloutCompleted FleetRolloutCompletedDetailsDetailType = "FleetRolloutCompleted"
 )
 
+// Defines values for FleetRolloutControlAction.
+const (
+	FleetRolloutControlActionAbort  FleetRolloutControlAction = "Abort"
+	FleetRolloutControlActionPause  FleetRolloutControlAction = "Pause"
+	FleetRolloutControlActionResume FleetRolloutControlAction = "Resume"
+)
+
 // Defines values for FleetRolloutDeviceSelectedDetailsDetailType.
 const (
 	FleetRolloutDeviceSelected FleetRolloutDeviceSelectedDetailsDetailType = "FleetRolloutDeviceSelected"
@@ -319,6 +387,21 @@ const (
 	FleetRolloutFailed FleetRolloutFailedDetailsDetailType = "FleetRolloutFailed"
 )
 
+// Defines values for FleetRolloutPausedDetailsDetailType.
+const (
+	FleetRolloutPaused FleetRolloutPausedDetailsDetailType = "FleetRolloutPaused"
+)
+
+// Defines values for FleetRolloutResumedDetailsDetailType.
+const (
+	FleetRolloutResumed FleetRolloutResumedDetailsDetailType = "FleetRolloutResumed"
+)
+
+// Defines values for FleetRolloutRolledBackDetailsDetailType.
+const (
+	FleetRolloutRolledBack FleetRolloutRolledBackDetailsDetailType = "FleetRolloutRolledBack"
+)
+
 // Defines values for FleetRolloutStartedDetailsDetailType.
 const (
 	FleetRolloutStarted FleetRolloutStartedDetailsDetailType = "FleetRolloutStarted"
@@ -340,6 +423,15 @@ const (
 	GitRepoSpecTypeGit GitRepoSpecType = "git"
 )
 
+// Defines values for HookActionHttpMethod.
+const (
+	HookActionHttpMethodDelete HookActionHttpMethod = "DELETE"
+	HookActionHttpMethodGet    HookActionHttpMethod = "GET"
+	HookActionHttpMethodPatch  HookActionHttpMethod = "PATCH"
+	HookActionHttpMethodPost   HookActionHttpMethod = "POST"
+	HookActionHttpMethodPut    HookActionHttpMethod = "PUT"
+)
+
 // Defines values for HttpRepoSpecType.
 const (
 	HttpRepoSpecTypeHttp HttpRepoSpecType = "http"
@@ -380,6 +472,20 @@ const (
 	NotIn        MatchExpressionOperator = "NotIn"
 )
 
+// Defines values for NetworkMonitorMetric.
+const (
+	NetworkMonitorMetricErrors     NetworkMonitorMetric = "Errors"
+	NetworkMonitorMetricThroughput NetworkMonitorMetric = "Throughput"
+)
+
+// Defines values for NotificationSinkType.
+const (
+	NotificationSinkTypeCloudEvents NotificationSinkType = "CloudEvents"
+	NotificationSinkTypeEmail       NotificationSinkType = "Email"
+	NotificationSinkTypeSlack       NotificationSinkType = "Slack"
+	NotificationSinkTypeWebhook     NotificationSinkType = "Webhook"
+)
+
 // Defines values for OAuth2ProviderSpecProviderType.
 const (
 	Oauth2 OAuth2ProviderSpecProviderType = "oauth2"
@@ -425,6 +531,12 @@ const (
 	Test    PatchRequestOp = "test"
 )
 
+// Defines values for ProcessMonitorMetric.
+const (
+	ProcessMonitorMetricMemory   ProcessMonitorMetric = "Memory"
+	ProcessMonitorMetricPresence ProcessMonitorMetric = "Presence"
+)
+
 // Defines values for ReferencedRepositoryUpdatedDetailsDetailType.
 const (
 	ReferencedRepositoryUpdated ReferencedRepositoryUpdatedDetailsDetailType = "ReferencedRepositoryUpdated"
@@ -486,9 +598,26 @@ const (
 	Rfc7662 Rfc7662IntrospectionSpecType = "rfc7662"
 )
 
+// Defines values for RolloutFailurePolicy.
+const (
+	RolloutFailurePolicyPause    RolloutFailurePolicy = "Pause"
+	RolloutFailurePolicyRollback RolloutFailurePolicy = "Rollback"
+)
+
+// Defines values for RolloutOutcome.
+const (
+	RolloutOutcomeAborted    RolloutOutcome = "Aborted"
+	RolloutOutcomeCompleted  RolloutOutcome = "Completed"
+	RolloutOutcomeInProgress RolloutOutcome = "InProgress"
+	RolloutOutcomeRolledBack RolloutOutcome = "RolledBack"
+	RolloutOutcomeSuperseded RolloutOutcome = "Superseded"
+)
+
 // Defines values for RolloutStrategy.
 const (
 	RolloutStrategyBatchSequence RolloutStrategy = "BatchSequence"
+	RolloutStrategyCanary        RolloutStrategy = "Canary"
+	RolloutStrategyProgressive   RolloutStrategy = "Progressive"
 )
 
 // Defines values for SystemdActiveStateType.
@@ -535,6 +664,13 @@ const (
 	SystemdLoadStateUnknown    SystemdLoadStateType = "unknown"
 )
 
+// Defines values for TemplateVersionChangeType.
+const (
+	TemplateVersionChangeAdded    TemplateVersionChangeType = "Added"
+	TemplateVersionChangeModified TemplateVersionChangeType = "Modified"
+	TemplateVersionChangeRemoved  TemplateVersionChangeType = "Removed"
+)
+
 // Defines values for TokenRequestGrantType.
 const (
 	AuthorizationCode TokenRequestGrantType = "authorization_code"
@@ -546,6 +682,14 @@ const (
 	Bearer TokenResponseTokenType = "Bearer"
 )
 
+// Defines values for WatchEventType.
+const (
+	WatchEventTypeAdded    WatchEventType = "ADDED"
+	WatchEventTypeDeleted  WatchEventType = "DELETED"
+	WatchEventTypeError    WatchEventType = "ERROR"
+	WatchEventTypeModified WatchEventType = "MODIFIED"
+)
+
 // Defines values for ListEventsParamsOrder.
 const (
 	Asc  ListEventsParamsOrder = "asc"
@@ -615,22 +759,124 @@ type ApplicationContent struct {
 	// Content The plain text (UTF-8) or base64-encoded content of the file.
 	Content *string `json:"content,omitempty"`
 
-	// ContentEncoding Specifies the encoding type used for data representation.
+	// ContentEncoding Specifies the encoding type used for data representation. The encrypted type is set by the service in rendered device specs for content that is encrypted to the device's key and cannot be used in user-provided specs.
 	ContentEncoding *EncodingType `json:"contentEncoding,omitempty"`
 
 	// Path A relative file path on the system. Note that any existing file will be overwritten.
 	Path string `json:"path"`
 }
 
+// ApplicationDependencies defines model for ApplicationDependencies.
+type ApplicationDependencies struct {
+	// DependsOn Names of the applications on the device that must be running and ready before this application is started. The application is stopped before the applications it depends on.
+	DependsOn *[]string `json:"dependsOn,omitempty"`
+}
+
 // ApplicationEnvVars defines model for ApplicationEnvVars.
 type ApplicationEnvVars struct {
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 }
 
+// ApplicationExecProbe Probes an application by running a command inside one of its containers. The probe succeeds if the command exits with status 0.
+type ApplicationExecProbe struct {
+	// Command The command and its arguments.
+	Command []string `json:"command"`
+
+	// Container The name of the container to run the command in. Required if the application runs more than one container.
+	Container *string `json:"container,omitempty"`
+}
+
+// ApplicationHealthProbes defines model for ApplicationHealthProbes.
+type ApplicationHealthProbes struct {
+	// LivenessProbe A health probe the agent periodically runs against an application. Exactly one of http, tcp and exec must be set.
+	LivenessProbe *ApplicationProbe `json:"livenessProbe,omitempty"`
+
+	// ReadinessProbe A health probe the agent periodically runs against an application. Exactly one of http, tcp and exec must be set.
+	ReadinessProbe *ApplicationProbe `json:"readinessProbe,omitempty"`
+}
+
+// ApplicationHooks defines model for ApplicationHooks.
+type ApplicationHooks struct {
+	// Hooks Actions the agent performs at points in the lifecycle of an application. They support the same actions as device lifecycle hooks.
+	Hooks *ApplicationLifecycleHooks `json:"hooks,omitempty"`
+}
+
+// ApplicationHttpProbe Probes an application with an HTTP GET request to a port published on the device. The probe succeeds if the response status code is between 200 and 399.
+type ApplicationHttpProbe struct {
+	// Path The path of the request. Defaults to "/".
+	Path *string `json:"path,omitempty"`
+
+	// Port The port on the device to send the request to.
+	Port int `json:"port"`
+
+	// Scheme The scheme of the request. Defaults to "http".
+	Scheme *ApplicationHttpProbeScheme `json:"scheme,omitempty"`
+}
+
+// ApplicationHttpProbeScheme The scheme of the request. Defaults to "http".
+type ApplicationHttpProbeScheme string
+
+// ApplicationLifecycleHookType defines model for ApplicationLifecycleHookType.
+type ApplicationLifecycleHookType string
+
+// ApplicationLifecycleHooks Actions the agent performs at points in the lifecycle of an application. They support the same actions as device lifecycle hooks.
+type ApplicationLifecycleHooks struct {
+	// PostStart Actions performed after the agent started the application because it was added or updated.
+	PostStart *[]HookAction `json:"postStart,omitempty"`
+
+	// PreStop Actions performed before the application is stopped to be updated or removed, after any preUpdate actions. If an action fails, the application is not stopped.
+	PreStop *[]HookAction `json:"preStop,omitempty"`
+
+	// PreUpdate Actions performed before the application or its image is updated. If an action fails, the application is not updated.
+	PreUpdate *[]HookAction `json:"preUpdate,omitempty"`
+}
+
 // ApplicationPort Port mapping in format "hostPort:containerPort" (e.g., "8080:80").
 type ApplicationPort = string
 
+// ApplicationProbe A health probe the agent periodically runs against an application. Exactly one of http, tcp and exec must be set.
+type ApplicationProbe struct {
+	// Exec Probes an application by running a command inside one of its containers. The probe succeeds if the command exits with status 0.
+	Exec *ApplicationExecProbe `json:"exec,omitempty"`
+
+	// FailureThreshold The number of consecutive failures after which the probe is considered failed. Defaults to 3.
+	FailureThreshold *int `json:"failureThreshold,omitempty"`
+
+	// Http Probes an application with an HTTP GET request to a port published on the device. The probe succeeds if the response status code is between 200 and 399.
+	Http *ApplicationHttpProbe `json:"http,omitempty"`
+
+	// InitialDelay How long to wait after the application started running before the first probe. Defaults to 0s.
+	InitialDelay *string `json:"initialDelay,omitempty"`
+
+	// Period How often to run the probe. Defaults to 10s.
+	Period *string `json:"period,omitempty"`
+
+	// Tcp Probes an application by opening a TCP connection to a port published on the device. The probe succeeds if the connection can be established.
+	Tcp *ApplicationTcpProbe `json:"tcp,omitempty"`
+
+	// Timeout How long to wait for the probe to succeed before it is considered failed. Defaults to 1s.
+	Timeout *string `json:"timeout,omitempty"`
+}
+
+// ApplicationProbeResult The result of a health probe. Unknown until the probe first succeeds or reaches its failure threshold.
+type ApplicationProbeResult string
+
+// ApplicationProbeStatus The result of a health probe of an application.
+type ApplicationProbeStatus struct {
+	// Message Human readable details of the last failure of the probe.
+	Message *string `json:"message,omitempty"`
+
+	// Result The result of a health probe. Unknown until the probe first succeeds or reaches its failure threshold.
+	Result ApplicationProbeResult `json:"result"`
+
+	// Type The type of a health probe.
+	Type ApplicationProbeType `json:"type"`
+}
+
+// ApplicationProbeType The type of a health probe.
+type ApplicationProbeType string
+
 // ApplicationProviderBase Common properties for all application types.
 type ApplicationProviderBase struct {
 	// Annotations Arbitrary metadata annotations. Used internally by the control plane (e.g., flightctl.io/workload-type) when transforming application types at render time.
@@ -663,9 +909,36 @@ type ApplicationResources struct {
 	Limits *ApplicationResourceLimits `json:"limits,omitempty"`
 }
 
+// ApplicationRestart defines model for ApplicationRestart.
+type ApplicationRestart struct {
+	// RestartPolicy When the containers of an application are restarted after they exit, and how many restarts are tolerated before the application is reported as crash-looping.
+	RestartPolicy *ApplicationRestartPolicy `json:"restartPolicy,omitempty"`
+}
+
+// ApplicationRestartPolicy When the containers of an application are restarted after they exit, and how many restarts are tolerated before the application is reported as crash-looping.
+type ApplicationRestartPolicy struct {
+	// MaxRetries The number of restarts within 10 minutes after which the application is reported as CrashLooping and the device's applications as Degraded. Defaults to 5.
+	MaxRetries *int `json:"maxRetries,omitempty"`
+
+	// Policy When to restart the containers of an application. Always restarts them whenever they exit, OnFailure only if they exit with a non-zero exit code or are killed, Never does not restart them.
+	Policy *ApplicationRestartPolicyType `json:"policy,omitempty"`
+
+	// RestartDelay How long to wait before restarting a container that exited. Defaults to 5s.
+	RestartDelay *string `json:"restartDelay,omitempty"`
+}
+
+// ApplicationRestartPolicyType When to restart the containers of an application. Always restarts them whenever they exit, OnFailure only if they exit with a non-zero exit code or are killed, Never does not restart them.
+type ApplicationRestartPolicyType string
+
 // ApplicationStatusType Status of a single application on the device.
 type ApplicationStatusType string
 
+// ApplicationTcpProbe Probes an application by opening a TCP connection to a port published on the device. The probe succeeds if the connection can be established.
+type ApplicationTcpProbe struct {
+	// Port The port on the device to connect to.
+	Port int `json:"port"`
+}
+
 // ApplicationUser defines model for ApplicationUser.
 type ApplicationUser struct {
 	// RunAs The username of the system user this application should be run under. This is not the same as the user within any containers of the application (if applicable). Defaults to the user that the agent runs as (generally root) if not specified.
@@ -850,6 +1123,9 @@ type Batch struct {
 	// Limit The maximum number or percentage of devices to update in the batch.
 	Limit *Batch_Limit `json:"limit,omitempty"`
 
+	// RequireApproval If true, the rollout waits for a user to approve the batch before rolling it out, even if the previous batch met its success threshold.
+	RequireApproval *bool `json:"requireApproval,omitempty"`
+
 	// Selector A label selector is a label query over a set of resources. The result of matchLabels and matchExpressions are ANDed. Empty/null label selectors match nothing.
 	Selector *LabelSelector `json:"selector,omitempty"`
 
@@ -874,6 +1150,21 @@ type BatchSequence struct {
 	Strategy RolloutStrategy `json:"strategy"`
 }
 
+// Canary Canary rolls out to a fixed set of canary devices, selected by label, first. The rollout continues to the rest of the fleet only after all canary devices have stayed Online with healthy applications for the soak duration.
+type Canary struct {
+	// Selector A label selector is a label query over a set of resources. The result of matchLabels and matchExpressions are ANDed. Empty/null label selectors match nothing.
+	Selector LabelSelector `json:"selector"`
+
+	// SoakDuration The maximum duration allowed for the action to complete. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
+	SoakDuration Duration `json:"soakDuration"`
+
+	// Strategy The strategy of choice for device selection in rollout policy.
+	Strategy RolloutStrategy `json:"strategy"`
+
+	// SuccessThreshold Percentage is the string format representing percentage string.
+	SuccessThreshold *Percentage `json:"successThreshold,omitempty"`
+}
+
 // CertificateSigningRequest CertificateSigningRequest represents a request for a signed certificate from the CA.
 type CertificateSigningRequest struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -975,9 +1266,15 @@ type ComposeApplication struct {
 	// AppType The type of the application.
 	AppType AppType `json:"appType"`
 
+	// DependsOn Names of the applications on the device that must be running and ready before this application is started. The application is stopped before the applications it depends on.
+	DependsOn *[]string `json:"dependsOn,omitempty"`
+
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 
+	// Hooks Actions the agent performs at points in the lifecycle of an application. They support the same actions as device lifecycle hooks.
+	Hooks *ApplicationLifecycleHooks `json:"hooks,omitempty"`
+
 	// Name The application name must be 1–253 characters long, start with a letter or number, and contain no whitespace.
 	Name *string `json:"name,omitempty"`
 
@@ -1044,21 +1341,36 @@ type ContainerApplication struct {
 	// AppType The type of the application.
 	AppType AppType `json:"appType"`
 
+	// DependsOn Names of the applications on the device that must be running and ready before this application is started. The application is stopped before the applications it depends on.
+	DependsOn *[]string `json:"dependsOn,omitempty"`
+
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 
+	// Hooks Actions the agent performs at points in the lifecycle of an application. They support the same actions as device lifecycle hooks.
+	Hooks *ApplicationLifecycleHooks `json:"hooks,omitempty"`
+
 	// Image Reference to the image for this container.
 	Image string `json:"image"`
 
+	// LivenessProbe A health probe the agent periodically runs against an application. Exactly one of http, tcp and exec must be set.
+	LivenessProbe *ApplicationProbe `json:"livenessProbe,omitempty"`
+
 	// Name The application name must be 1–253 characters long, start with a letter or number, and contain no whitespace.
 	Name *string `json:"name,omitempty"`
 
 	// Ports Port mappings.
 	Ports *[]ApplicationPort `json:"ports,omitempty"`
 
+	// ReadinessProbe A health probe the agent periodically runs against an application. Exactly one of http, tcp and exec must be set.
+	ReadinessProbe *ApplicationProbe `json:"readinessProbe,omitempty"`
+
 	// Resources Resource constraints for the application.
 	Resources *ApplicationResources `json:"resources,omitempty"`
 
+	// RestartPolicy When the containers of an application are restarted after they exit, and how many restarts are tolerated before the application is reported as crash-looping.
+	RestartPolicy *ApplicationRestartPolicy `json:"restartPolicy,omitempty"`
+
 	// RunAs The username of the system user this application should be run under. This is not the same as the user within any containers of the application (if applicable). Defaults to the user that the agent runs as (generally root) if not specified.
 	RunAs Username `json:"runAs,omitempty"`
 
@@ -1096,6 +1408,27 @@ type CronExpression = string
 // CustomDeviceInfo User-defined information about the device.
 type CustomDeviceInfo map[string]string
 
+// CustomResourceMonitorSpec defines model for CustomResourceMonitorSpec.
+type CustomResourceMonitorSpec struct {
+	// AlertRules Array of alert rules. Only one alert per severity is allowed.
+	AlertRules []ResourceAlertRule `json:"alertRules"`
+
+	// MaxValue The value that the number printed by the executable is relative to. If unset, the number is a percentage.
+	MaxValue *float32 `json:"maxValue,omitempty"`
+
+	// MonitorType The type of resource to monitor.
+	MonitorType string `json:"monitorType"`
+
+	// Name The name of the custom monitor, unique among the custom monitors of the device. It is also the name of the executable in /usr/lib/flightctl/custom-monitor.d that is run at every sample. The executable must print a single number to stdout and exit with code 0.
+	Name string `json:"name"`
+
+	// SamplingInterval Duration between monitor samples. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	SamplingInterval string `json:"samplingInterval"`
+
+	// Timeout The maximum duration of a run of the executable. Defaults to 10s and must be less than the sampling interval. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	Timeout *string `json:"timeout,omitempty"`
+}
+
 // DependencyChangeDetectedDetails defines model for DependencyChangeDetectedDetails.
 type DependencyChangeDetectedDetails struct {
 	// DetailType The type of detail for discriminator purposes.
@@ -1167,12 +1500,24 @@ type DeviceApplicationStatus struct {
 	// AppType The type of the application.
 	AppType AppType `json:"appType"`
 
+	// BlockedBy Names of the applications this application depends on that are not yet running and ready. The application is started once they are.
+	BlockedBy *[]string `json:"blockedBy,omitempty"`
+
 	// Embedded Whether the application is embedded in the bootc image.
 	Embedded bool `json:"embedded"`
 
+	// LastExitCode The exit code of the container of this application that exited last.
+	LastExitCode *int `json:"lastExitCode,omitempty"`
+
+	// LastTerminationReason Why the container of this application that exited last terminated, for example Error, OOMKilled or Completed.
+	LastTerminationReason *string `json:"lastTerminationReason,omitempty"`
+
 	// Name Human readable name of the application.
 	Name string `json:"name"`
 
+	// Probes Results of the health probes of this application.
+	Probes *[]ApplicationProbeStatus `json:"probes,omitempty"`
+
 	// Ready The number of containers which are ready in the application.
 	Ready string `json:"ready"`
 
@@ -1357,16 +1702,82 @@ type DeviceOwnershipChangedDetails struct {
 // DeviceOwnershipChangedDetailsDetailType The type of detail for discriminator purposes.
 type DeviceOwnershipChangedDetailsDetailType string
 
+// DeviceRegistration DeviceRegistration pre-registers a device before it enrolls. The enrollment request of the device is approved automatically.
+type DeviceRegistration struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec DeviceRegistrationSpec identifies a pre-registered device and describes how it is set up when it enrolls. At least one of tpmEkCertificate and systemInfo must be set, and an enrollment request must match all that are set.
+	Spec DeviceRegistrationSpec `json:"spec"`
+
+	// Status DeviceRegistrationStatus records the enrollment of a pre-registered device. A registration without status is pending.
+	Status *DeviceRegistrationStatus `json:"status,omitempty"`
+}
+
+// DeviceRegistrationList DeviceRegistrationList is a list of DeviceRegistrations.
+type DeviceRegistrationList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of device registrations.
+	Items []DeviceRegistration `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// DeviceRegistrationSpec DeviceRegistrationSpec identifies a pre-registered device and describes how it is set up when it enrolls. At least one of tpmEkCertificate and systemInfo must be set, and an enrollment request must match all that are set.
+type DeviceRegistrationSpec struct {
+	// Labels Labels to set on the device. They are merged with the labels requested by the agent, taking precedence over them.
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// SystemInfo System info values that the enrollment request must report, such as the product serial number.
+	SystemInfo *map[string]string `json:"systemInfo,omitempty"`
+
+	// TpmEkCertificate The PEM-encoded endorsement key certificate of the device's TPM. The enrollment request must have passed the verification of its TPM with this certificate.
+	TpmEkCertificate *string `json:"tpmEkCertificate,omitempty"`
+}
+
+// DeviceRegistrationStatus DeviceRegistrationStatus records the enrollment of a pre-registered device. A registration without status is pending.
+type DeviceRegistrationStatus struct {
+	// EnrolledAt The time at which the registration approved the enrollment request.
+	EnrolledAt *time.Time `json:"enrolledAt,omitempty"`
+
+	// EnrollmentRequest The name of the enrollment request that the registration approved, which is also the name of the device. A registration approves only this request.
+	EnrollmentRequest *string `json:"enrollmentRequest,omitempty"`
+}
+
 // DeviceResourceStatus Current status of the resources of the device.
 type DeviceResourceStatus struct {
 	// Cpu The types of resource statuses.
 	Cpu DeviceResourceStatusType `json:"cpu"`
 
+	// Custom The types of resource statuses.
+	Custom *DeviceResourceStatusType `json:"custom,omitempty"`
+
 	// Disk The types of resource statuses.
 	Disk DeviceResourceStatusType `json:"disk"`
 
 	// Memory The types of resource statuses.
 	Memory DeviceResourceStatusType `json:"memory"`
+
+	// Network The types of resource statuses.
+	Network *DeviceResourceStatusType `json:"network,omitempty"`
+
+	// Process The types of resource statuses.
+	Process *DeviceResourceStatusType `json:"process,omitempty"`
+
+	// Temperature The types of resource statuses.
+	Temperature *DeviceResourceStatusType `json:"temperature,omitempty"`
 }
 
 // DeviceResourceStatusType The types of resource statuses.
@@ -1596,7 +2007,7 @@ type DockerAuth struct {
 // Duration The maximum duration allowed for the action to complete. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
 type Duration = string
 
-// EncodingType Specifies the encoding type used for data representation.
+// EncodingType Specifies the encoding type used for data representation. The encrypted type is set by the service in rendered device specs for content that is encrypted to the device's key and cannot be used in user-provided specs.
 type EncodingType string
 
 // EnrollmentConfig defines model for EnrollmentConfig.
@@ -1605,6 +2016,75 @@ type EnrollmentConfig struct {
 	EnrollmentService EnrollmentService `json:"enrollment-service"`
 }
 
+// EnrollmentPolicy EnrollmentPolicy approves the enrollment requests that match its rules automatically.
+type EnrollmentPolicy struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec EnrollmentPolicySpec describes which enrollment requests a policy approves and how the devices it approves are set up.
+	Spec EnrollmentPolicySpec `json:"spec"`
+}
+
+// EnrollmentPolicyList EnrollmentPolicyList is a list of EnrollmentPolicies.
+type EnrollmentPolicyList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of enrollment policies.
+	Items []EnrollmentPolicy `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// EnrollmentPolicyMatch EnrollmentPolicyMatch holds the rules an enrollment request must satisfy to be approved by a policy. A request matches if it satisfies every rule that is set. At least one rule must be set.
+type EnrollmentPolicyMatch struct {
+	// Labels Labels that the request must contain with the same values.
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// SerialNumbers The product serial numbers that may enroll. The request must report one of them in its system info.
+	SerialNumbers *[]string `json:"serialNumbers,omitempty"`
+
+	// SourceCidrs The networks, in CIDR notation, that requests may be sent from.
+	SourceCidrs *[]string `json:"sourceCidrs,omitempty"`
+
+	// TimeWindow EnrollmentPolicyTimeWindow is the period in which a policy approves requests.
+	TimeWindow *EnrollmentPolicyTimeWindow `json:"timeWindow,omitempty"`
+
+	// TpmVerified If true, the request must have passed the verification of its TPM chain of trust and credential challenge.
+	TpmVerified *bool `json:"tpmVerified,omitempty"`
+}
+
+// EnrollmentPolicySpec EnrollmentPolicySpec describes which enrollment requests a policy approves and how the devices it approves are set up.
+type EnrollmentPolicySpec struct {
+	// Fleet The name of the fleet that owns the devices approved by the policy. The devices get the labels of the fleet's selector, so the fleet selects them.
+	Fleet *string `json:"fleet,omitempty"`
+
+	// Labels Labels to set on the devices approved by the policy. They are merged with the labels requested by the agent, taking precedence over them.
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Match EnrollmentPolicyMatch holds the rules an enrollment request must satisfy to be approved by a policy. A request matches if it satisfies every rule that is set. At least one rule must be set.
+	Match EnrollmentPolicyMatch `json:"match"`
+}
+
+// EnrollmentPolicyTimeWindow EnrollmentPolicyTimeWindow is the period in which a policy approves requests.
+type EnrollmentPolicyTimeWindow struct {
+	// NotAfter The time until which requests are approved.
+	NotAfter *time.Time `json:"notAfter,omitempty"`
+
+	// NotBefore The time from which requests are approved.
+	NotBefore *time.Time `json:"notBefore,omitempty"`
+}
+
 // EnrollmentRequest EnrollmentRequest represents a request for approval to enroll a device.
 type EnrollmentRequest struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -1646,6 +2126,12 @@ type EnrollmentRequestApprovalStatus struct {
 	// ApprovedBy The name of the approver.
 	ApprovedBy string `json:"approvedBy"`
 
+	// DeviceRegistration The name of the DeviceRegistration that approved the request automatically, if any.
+	DeviceRegistration *string `json:"deviceRegistration,omitempty"`
+
+	// EnrollmentPolicy The name of the EnrollmentPolicy that approved the request automatically, if any.
+	EnrollmentPolicy *string `json:"enrollmentPolicy,omitempty"`
+
 	// Labels Labels to set on the device. If replaceLabels is false (default), labels are merged with agent-provided labels from the enrollment request. If replaceLabels is true, labels are used as the complete final set ignoring agent-provided labels.
 	Labels *map[string]string `json:"labels,omitempty"`
 
@@ -1795,7 +2281,7 @@ type FileContent struct {
 	// Content The plain text (UTF-8) or base64-encoded content of the file.
 	Content *string `json:"content,omitempty"`
 
-	// ContentEncoding Specifies the encoding type used for data representation.
+	// ContentEncoding Specifies the encoding type used for data representation. The encrypted type is set by the service in rendered device specs for content that is encrypted to the device's key and cannot be used in user-provided specs.
 	ContentEncoding *EncodingType `json:"contentEncoding,omitempty"`
 }
 
@@ -1819,7 +2305,7 @@ type FileSpec struct {
 	// Content The plain text (UTF-8) or base64-encoded content of the file.
 	Content string `json:"content"`
 
-	// ContentEncoding Specifies the encoding type used for data representation.
+	// ContentEncoding Specifies the encoding type used for data representation. The encrypted type is set by the service in rendered device specs for content that is encrypted to the device's key and cannot be used in user-provided specs.
 	ContentEncoding *EncodingType `json:"contentEncoding,omitempty"`
 
 	// Group The file's group, specified either as a name or numeric ID. Defaults to "root".
@@ -1868,6 +2354,24 @@ type FleetList struct {
 	Metadata ListMeta `json:"metadata"`
 }
 
+// FleetRolloutAbortedDetails defines model for FleetRolloutAbortedDetails.
+type FleetRolloutAbortedDetails struct {
+	// DetailType The type of detail for discriminator purposes.
+	DetailType FleetRolloutAbortedDetailsDetailType `json:"detailType"`
+
+	// TemplateVersion The name of the TemplateVersion whose rollout was aborted.
+	TemplateVersion string `json:"templateVersion"`
+}
+
+// FleetRolloutAbortedDetailsDetailType The type of detail for discriminator purposes.
+type FleetRolloutAbortedDetailsDetailType string
+
+// FleetRolloutBatchApproval FleetRolloutBatchApproval approves a rollout batch of a fleet that is awaiting approval.
+type FleetRolloutBatchApproval struct {
+	// Batch The number of the batch to approve, as shown in the fleet's RolloutInProgress condition. It must match the batch that is awaiting approval.
+	Batch int `json:"batch"`
+}
+
 // FleetRolloutBatchCompletedDetails defines model for FleetRolloutBatchCompletedDetails.
 type FleetRolloutBatchCompletedDetails struct {
 	// Batch The batch within the fleet rollout.
@@ -1925,6 +2429,15 @@ type FleetRolloutCompletedDetails struct {
 // FleetRolloutCompletedDetailsDetailType The type of detail for discriminator purposes.
 type FleetRolloutCompletedDetailsDetailType string
 
+// FleetRolloutControl FleetRolloutControl changes the state of the rollout of a fleet.
+type FleetRolloutControl struct {
+	// Action The action to take on the rollout. Pause stops dispatching and rendering further devices until the rollout is resumed. Resume continues a paused rollout. Abort stops the rollout for good; devices that were already updated keep the new TemplateVersion, and the remaining devices are not updated until a new TemplateVersion or rollout policy is applied.
+	Action FleetRolloutControlAction `json:"action"`
+}
+
+// FleetRolloutControlAction The action to take on the rollout. Pause stops dispatching and rendering further devices until the rollout is resumed. Resume continues a paused rollout. Abort stops the rollout for good; devices that were already updated keep the new TemplateVersion, and the remaining devices are not updated until a new TemplateVersion or rollout policy is applied.
+type FleetRolloutControlAction string
+
 // FleetRolloutDeviceSelectedDetails defines model for FleetRolloutDeviceSelectedDetails.
 type FleetRolloutDeviceSelectedDetails struct {
 	// DetailType The type of detail for discriminator purposes.
@@ -1952,6 +2465,48 @@ type FleetRolloutFailedDetails struct {
 // FleetRolloutFailedDetailsDetailType The type of detail for discriminator purposes.
 type FleetRolloutFailedDetailsDetailType string
 
+// FleetRolloutPausedDetails defines model for FleetRolloutPausedDetails.
+type FleetRolloutPausedDetails struct {
+	// DetailType The type of detail for discriminator purposes.
+	DetailType FleetRolloutPausedDetailsDetailType `json:"detailType"`
+
+	// TemplateVersion The name of the TemplateVersion whose rollout was paused.
+	TemplateVersion string `json:"templateVersion"`
+}
+
+// FleetRolloutPausedDetailsDetailType The type of detail for discriminator purposes.
+type FleetRolloutPausedDetailsDetailType string
+
+// FleetRolloutResumedDetails defines model for FleetRolloutResumedDetails.
+type FleetRolloutResumedDetails struct {
+	// DetailType The type of detail for discriminator purposes.
+	DetailType FleetRolloutResumedDetailsDetailType `json:"detailType"`
+
+	// TemplateVersion The name of the TemplateVersion whose rollout was resumed.
+	TemplateVersion string `json:"templateVersion"`
+}
+
+// FleetRolloutResumedDetailsDetailType The type of detail for discriminator purposes.
+type FleetRolloutResumedDetailsDetailType string
+
+// FleetRolloutRolledBackDetails defines model for FleetRolloutRolledBackDetails.
+type FleetRolloutRolledBackDetails struct {
+	// Batch The batch that missed its success threshold.
+	Batch string `json:"batch"`
+
+	// DetailType The type of detail for discriminator purposes.
+	DetailType FleetRolloutRolledBackDetailsDetailType `json:"detailType"`
+
+	// PreviousTemplateVersion The name of the TemplateVersion that the updated devices are returned to.
+	PreviousTemplateVersion string `json:"previousTemplateVersion"`
+
+	// TemplateVersion The name of the TemplateVersion whose rollout was rolled back.
+	TemplateVersion string `json:"templateVersion"`
+}
+
+// FleetRolloutRolledBackDetailsDetailType The type of detail for discriminator purposes.
+type FleetRolloutRolledBackDetailsDetailType string
+
 // FleetRolloutStartedDetails defines model for FleetRolloutStartedDetails.
 type FleetRolloutStartedDetails struct {
 	// DetailType The type of detail for discriminator purposes.
@@ -2088,6 +2643,36 @@ type HookAction struct {
 	union   json.RawMessage
 }
 
+// HookActionHttp defines model for HookActionHttp.
+type HookActionHttp struct {
+	// Http An HTTP request to a local or remote endpoint. The request succeeds if the endpoint responds with a 2xx status code.
+	Http HookActionHttpSpec `json:"http"`
+}
+
+// HookActionHttpMethod The HTTP method of the request.
+type HookActionHttpMethod string
+
+// HookActionHttpSpec An HTTP request to a local or remote endpoint. The request succeeds if the endpoint responds with a 2xx status code.
+type HookActionHttpSpec struct {
+	// Body The body of the request. Variables such as ${ Hook } or ${ Files } are replaced.
+	Body *string `json:"body,omitempty"`
+
+	// Headers Header key-value pairs sent with the request. Variables in the values are replaced.
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Method The HTTP method of the request.
+	Method *HookActionHttpMethod `json:"method,omitempty"`
+
+	// Retries The number of times a failed request is retried before the action fails. All attempts must complete within the timeout of the action.
+	Retries *int `json:"retries,omitempty"`
+
+	// RetryInterval The duration to wait between attempts. The duration should be specified as a positive integer followed by a time unit. Supported time units are 's' for seconds, 'm' for minutes, and 'h' for hours.
+	RetryInterval *string `json:"retryInterval,omitempty"`
+
+	// Url The http or https URL of the endpoint. Variables such as ${ Hook } or ${ Path } are replaced like in the command of a run action.
+	Url string `json:"url"`
+}
+
 // HookActionRun defines model for HookActionRun.
 type HookActionRun struct {
 	// EnvVars Environment variable key-value pairs, injected during runtime.
@@ -2393,9 +2978,126 @@ type MountVolumeProviderSpec struct {
 	Mount VolumeMount `json:"mount"`
 }
 
-// OAuth2Introspection OAuth2Introspection defines the token introspection configuration.
-type OAuth2Introspection struct {
-	union json.RawMessage
+// NetworkMonitorMetric The network metric that alert rules apply to. Throughput is the higher of the receive and transmit rates as a percentage of the link speed. Errors is the share of packets received or transmitted with errors over the sampling interval.
+type NetworkMonitorMetric string
+
+// NetworkResourceMonitorSpec defines model for NetworkResourceMonitorSpec.
+type NetworkResourceMonitorSpec struct {
+	// AlertRules Array of alert rules. Only one alert per severity is allowed.
+	AlertRules []ResourceAlertRule `json:"alertRules"`
+
+	// Interface The name of the network interface to monitor, for example eth0.
+	Interface string `json:"interface"`
+
+	// LinkSpeed The speed of the link in Mbit/s that the throughput percentage is relative to. Defaults to the speed reported by the interface.
+	LinkSpeed *int `json:"linkSpeed,omitempty"`
+
+	// Metric The network metric that alert rules apply to. Throughput is the higher of the receive and transmit rates as a percentage of the link speed. Errors is the share of packets received or transmitted with errors over the sampling interval.
+	Metric NetworkMonitorMetric `json:"metric"`
+
+	// MonitorType The type of resource to monitor.
+	MonitorType string `json:"monitorType"`
+
+	// SamplingInterval Duration between monitor samples. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	SamplingInterval string `json:"samplingInterval"`
+}
+
+// NotificationSink NotificationSink delivers the events of the organization that match its filter to an external target.
+type NotificationSink struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec NotificationSinkSpec describes where a sink delivers events to and which events it delivers.
+	Spec NotificationSinkSpec `json:"spec"`
+}
+
+// NotificationSinkEmailTarget NotificationSinkEmailTarget is an SMTP server that mails about events are sent through. The connection is upgraded with STARTTLS if the server supports it, or uses TLS from the start if the port is 465.
+type NotificationSinkEmailTarget struct {
+	// From The address the mails are sent from.
+	From string `json:"from"`
+
+	// Host The hostname of the SMTP server.
+	Host string `json:"host"`
+
+	// Password SecretKeyReference refers to the value of a key of a Secret resource in the same organization.
+	Password *SecretKeyReference `json:"password,omitempty"`
+
+	// Port The port of the SMTP server.
+	Port *int `json:"port,omitempty"`
+
+	// To The addresses the mails are sent to.
+	To []string `json:"to"`
+
+	// Username The username to authenticate to the SMTP server with. If unset, no authentication is performed.
+	Username *string `json:"username,omitempty"`
+}
+
+// NotificationSinkFilter NotificationSinkFilter selects the events a sink delivers. An event matches if it satisfies every criterion that is set. A sink without filter delivers all events.
+type NotificationSinkFilter struct {
+	// Kinds The kinds of the objects involved in the events to deliver.
+	Kinds *[]string `json:"kinds,omitempty"`
+
+	// LabelSelector A label selector is a label query over a set of resources. The result of matchLabels and matchExpressions are ANDed. Empty/null label selectors match nothing.
+	LabelSelector *LabelSelector `json:"labelSelector,omitempty"`
+
+	// Reasons The reasons of the events to deliver.
+	Reasons *[]EventReason `json:"reasons,omitempty"`
+}
+
+// NotificationSinkHttpTarget NotificationSinkHttpTarget is an HTTP endpoint that events are posted to. Delivery succeeds if the endpoint responds with a 2xx status code.
+type NotificationSinkHttpTarget struct {
+	// Authorization SecretKeyReference refers to the value of a key of a Secret resource in the same organization.
+	Authorization *SecretKeyReference `json:"authorization,omitempty"`
+
+	// Headers Header key-value pairs sent with each request.
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Url The http or https URL of the endpoint.
+	Url string `json:"url"`
+}
+
+// NotificationSinkList NotificationSinkList is a list of NotificationSinks.
+type NotificationSinkList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of notification sinks.
+	Items []NotificationSink `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// NotificationSinkSpec NotificationSinkSpec describes where a sink delivers events to and which events it delivers.
+type NotificationSinkSpec struct {
+	// Email NotificationSinkEmailTarget is an SMTP server that mails about events are sent through. The connection is upgraded with STARTTLS if the server supports it, or uses TLS from the start if the port is 465.
+	Email *NotificationSinkEmailTarget `json:"email,omitempty"`
+
+	// Filter NotificationSinkFilter selects the events a sink delivers. An event matches if it satisfies every criterion that is set. A sink without filter delivers all events.
+	Filter *NotificationSinkFilter `json:"filter,omitempty"`
+
+	// Http NotificationSinkHttpTarget is an HTTP endpoint that events are posted to. Delivery succeeds if the endpoint responds with a 2xx status code.
+	Http *NotificationSinkHttpTarget `json:"http,omitempty"`
+
+	// Type The type of target a sink delivers events to. Webhook posts each event as JSON, Slack posts a message in the format of Slack incoming webhooks, CloudEvents posts each event as a structured CloudEvent, and Email sends a mail through an SMTP server. Webhook, Slack and CloudEvents sinks require http to be set, Email sinks require email to be set.
+	Type NotificationSinkType `json:"type"`
+}
+
+// NotificationSinkType The type of target a sink delivers events to. Webhook posts each event as JSON, Slack posts a message in the format of Slack incoming webhooks, CloudEvents posts each event as a structured CloudEvent, and Email sends a mail through an SMTP server. Webhook, Slack and CloudEvents sinks require http to be set, Email sinks require email to be set.
+type NotificationSinkType string
+
+// OAuth2Introspection OAuth2Introspection defines the token introspection configuration.
+type OAuth2Introspection struct {
+	union json.RawMessage
 }
 
 // OAuth2ProviderSpec OAuth2ProviderSpec describes an OAuth2 provider configuration.
@@ -2679,6 +3381,42 @@ type PermissionList struct {
 	Permissions []Permission `json:"permissions"`
 }
 
+// ProcessMonitorMetric The process metric that alert rules apply to. Presence is 100 percent while no process with the name is running and 0 percent otherwise. Memory is the resident memory of all processes with the name as a percentage of the total memory.
+type ProcessMonitorMetric string
+
+// ProcessResourceMonitorSpec defines model for ProcessResourceMonitorSpec.
+type ProcessResourceMonitorSpec struct {
+	// AlertRules Array of alert rules. Only one alert per severity is allowed.
+	AlertRules []ResourceAlertRule `json:"alertRules"`
+
+	// Metric The process metric that alert rules apply to. Presence is 100 percent while no process with the name is running and 0 percent otherwise. Memory is the resident memory of all processes with the name as a percentage of the total memory.
+	Metric ProcessMonitorMetric `json:"metric"`
+
+	// MonitorType The type of resource to monitor.
+	MonitorType string `json:"monitorType"`
+
+	// Name The name of the process to monitor as reported in /proc/[pid]/comm.
+	Name string `json:"name"`
+
+	// SamplingInterval Duration between monitor samples. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	SamplingInterval string `json:"samplingInterval"`
+}
+
+// Progressive Progressive raises the share of updated devices of the fleet step by step on a schedule. Each step lasts at least the step interval and the rollout proceeds to the next step only if the devices updated in the step meet the success threshold.
+type Progressive struct {
+	// StepInterval The maximum duration allowed for the action to complete. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
+	StepInterval Duration `json:"stepInterval"`
+
+	// Steps The share of the fleet's devices that is updated once each step completes, in increasing order, for example 1%, 10%, 50%, 100%.
+	Steps []Percentage `json:"steps"`
+
+	// Strategy The strategy of choice for device selection in rollout policy.
+	Strategy RolloutStrategy `json:"strategy"`
+
+	// SuccessThreshold Percentage is the string format representing percentage string.
+	SuccessThreshold *Percentage `json:"successThreshold,omitempty"`
+}
+
 // QuadletApplication defines model for QuadletApplication.
 type QuadletApplication struct {
 	// Annotations Arbitrary metadata annotations. Used internally by the control plane (e.g., flightctl.io/workload-type) when transforming application types at render time.
@@ -2687,12 +3425,27 @@ type QuadletApplication struct {
 	// AppType The type of the application.
 	AppType AppType `json:"appType"`
 
+	// DependsOn Names of the applications on the device that must be running and ready before this application is started. The application is stopped before the applications it depends on.
+	DependsOn *[]string `json:"dependsOn,omitempty"`
+
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 
+	// Hooks Actions the agent performs at points in the lifecycle of an application. They support the same actions as device lifecycle hooks.
+	Hooks *ApplicationLifecycleHooks `json:"hooks,omitempty"`
+
+	// LivenessProbe A health probe the agent periodically runs against an application. Exactly one of http, tcp and exec must be set.
+	LivenessProbe *ApplicationProbe `json:"livenessProbe,omitempty"`
+
 	// Name The application name must be 1–253 characters long, start with a letter or number, and contain no whitespace.
 	Name *string `json:"name,omitempty"`
 
+	// ReadinessProbe A health probe the agent periodically runs against an application. Exactly one of http, tcp and exec must be set.
+	ReadinessProbe *ApplicationProbe `json:"readinessProbe,omitempty"`
+
+	// RestartPolicy When the containers of an application are restarted after they exit, and how many restarts are tolerated before the application is reported as crash-looping.
+	RestartPolicy *ApplicationRestartPolicy `json:"restartPolicy,omitempty"`
+
 	// RunAs The username of the system user this application should be run under. This is not the same as the user within any containers of the application (if applicable). Defaults to the user that the agent runs as (generally root) if not specified.
 	RunAs Username `json:"runAs,omitempty"`
 
@@ -2774,7 +3527,7 @@ type ResourceAlertRule struct {
 	// Duration Duration is the time over which the average usage is observed before alerting. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
 	Duration string `json:"duration"`
 
-	// Percentage The percentage of usage that triggers the alert.
+	// Percentage The percentage of usage that triggers the alert. For network, temperature, process and custom monitors the percentage is relative to the reference of the monitor's metric.
 	Percentage float32 `json:"percentage"`
 
 	// Severity Severity of the alert.
@@ -2915,11 +3668,41 @@ type Rfc7662IntrospectionSpec struct {
 // Rfc7662IntrospectionSpecType The introspection type.
 type Rfc7662IntrospectionSpecType string
 
+// RolloutBatchRecord The record of a completed batch of a rollout.
+type RolloutBatchRecord struct {
+	// CompletedAt The time at which the batch completed.
+	CompletedAt time.Time `json:"completedAt"`
+
+	// Failed The number of devices of the batch that failed to update.
+	Failed int64 `json:"failed"`
+
+	// Name The name of the batch.
+	Name string `json:"name"`
+
+	// SuccessPercentage The percentage of devices of the batch that were updated successfully.
+	SuccessPercentage int64 `json:"successPercentage"`
+
+	// Successful The number of devices of the batch that were updated successfully.
+	Successful int64 `json:"successful"`
+
+	// TimedOut The number of devices of the batch whose update timed out.
+	TimedOut int64 `json:"timedOut"`
+
+	// Total The number of devices of the batch.
+	Total int64 `json:"total"`
+}
+
 // RolloutDeviceSelection Describes how to select devices for rollout.
 type RolloutDeviceSelection struct {
 	union json.RawMessage
 }
 
+// RolloutFailurePolicy What to do when a batch of a rollout misses its success threshold. Pause (the default) suspends the rollout. Rollback suspends the rollout and returns the devices that were already updated to the previous TemplateVersion of the fleet. Requires deviceSelection.
+type RolloutFailurePolicy string
+
+// RolloutOutcome The outcome of the rollout of a template version.
+type RolloutOutcome string
+
 // RolloutPolicy RolloutPolicy is the rollout policy of the fleet.
 type RolloutPolicy struct {
 	// DefaultUpdateTimeout The maximum duration allowed for the action to complete. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
@@ -2931,13 +3714,130 @@ type RolloutPolicy struct {
 	// DisruptionBudget DisruptionBudget defines the level of allowed disruption when rollout is in progress.
 	DisruptionBudget *DisruptionBudget `json:"disruptionBudget,omitempty"`
 
+	// MaintenanceWindows Windows in which new batches of a rollout may be dispatched. Each window opens at the time set by `at` and stays open for `startGraceDuration`. Outside the windows, batches are held back. When empty, batches may be dispatched at any time. Requires deviceSelection.
+	MaintenanceWindows *[]UpdateSchedule `json:"maintenanceWindows,omitempty"`
+
+	// OnFailure What to do when a batch of a rollout misses its success threshold. Pause (the default) suspends the rollout. Rollback suspends the rollout and returns the devices that were already updated to the previous TemplateVersion of the fleet. Requires deviceSelection.
+	OnFailure *RolloutFailurePolicy `json:"onFailure,omitempty"`
+
 	// SuccessThreshold Percentage is the string format representing percentage string.
 	SuccessThreshold *Percentage `json:"successThreshold,omitempty"`
 }
 
+// RolloutPreview RolloutPreview shows how a rollout of a candidate fleet spec would select devices into batches and how the disruption budget would throttle them.
+type RolloutPreview struct {
+	// Batches The batches of the rollout in the order they are rolled out.
+	Batches []RolloutPreviewBatch `json:"batches"`
+
+	// UnselectedDevices Devices that would not be rolled out by any batch.
+	UnselectedDevices []RolloutPreviewUnselectedDevice `json:"unselectedDevices"`
+}
+
+// RolloutPreviewBatch RolloutPreviewBatch is a batch of a rollout preview.
+type RolloutPreviewBatch struct {
+	// Devices The names of the devices that the batch would select.
+	Devices []string `json:"devices"`
+
+	// DisruptionBudgetGroups How the disruption budget of the fleet would throttle the devices of the batch. Only set if the fleet defines a disruption budget.
+	DisruptionBudgetGroups *[]RolloutPreviewDisruptionBudgetGroup `json:"disruptionBudgetGroups,omitempty"`
+
+	// Name The name of the batch, as used in rollout conditions and events.
+	Name string `json:"name"`
+}
+
+// RolloutPreviewDisruptionBudgetGroup RolloutPreviewDisruptionBudgetGroup describes a group of devices that share the values of the disruption budget groupBy labels.
+type RolloutPreviewDisruptionBudgetGroup struct {
+	// Devices The number of devices of the batch in the group.
+	Devices int `json:"devices"`
+
+	// Labels The values of the groupBy labels of the group. Labels that the devices of the group don't have are omitted.
+	Labels map[string]string `json:"labels"`
+
+	// MaxConcurrent The number of devices of the group that the disruption budget currently allows to be updated at the same time.
+	MaxConcurrent int `json:"maxConcurrent"`
+
+	// Total The number of devices of the fleet in the group.
+	Total int `json:"total"`
+}
+
+// RolloutPreviewUnselectedDevice RolloutPreviewUnselectedDevice is a device that would not be rolled out by any batch.
+type RolloutPreviewUnselectedDevice struct {
+	// Name The name of the device.
+	Name string `json:"name"`
+
+	// Reason Why the device would not be rolled out.
+	Reason string `json:"reason"`
+}
+
 // RolloutStrategy The strategy of choice for device selection in rollout policy.
 type RolloutStrategy string
 
+// Secret Secret holds sensitive data that is stored encrypted by the service and only delivered to the devices whose configuration references it.
+type Secret struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec SecretSpec describes the data of a secret.
+	Spec SecretSpec `json:"spec"`
+}
+
+// SecretKeyReference SecretKeyReference refers to the value of a key of a Secret resource in the same organization.
+type SecretKeyReference struct {
+	// Key The key of the value in the secret.
+	Key string `json:"key"`
+
+	// Name The name of the Secret resource.
+	Name string `json:"name"`
+}
+
+// SecretList SecretList is a list of Secrets.
+type SecretList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of secrets.
+	Items []Secret `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// SecretProviderSpec defines model for SecretProviderSpec.
+type SecretProviderSpec struct {
+	// Name The name of the config provider.
+	Name string `json:"name"`
+
+	// Secret The reference to a Secret resource. Each key of the secret is written to a file of the same name in the mount path, readable only by its owner.
+	Secret struct {
+		// Group The file's group, specified either as a name or numeric ID. Defaults to "root".
+		Group string `json:"group,omitempty"`
+
+		// MountPath Path in the device's file system at which the secret should be mounted.
+		MountPath string `json:"mountPath"`
+
+		// Name The name of the Secret resource.
+		Name string `json:"name"`
+
+		// User The file's owner, specified either as a name or numeric ID. Defaults to "root".
+		User Username `json:"user,omitempty"`
+	} `json:"secret"`
+}
+
+// SecretSpec SecretSpec describes the data of a secret.
+type SecretSpec struct {
+	// Data The data of the secret, mapping each key to its plain text value. Values are never returned by the API, they are replaced by a masked placeholder that may be sent back unchanged to keep the stored value.
+	Data map[string]string `json:"data"`
+}
+
 // SshConfig Configuration for SSH transport.
 type SshConfig struct {
 	// PrivateKeyPassphrase The passphrase for sshPrivateKey.
@@ -3001,6 +3901,24 @@ type SystemdUnitStatus struct {
 	Unit string `json:"unit"`
 }
 
+// TemperatureResourceMonitorSpec defines model for TemperatureResourceMonitorSpec.
+type TemperatureResourceMonitorSpec struct {
+	// AlertRules Array of alert rules. Only one alert per severity is allowed.
+	AlertRules []ResourceAlertRule `json:"alertRules"`
+
+	// MaxTemperature The temperature in degrees Celsius that the temperature percentage is relative to. Defaults to the critical trip point of the thermal zone.
+	MaxTemperature *int `json:"maxTemperature,omitempty"`
+
+	// MonitorType The type of resource to monitor.
+	MonitorType string `json:"monitorType"`
+
+	// SamplingInterval Duration between monitor samples. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	SamplingInterval string `json:"samplingInterval"`
+
+	// Zone The thermal zone to monitor, either its directory name under /sys/class/thermal (for example thermal_zone0) or its type (for example x86_pkg_temp).
+	Zone string `json:"zone"`
+}
+
 // TemplateVersion TemplateVersion represents a version of a template.
 type TemplateVersion struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -3019,6 +3937,39 @@ type TemplateVersion struct {
 	Status *TemplateVersionStatus `json:"status,omitempty"`
 }
 
+// TemplateVersionChange A changed field of the device specification of a template version.
+type TemplateVersionChange struct {
+	// From The value of the field in the template version compared from. Unset if the field was added.
+	From interface{} `json:"from,omitempty"`
+
+	// Path The path of the field, for example `applications[name=web].image`. Elements of lists of named items are identified by their name, and other list elements by their index.
+	Path string `json:"path"`
+
+	// To The value of the field in the template version compared to. Unset if the field was removed.
+	To interface{} `json:"to,omitempty"`
+
+	// Type The type of a change of a field.
+	Type TemplateVersionChangeType `json:"type"`
+}
+
+// TemplateVersionChangeType The type of a change of a field.
+type TemplateVersionChangeType string
+
+// TemplateVersionDiff The differences between the device specifications of two template versions of a fleet.
+type TemplateVersionDiff struct {
+	// Changes The changed fields of the device specification, ordered by path.
+	Changes []TemplateVersionChange `json:"changes"`
+
+	// Fleet The fleet that owns the template versions.
+	Fleet string `json:"fleet"`
+
+	// From The name of the template version compared from.
+	From string `json:"from"`
+
+	// To The name of the template version compared to.
+	To string `json:"to"`
+}
+
 // TemplateVersionList TemplateVersionList is a list of TemplateVersions.
 type TemplateVersionList struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -3034,6 +3985,21 @@ type TemplateVersionList struct {
 	Metadata ListMeta `json:"metadata"`
 }
 
+// TemplateVersionRolloutRecord The record of the rollout of a template version to the devices of its fleet. It is kept for rollouts that use a device selection strategy.
+type TemplateVersionRolloutRecord struct {
+	// Batches The batches of the rollout that completed, in the order in which they completed.
+	Batches []RolloutBatchRecord `json:"batches"`
+
+	// CompletedAt The time at which the rollout ended. Unset while the rollout is in progress.
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// Outcome The outcome of the rollout of a template version.
+	Outcome RolloutOutcome `json:"outcome"`
+
+	// StartedAt The time at which the rollout started.
+	StartedAt time.Time `json:"startedAt"`
+}
+
 // TemplateVersionSpec TemplateVersionSpec describes a version of a device template.
 type TemplateVersionSpec struct {
 	// Fleet The fleet whose template this refers to.
@@ -3063,6 +4029,9 @@ type TemplateVersionStatus struct {
 	// Resources Array of resource monitor configurations.
 	Resources *[]ResourceMonitor `json:"resources,omitempty"`
 
+	// Rollout The record of the rollout of a template version to the devices of its fleet. It is kept for rollouts that use a device selection strategy.
+	Rollout *TemplateVersionRolloutRecord `json:"rollout,omitempty"`
+
 	// Systemd The systemd services to monitor.
 	Systemd *struct {
 		// MatchPatterns A list of match patterns.
@@ -3194,6 +4163,18 @@ type VolumeMount struct {
 	Path string `json:"path"`
 }
 
+// WatchEvent WatchEvent describes a change to a resource, as streamed by list operations when 'watch' is set.
+type WatchEvent struct {
+	// Object The resource that changed. For DELETED events only its apiVersion, kind and metadata.name are set. For ERROR events this is a Status describing why the watch ended.
+	Object json.RawMessage `json:"object"`
+
+	// Type The type of change a WatchEvent describes.
+	Type WatchEventType `json:"type"`
+}
+
+// WatchEventType The type of change a WatchEvent describes.
+type WatchEventType string
+
 // AuthValidateParams defines parameters for AuthValidate.
 type AuthValidateParams struct {
 	// Authorization The authentication token to validate.
@@ -3230,6 +4211,21 @@ type ListCertificateSigningRequestsParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListDeviceRegistrationsParams defines parameters for ListDeviceRegistrations.
+type ListDeviceRegistrationsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListDevicesParams defines parameters for ListDevices.
 type ListDevicesParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
@@ -3249,6 +4245,9 @@ type ListDevicesParams struct {
 
 	// CveId Filter devices by CVE ID. Only returns devices whose OS image digest has the specified vulnerability. Must be a MITRE-style identifier (CVE-YYYY-sequence, e.g. CVE-2024-12345).
 	CveId *string `form:"cveId,omitempty" json:"cveId,omitempty"`
+
+	// Watch Watch for changes to the described resources and stream them as a sequence of newline-delimited WatchEvent objects. The current state of the matching resources is sent first as ADDED events.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
 }
 
 // GetRenderedDeviceParams defines parameters for GetRenderedDevice.
@@ -3263,6 +4262,21 @@ type GetEnrollmentConfigParams struct {
 	Csr *string `form:"csr,omitempty" json:"csr,omitempty"`
 }
 
+// ListEnrollmentPoliciesParams defines parameters for ListEnrollmentPolicies.
+type ListEnrollmentPoliciesParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListEnrollmentRequestsParams defines parameters for ListEnrollmentRequests.
 type ListEnrollmentRequestsParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
@@ -3291,6 +4305,12 @@ type ListEventsParams struct {
 
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
 	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// Watch Watch for new events and stream them as a sequence of newline-delimited WatchEvent objects. Only events created after the watch was established are sent.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
+
+	// ResourceVersion Only valid together with 'watch'. The metadata.resourceVersion of the last event received from an earlier watch. The events created after that event are sent before the new ones, allowing a client to resume a watch without missing or repeating events.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
 }
 
 // ListEventsParamsOrder defines parameters for ListEvents.
@@ -3312,6 +4332,9 @@ type ListFleetsParams struct {
 
 	// AddDevicesSummary Include a summary of the devices in the fleet.
 	AddDevicesSummary *bool `form:"addDevicesSummary,omitempty" json:"addDevicesSummary,omitempty"`
+
+	// Watch Watch for changes to the described resources and stream them as a sequence of newline-delimited WatchEvent objects. The current state of the matching resources is sent first as ADDED events.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
 }
 
 // ListTemplateVersionsParams defines parameters for ListTemplateVersions.
@@ -3353,6 +4376,21 @@ type ListLabelsParams struct {
 // ListLabelsParamsKind defines parameters for ListLabels.
 type ListLabelsParamsKind string
 
+// ListNotificationSinksParams defines parameters for ListNotificationSinks.
+type ListNotificationSinksParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListOrganizationsParams defines parameters for ListOrganizations.
 type ListOrganizationsParams struct {
 	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
@@ -3389,6 +4427,21 @@ type ListResourceSyncsParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListSecretsParams defines parameters for ListSecrets.
+type ListSecretsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetDeviceApplicationConsoleParams defines parameters for GetDeviceApplicationConsole.
 type GetDeviceApplicationConsoleParams struct {
 	// ConsoleType The type of console session to open. Currently only "serial" is supported.
@@ -3428,6 +4481,12 @@ type UpdateCertificateSigningRequestApprovalJSONRequestBody = CertificateSigning
 // ResumeDevicesJSONRequestBody defines body for ResumeDevices for application/json ContentType.
 type ResumeDevicesJSONRequestBody = DeviceResumeRequest
 
+// CreateDeviceRegistrationJSONRequestBody defines body for CreateDeviceRegistration for application/json ContentType.
+type CreateDeviceRegistrationJSONRequestBody = DeviceRegistration
+
+// ReplaceDeviceRegistrationJSONRequestBody defines body for ReplaceDeviceRegistration for application/json ContentType.
+type ReplaceDeviceRegistrationJSONRequestBody = DeviceRegistration
+
 // CreateDeviceJSONRequestBody defines body for CreateDevice for application/json ContentType.
 type CreateDeviceJSONRequestBody = Device
 
@@ -3446,6 +4505,12 @@ type PatchDeviceStatusApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceDeviceStatusJSONRequestBody defines body for ReplaceDeviceStatus for application/json ContentType.
 type ReplaceDeviceStatusJSONRequestBody = Device
 
+// CreateEnrollmentPolicyJSONRequestBody defines body for CreateEnrollmentPolicy for application/json ContentType.
+type CreateEnrollmentPolicyJSONRequestBody = EnrollmentPolicy
+
+// ReplaceEnrollmentPolicyJSONRequestBody defines body for ReplaceEnrollmentPolicy for application/json ContentType.
+type ReplaceEnrollmentPolicyJSONRequestBody = EnrollmentPolicy
+
 // CreateEnrollmentRequestJSONRequestBody defines body for CreateEnrollmentRequest for application/json ContentType.
 type CreateEnrollmentRequestJSONRequestBody = EnrollmentRequest
 
@@ -3473,12 +4538,27 @@ type PatchFleetApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceFleetJSONRequestBody defines body for ReplaceFleet for application/json ContentType.
 type ReplaceFleetJSONRequestBody = Fleet
 
+// ApproveFleetRolloutBatchJSONRequestBody defines body for ApproveFleetRolloutBatch for application/json ContentType.
+type ApproveFleetRolloutBatchJSONRequestBody = FleetRolloutBatchApproval
+
+// ControlFleetRolloutJSONRequestBody defines body for ControlFleetRollout for application/json ContentType.
+type ControlFleetRolloutJSONRequestBody = FleetRolloutControl
+
+// PreviewFleetRolloutJSONRequestBody defines body for PreviewFleetRollout for application/json ContentType.
+type PreviewFleetRolloutJSONRequestBody = FleetSpec
+
 // PatchFleetStatusApplicationJSONPatchPlusJSONRequestBody defines body for PatchFleetStatus for application/json-patch+json ContentType.
 type PatchFleetStatusApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 
 // ReplaceFleetStatusJSONRequestBody defines body for ReplaceFleetStatus for application/json ContentType.
 type ReplaceFleetStatusJSONRequestBody = Fleet
 
+// CreateNotificationSinkJSONRequestBody defines body for CreateNotificationSink for application/json ContentType.
+type CreateNotificationSinkJSONRequestBody = NotificationSink
+
+// ReplaceNotificationSinkJSONRequestBody defines body for ReplaceNotificationSink for application/json ContentType.
+type ReplaceNotificationSinkJSONRequestBody = NotificationSink
+
 // CreateRepositoryJSONRequestBody defines body for CreateRepository for application/json ContentType.
 type CreateRepositoryJSONRequestBody = Repository
 
@@ -3503,6 +4583,12 @@ type PatchResourceSyncApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceResourceSyncJSONRequestBody defines body for ReplaceResourceSync for application/json ContentType.
 type ReplaceResourceSyncJSONRequestBody = ResourceSync
 
+// CreateSecretJSONRequestBody defines body for CreateSecret for application/json ContentType.
+type CreateSecretJSONRequestBody = Secret
+
+// ReplaceSecretJSONRequestBody defines body for ReplaceSecret for application/json ContentType.
+type ReplaceSecretJSONRequestBody = Secret
+
 // Getter for additional properties for DeviceSystemInfo. Returns the specified
 // element and whether it was found
 func (a DeviceSystemInfo) Get(fieldName string) (value string, found bool) {
@@ -4462,6 +5548,13 @@ func (t ComposeApplication) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'appType': %w", err)
 	}
 
+	if t.DependsOn != nil {
+		object["dependsOn"], err = json.Marshal(t.DependsOn)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'dependsOn': %w", err)
+		}
+	}
+
 	if t.EnvVars != nil {
 		object["envVars"], err = json.Marshal(t.EnvVars)
 		if err != nil {
@@ -4469,6 +5562,13 @@ func (t ComposeApplication) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.Hooks != nil {
+		object["hooks"], err = json.Marshal(t.Hooks)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'hooks': %w", err)
+		}
+	}
+
 	if t.Name != nil {
 		object["name"], err = json.Marshal(t.Name)
 		if err != nil {
@@ -4511,6 +5611,13 @@ func (t *ComposeApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["dependsOn"]; found {
+		err = json.Unmarshal(raw, &t.DependsOn)
+		if err != nil {
+			return fmt.Errorf("error reading 'dependsOn': %w", err)
+		}
+	}
+
 	if raw, found := object["envVars"]; found {
 		err = json.Unmarshal(raw, &t.EnvVars)
 		if err != nil {
@@ -4518,6 +5625,13 @@ func (t *ComposeApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["hooks"]; found {
+		err = json.Unmarshal(raw, &t.Hooks)
+		if err != nil {
+			return fmt.Errorf("error reading 'hooks': %w", err)
+		}
+	}
+
 	if raw, found := object["name"]; found {
 		err = json.Unmarshal(raw, &t.Name)
 		if err != nil {
@@ -4613,22 +5727,48 @@ func (t *ConfigProviderSpec) MergeInlineConfigProviderSpec(v InlineConfigProvide
 	return err
 }
 
-// AsHttpConfigProviderSpec returns the union data inside the ConfigProviderSpec as a HttpConfigProviderSpec
-func (t ConfigProviderSpec) AsHttpConfigProviderSpec() (HttpConfigProviderSpec, error) {
-	var body HttpConfigProviderSpec
+// AsHttpConfigProviderSpec returns the union data inside the ConfigProviderSpec as a HttpConfigProviderSpec
+func (t ConfigProviderSpec) AsHttpConfigProviderSpec() (HttpConfigProviderSpec, error) {
+	var body HttpConfigProviderSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHttpConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided HttpConfigProviderSpec
+func (t *ConfigProviderSpec) FromHttpConfigProviderSpec(v HttpConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHttpConfigProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided HttpConfigProviderSpec
+func (t *ConfigProviderSpec) MergeHttpConfigProviderSpec(v HttpConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsSecretProviderSpec returns the union data inside the ConfigProviderSpec as a SecretProviderSpec
+func (t ConfigProviderSpec) AsSecretProviderSpec() (SecretProviderSpec, error) {
+	var body SecretProviderSpec
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromHttpConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided HttpConfigProviderSpec
-func (t *ConfigProviderSpec) FromHttpConfigProviderSpec(v HttpConfigProviderSpec) error {
+// FromSecretProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided SecretProviderSpec
+func (t *ConfigProviderSpec) FromSecretProviderSpec(v SecretProviderSpec) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeHttpConfigProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided HttpConfigProviderSpec
-func (t *ConfigProviderSpec) MergeHttpConfigProviderSpec(v HttpConfigProviderSpec) error {
+// MergeSecretProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided SecretProviderSpec
+func (t *ConfigProviderSpec) MergeSecretProviderSpec(v SecretProviderSpec) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -5151,6 +6291,118 @@ func (t *EventDetails) MergeFleetRolloutDeviceSelectedDetails(v FleetRolloutDevi
 	return err
 }
 
+// AsFleetRolloutRolledBackDetails returns the union data inside the EventDetails as a FleetRolloutRolledBackDetails
+func (t EventDetails) AsFleetRolloutRolledBackDetails() (FleetRolloutRolledBackDetails, error) {
+	var body FleetRolloutRolledBackDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromFleetRolloutRolledBackDetails overwrites any union data inside the EventDetails as the provided FleetRolloutRolledBackDetails
+func (t *EventDetails) FromFleetRolloutRolledBackDetails(v FleetRolloutRolledBackDetails) error {
+	v.DetailType = "FleetRolloutRolledBack"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeFleetRolloutRolledBackDetails performs a merge with any union data inside the EventDetails, using the provided FleetRolloutRolledBackDetails
+func (t *EventDetails) MergeFleetRolloutRolledBackDetails(v FleetRolloutRolledBackDetails) error {
+	v.DetailType = "FleetRolloutRolledBack"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsFleetRolloutPausedDetails returns the union data inside the EventDetails as a FleetRolloutPausedDetails
+func (t EventDetails) AsFleetRolloutPausedDetails() (FleetRolloutPausedDetails, error) {
+	var body FleetRolloutPausedDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromFleetRolloutPausedDetails overwrites any union data inside the EventDetails as the provided FleetRolloutPausedDetails
+func (t *EventDetails) FromFleetRolloutPausedDetails(v FleetRolloutPausedDetails) error {
+	v.DetailType = "FleetRolloutPaused"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeFleetRolloutPausedDetails performs a merge with any union data inside the EventDetails, using the provided FleetRolloutPausedDetails
+func (t *EventDetails) MergeFleetRolloutPausedDetails(v FleetRolloutPausedDetails) error {
+	v.DetailType = "FleetRolloutPaused"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsFleetRolloutResumedDetails returns the union data inside the EventDetails as a FleetRolloutResumedDetails
+func (t EventDetails) AsFleetRolloutResumedDetails() (FleetRolloutResumedDetails, error) {
+	var body FleetRolloutResumedDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromFleetRolloutResumedDetails overwrites any union data inside the EventDetails as the provided FleetRolloutResumedDetails
+func (t *EventDetails) FromFleetRolloutResumedDetails(v FleetRolloutResumedDetails) error {
+	v.DetailType = "FleetRolloutResumed"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeFleetRolloutResumedDetails performs a merge with any union data inside the EventDetails, using the provided FleetRolloutResumedDetails
+func (t *EventDetails) MergeFleetRolloutResumedDetails(v FleetRolloutResumedDetails) error {
+	v.DetailType = "FleetRolloutResumed"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsFleetRolloutAbortedDetails returns the union data inside the EventDetails as a FleetRolloutAbortedDetails
+func (t EventDetails) AsFleetRolloutAbortedDetails() (FleetRolloutAbortedDetails, error) {
+	var body FleetRolloutAbortedDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromFleetRolloutAbortedDetails overwrites any union data inside the EventDetails as the provided FleetRolloutAbortedDetails
+func (t *EventDetails) FromFleetRolloutAbortedDetails(v FleetRolloutAbortedDetails) error {
+	v.DetailType = "FleetRolloutAborted"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeFleetRolloutAbortedDetails performs a merge with any union data inside the EventDetails, using the provided FleetRolloutAbortedDetails
+func (t *EventDetails) MergeFleetRolloutAbortedDetails(v FleetRolloutAbortedDetails) error {
+	v.DetailType = "FleetRolloutAborted"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 // AsDeviceVulnerabilityCveDetails returns the union data inside the EventDetails as a DeviceVulnerabilityCveDetails
 func (t EventDetails) AsDeviceVulnerabilityCveDetails() (DeviceVulnerabilityCveDetails, error) {
 	var body DeviceVulnerabilityCveDetails
@@ -5261,6 +6513,8 @@ func (t EventDetails) ValueByDiscriminator() (interface{}, error) {
 		return t.AsDeviceOwnershipChangedDetails()
 	case "DeviceVulnerabilityCVE":
 		return t.AsDeviceVulnerabilityCveDetails()
+	case "FleetRolloutAborted":
+		return t.AsFleetRolloutAbortedDetails()
 	case "FleetRolloutBatchCompleted":
 		return t.AsFleetRolloutBatchCompletedDetails()
 	case "FleetRolloutBatchDispatched":
@@ -5271,6 +6525,12 @@ func (t EventDetails) ValueByDiscriminator() (interface{}, error) {
 		return t.AsFleetRolloutDeviceSelectedDetails()
 	case "FleetRolloutFailed":
 		return t.AsFleetRolloutFailedDetails()
+	case "FleetRolloutPaused":
+		return t.AsFleetRolloutPausedDetails()
+	case "FleetRolloutResumed":
+		return t.AsFleetRolloutResumedDetails()
+	case "FleetRolloutRolledBack":
+		return t.AsFleetRolloutRolledBackDetails()
 	case "FleetRolloutStarted":
 		return t.AsFleetRolloutStartedDetails()
 	case "InternalTaskFailed":
@@ -5324,6 +6584,32 @@ func (t *HookAction) MergeHookActionRun(v HookActionRun) error {
 	return err
 }
 
+// AsHookActionHttp returns the union data inside the HookAction as a HookActionHttp
+func (t HookAction) AsHookActionHttp() (HookActionHttp, error) {
+	var body HookActionHttp
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHookActionHttp overwrites any union data inside the HookAction as the provided HookActionHttp
+func (t *HookAction) FromHookActionHttp(v HookActionHttp) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHookActionHttp performs a merge with any union data inside the HookAction, using the provided HookActionHttp
+func (t *HookAction) MergeHookActionHttp(v HookActionHttp) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t HookAction) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	if err != nil {
@@ -5699,6 +6985,13 @@ func (t QuadletApplication) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'appType': %w", err)
 	}
 
+	if t.DependsOn != nil {
+		object["dependsOn"], err = json.Marshal(t.DependsOn)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'dependsOn': %w", err)
+		}
+	}
+
 	if t.EnvVars != nil {
 		object["envVars"], err = json.Marshal(t.EnvVars)
 		if err != nil {
@@ -5706,6 +6999,20 @@ func (t QuadletApplication) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.Hooks != nil {
+		object["hooks"], err = json.Marshal(t.Hooks)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'hooks': %w", err)
+		}
+	}
+
+	if t.LivenessProbe != nil {
+		object["livenessProbe"], err = json.Marshal(t.LivenessProbe)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'livenessProbe': %w", err)
+		}
+	}
+
 	if t.Name != nil {
 		object["name"], err = json.Marshal(t.Name)
 		if err != nil {
@@ -5713,6 +7020,20 @@ func (t QuadletApplication) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.ReadinessProbe != nil {
+		object["readinessProbe"], err = json.Marshal(t.ReadinessProbe)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'readinessProbe': %w", err)
+		}
+	}
+
+	if t.RestartPolicy != nil {
+		object["restartPolicy"], err = json.Marshal(t.RestartPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'restartPolicy': %w", err)
+		}
+	}
+
 	object["runAs"], err = json.Marshal(t.RunAs)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling 'runAs': %w", err)
@@ -5753,6 +7074,13 @@ func (t *QuadletApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["dependsOn"]; found {
+		err = json.Unmarshal(raw, &t.DependsOn)
+		if err != nil {
+			return fmt.Errorf("error reading 'dependsOn': %w", err)
+		}
+	}
+
 	if raw, found := object["envVars"]; found {
 		err = json.Unmarshal(raw, &t.EnvVars)
 		if err != nil {
@@ -5760,6 +7088,20 @@ func (t *QuadletApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["hooks"]; found {
+		err = json.Unmarshal(raw, &t.Hooks)
+		if err != nil {
+			return fmt.Errorf("error reading 'hooks': %w", err)
+		}
+	}
+
+	if raw, found := object["livenessProbe"]; found {
+		err = json.Unmarshal(raw, &t.LivenessProbe)
+		if err != nil {
+			return fmt.Errorf("error reading 'livenessProbe': %w", err)
+		}
+	}
+
 	if raw, found := object["name"]; found {
 		err = json.Unmarshal(raw, &t.Name)
 		if err != nil {
@@ -5767,6 +7109,20 @@ func (t *QuadletApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["readinessProbe"]; found {
+		err = json.Unmarshal(raw, &t.ReadinessProbe)
+		if err != nil {
+			return fmt.Errorf("error reading 'readinessProbe': %w", err)
+		}
+	}
+
+	if raw, found := object["restartPolicy"]; found {
+		err = json.Unmarshal(raw, &t.RestartPolicy)
+		if err != nil {
+			return fmt.Errorf("error reading 'restartPolicy': %w", err)
+		}
+	}
+
 	if raw, found := object["runAs"]; found {
 		err = json.Unmarshal(raw, &t.RunAs)
 		if err != nil {
@@ -5987,6 +7343,118 @@ func (t *ResourceMonitor) MergeDiskResourceMonitorSpec(v DiskResourceMonitorSpec
 	return err
 }
 
+// AsNetworkResourceMonitorSpec returns the union data inside the ResourceMonitor as a NetworkResourceMonitorSpec
+func (t ResourceMonitor) AsNetworkResourceMonitorSpec() (NetworkResourceMonitorSpec, error) {
+	var body NetworkResourceMonitorSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromNetworkResourceMonitorSpec overwrites any union data inside the ResourceMonitor as the provided NetworkResourceMonitorSpec
+func (t *ResourceMonitor) FromNetworkResourceMonitorSpec(v NetworkResourceMonitorSpec) error {
+	v.MonitorType = "Network"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeNetworkResourceMonitorSpec performs a merge with any union data inside the ResourceMonitor, using the provided NetworkResourceMonitorSpec
+func (t *ResourceMonitor) MergeNetworkResourceMonitorSpec(v NetworkResourceMonitorSpec) error {
+	v.MonitorType = "Network"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsTemperatureResourceMonitorSpec returns the union data inside the ResourceMonitor as a TemperatureResourceMonitorSpec
+func (t ResourceMonitor) AsTemperatureResourceMonitorSpec() (TemperatureResourceMonitorSpec, error) {
+	var body TemperatureResourceMonitorSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromTemperatureResourceMonitorSpec overwrites any union data inside the ResourceMonitor as the provided TemperatureResourceMonitorSpec
+func (t *ResourceMonitor) FromTemperatureResourceMonitorSpec(v TemperatureResourceMonitorSpec) error {
+	v.MonitorType = "Temperature"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeTemperatureResourceMonitorSpec performs a merge with any union data inside the ResourceMonitor, using the provided TemperatureResourceMonitorSpec
+func (t *ResourceMonitor) MergeTemperatureResourceMonitorSpec(v TemperatureResourceMonitorSpec) error {
+	v.MonitorType = "Temperature"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProcessResourceMonitorSpec returns the union data inside the ResourceMonitor as a ProcessResourceMonitorSpec
+func (t ResourceMonitor) AsProcessResourceMonitorSpec() (ProcessResourceMonitorSpec, error) {
+	var body ProcessResourceMonitorSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProcessResourceMonitorSpec overwrites any union data inside the ResourceMonitor as the provided ProcessResourceMonitorSpec
+func (t *ResourceMonitor) FromProcessResourceMonitorSpec(v ProcessResourceMonitorSpec) error {
+	v.MonitorType = "Process"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProcessResourceMonitorSpec performs a merge with any union data inside the ResourceMonitor, using the provided ProcessResourceMonitorSpec
+func (t *ResourceMonitor) MergeProcessResourceMonitorSpec(v ProcessResourceMonitorSpec) error {
+	v.MonitorType = "Process"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCustomResourceMonitorSpec returns the union data inside the ResourceMonitor as a CustomResourceMonitorSpec
+func (t ResourceMonitor) AsCustomResourceMonitorSpec() (CustomResourceMonitorSpec, error) {
+	var body CustomResourceMonitorSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCustomResourceMonitorSpec overwrites any union data inside the ResourceMonitor as the provided CustomResourceMonitorSpec
+func (t *ResourceMonitor) FromCustomResourceMonitorSpec(v CustomResourceMonitorSpec) error {
+	v.MonitorType = "Custom"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCustomResourceMonitorSpec performs a merge with any union data inside the ResourceMonitor, using the provided CustomResourceMonitorSpec
+func (t *ResourceMonitor) MergeCustomResourceMonitorSpec(v CustomResourceMonitorSpec) error {
+	v.MonitorType = "Custom"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t ResourceMonitor) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"monitorType"`
@@ -6003,10 +7471,18 @@ func (t ResourceMonitor) ValueByDiscriminator() (interface{}, error) {
 	switch discriminator {
 	case "CPU":
 		return t.AsCpuResourceMonitorSpec()
+	case "Custom":
+		return t.AsCustomResourceMonitorSpec()
 	case "Disk":
 		return t.AsDiskResourceMonitorSpec()
 	case "Memory":
 		return t.AsMemoryResourceMonitorSpec()
+	case "Network":
+		return t.AsNetworkResourceMonitorSpec()
+	case "Process":
+		return t.AsProcessResourceMonitorSpec()
+	case "Temperature":
+		return t.AsTemperatureResourceMonitorSpec()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -6050,6 +7526,62 @@ func (t *RolloutDeviceSelection) MergeBatchSequence(v BatchSequence) error {
 	return err
 }
 
+// AsCanary returns the union data inside the RolloutDeviceSelection as a Canary
+func (t RolloutDeviceSelection) AsCanary() (Canary, error) {
+	var body Canary
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCanary overwrites any union data inside the RolloutDeviceSelection as the provided Canary
+func (t *RolloutDeviceSelection) FromCanary(v Canary) error {
+	v.Strategy = "Canary"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCanary performs a merge with any union data inside the RolloutDeviceSelection, using the provided Canary
+func (t *RolloutDeviceSelection) MergeCanary(v Canary) error {
+	v.Strategy = "Canary"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProgressive returns the union data inside the RolloutDeviceSelection as a Progressive
+func (t RolloutDeviceSelection) AsProgressive() (Progressive, error) {
+	var body Progressive
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProgressive overwrites any union data inside the RolloutDeviceSelection as the provided Progressive
+func (t *RolloutDeviceSelection) FromProgressive(v Progressive) error {
+	v.Strategy = "Progressive"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProgressive performs a merge with any union data inside the RolloutDeviceSelection, using the provided Progressive
+func (t *RolloutDeviceSelection) MergeProgressive(v Progressive) error {
+	v.Strategy = "Progressive"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t RolloutDeviceSelection) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"strategy"`
@@ -6066,6 +7598,10 @@ func (t RolloutDeviceSelection) ValueByDiscriminator() (interface{}, error) {
 	switch discriminator {
 	case "BatchSequence":
 		return t.AsBatchSequence()
+	case "Canary":
+		return t.AsCanary()
+	case "Progressive":
+		return t.AsProgressive()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -3,6 +3,7 @@ package v1beta1
 import (
 	"encoding/base64"
 	"fmt"
+	"time"
 )
 
 type DeviceCommand struct {
@@ -25,12 +26,28 @@ type DeviceRemoteSession struct {
 	ConsoleType string `json:"consoleType"`
 }
 
+// DeviceLogsRequest asks the agent to stream the logs of an application or of a systemd unit
+// instead of running a command. Exactly one of Application and Unit must be set.
+type DeviceLogsRequest struct {
+	// Application is the name of an application of the device whose container logs are streamed.
+	Application string `json:"application,omitempty"`
+	// Unit is the name of a systemd unit whose journal is streamed.
+	Unit string `json:"unit,omitempty"`
+	// Follow keeps streaming new log lines until the session is closed.
+	Follow bool `json:"follow,omitempty"`
+	// Since limits the logs to lines written after this time.
+	Since *time.Time `json:"since,omitempty"`
+	// Tail limits the logs to this number of most recent lines.
+	Tail *int `json:"tail,omitempty"`
+}
+
 type DeviceConsoleSessionMetadata struct {
-	Term              *string        `json:"term,omitempty"`
-	InitialDimensions *TerminalSize  `json:"initialDimensions,omitempty"`
-	Command           *DeviceCommand `json:"command,omitempty"`
-	TTY               bool           `json:"tty,omitempty"`
-	Protocols         []string       `json:"protocols,omitempty"`
+	Term              *string            `json:"term,omitempty"`
+	InitialDimensions *TerminalSize      `json:"initialDimensions,omitempty"`
+	Command           *DeviceCommand     `json:"command,omitempty"`
+	TTY               bool               `json:"tty,omitempty"`
+	Protocols         []string           `json:"protocols,omitempty"`
+	Logs              *DeviceLogsRequest `json:"logs,omitempty"`
 }
 
 type RolloutBatchCompletionReport struct {
@@ -40,6 +57,8 @@ type RolloutBatchCompletionReport struct {
 	Successful        int64  `json:"successful"`
 	Failed            int64  `json:"failed"`
 	TimedOut          int64  `json:"timedOut"`
+	// SoakFailed is set if the devices of a canary batch did not stay healthy for the soak duration in time
+	SoakFailed bool `json:"soakFailed,omitempty"`
 }
 
 // A username on the system
@@ -97,6 +116,8 @@ func decodeContents(content string, encoding *EncodingType) ([]byte,
 			return nil, fmt.Errorf("failed to decode base64 content: %w", err)
 		}
 		return decoded, nil
+	case EncodingEncrypted:
+		return nil, fmt.Errorf("content is encrypted to the device's key and cannot be decoded without it")
 	default:
 		return nil, fmt.Errorf("unsupported content encoding: %q", *encoding)
 	}
@@ -2,7 +2,9 @@ package v1beta1
 
 import (
 	"bytes"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"strings"
 	"text/template"
@@ -14,12 +16,14 @@ type DeviceCompletionCount struct {
 	SameTemplateVersion bool
 	UpdatingReason      UpdateState
 	UpdateTimedOut      bool
+	ApplicationsStatus  ApplicationsSummaryStatusType
 }
 
 type HookActionType string
 
 const (
-	HookActionTypeRun HookActionType = "run"
+	HookActionTypeRun  HookActionType = "run"
+	HookActionTypeHttp HookActionType = "http"
 )
 
 type HookConditionType string
@@ -36,6 +40,7 @@ const (
 	HttpConfigProviderType       ConfigProviderType = "httpRef"
 	InlineConfigProviderType     ConfigProviderType = "inline"
 	KubernetesSecretProviderType ConfigProviderType = "secretRef"
+	SecretProviderType           ConfigProviderType = "secret"
 )
 
 type ApplicationProviderType string
@@ -68,6 +73,7 @@ func (t HookAction) Type() (HookActionType, error) {
 
 	types := []HookActionType{
 		HookActionTypeRun,
+		HookActionTypeHttp,
 	}
 	for _, t := range types {
 		if _, exists := data[t]; exists {
@@ -113,6 +119,7 @@ func (c ConfigProviderSpec) Type() (ConfigProviderType, error) {
 		HttpConfigProviderType,
 		InlineConfigProviderType,
 		KubernetesSecretProviderType,
+		SecretProviderType,
 	}
 	for _, t := range types {
 		if _, exists := data[t]; exists {
@@ -576,6 +583,52 @@ func (r *Repository) PreserveSensitiveData(existing SensitiveDataPreserver) erro
 	}
 }
 
+func (s *Secret) HideSensitiveData() error {
+	if s == nil {
+		return nil
+	}
+	for key := range s.Spec.Data {
+		s.Spec.Data[key] = MaskedValuePlaceholder
+	}
+	return nil
+}
+
+func (s *SecretList) HideSensitiveData() error {
+	if s == nil {
+		return nil
+	}
+	for i := range s.Items {
+		if err := s.Items[i].HideSensitiveData(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PreserveSensitiveData preserves the values of the existing secret for the keys whose new value is the masked
+// placeholder ("*****"). This allows users to update a secret they read from the API without re-providing the
+// values they don't change.
+func (s *Secret) PreserveSensitiveData(existing SensitiveDataPreserver) error {
+	if s == nil || existing == nil {
+		return nil
+	}
+
+	existingSecret, ok := existing.(*Secret)
+	if !ok {
+		return fmt.Errorf("existing object is not a Secret")
+	}
+
+	for key, value := range s.Spec.Data {
+		existingValue, ok := existingSecret.Spec.Data[key]
+		if !ok {
+			continue
+		}
+		preserveValue(&value, &existingValue)
+		s.Spec.Data[key] = value
+	}
+	return nil
+}
+
 func (a *AuthProvider) HideSensitiveData() error {
 	if a == nil {
 		return nil
@@ -742,3 +795,19 @@ func (a *AuthProvider) PreserveSensitiveData(existing SensitiveDataPreserver) er
 	}
 	return nil
 }
+
+// TPMEKCertificateDER returns the DER encoding of the TPM endorsement key certificate of the registration,
+// or nil if it has none.
+func (s DeviceRegistrationSpec) TPMEKCertificateDER() ([]byte, error) {
+	if s.TpmEkCertificate == nil {
+		return nil, nil
+	}
+	block, _ := pem.Decode([]byte(*s.TpmEkCertificate))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("must be a PEM-encoded certificate")
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return nil, fmt.Errorf("parsing certificate: %w", err)
+	}
+	return block.Bytes, nil
+}
@@ -1057,3 +1057,27 @@ func TestAuthProviderPreserveSensitiveData(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretSensitiveData(t *testing.T) {
+	require := require.New(t)
+
+	existing := &Secret{
+		Metadata: ObjectMeta{Name: lo.ToPtr("db-creds")},
+		Spec:     SecretSpec{Data: map[string]string{"username": "admin", "password": "hunter2"}},
+	}
+
+	list := &SecretList{Items: []Secret{*existing}}
+	list.Items[0].Spec.Data = map[string]string{"username": "admin", "password": "hunter2"}
+	require.NoError(list.HideSensitiveData())
+	require.Equal(map[string]string{"username": "*****", "password": "*****"}, list.Items[0].Spec.Data)
+
+	// the masked values of an update keep their stored values, new values and keys are taken as is
+	updated := &Secret{
+		Metadata: ObjectMeta{Name: lo.ToPtr("db-creds")},
+		Spec:     SecretSpec{Data: map[string]string{"username": "*****", "password": "hunter3", "token": "*****"}},
+	}
+	require.NoError(updated.PreserveSensitiveData(existing))
+	require.Equal(map[string]string{"username": "admin", "password": "hunter3", "token": "*****"}, updated.Spec.Data)
+
+	require.Error(updated.PreserveSensitiveData(&Repository{}))
+}
@@ -6,6 +6,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"net"
+	"net/mail"
+	"net/url"
 	"reflect"
 	"regexp"
 	"slices"
@@ -28,11 +32,15 @@ import (
 const (
 	maxBase64CertificateLength  = 20 * 1024 * 1024
 	maxInlineLength             = 1024 * 1024
+	maxSecretDataLength         = 1024 * 1024
 	privilegedPortRangeStart    = 1
 	nonPrivilegedPortRangeStart = 1024
 	portRangeEnd                = 65535
 )
 
+// secretKeyRegexp matches the keys of a secret, which are the names of the files the secret is mounted as.
+var secretKeyRegexp = regexp.MustCompile(`^[-._a-zA-Z0-9]+$`)
+
 var (
 	ErrStartGraceDurationExceedsCronInterval = errors.New("startGraceDuration exceeds the cron interval between schedule times")
 	ErrInfoAlertLessThanWarn                 = errors.New("info alert percentage must be less than warning")
@@ -42,6 +50,9 @@ var (
 	ErrDuplicateMonitorType                  = errors.New("duplicate monitorType in resources")
 	ErrInvalidCPUMonitorField                = errors.New("invalid field for CPU monitor")
 	ErrInvalidMemoryMonitorField             = errors.New("invalid field for Memory monitor")
+	ErrProcessPresenceAlertPercentage        = errors.New("process presence alert percentage must be less than 100")
+	ErrDuplicateCustomMonitorName            = errors.New("duplicate custom monitor name in resources")
+	ErrInvalidCustomMonitorField             = errors.New("invalid field for Custom monitor")
 	ErrClaimPathRequiredDynamicOrg           = errors.New("claimPath is required for dynamic assignment")
 	ErrClaimPathRequiredDynamicRole          = errors.New("claimPath is required for dynamic role assignment")
 	ErrMappedIdentityNotFound                = errors.New("mapped identity not found in context")
@@ -178,6 +189,14 @@ func validateConfigs(configs []ConfigProviderSpec, fleetTemplate bool) []error {
 			}
 			configName = provider.Name
 			allErrs = append(allErrs, provider.Validate(fleetTemplate)...)
+		case SecretProviderType:
+			provider, err := config.AsSecretProviderSpec()
+			if err != nil {
+				allErrs = append(allErrs, err)
+				break
+			}
+			configName = provider.Name
+			allErrs = append(allErrs, provider.Validate(fleetTemplate)...)
 		default:
 			allErrs = append(allErrs, fmt.Errorf("unknown config provider type: %s", t))
 		}
@@ -215,6 +234,13 @@ func (a HookAction) Validate(path string) []error {
 		// TODO: pull the extra validation done by the agent up here
 		allErrs = append(allErrs, validation.ValidateStringMap(runAction.EnvVars, path+".envVars", 1, 256, nil, nil, "")...)
 		allErrs = append(allErrs, validation.ValidateFileOrDirectoryPath(runAction.WorkDir, path+".workDir")...)
+	case HookActionTypeHttp:
+		httpAction, err := a.AsHookActionHttp()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		allErrs = append(allErrs, validateHookActionHttp(httpAction.Http, path+".http")...)
 	default:
 		// if we hit this case, it means that the type should be added to the switch statement above
 		allErrs = append(allErrs, fmt.Errorf("%s: unknown hook action type: %s", path, t))
@@ -229,6 +255,41 @@ func (a HookAction) Validate(path string) []error {
 	return allErrs
 }
 
+func validateHookActionHttp(spec HookActionHttpSpec, path string) []error {
+	allErrs := validation.ValidateString(&spec.Url, path+".url", 1, 2048, nil, "")
+	if spec.Url != "" {
+		u, err := url.Parse(spec.Url)
+		switch {
+		case err != nil:
+			allErrs = append(allErrs, fmt.Errorf("%s.url: invalid URL: %w", path, err))
+		case u.Scheme != "http" && u.Scheme != "https":
+			allErrs = append(allErrs, fmt.Errorf("%s.url: scheme must be http or https: %s", path, spec.Url))
+		case u.Host == "":
+			allErrs = append(allErrs, fmt.Errorf("%s.url: host is required: %s", path, spec.Url))
+		}
+	}
+	if spec.Method != nil {
+		switch *spec.Method {
+		case HookActionHttpMethodGet, HookActionHttpMethodPost, HookActionHttpMethodPut, HookActionHttpMethodPatch, HookActionHttpMethodDelete:
+		default:
+			allErrs = append(allErrs, fmt.Errorf("%s.method: unsupported HTTP method: %s", path, *spec.Method))
+		}
+	}
+	allErrs = append(allErrs, validation.ValidateStringMap(spec.Headers, path+".headers", 1, 8192, nil, nil, "")...)
+	if spec.Body != nil && len(*spec.Body) > 65536 {
+		allErrs = append(allErrs, fmt.Errorf("%s.body: must be no more than 65536 characters", path))
+	}
+	if spec.Retries != nil && (*spec.Retries < 0 || *spec.Retries > 10) {
+		allErrs = append(allErrs, fmt.Errorf("%s.retries: must be between 0 and 10: %d", path, *spec.Retries))
+	}
+	if spec.RetryInterval != nil {
+		if _, err := time.ParseDuration(*spec.RetryInterval); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("%s.retryInterval: %w", path, err))
+		}
+	}
+	return allErrs
+}
+
 func (c HookCondition) Validate(path string) []error {
 	allErrs := []error{}
 
@@ -274,7 +335,7 @@ func (r ResourceMonitor) Validate() []error {
 			allErrs = append(allErrs, err)
 		}
 		// CPU monitors should not have a path field
-		if hasPathField(r.union) {
+		if hasField(r.union, "path") {
 			allErrs = append(allErrs, fmt.Errorf("%w: CPU monitors cannot have a path field", ErrInvalidCPUMonitorField))
 		}
 		allErrs = append(allErrs, validateAlertRules(spec.AlertRules, spec.SamplingInterval)...)
@@ -291,12 +352,84 @@ func (r ResourceMonitor) Validate() []error {
 			allErrs = append(allErrs, err)
 		}
 		// Memory monitors should not have a path field
-		if hasPathField(r.union) {
+		if hasField(r.union, "path") {
 			allErrs = append(allErrs, fmt.Errorf("%w: Memory monitors cannot have a path field", ErrInvalidMemoryMonitorField))
 		}
 		allErrs = append(allErrs, validateAlertRules(spec.AlertRules, spec.SamplingInterval)...)
+	case "Network":
+		spec, err := r.AsNetworkResourceMonitorSpec()
+		if err != nil {
+			allErrs = append(allErrs, err)
+		}
+		allErrs = append(allErrs, validation.ValidateNetworkInterfaceName(&spec.Interface, "spec.resources[].network.interface")...)
+		switch spec.Metric {
+		case NetworkMonitorMetricThroughput, NetworkMonitorMetricErrors:
+		default:
+			allErrs = append(allErrs, fmt.Errorf("spec.resources[].network.metric must be Throughput or Errors: %q", spec.Metric))
+		}
+		if spec.LinkSpeed != nil && *spec.LinkSpeed < 1 {
+			allErrs = append(allErrs, fmt.Errorf("spec.resources[].network.linkSpeed must be at least 1 Mbit/s: %d", *spec.LinkSpeed))
+		}
+		allErrs = append(allErrs, validateAlertRules(spec.AlertRules, spec.SamplingInterval)...)
+	case "Temperature":
+		spec, err := r.AsTemperatureResourceMonitorSpec()
+		if err != nil {
+			allErrs = append(allErrs, err)
+		}
+		allErrs = append(allErrs, validation.ValidateThermalZone(&spec.Zone, "spec.resources[].temperature.zone")...)
+		if spec.MaxTemperature != nil && *spec.MaxTemperature < 1 {
+			allErrs = append(allErrs, fmt.Errorf("spec.resources[].temperature.maxTemperature must be at least 1 degree Celsius: %d", *spec.MaxTemperature))
+		}
+		allErrs = append(allErrs, validateAlertRules(spec.AlertRules, spec.SamplingInterval)...)
+	case "Process":
+		spec, err := r.AsProcessResourceMonitorSpec()
+		if err != nil {
+			allErrs = append(allErrs, err)
+		}
+		// the kernel truncates process names to 15 characters
+		allErrs = append(allErrs, validation.ValidateString(&spec.Name, "spec.resources[].process.name", 1, 15, nil, "")...)
+		switch spec.Metric {
+		case ProcessMonitorMetricPresence:
+			// a missing process is reported as 100%, so a rule at 100% could never fire
+			for _, rule := range spec.AlertRules {
+				if rule.Percentage >= 100 {
+					allErrs = append(allErrs, fmt.Errorf("%w: %s", ErrProcessPresenceAlertPercentage, rule.Severity))
+				}
+			}
+		case ProcessMonitorMetricMemory:
+		default:
+			allErrs = append(allErrs, fmt.Errorf("spec.resources[].process.metric must be Presence or Memory: %q", spec.Metric))
+		}
+		allErrs = append(allErrs, validateAlertRules(spec.AlertRules, spec.SamplingInterval)...)
+	case "Custom":
+		spec, err := r.AsCustomResourceMonitorSpec()
+		if err != nil {
+			allErrs = append(allErrs, err)
+		}
+		// the name selects an executable installed in the agent's custom monitor directory, so it must not be a path
+		allErrs = append(allErrs, validation.ValidateGenericName(&spec.Name, "spec.resources[].custom.name")...)
+		// the device spec must not choose what the agent executes
+		for _, field := range []string{"command", "args"} {
+			if hasField(r.union, field) {
+				allErrs = append(allErrs, fmt.Errorf("%w: Custom monitors cannot have a %s field", ErrInvalidCustomMonitorField, field))
+			}
+		}
+		if spec.Timeout != nil {
+			timeout, err := time.ParseDuration(*spec.Timeout)
+			sampleInterval, intervalErr := time.ParseDuration(spec.SamplingInterval)
+			switch {
+			case err != nil:
+				allErrs = append(allErrs, fmt.Errorf("spec.resources[].custom.timeout is invalid: %w", err))
+			case intervalErr == nil && timeout >= sampleInterval:
+				allErrs = append(allErrs, fmt.Errorf("spec.resources[].custom.timeout %s must be less than the sampling interval: %s", timeout, sampleInterval))
+			}
+		}
+		if spec.MaxValue != nil && *spec.MaxValue <= 0 {
+			allErrs = append(allErrs, fmt.Errorf("spec.resources[].custom.maxValue must be greater than 0: %v", *spec.MaxValue))
+		}
+		allErrs = append(allErrs, validateAlertRules(spec.AlertRules, spec.SamplingInterval)...)
 	default:
-		allErrs = append(allErrs, fmt.Errorf("unknown monitor type valid types are CPU, Disk and Memory: %s", monitorType))
+		allErrs = append(allErrs, fmt.Errorf("unknown monitor type valid types are CPU, Disk, Memory, Network, Temperature, Process and Custom: %s", monitorType))
 	}
 
 	return allErrs
@@ -404,6 +537,30 @@ func (c KubernetesSecretProviderSpec) Validate(fleetTemplate bool) []error {
 	return allErrs
 }
 
+func (c SecretProviderSpec) Validate(fleetTemplate bool) []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateConfigName(&c.Name, "spec.config[].name")...)
+
+	containsParams, paramErrs := validateParametersInString(&c.Secret.Name, "spec.config[].secret.name", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateResourceNameReference(&c.Secret.Name, "spec.config[].secret.name")...)
+	}
+
+	containsParams, paramErrs = validateParametersInString(&c.Secret.MountPath, "spec.config[].secret.mountPath", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateFilePath(&c.Secret.MountPath, "spec.config[].secret.mountPath")...)
+		if err := validation.DenyForbiddenDevicePath(c.Secret.MountPath); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.config[].secret.mountPath: %w", err))
+		}
+	}
+
+	allErrs = append(allErrs, validation.ValidateLinuxUserGroup(string(c.Secret.User), "spec.config[].secret.user")...)
+	allErrs = append(allErrs, validation.ValidateLinuxUserGroup(c.Secret.Group, "spec.config[].secret.group")...)
+	return allErrs
+}
+
 func (c InlineConfigProviderSpec) Validate(fleetTemplate bool) []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateConfigName(&c.Name, "spec.config[].name")...)
@@ -433,6 +590,8 @@ func (c InlineConfigProviderSpec) Validate(fleetTemplate bool) []error {
 			allErrs = append(allErrs, validation.ValidateString(&c.Inline[i].Content, fmt.Sprintf("spec.config[].inline[%d].content", i), 0, maxInlineLength, nil, "")...)
 			_, paramErrs = validateParametersInString(&c.Inline[i].Content, fmt.Sprintf("spec.config[].inline[%d].content", i), fleetTemplate)
 			allErrs = append(allErrs, paramErrs...)
+		} else if *(c.Inline[i].ContentEncoding) == EncodingEncrypted {
+			allErrs = append(allErrs, fmt.Errorf("spec.config[].inline[%d].contentEncoding: %s is reserved for rendered device specs", i, EncodingEncrypted))
 		} else {
 			allErrs = append(allErrs, fmt.Errorf("unknown contentEncoding: %s", *(c.Inline[i].ContentEncoding)))
 		}
@@ -551,6 +710,61 @@ func (b BatchSequence) Validate() []error {
 	return errs
 }
 
+func (c Canary) Validate() []error {
+	var errs []error
+	if len(lo.FromPtr(c.Selector.MatchLabels)) == 0 && len(lo.FromPtr(c.Selector.MatchExpressions)) == 0 {
+		errs = append(errs, errors.New("canary selector must not be empty"))
+	}
+	errs = append(errs, c.Selector.Validate()...)
+	if c.SuccessThreshold != nil {
+		if err := validatePercentage(*c.SuccessThreshold); err != nil {
+			errs = append(errs, fmt.Errorf("canary success threshold: %w", err))
+		}
+	}
+	soakDuration, err := time.ParseDuration(c.SoakDuration)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("invalid canary soak duration %q: %w", c.SoakDuration, err))
+	} else if soakDuration <= 0 {
+		errs = append(errs, fmt.Errorf("canary soak duration must be positive, got %q", c.SoakDuration))
+	}
+	return errs
+}
+
+func (p Progressive) Validate() []error {
+	var errs []error
+	if len(p.Steps) == 0 {
+		errs = append(errs, errors.New("progressive rollout must have at least one step"))
+	}
+	previous := 0
+	for i, step := range p.Steps {
+		if err := validatePercentage(step); err != nil {
+			errs = append(errs, fmt.Errorf("progressive step %d: %w", i+1, err))
+			continue
+		}
+		percentage, err := util.PercentageAsInt(step)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("progressive step %d: %w", i+1, err))
+			continue
+		}
+		if percentage <= previous {
+			errs = append(errs, fmt.Errorf("progressive step %d: %s must be greater than the share of the previous step", i+1, step))
+		}
+		previous = percentage
+	}
+	if p.SuccessThreshold != nil {
+		if err := validatePercentage(*p.SuccessThreshold); err != nil {
+			errs = append(errs, fmt.Errorf("progressive success threshold: %w", err))
+		}
+	}
+	stepInterval, err := time.ParseDuration(p.StepInterval)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("invalid progressive step interval %q: %w", p.StepInterval, err))
+	} else if stepInterval <= 0 {
+		errs = append(errs, fmt.Errorf("progressive step interval must be positive, got %q", p.StepInterval))
+	}
+	return errs
+}
+
 func (r *RolloutDeviceSelection) Validate() []error {
 	var errs []error
 	if r == nil {
@@ -563,6 +777,10 @@ func (r *RolloutDeviceSelection) Validate() []error {
 		switch v := i.(type) {
 		case BatchSequence:
 			errs = append(errs, v.Validate()...)
+		case Canary:
+			errs = append(errs, v.Validate()...)
+		case Progressive:
+			errs = append(errs, v.Validate()...)
 		}
 	}
 	return errs
@@ -603,6 +821,28 @@ func (r *RolloutPolicy) Validate() []error {
 			errs = append(errs, fmt.Errorf("rollout policy success threshold: %w", err))
 		}
 	}
+	if r.OnFailure != nil {
+		switch *r.OnFailure {
+		case RolloutFailurePolicyPause:
+		case RolloutFailurePolicyRollback:
+			if r.DeviceSelection == nil {
+				errs = append(errs, errors.New("onFailure 'Rollback' requires deviceSelection to be defined"))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("unsupported onFailure policy %q", *r.OnFailure))
+		}
+	}
+	if len(lo.FromPtr(r.MaintenanceWindows)) > 0 && r.DeviceSelection == nil {
+		errs = append(errs, errors.New("maintenanceWindows requires deviceSelection to be defined"))
+	}
+	for i, window := range lo.FromPtr(r.MaintenanceWindows) {
+		for _, err := range window.Validate() {
+			errs = append(errs, fmt.Errorf("maintenance window %d: %w", i, err))
+		}
+		if duration, err := time.ParseDuration(window.StartGraceDuration); err == nil && duration <= 0 {
+			errs = append(errs, fmt.Errorf("maintenance window %d: startGraceDuration must be positive", i))
+		}
+	}
 	return errs
 }
 
@@ -746,6 +986,218 @@ func (r *Repository) ValidateUpdate(newObj *Repository) []error {
 		r.Status, newObj.Status)
 }
 
+func (s *Secret) Validate() []error {
+	if s == nil {
+		return nil
+	}
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(s.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(s.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(s.Metadata.Annotations)...)
+
+	size := 0
+	for key, value := range s.Spec.Data {
+		path := fmt.Sprintf("spec.data[%s]", key)
+		allErrs = append(allErrs, validation.ValidateString(&key, path, 1, 253, secretKeyRegexp, "[-._a-zA-Z0-9]+")...)
+		if key == "." || key == ".." {
+			allErrs = append(allErrs, fmt.Errorf("%s: key must not be %q", path, key))
+		}
+		size += len(key) + len(value)
+	}
+	if size > maxSecretDataLength {
+		allErrs = append(allErrs, fmt.Errorf("spec.data: total size must not exceed %d bytes", maxSecretDataLength))
+	}
+	return allErrs
+}
+
+func (r *DeviceRegistration) Validate() []error {
+	if r == nil {
+		return nil
+	}
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(r.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
+	allErrs = append(allErrs, validation.ValidateLabelsWithPath(r.Spec.Labels, "spec.labels")...)
+
+	if r.Spec.TpmEkCertificate == nil && len(lo.FromPtr(r.Spec.SystemInfo)) == 0 {
+		allErrs = append(allErrs, errors.New("spec: tpmEkCertificate or systemInfo must be set"))
+	}
+	if r.Spec.TpmEkCertificate != nil {
+		if _, err := r.Spec.TPMEKCertificateDER(); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.tpmEkCertificate: %w", err))
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(lo.FromPtr(r.Spec.SystemInfo))) {
+		value := (*r.Spec.SystemInfo)[key]
+		allErrs = append(allErrs, validation.ValidateString(&key, "spec.systemInfo key", 1, 256, nil, "")...)
+		allErrs = append(allErrs, validation.ValidateString(&value, fmt.Sprintf("spec.systemInfo[%s]", key), 1, 256, nil, "")...)
+	}
+	return allErrs
+}
+
+func (p *EnrollmentPolicy) Validate() []error {
+	if p == nil {
+		return nil
+	}
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(p.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(p.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(p.Metadata.Annotations)...)
+	allErrs = append(allErrs, validation.ValidateLabelsWithPath(p.Spec.Labels, "spec.labels")...)
+	if p.Spec.Fleet != nil {
+		allErrs = append(allErrs, validation.ValidateResourceNameReference(p.Spec.Fleet, "spec.fleet")...)
+	}
+
+	match := p.Spec.Match
+	if match.TpmVerified == nil && match.Labels == nil && match.SerialNumbers == nil && match.SourceCidrs == nil && match.TimeWindow == nil {
+		allErrs = append(allErrs, errors.New("spec.match: at least one rule must be set"))
+	}
+	if match.TpmVerified != nil && !*match.TpmVerified && match.Labels == nil && match.SerialNumbers == nil && match.SourceCidrs == nil && match.TimeWindow == nil {
+		allErrs = append(allErrs, errors.New("spec.match: tpmVerified must be true if it is the only rule"))
+	}
+	allErrs = append(allErrs, validation.ValidateLabelsWithPath(match.Labels, "spec.match.labels")...)
+	if match.SerialNumbers != nil {
+		if len(*match.SerialNumbers) == 0 {
+			allErrs = append(allErrs, errors.New("spec.match.serialNumbers: must not be empty"))
+		}
+		for i, serial := range *match.SerialNumbers {
+			allErrs = append(allErrs, validation.ValidateString(&serial, fmt.Sprintf("spec.match.serialNumbers[%d]", i), 1, 256, nil, "")...)
+		}
+	}
+	if match.SourceCidrs != nil {
+		if len(*match.SourceCidrs) == 0 {
+			allErrs = append(allErrs, errors.New("spec.match.sourceCidrs: must not be empty"))
+		}
+		for i, cidr := range *match.SourceCidrs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				allErrs = append(allErrs, fmt.Errorf("spec.match.sourceCidrs[%d]: invalid CIDR %q", i, cidr))
+			}
+		}
+	}
+	if window := match.TimeWindow; window != nil {
+		if window.NotBefore == nil && window.NotAfter == nil {
+			allErrs = append(allErrs, errors.New("spec.match.timeWindow: notBefore or notAfter must be set"))
+		}
+		if window.NotBefore != nil && window.NotAfter != nil && !window.NotBefore.Before(*window.NotAfter) {
+			allErrs = append(allErrs, errors.New("spec.match.timeWindow: notBefore must be before notAfter"))
+		}
+	}
+	return allErrs
+}
+
+func (s *NotificationSink) Validate() []error {
+	if s == nil {
+		return nil
+	}
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(s.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(s.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(s.Metadata.Annotations)...)
+
+	switch s.Spec.Type {
+	case NotificationSinkTypeWebhook, NotificationSinkTypeSlack, NotificationSinkTypeCloudEvents:
+		if s.Spec.Http == nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.http: must be set for sinks of type %s", s.Spec.Type))
+		} else {
+			allErrs = append(allErrs, validateNotificationSinkHttpTarget(*s.Spec.Http, "spec.http")...)
+		}
+		if s.Spec.Email != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.email: must not be set for sinks of type %s", s.Spec.Type))
+		}
+	case NotificationSinkTypeEmail:
+		if s.Spec.Email == nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.email: must be set for sinks of type %s", s.Spec.Type))
+		} else {
+			allErrs = append(allErrs, validateNotificationSinkEmailTarget(*s.Spec.Email, "spec.email")...)
+		}
+		if s.Spec.Http != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.http: must not be set for sinks of type %s", s.Spec.Type))
+		}
+	default:
+		allErrs = append(allErrs, fmt.Errorf("spec.type: unsupported notification sink type %q", s.Spec.Type))
+	}
+
+	if filter := s.Spec.Filter; filter != nil {
+		if filter.Reasons != nil && len(*filter.Reasons) == 0 {
+			allErrs = append(allErrs, errors.New("spec.filter.reasons: must not be empty"))
+		}
+		for i, reason := range lo.FromPtr(filter.Reasons) {
+			allErrs = append(allErrs, validation.ValidateString(lo.ToPtr(string(reason)), fmt.Sprintf("spec.filter.reasons[%d]", i), 1, 256, nil, "")...)
+		}
+		if filter.Kinds != nil && len(*filter.Kinds) == 0 {
+			allErrs = append(allErrs, errors.New("spec.filter.kinds: must not be empty"))
+		}
+		for i, kind := range lo.FromPtr(filter.Kinds) {
+			allErrs = append(allErrs, validation.ValidateString(&kind, fmt.Sprintf("spec.filter.kinds[%d]", i), 1, 256, nil, "")...)
+		}
+		if filter.LabelSelector != nil {
+			allErrs = append(allErrs, filter.LabelSelector.Validate()...)
+			allErrs = append(allErrs, validation.ValidateLabelsWithPath(filter.LabelSelector.MatchLabels, "spec.filter.labelSelector.matchLabels")...)
+		}
+	}
+	return allErrs
+}
+
+func validateNotificationSinkHttpTarget(target NotificationSinkHttpTarget, path string) []error {
+	allErrs := validation.ValidateString(&target.Url, path+".url", 1, 2048, nil, "")
+	if target.Url != "" {
+		u, err := url.Parse(target.Url)
+		switch {
+		case err != nil:
+			allErrs = append(allErrs, fmt.Errorf("%s.url: invalid URL: %w", path, err))
+		case u.Scheme != "http" && u.Scheme != "https":
+			allErrs = append(allErrs, fmt.Errorf("%s.url: scheme must be http or https: %s", path, target.Url))
+		case u.Host == "":
+			allErrs = append(allErrs, fmt.Errorf("%s.url: host is required: %s", path, target.Url))
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(lo.FromPtr(target.Headers))) {
+		allErrs = append(allErrs, validation.ValidateString(&key, path+".headers key", 1, 256, nil, "")...)
+	}
+	if target.Authorization != nil {
+		allErrs = append(allErrs, validateSecretKeyReference(*target.Authorization, path+".authorization")...)
+	}
+	return allErrs
+}
+
+func validateNotificationSinkEmailTarget(target NotificationSinkEmailTarget, path string) []error {
+	allErrs := []error{}
+	if net.ParseIP(target.Host) == nil {
+		allErrs = append(allErrs, validation.ValidateHostnameOrFQDN(&target.Host, path+".host")...)
+	}
+	if target.Port != nil && (*target.Port < privilegedPortRangeStart || *target.Port > portRangeEnd) {
+		allErrs = append(allErrs, fmt.Errorf("%s.port: must be between %d and %d", path, privilegedPortRangeStart, portRangeEnd))
+	}
+	if _, err := mail.ParseAddress(target.From); err != nil {
+		allErrs = append(allErrs, fmt.Errorf("%s.from: invalid address %q: %w", path, target.From, err))
+	}
+	if len(target.To) == 0 {
+		allErrs = append(allErrs, fmt.Errorf("%s.to: must not be empty", path))
+	}
+	for i, to := range target.To {
+		if _, err := mail.ParseAddress(to); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("%s.to[%d]: invalid address %q: %w", path, i, to, err))
+		}
+	}
+	if target.Username != nil {
+		allErrs = append(allErrs, validation.ValidateString(target.Username, path+".username", 1, 256, nil, "")...)
+	}
+	if target.Password != nil {
+		if target.Username == nil {
+			allErrs = append(allErrs, fmt.Errorf("%s.password cannot be specified without %s.username", path, path))
+		}
+		allErrs = append(allErrs, validateSecretKeyReference(*target.Password, path+".password")...)
+	}
+	return allErrs
+}
+
+func validateSecretKeyReference(ref SecretKeyReference, path string) []error {
+	allErrs := validation.ValidateResourceNameReference(&ref.Name, path+".name")
+	allErrs = append(allErrs, validation.ValidateString(&ref.Key, path+".key", 1, 253, secretKeyRegexp, "[-._a-zA-Z0-9]+")...)
+	return allErrs
+}
+
 func (r ResourceSync) Validate() []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
@@ -1006,6 +1458,8 @@ func validateApplications(apps []ApplicationProviderSpec, fleetTemplate bool) []
 		}
 	}
 
+	allErrs = append(allErrs, validateApplicationDependencies(apps)...)
+
 	return allErrs
 }
 
@@ -1028,6 +1482,10 @@ func validateContainerApplication(app ApplicationProviderSpec, appName string, f
 
 	allErrs = append(allErrs, validateEnvVars(container.EnvVars, pathPrefix)...)
 	allErrs = append(allErrs, validateApplicationVolumes(container.Volumes, appName, AppTypeContainer, fleetTemplate)...)
+	allErrs = append(allErrs, container.LivenessProbe.Validate(pathPrefix+".livenessProbe")...)
+	allErrs = append(allErrs, container.ReadinessProbe.Validate(pathPrefix+".readinessProbe")...)
+	allErrs = append(allErrs, container.RestartPolicy.Validate(pathPrefix+".restartPolicy")...)
+	allErrs = append(allErrs, container.Hooks.Validate(pathPrefix+".hooks")...)
 
 	return allErrs
 }
@@ -1091,6 +1549,7 @@ func validateComposeApplication(app ApplicationProviderSpec, appName string, fle
 
 	allErrs = append(allErrs, validateEnvVars(compose.EnvVars, pathPrefix)...)
 	allErrs = append(allErrs, validateApplicationVolumes(compose.Volumes, appName, AppTypeCompose, fleetTemplate)...)
+	allErrs = append(allErrs, compose.Hooks.Validate(pathPrefix+".hooks")...)
 
 	return allErrs
 }
@@ -1131,10 +1590,118 @@ func validateQuadletApplication(app ApplicationProviderSpec, appName string, fle
 
 	allErrs = append(allErrs, validateEnvVars(quadlet.EnvVars, pathPrefix)...)
 	allErrs = append(allErrs, validateApplicationVolumes(quadlet.Volumes, appName, AppTypeQuadlet, fleetTemplate)...)
+	allErrs = append(allErrs, quadlet.LivenessProbe.Validate(pathPrefix+".livenessProbe")...)
+	allErrs = append(allErrs, quadlet.ReadinessProbe.Validate(pathPrefix+".readinessProbe")...)
+	allErrs = append(allErrs, quadlet.RestartPolicy.Validate(pathPrefix+".restartPolicy")...)
+	allErrs = append(allErrs, quadlet.Hooks.Validate(pathPrefix+".hooks")...)
 
 	return allErrs
 }
 
+// Validate checks the actions of the lifecycle hooks of an application. Nil hooks are valid.
+func (h *ApplicationLifecycleHooks) Validate(path string) []error {
+	if h == nil {
+		return nil
+	}
+	allErrs := []error{}
+	hooks := []struct {
+		name    string
+		actions *[]HookAction
+	}{
+		{"preUpdate", h.PreUpdate},
+		{"preStop", h.PreStop},
+		{"postStart", h.PostStart},
+	}
+	for _, hook := range hooks {
+		for i, action := range lo.FromPtr(hook.actions) {
+			allErrs = append(allErrs, action.Validate(fmt.Sprintf("%s.%s[%d]", path, hook.name, i))...)
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the restart policy of an application. A nil policy is valid.
+func (p *ApplicationRestartPolicy) Validate(path string) []error {
+	if p == nil {
+		return nil
+	}
+	allErrs := []error{}
+	if p.Policy != nil {
+		switch *p.Policy {
+		case ApplicationRestartPolicyAlways, ApplicationRestartPolicyOnFailure, ApplicationRestartPolicyNever:
+		default:
+			allErrs = append(allErrs, fmt.Errorf("%s.policy: unsupported restart policy %q", path, *p.Policy))
+		}
+	}
+	if p.MaxRetries != nil && *p.MaxRetries < 0 {
+		allErrs = append(allErrs, fmt.Errorf("%s.maxRetries: must not be negative, got %d", path, *p.MaxRetries))
+	}
+	if p.RestartDelay != nil {
+		delay, err := time.ParseDuration(*p.RestartDelay)
+		switch {
+		case err != nil:
+			allErrs = append(allErrs, fmt.Errorf("%s.restartDelay: invalid duration %q: %w", path, *p.RestartDelay, err))
+		case delay <= 0:
+			allErrs = append(allErrs, fmt.Errorf("%s.restartDelay: must be positive, got %q", path, *p.RestartDelay))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks that a health probe of an application sets exactly one way of probing and valid timings.
+// A nil probe is valid.
+func (p *ApplicationProbe) Validate(path string) []error {
+	if p == nil {
+		return nil
+	}
+	allErrs := []error{}
+
+	set := 0
+	if p.Http != nil {
+		set++
+		if p.Http.Path != nil && !strings.HasPrefix(*p.Http.Path, "/") {
+			allErrs = append(allErrs, fmt.Errorf("%s.http.path: must start with \"/\", got %q", path, *p.Http.Path))
+		}
+	}
+	if p.Tcp != nil {
+		set++
+	}
+	if p.Exec != nil {
+		set++
+		if len(p.Exec.Command) == 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.exec.command: must not be empty", path))
+		}
+	}
+	if set != 1 {
+		allErrs = append(allErrs, fmt.Errorf("%s: exactly one of http, tcp and exec must be set", path))
+	}
+
+	for _, d := range []struct {
+		field     string
+		value     *string
+		allowZero bool
+	}{
+		{"initialDelay", p.InitialDelay, true},
+		{"period", p.Period, false},
+		{"timeout", p.Timeout, false},
+	} {
+		if d.value == nil {
+			continue
+		}
+		duration, err := time.ParseDuration(*d.value)
+		switch {
+		case err != nil:
+			allErrs = append(allErrs, fmt.Errorf("%s.%s: invalid duration %q: %w", path, d.field, *d.value, err))
+		case duration < 0, duration == 0 && !d.allowZero:
+			allErrs = append(allErrs, fmt.Errorf("%s.%s: must be positive, got %q", path, d.field, *d.value))
+		}
+	}
+	if p.FailureThreshold != nil && *p.FailureThreshold < 1 {
+		allErrs = append(allErrs, fmt.Errorf("%s.failureThreshold: must be at least 1, got %d", path, *p.FailureThreshold))
+	}
+	return allErrs
+}
+
 // validateKubeYamlDirectives checks that every .kube file in inlineSpec has its
 // Yaml= target present in the inline set and that the target is a valid Pod manifest.
 // Content is decoded from base64 when necessary so validation is consistent regardless
@@ -1688,30 +2255,60 @@ func validateImmutableCoreFields(currentName, newName *string, currentAPIVersion
 func validateResourceMonitor(resources []ResourceMonitor) []error {
 	var allErrs []error
 
-	// Validate no duplicate monitorTypes exist across resources
-	// Each monitorType (CPU, Disk, Memory) should only appear once in the resources array
-	seenMonitorTypes := make(map[string]struct{})
+	// Validate no duplicate monitors exist across resources
+	// Each of the CPU, Disk and Memory monitorTypes should only appear once in the resources array, while Network,
+	// Temperature and Process monitors may appear once per interface, zone and process name respectively, and Custom
+	// monitors may appear several times with different names
+	seenMonitors := make(map[string]struct{})
+	seenCustomNames := make(map[string]struct{})
 	for _, resource := range resources {
 		monitorType, err := resource.Discriminator()
-		if err == nil {
-			if _, exists := seenMonitorTypes[monitorType]; exists {
-				allErrs = append(allErrs, fmt.Errorf("%w: %s", ErrDuplicateMonitorType, monitorType))
+		if err != nil {
+			continue
+		}
+		key := monitorType
+		switch monitorType {
+		case "Custom":
+			spec, err := resource.AsCustomResourceMonitorSpec()
+			if err != nil {
+				continue
+			}
+			if _, exists := seenCustomNames[spec.Name]; exists {
+				allErrs = append(allErrs, fmt.Errorf("%w: %s", ErrDuplicateCustomMonitorName, spec.Name))
 			} else {
-				seenMonitorTypes[monitorType] = struct{}{}
+				seenCustomNames[spec.Name] = struct{}{}
+			}
+			continue
+		case "Network":
+			if spec, err := resource.AsNetworkResourceMonitorSpec(); err == nil {
+				key = fmt.Sprintf("%s %s", monitorType, spec.Interface)
+			}
+		case "Temperature":
+			if spec, err := resource.AsTemperatureResourceMonitorSpec(); err == nil {
+				key = fmt.Sprintf("%s %s", monitorType, spec.Zone)
 			}
+		case "Process":
+			if spec, err := resource.AsProcessResourceMonitorSpec(); err == nil {
+				key = fmt.Sprintf("%s %s", monitorType, spec.Name)
+			}
+		}
+		if _, exists := seenMonitors[key]; exists {
+			allErrs = append(allErrs, fmt.Errorf("%w: %s", ErrDuplicateMonitorType, key))
+		} else {
+			seenMonitors[key] = struct{}{}
 		}
 	}
 
 	return allErrs
 }
 
-// hasPathField checks if the raw JSON contains a "path" field
-func hasPathField(rawJSON []byte) bool {
+// hasField checks if the raw JSON contains the given field
+func hasField(rawJSON []byte, field string) bool {
 	var data map[string]interface{}
 	if err := json.Unmarshal(rawJSON, &data); err != nil {
 		return false
 	}
-	_, exists := data["path"]
+	_, exists := data[field]
 	return exists
 }
 
@@ -2,9 +2,18 @@ package v1beta1
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"math/big"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/api/common"
 	"github.com/flightctl/flightctl/internal/consts"
@@ -1580,6 +1589,96 @@ func TestResourceMonitorValidate_CPUPathField(t *testing.T) {
 	}
 }
 
+func TestResourceMonitorValidate_EdgeMonitors(t *testing.T) {
+	rules := []ResourceAlertRule{
+		{Severity: ResourceAlertSeverityTypeWarning, Percentage: 70, Duration: "5m"},
+		{Severity: ResourceAlertSeverityTypeCritical, Percentage: 90, Duration: "5m"},
+	}
+	network := func(iface string, metric NetworkMonitorMetric, linkSpeed *int) ResourceMonitor {
+		var monitor ResourceMonitor
+		require.NoError(t, monitor.FromNetworkResourceMonitorSpec(NetworkResourceMonitorSpec{
+			SamplingInterval: "30s", AlertRules: rules, Interface: iface, Metric: metric, LinkSpeed: linkSpeed,
+		}))
+		return monitor
+	}
+	temperature := func(zone string, maxTemperature *int) ResourceMonitor {
+		var monitor ResourceMonitor
+		require.NoError(t, monitor.FromTemperatureResourceMonitorSpec(TemperatureResourceMonitorSpec{
+			SamplingInterval: "30s", AlertRules: rules, Zone: zone, MaxTemperature: maxTemperature,
+		}))
+		return monitor
+	}
+	process := func(name string, metric ProcessMonitorMetric, percentage float32) ResourceMonitor {
+		var monitor ResourceMonitor
+		require.NoError(t, monitor.FromProcessResourceMonitorSpec(ProcessResourceMonitorSpec{
+			SamplingInterval: "30s",
+			AlertRules:       []ResourceAlertRule{{Severity: ResourceAlertSeverityTypeCritical, Percentage: percentage, Duration: "5m"}},
+			Name:             name,
+			Metric:           metric,
+		}))
+		return monitor
+	}
+	custom := func(name string, timeout *string, maxValue *float32) ResourceMonitor {
+		var monitor ResourceMonitor
+		require.NoError(t, monitor.FromCustomResourceMonitorSpec(CustomResourceMonitorSpec{
+			SamplingInterval: "30s", AlertRules: rules, Name: name, Timeout: timeout, MaxValue: maxValue,
+		}))
+		return monitor
+	}
+	customWith := func(field string) ResourceMonitor {
+		var monitor ResourceMonitor
+		raw := `{"monitorType":"Custom","samplingInterval":"30s","alertRules":[],"name":"ups-battery",` + field + `}`
+		require.NoError(t, monitor.UnmarshalJSON([]byte(raw)))
+		return monitor
+	}
+
+	tests := []struct {
+		name        string
+		monitor     ResourceMonitor
+		wantErr     error
+		errorString string
+	}{
+		{name: "valid network throughput monitor", monitor: network("eth0", NetworkMonitorMetricThroughput, lo.ToPtr(1000))},
+		{name: "valid network errors monitor", monitor: network("wlan0", NetworkMonitorMetricErrors, nil)},
+		{name: "network monitor without interface", monitor: network("", NetworkMonitorMetricErrors, nil), errorString: "network.interface"},
+		{name: "network monitor with invalid interface", monitor: network("../eth0", NetworkMonitorMetricErrors, nil), errorString: "network.interface"},
+		{name: "network monitor with unknown metric", monitor: network("eth0", "Latency", nil), errorString: "must be Throughput or Errors"},
+		{name: "network monitor with zero link speed", monitor: network("eth0", NetworkMonitorMetricThroughput, lo.ToPtr(0)), errorString: "linkSpeed"},
+		{name: "valid temperature monitor", monitor: temperature("thermal_zone0", nil)},
+		{name: "valid temperature monitor by type", monitor: temperature("x86_pkg_temp", lo.ToPtr(100))},
+		{name: "temperature monitor without zone", monitor: temperature("", nil), errorString: "temperature.zone"},
+		{name: "temperature monitor with negative max temperature", monitor: temperature("thermal_zone0", lo.ToPtr(-5)), errorString: "maxTemperature"},
+		{name: "valid process presence monitor", monitor: process("sshd", ProcessMonitorMetricPresence, 0)},
+		{name: "valid process memory monitor", monitor: process("postgres", ProcessMonitorMetricMemory, 100)},
+		{name: "process presence monitor that can never fire", monitor: process("sshd", ProcessMonitorMetricPresence, 100), wantErr: ErrProcessPresenceAlertPercentage},
+		{name: "process monitor with too long name", monitor: process("a-very-long-process-name", ProcessMonitorMetricMemory, 50), errorString: "process.name"},
+		{name: "process monitor with unknown metric", monitor: process("sshd", "Cpu", 50), errorString: "must be Presence or Memory"},
+		{name: "valid custom monitor", monitor: custom("ups-battery", lo.ToPtr("5s"), lo.ToPtr(float32(100)))},
+		{name: "custom monitor with invalid name", monitor: custom("UPS Battery", nil, nil), errorString: "custom.name"},
+		{name: "custom monitor with path as name", monitor: custom("../../bin/sh", nil, nil), errorString: "custom.name"},
+		{name: "custom monitor with command", monitor: customWith(`"command":"/bin/sh"`), wantErr: ErrInvalidCustomMonitorField},
+		{name: "custom monitor with args", monitor: customWith(`"args":["-c","id"]`), wantErr: ErrInvalidCustomMonitorField},
+		{name: "custom monitor with timeout above sampling interval", monitor: custom("ups-battery", lo.ToPtr("30s"), nil), errorString: "must be less than the sampling interval"},
+		{name: "custom monitor with zero max value", monitor: custom("ups-battery", nil, lo.ToPtr(float32(0))), errorString: "maxValue"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.monitor.Validate()
+			switch {
+			case tt.wantErr != nil:
+				require.Len(t, errs, 1)
+				require.ErrorIs(t, errs[0], tt.wantErr)
+			case tt.errorString != "":
+				require.NotEmpty(t, errs)
+				require.Contains(t, errs[0].Error(), tt.errorString)
+			default:
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
 func TestDeviceSpecValidate_ResourceMonitors(t *testing.T) {
 	require := require.New(t)
 	tests := []struct {
@@ -1611,6 +1710,33 @@ func TestDeviceSpecValidate_ResourceMonitors(t *testing.T) {
 			},
 			errorStrings: []string{"CPU monitors cannot have a path field", "CPU monitors cannot have a path field", "duplicate monitorType in resources: CPU"},
 		},
+		{
+			name:      "custom monitors with different names",
+			resources: &[]ResourceMonitor{createCustomMonitor(t, "ups-battery"), createCustomMonitor(t, "modem-signal")},
+		},
+		{
+			name:         "custom monitors with the same name",
+			resources:    &[]ResourceMonitor{createCustomMonitor(t, "ups-battery"), createCustomMonitor(t, "ups-battery")},
+			errorStrings: []string{"duplicate custom monitor name in resources: ups-battery"},
+		},
+		{
+			name:      "network monitors of different interfaces",
+			resources: &[]ResourceMonitor{createNetworkMonitor(t, "eth0"), createNetworkMonitor(t, "wlan0")},
+		},
+		{
+			name:         "network monitors of the same interface",
+			resources:    &[]ResourceMonitor{createNetworkMonitor(t, "eth0"), createNetworkMonitor(t, "eth0")},
+			errorStrings: []string{"duplicate monitorType in resources: Network eth0"},
+		},
+		{
+			name:      "temperature monitors of different zones",
+			resources: &[]ResourceMonitor{createTemperatureMonitor(t, "thermal_zone0"), createTemperatureMonitor(t, "thermal_zone1")},
+		},
+		{
+			name:         "temperature monitors of the same zone",
+			resources:    &[]ResourceMonitor{createTemperatureMonitor(t, "thermal_zone0"), createTemperatureMonitor(t, "thermal_zone0")},
+			errorStrings: []string{"duplicate monitorType in resources: Temperature thermal_zone0"},
+		},
 		{
 			name:      "nil resources",
 			resources: nil,
@@ -1644,6 +1770,49 @@ func TestDeviceSpecValidate_ResourceMonitors(t *testing.T) {
 
 // Helper functions to create test ResourceMonitor instances
 
+func createCustomMonitor(t *testing.T, name string) ResourceMonitor {
+	var monitor ResourceMonitor
+	err := monitor.FromCustomResourceMonitorSpec(CustomResourceMonitorSpec{
+		MonitorType:      "Custom",
+		SamplingInterval: "1m",
+		Name:             name,
+		AlertRules: []ResourceAlertRule{
+			{Severity: ResourceAlertSeverityTypeWarning, Percentage: 80, Duration: "5m"},
+		},
+	})
+	require.NoError(t, err)
+	return monitor
+}
+
+func createNetworkMonitor(t *testing.T, iface string) ResourceMonitor {
+	var monitor ResourceMonitor
+	err := monitor.FromNetworkResourceMonitorSpec(NetworkResourceMonitorSpec{
+		MonitorType:      "Network",
+		SamplingInterval: "1m",
+		Interface:        iface,
+		Metric:           NetworkMonitorMetricErrors,
+		AlertRules: []ResourceAlertRule{
+			{Severity: ResourceAlertSeverityTypeWarning, Percentage: 80, Duration: "5m"},
+		},
+	})
+	require.NoError(t, err)
+	return monitor
+}
+
+func createTemperatureMonitor(t *testing.T, zone string) ResourceMonitor {
+	var monitor ResourceMonitor
+	err := monitor.FromTemperatureResourceMonitorSpec(TemperatureResourceMonitorSpec{
+		MonitorType:      "Temperature",
+		SamplingInterval: "1m",
+		Zone:             zone,
+		AlertRules: []ResourceAlertRule{
+			{Severity: ResourceAlertSeverityTypeWarning, Percentage: 80, Duration: "5m"},
+		},
+	})
+	require.NoError(t, err)
+	return monitor
+}
+
 func createCPUMonitor(t *testing.T) ResourceMonitor {
 	var monitor ResourceMonitor
 	err := monitor.FromCpuResourceMonitorSpec(CpuResourceMonitorSpec{
@@ -2928,3 +3097,663 @@ func TestDisruptionBudgetValidateGroupBy(t *testing.T) {
 		})
 	}
 }
+
+func TestRolloutPolicyValidateOnFailure(t *testing.T) {
+	require := require.New(t)
+	minAvail := 1
+
+	var deviceSelection RolloutDeviceSelection
+	require.NoError(deviceSelection.FromBatchSequence(BatchSequence{Strategy: RolloutStrategyBatchSequence}))
+
+	tests := []struct {
+		name    string
+		policy  RolloutPolicy
+		wantErr bool
+	}{
+		{"unset", RolloutPolicy{DeviceSelection: &deviceSelection}, false},
+		{"pause", RolloutPolicy{DeviceSelection: &deviceSelection, OnFailure: lo.ToPtr(RolloutFailurePolicyPause)}, false},
+		{"rollback", RolloutPolicy{DeviceSelection: &deviceSelection, OnFailure: lo.ToPtr(RolloutFailurePolicyRollback)}, false},
+		{"rollback without device selection", RolloutPolicy{DisruptionBudget: &DisruptionBudget{MinAvailable: &minAvail}, OnFailure: lo.ToPtr(RolloutFailurePolicyRollback)}, true},
+		{"unsupported policy", RolloutPolicy{DeviceSelection: &deviceSelection, OnFailure: lo.ToPtr(RolloutFailurePolicy("Retry"))}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.policy.Validate()
+			if tt.wantErr {
+				require.NotEmpty(errs)
+			} else {
+				require.Empty(errs)
+			}
+		})
+	}
+}
+
+func TestRolloutPolicyValidateMaintenanceWindows(t *testing.T) {
+	require := require.New(t)
+	minAvail := 1
+
+	var deviceSelection RolloutDeviceSelection
+	require.NoError(deviceSelection.FromBatchSequence(BatchSequence{Strategy: RolloutStrategyBatchSequence}))
+	nightly := UpdateSchedule{At: "0 2 * * *", StartGraceDuration: "2h", TimeZone: lo.ToPtr("UTC")}
+
+	tests := []struct {
+		name    string
+		policy  RolloutPolicy
+		wantErr bool
+	}{
+		{"valid", RolloutPolicy{DeviceSelection: &deviceSelection, MaintenanceWindows: &[]UpdateSchedule{nightly}}, false},
+		{"without device selection", RolloutPolicy{DisruptionBudget: &DisruptionBudget{MinAvailable: &minAvail}, MaintenanceWindows: &[]UpdateSchedule{nightly}}, true},
+		{"invalid cron expression", RolloutPolicy{DeviceSelection: &deviceSelection, MaintenanceWindows: &[]UpdateSchedule{{At: "0 2 * *", StartGraceDuration: "2h"}}}, true},
+		{"invalid time zone", RolloutPolicy{DeviceSelection: &deviceSelection, MaintenanceWindows: &[]UpdateSchedule{{At: "0 2 * * *", StartGraceDuration: "2h", TimeZone: lo.ToPtr("Mars/Olympus")}}}, true},
+		{"zero duration", RolloutPolicy{DeviceSelection: &deviceSelection, MaintenanceWindows: &[]UpdateSchedule{{At: "0 2 * * *", StartGraceDuration: "0s"}}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.policy.Validate()
+			if tt.wantErr {
+				require.NotEmpty(errs)
+			} else {
+				require.Empty(errs)
+			}
+		})
+	}
+}
+
+func TestCanaryValidate(t *testing.T) {
+	selector := LabelSelector{MatchLabels: &map[string]string{"stage": "canary"}}
+
+	tests := []struct {
+		name    string
+		canary  Canary
+		wantErr string
+	}{
+		{"valid", Canary{Strategy: RolloutStrategyCanary, Selector: selector, SoakDuration: "30m"}, ""},
+		{"valid with success threshold", Canary{Strategy: RolloutStrategyCanary, Selector: selector, SoakDuration: "1h", SuccessThreshold: lo.ToPtr("100%")}, ""},
+		{"empty selector", Canary{Strategy: RolloutStrategyCanary, SoakDuration: "30m"}, "must not be empty"},
+		{"invalid soak duration", Canary{Strategy: RolloutStrategyCanary, Selector: selector, SoakDuration: "soon"}, "invalid canary soak duration"},
+		{"zero soak duration", Canary{Strategy: RolloutStrategyCanary, Selector: selector, SoakDuration: "0s"}, "must be positive"},
+		{"invalid success threshold", Canary{Strategy: RolloutStrategyCanary, Selector: selector, SoakDuration: "30m", SuccessThreshold: lo.ToPtr("120%")}, "canary success threshold"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deviceSelection RolloutDeviceSelection
+			require.NoError(t, deviceSelection.FromCanary(tt.canary))
+			errs := deviceSelection.Validate()
+			if tt.wantErr == "" {
+				require.Empty(t, errs)
+				return
+			}
+			require.ErrorContains(t, errors.Join(errs...), tt.wantErr)
+		})
+	}
+}
+
+func TestProgressiveValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		progressive Progressive
+		wantErr     string
+	}{
+		{"valid", Progressive{Strategy: RolloutStrategyProgressive, Steps: []Percentage{"1%", "10%", "50%", "100%"}, StepInterval: "4h"}, ""},
+		{"valid with success threshold", Progressive{Strategy: RolloutStrategyProgressive, Steps: []Percentage{"50%"}, StepInterval: "30m", SuccessThreshold: lo.ToPtr("90%")}, ""},
+		{"no steps", Progressive{Strategy: RolloutStrategyProgressive, StepInterval: "4h"}, "at least one step"},
+		{"invalid step", Progressive{Strategy: RolloutStrategyProgressive, Steps: []Percentage{"10%", "150%"}, StepInterval: "4h"}, "progressive step 2"},
+		{"decreasing steps", Progressive{Strategy: RolloutStrategyProgressive, Steps: []Percentage{"10%", "10%"}, StepInterval: "4h"}, "must be greater than the share of the previous step"},
+		{"zero step", Progressive{Strategy: RolloutStrategyProgressive, Steps: []Percentage{"0%"}, StepInterval: "4h"}, "must be greater than the share of the previous step"},
+		{"invalid step interval", Progressive{Strategy: RolloutStrategyProgressive, Steps: []Percentage{"10%"}, StepInterval: "daily"}, "invalid progressive step interval"},
+		{"invalid success threshold", Progressive{Strategy: RolloutStrategyProgressive, Steps: []Percentage{"10%"}, StepInterval: "4h", SuccessThreshold: lo.ToPtr("high")}, "progressive success threshold"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deviceSelection RolloutDeviceSelection
+			require.NoError(t, deviceSelection.FromProgressive(tt.progressive))
+			errs := deviceSelection.Validate()
+			if tt.wantErr == "" {
+				require.Empty(t, errs)
+				return
+			}
+			require.ErrorContains(t, errors.Join(errs...), tt.wantErr)
+		})
+	}
+}
+
+func TestApplicationProbeValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		probe   *ApplicationProbe
+		wantErr string
+	}{
+		{"nil", nil, ""},
+		{"http", &ApplicationProbe{Http: &ApplicationHttpProbe{Port: 8080, Path: lo.ToPtr("/healthz")}, Period: lo.ToPtr("5s"), FailureThreshold: lo.ToPtr(2)}, ""},
+		{"tcp", &ApplicationProbe{Tcp: &ApplicationTcpProbe{Port: 5432}, InitialDelay: lo.ToPtr("0s")}, ""},
+		{"exec", &ApplicationProbe{Exec: &ApplicationExecProbe{Command: []string{"pg_isready"}}, Timeout: lo.ToPtr("3s")}, ""},
+		{"no action", &ApplicationProbe{Period: lo.ToPtr("5s")}, "exactly one of http, tcp and exec must be set"},
+		{"several actions", &ApplicationProbe{Http: &ApplicationHttpProbe{Port: 8080}, Tcp: &ApplicationTcpProbe{Port: 8080}}, "exactly one of http, tcp and exec must be set"},
+		{"relative http path", &ApplicationProbe{Http: &ApplicationHttpProbe{Port: 8080, Path: lo.ToPtr("healthz")}}, "must start with"},
+		{"empty command", &ApplicationProbe{Exec: &ApplicationExecProbe{}}, "exec.command: must not be empty"},
+		{"invalid period", &ApplicationProbe{Tcp: &ApplicationTcpProbe{Port: 5432}, Period: lo.ToPtr("often")}, "probe.period: invalid duration"},
+		{"zero timeout", &ApplicationProbe{Tcp: &ApplicationTcpProbe{Port: 5432}, Timeout: lo.ToPtr("0s")}, "probe.timeout: must be positive"},
+		{"zero failure threshold", &ApplicationProbe{Tcp: &ApplicationTcpProbe{Port: 5432}, FailureThreshold: lo.ToPtr(0)}, "failureThreshold: must be at least 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.probe.Validate("spec.applications[app].probe")
+			if tt.wantErr == "" {
+				require.Empty(t, errs)
+				return
+			}
+			require.ErrorContains(t, errors.Join(errs...), tt.wantErr)
+		})
+	}
+}
+
+func TestApplicationRestartPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *ApplicationRestartPolicy
+		wantErr string
+	}{
+		{"nil", nil, ""},
+		{"valid", &ApplicationRestartPolicy{Policy: lo.ToPtr(ApplicationRestartPolicyAlways), MaxRetries: lo.ToPtr(0), RestartDelay: lo.ToPtr("10s")}, ""},
+		{"unsupported policy", &ApplicationRestartPolicy{Policy: lo.ToPtr(ApplicationRestartPolicyType("Sometimes"))}, "policy: unsupported restart policy"},
+		{"negative max retries", &ApplicationRestartPolicy{MaxRetries: lo.ToPtr(-1)}, "maxRetries: must not be negative"},
+		{"invalid delay", &ApplicationRestartPolicy{RestartDelay: lo.ToPtr("soon")}, "restartDelay: invalid duration"},
+		{"zero delay", &ApplicationRestartPolicy{RestartDelay: lo.ToPtr("0s")}, "restartDelay: must be positive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.policy.Validate("spec.applications[app].restartPolicy")
+			if tt.wantErr == "" {
+				require.Empty(t, errs)
+				return
+			}
+			require.ErrorContains(t, errors.Join(errs...), tt.wantErr)
+		})
+	}
+}
+
+func TestHookActionValidate_Http(t *testing.T) {
+	httpAction := func(spec HookActionHttpSpec) HookAction {
+		var action HookAction
+		require.NoError(t, action.FromHookActionHttp(HookActionHttp{Http: spec}))
+		return action
+	}
+
+	tests := []struct {
+		name        string
+		action      HookAction
+		errorString string
+	}{
+		{
+			name: "valid http action",
+			action: httpAction(HookActionHttpSpec{
+				Url:           "http://localhost:8080/plc/${ Hook }",
+				Method:        lo.ToPtr(HookActionHttpMethodPut),
+				Headers:       &map[string]string{"Content-Type": "application/json"},
+				Body:          lo.ToPtr(`{"event":"${ Hook }"}`),
+				Retries:       lo.ToPtr(3),
+				RetryInterval: lo.ToPtr("5s"),
+			}),
+		},
+		{name: "http action without url", action: httpAction(HookActionHttpSpec{}), errorString: "http.url"},
+		{name: "http action with unsupported scheme", action: httpAction(HookActionHttpSpec{Url: "ftp://gateway/plc"}), errorString: "scheme must be http or https"},
+		{name: "http action without host", action: httpAction(HookActionHttpSpec{Url: "http:///plc"}), errorString: "host is required"},
+		{name: "http action with unsupported method", action: httpAction(HookActionHttpSpec{Url: "http://gateway", Method: lo.ToPtr(HookActionHttpMethod("HEAD"))}), errorString: "unsupported HTTP method"},
+		{name: "http action with too many retries", action: httpAction(HookActionHttpSpec{Url: "http://gateway", Retries: lo.ToPtr(11)}), errorString: "http.retries"},
+		{name: "http action with invalid retry interval", action: httpAction(HookActionHttpSpec{Url: "http://gateway", RetryInterval: lo.ToPtr("soon")}), errorString: "http.retryInterval"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.action.Validate("action")
+			if tt.errorString == "" {
+				require.Empty(t, errs)
+				return
+			}
+			require.NotEmpty(t, errs)
+			require.Contains(t, errs[0].Error(), tt.errorString)
+		})
+	}
+}
+
+func TestApplicationLifecycleHooksValidate(t *testing.T) {
+	runAction := func(command string) HookAction {
+		var action HookAction
+		require.NoError(t, action.FromHookActionRun(HookActionRun{Run: command}))
+		return action
+	}
+
+	var nilHooks *ApplicationLifecycleHooks
+	require.Empty(t, nilHooks.Validate("spec.applications[0].hooks"))
+
+	valid := &ApplicationLifecycleHooks{
+		PreUpdate: &[]HookAction{runAction("/usr/bin/pg_dump -f /var/backups/db.sql")},
+		PreStop:   &[]HookAction{runAction("/usr/local/bin/drain ${ Application }")},
+	}
+	require.Empty(t, valid.Validate("spec.applications[0].hooks"))
+
+	invalid := &ApplicationLifecycleHooks{
+		PostStart: &[]HookAction{runAction("/usr/local/bin/warmup"), runAction("")},
+	}
+	errs := invalid.Validate("spec.applications[0].hooks")
+	require.NotEmpty(t, errs)
+	require.Contains(t, errs[0].Error(), "spec.applications[0].hooks.postStart[1]")
+}
+
+func TestValidateApplicationDependencies(t *testing.T) {
+	require := require.New(t)
+	containerApp := func(name string, dependsOn ...string) ApplicationProviderSpec {
+		var app ApplicationProviderSpec
+		container := ContainerApplication{
+			Name:    lo.ToPtr(name),
+			AppType: AppTypeContainer,
+			Image:   "quay.io/flightctl/" + name + ":v1",
+		}
+		if len(dependsOn) > 0 {
+			container.DependsOn = &dependsOn
+		}
+		require.NoError(app.FromContainerApplication(container))
+		return app
+	}
+	helmApp := func(name string) ApplicationProviderSpec {
+		var app ApplicationProviderSpec
+		require.NoError(app.FromHelmApplication(HelmApplication{
+			Name:    lo.ToPtr(name),
+			AppType: AppTypeHelm,
+			Image:   "quay.io/flightctl/" + name + ":v1",
+		}))
+		return app
+	}
+
+	tests := []struct {
+		name     string
+		apps     []ApplicationProviderSpec
+		wantErrs []string
+	}{
+		{
+			name: "valid dependencies",
+			apps: []ApplicationProviderSpec{containerApp("api", "db", "cache"), containerApp("db"), containerApp("cache", "db")},
+		},
+		{
+			name:     "unknown application",
+			apps:     []ApplicationProviderSpec{containerApp("api", "db")},
+			wantErrs: []string{`spec.applications[api].dependsOn: unknown application "db"`},
+		},
+		{
+			name:     "self dependency",
+			apps:     []ApplicationProviderSpec{containerApp("api", "api")},
+			wantErrs: []string{"spec.applications[api].dependsOn: application cannot depend on itself"},
+		},
+		{
+			name:     "duplicate dependency",
+			apps:     []ApplicationProviderSpec{containerApp("api", "db", "db"), containerApp("db")},
+			wantErrs: []string{`spec.applications[api].dependsOn: duplicate application "db"`},
+		},
+		{
+			name:     "dependency on a helm application",
+			apps:     []ApplicationProviderSpec{containerApp("api", "db"), helmApp("db")},
+			wantErrs: []string{`application "db" must be a compose, quadlet or container application`},
+		},
+		{
+			name:     "dependency cycle",
+			apps:     []ApplicationProviderSpec{containerApp("api", "db"), containerApp("db", "cache"), containerApp("cache", "api")},
+			wantErrs: []string{"spec.applications: dependency cycle: api -> db -> cache -> api"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateApplicationDependencies(tt.apps)
+			require.Len(errs, len(tt.wantErrs), "%v", errs)
+			for i, want := range tt.wantErrs {
+				require.Contains(errs[i].Error(), want)
+			}
+		})
+	}
+}
+
+func TestValidateSecret(t *testing.T) {
+	require := require.New(t)
+
+	secret := func(data map[string]string) *Secret {
+		return &Secret{
+			Metadata: ObjectMeta{Name: lo.ToPtr("db-creds")},
+			Spec:     SecretSpec{Data: data},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		secret   *Secret
+		wantErrs []string
+	}{
+		{
+			name:   "valid secret",
+			secret: secret(map[string]string{"password": "hunter2", "tls.crt": "cert", "api_key-1": "key"}),
+		},
+		{
+			name:   "empty data",
+			secret: secret(nil),
+		},
+		{
+			name:     "key with path separator",
+			secret:   secret(map[string]string{"../password": "hunter2"}),
+			wantErrs: []string{`Invalid value: "../password"`},
+		},
+		{
+			name:     "dot key",
+			secret:   secret(map[string]string{"..": "hunter2"}),
+			wantErrs: []string{`spec.data[..]: key must not be ".."`},
+		},
+		{
+			name:     "data too large",
+			secret:   secret(map[string]string{"blob": strings.Repeat("a", 1024*1024)}),
+			wantErrs: []string{"spec.data: total size must not exceed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.secret.Validate()
+			require.Len(errs, len(tt.wantErrs), "%v", errs)
+			for i, want := range tt.wantErrs {
+				require.Contains(errs[i].Error(), want)
+				require.NotContains(errs[i].Error(), "hunter2")
+			}
+		})
+	}
+}
+
+func TestValidateSecretProviderSpec(t *testing.T) {
+	require := require.New(t)
+
+	provider := func(name, mountPath string) SecretProviderSpec {
+		spec := SecretProviderSpec{Name: "creds"}
+		spec.Secret.Name = name
+		spec.Secret.MountPath = mountPath
+		return spec
+	}
+
+	tests := []struct {
+		name          string
+		spec          SecretProviderSpec
+		fleetTemplate bool
+		wantErr       bool
+	}{
+		{name: "valid", spec: provider("db-creds", "/etc/db")},
+		{name: "invalid secret name", spec: provider("Db_Creds", "/etc/db"), wantErr: true},
+		{name: "relative mount path", spec: provider("db-creds", "etc/db"), wantErr: true},
+		{name: "parameterized name in fleet template", spec: provider("{{ .metadata.name }}-creds", "/etc/db"), fleetTemplate: true},
+		{name: "parameterized name in device", spec: provider("{{ .metadata.name }}-creds", "/etc/db"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.spec.Validate(tt.fleetTemplate)
+			if tt.wantErr {
+				require.NotEmpty(errs)
+			} else {
+				require.Empty(errs, "%v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateInlineConfigProviderSpecEncryptedContent(t *testing.T) {
+	require := require.New(t)
+
+	spec := InlineConfigProviderSpec{
+		Name: "inline",
+		Inline: []FileSpec{
+			{Path: "/etc/app/password", Content: "AQID", ContentEncoding: lo.ToPtr(EncodingEncrypted)},
+		},
+	}
+
+	errs := spec.Validate(false)
+	require.Len(errs, 1)
+	require.Contains(errs[0].Error(), "reserved for rendered device specs")
+
+	_, err := spec.Inline[0].ContentsDecoded()
+	require.Error(err)
+}
+
+func TestValidateEnrollmentPolicy(t *testing.T) {
+	require := require.New(t)
+
+	policy := func(match EnrollmentPolicyMatch) *EnrollmentPolicy {
+		return &EnrollmentPolicy{
+			Metadata: ObjectMeta{Name: lo.ToPtr("factory-line-1")},
+			Spec: EnrollmentPolicySpec{
+				Match:  match,
+				Labels: &map[string]string{"site": "factory-1"},
+				Fleet:  lo.ToPtr("factory-devices"),
+			},
+		}
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		policy   *EnrollmentPolicy
+		wantErrs []string
+	}{
+		{
+			name: "valid policy",
+			policy: policy(EnrollmentPolicyMatch{
+				TpmVerified:   lo.ToPtr(true),
+				Labels:        &map[string]string{"line": "1"},
+				SerialNumbers: &[]string{"SN-0001"},
+				SourceCidrs:   &[]string{"10.10.0.0/16", "fd00::/8"},
+				TimeWindow:    &EnrollmentPolicyTimeWindow{NotBefore: lo.ToPtr(now), NotAfter: lo.ToPtr(now.Add(time.Hour))},
+			}),
+		},
+		{
+			name:     "no rules",
+			policy:   policy(EnrollmentPolicyMatch{}),
+			wantErrs: []string{"spec.match: at least one rule must be set"},
+		},
+		{
+			name:     "only tpmVerified false",
+			policy:   policy(EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(false)}),
+			wantErrs: []string{"spec.match: tpmVerified must be true if it is the only rule"},
+		},
+		{
+			name:     "invalid CIDR",
+			policy:   policy(EnrollmentPolicyMatch{SourceCidrs: &[]string{"10.10.0.0"}}),
+			wantErrs: []string{`spec.match.sourceCidrs[0]: invalid CIDR "10.10.0.0"`},
+		},
+		{
+			name:     "empty serial numbers",
+			policy:   policy(EnrollmentPolicyMatch{SerialNumbers: &[]string{}}),
+			wantErrs: []string{"spec.match.serialNumbers: must not be empty"},
+		},
+		{
+			name:     "inverted time window",
+			policy:   policy(EnrollmentPolicyMatch{TimeWindow: &EnrollmentPolicyTimeWindow{NotBefore: lo.ToPtr(now), NotAfter: lo.ToPtr(now.Add(-time.Hour))}}),
+			wantErrs: []string{"spec.match.timeWindow: notBefore must be before notAfter"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.policy.Validate()
+			require.Len(errs, len(tt.wantErrs), "%v", errs)
+			for i, want := range tt.wantErrs {
+				require.Contains(errs[i].Error(), want)
+			}
+		})
+	}
+
+	invalidFleet := policy(EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(true)})
+	invalidFleet.Spec.Fleet = lo.ToPtr("Factory_Devices")
+	require.NotEmpty(invalidFleet.Validate())
+}
+
+func TestValidateNotificationSink(t *testing.T) {
+	require := require.New(t)
+
+	sink := func(spec NotificationSinkSpec) *NotificationSink {
+		return &NotificationSink{
+			Metadata: ObjectMeta{Name: lo.ToPtr("ops")},
+			Spec:     spec,
+		}
+	}
+	httpTarget := &NotificationSinkHttpTarget{
+		Url:           "https://hooks.example.com/flightctl",
+		Headers:       &map[string]string{"X-Team": "ops"},
+		Authorization: &SecretKeyReference{Name: "webhook-credentials", Key: "authorization"},
+	}
+	emailTarget := &NotificationSinkEmailTarget{
+		Host:     "smtp.example.com",
+		Port:     lo.ToPtr(587),
+		From:     "flightctl@example.com",
+		To:       []string{"Ops <ops@example.com>"},
+		Username: lo.ToPtr("flightctl"),
+		Password: &SecretKeyReference{Name: "smtp-credentials", Key: "password"},
+	}
+
+	tests := []struct {
+		name     string
+		sink     *NotificationSink
+		wantErrs []string
+	}{
+		{
+			name: "valid webhook sink with filter",
+			sink: sink(NotificationSinkSpec{
+				Type: NotificationSinkTypeWebhook,
+				Http: httpTarget,
+				Filter: &NotificationSinkFilter{
+					Reasons:       &[]EventReason{EventReasonDeviceDisconnected},
+					Kinds:         &[]string{DeviceKind},
+					LabelSelector: &LabelSelector{MatchLabels: &map[string]string{"site": "factory-1"}},
+				},
+			}),
+		},
+		{
+			name: "valid email sink",
+			sink: sink(NotificationSinkSpec{Type: NotificationSinkTypeEmail, Email: emailTarget}),
+		},
+		{
+			name:     "unsupported type",
+			sink:     sink(NotificationSinkSpec{Type: "Pager"}),
+			wantErrs: []string{`spec.type: unsupported notification sink type "Pager"`},
+		},
+		{
+			name:     "slack sink without http target",
+			sink:     sink(NotificationSinkSpec{Type: NotificationSinkTypeSlack, Email: emailTarget}),
+			wantErrs: []string{"spec.http: must be set for sinks of type Slack", "spec.email: must not be set for sinks of type Slack"},
+		},
+		{
+			name:     "cloudevents sink with invalid URL scheme",
+			sink:     sink(NotificationSinkSpec{Type: NotificationSinkTypeCloudEvents, Http: &NotificationSinkHttpTarget{Url: "ftp://events.example.com"}}),
+			wantErrs: []string{"spec.http.url: scheme must be http or https"},
+		},
+		{
+			name: "email sink with invalid addresses",
+			sink: sink(NotificationSinkSpec{Type: NotificationSinkTypeEmail, Email: &NotificationSinkEmailTarget{
+				Host: "smtp.example.com",
+				From: "flightctl",
+				To:   []string{},
+			}}),
+			wantErrs: []string{`spec.email.from: invalid address "flightctl"`, "spec.email.to: must not be empty"},
+		},
+		{
+			name: "email sink with password but no username",
+			sink: sink(NotificationSinkSpec{Type: NotificationSinkTypeEmail, Email: &NotificationSinkEmailTarget{
+				Host:     "10.0.0.25",
+				From:     "flightctl@example.com",
+				To:       []string{"ops@example.com"},
+				Password: &SecretKeyReference{Name: "smtp-credentials", Key: "password"},
+			}}),
+			wantErrs: []string{"spec.email.password cannot be specified without spec.email.username"},
+		},
+		{
+			name: "empty filter lists and selector",
+			sink: sink(NotificationSinkSpec{
+				Type:   NotificationSinkTypeWebhook,
+				Http:   httpTarget,
+				Filter: &NotificationSinkFilter{Reasons: &[]EventReason{}, Kinds: &[]string{}, LabelSelector: &LabelSelector{}},
+			}),
+			wantErrs: []string{"spec.filter.reasons: must not be empty", "spec.filter.kinds: must not be empty", "at least one of [matchLabels,matchExpressions]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.sink.Validate()
+			require.Len(errs, len(tt.wantErrs), "%v", errs)
+			for i, want := range tt.wantErrs {
+				require.Contains(errs[i].Error(), want)
+			}
+		})
+	}
+}
+
+func TestValidateDeviceRegistration(t *testing.T) {
+	require := require.New(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	template := &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "ek"}, NotAfter: time.Now().Add(time.Hour)}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(err)
+	ekCertificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	registration := func(spec DeviceRegistrationSpec) *DeviceRegistration {
+		return &DeviceRegistration{
+			Metadata: ObjectMeta{Name: lo.ToPtr("sn-0001")},
+			Spec:     spec,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		registration *DeviceRegistration
+		wantErrs     []string
+	}{
+		{
+			name: "valid registration",
+			registration: registration(DeviceRegistrationSpec{
+				TpmEkCertificate: &ekCertificate,
+				SystemInfo:       &map[string]string{"productSerial": "SN-0001"},
+				Labels:           &map[string]string{"site": "factory-1"},
+			}),
+		},
+		{
+			name:         "no identity",
+			registration: registration(DeviceRegistrationSpec{Labels: &map[string]string{"site": "factory-1"}}),
+			wantErrs:     []string{"spec: tpmEkCertificate or systemInfo must be set"},
+		},
+		{
+			name:         "empty system info",
+			registration: registration(DeviceRegistrationSpec{SystemInfo: &map[string]string{}}),
+			wantErrs:     []string{"spec: tpmEkCertificate or systemInfo must be set"},
+		},
+		{
+			name:         "invalid EK certificate",
+			registration: registration(DeviceRegistrationSpec{TpmEkCertificate: lo.ToPtr("not a certificate")}),
+			wantErrs:     []string{"spec.tpmEkCertificate: must be a PEM-encoded certificate"},
+		},
+		{
+			name:         "empty system info value",
+			registration: registration(DeviceRegistrationSpec{SystemInfo: &map[string]string{"productSerial": ""}}),
+			wantErrs:     []string{"spec.systemInfo[productSerial]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.registration.Validate()
+			require.Len(errs, len(tt.wantErrs), "%v", errs)
+			for i, want := range tt.wantErrs {
+				require.Contains(errs[i].Error(), want)
+			}
+		})
+	}
+}
@@ -210,7 +210,7 @@ func main() {
 	defer orgCache.Stop()
 
 	// Create service handler for auth provider access
-	baseServiceHandler := service.NewServiceHandler(dataStore, nil, nil, nil, logger, "", "", nil, false)
+	baseServiceHandler := service.NewServiceHandler(dataStore, nil, nil, nil, logger, "", "", nil, false, false)
 	serviceHandler := service.WrapWithTracing(baseServiceHandler)
 
 	// Initialize auth system
@@ -23,6 +23,7 @@ import (
 	"github.com/flightctl/flightctl/internal/rendered"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/watch"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/flightctl/flightctl/pkg/queues"
 	"github.com/google/uuid"
@@ -67,7 +68,11 @@ func main() {
 		log.Fatalf("initializing data store: %v", err)
 	}
 
-	store := store.NewStore(db, log.WithField("pkg", "store"))
+	storeOpts, err := store.SecretOptionsFromConfig(cfg)
+	if err != nil {
+		log.Fatalf("initializing secret encryption: %v", err)
+	}
+	store := store.NewStore(db, log.WithField("pkg", "store"), storeOpts...)
 	defer store.Close()
 
 	tlsConfig, agentTlsConfig, err := crypto.TLSConfigForServer(ca.GetCABundleX509(), serverCerts)
@@ -93,6 +98,12 @@ func main() {
 	if err = rendered.Bus.Instance().Start(ctx); err != nil {
 		log.Fatalf("starting rendered version manager: %v", err)
 	}
+	if err = watch.Bus.Initialize(ctx, provider, log); err != nil {
+		log.Fatalf("creating resource watch manager: %v", err)
+	}
+	if err = watch.Bus.Instance().Start(ctx); err != nil {
+		log.Fatalf("starting resource watch manager: %v", err)
+	}
 
 	// create the agent service listener as tcp (combined HTTP+gRPC)
 	network := "tcp"
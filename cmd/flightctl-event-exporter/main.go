@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/event_exporter"
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
+	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/org/cache"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/worker_client"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/google/uuid"
+)
+
+func main() {
+	ctx := context.Background()
+
+	cfg, err := config.LoadOrGenerate(config.ConfigFile())
+	if err != nil {
+		log.InitLogs().Fatalf("reading configuration: %v", err)
+	}
+
+	log := log.InitLogs(cfg.Service.LogLevel)
+	log.Println("Starting event exporter")
+	log.Printf("Using config: %s", cfg)
+
+	tracerShutdown := tracing.InitTracer(log, cfg, "flightctl-event-exporter")
+	defer func() {
+		if err := tracerShutdown(ctx); err != nil {
+			log.Fatalf("failed to shut down tracer: %v", err)
+		}
+	}()
+
+	ctx = context.WithValue(ctx, consts.EventSourceComponentCtxKey, "flightctl-event-exporter")
+	ctx = context.WithValue(ctx, consts.EventActorCtxKey, "service:flightctl-event-exporter")
+	ctx = context.WithValue(ctx, consts.InternalRequestCtxKey, true)
+
+	log.Println("Initializing data store")
+	db, err := store.InitDB(cfg, log)
+	if err != nil {
+		log.Fatalf("initializing data store: %v", err)
+	}
+
+	store := store.NewStore(db, log.WithField("pkg", "store"))
+	defer store.Close()
+
+	processID := fmt.Sprintf("event-exporter-%s-%s", util.GetHostname(), uuid.New().String())
+	queuesProvider, err := queues.NewRedisProvider(ctx, log, processID, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password, queues.DefaultRetryConfig())
+	if err != nil {
+		log.Fatalf("initializing queue provider: %v", err)
+	}
+	defer func() {
+		queuesProvider.Stop()
+		queuesProvider.Wait()
+	}()
+
+	kvStore, err := kvstore.NewKVStore(ctx, log, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password)
+	if err != nil {
+		log.Fatalf("initializing kv store: %v", err)
+	}
+	defer kvStore.Close()
+
+	publisher, err := worker_client.QueuePublisher(ctx, queuesProvider)
+	if err != nil {
+		log.Fatalf("initializing task queue publisher: %v", err)
+	}
+	defer publisher.Close()
+	workerClient := worker_client.NewWorkerClient(publisher, log)
+
+	orgCache := cache.NewOrganizationTTL(cache.DefaultTTL)
+	orgCache.Start()
+	defer orgCache.Stop()
+
+	serviceHandler := service.WrapWithTracing(service.NewServiceHandler(store, workerClient, kvStore, nil, log, "", "", []string{}, false, false))
+
+	server := event_exporter.New(cfg, log)
+	if err := server.Run(ctx, serviceHandler); err != nil {
+		log.Fatalf("Error running server: %s", err)
+	}
+}
@@ -50,7 +50,11 @@ func main() {
 		log.Fatalf("initializing data store: %v", err)
 	}
 
-	store := store.NewStore(db, log.WithField("pkg", "store"))
+	storeOpts, err := store.SecretOptionsFromConfig(cfg)
+	if err != nil {
+		log.Fatalf("initializing secret encryption: %v", err)
+	}
+	store := store.NewStore(db, log.WithField("pkg", "store"), storeOpts...)
 	defer store.Close()
 
 	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGHUP, syscall.SIGTERM, syscall.SIGQUIT)
@@ -1,7 +1,7 @@
 #!/usr/bin/env bash
 set -x -e
 
-CONTAINER_IMAGES="flightctl-api flightctl-pam-issuer flightctl-worker flightctl-periodic flightctl-alert-exporter cli-artifacts"
+CONTAINER_IMAGES="flightctl-api flightctl-pam-issuer flightctl-worker flightctl-periodic flightctl-alert-exporter flightctl-event-exporter cli-artifacts"
 
 
 GIT_REF=$(git rev-parse --short HEAD)
@@ -34,6 +34,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/reload"
 	"github.com/flightctl/flightctl/internal/agent/shutdown"
 	"github.com/flightctl/flightctl/internal/tpm"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/flightctl/flightctl/pkg/poll"
@@ -223,6 +224,20 @@ func (a *Agent) Run(ctx context.Context) error {
 		}()
 	}
 
+	systemInfoManager.RegisterCollector(ctx, systeminfocommon.SecretEncryptionKeyKey, func(ctx context.Context) string {
+		key, err := identityProvider.KeyAgreement()
+		if err != nil {
+			a.log.Debugf("No key for the encryption of secrets: %v", err)
+			return ""
+		}
+		encoded, err := fccrypto.EncodeKeyAgreementPublicKey(key.PublicKey())
+		if err != nil {
+			a.log.Debugf("Failed to encode key for the encryption of secrets: %v", err)
+			return ""
+		}
+		return encoded
+	})
+
 	reloadManager := reload.NewManager(a.configFile, a.log)
 
 	policyManager := policy.NewManager(a.log)
@@ -264,6 +279,8 @@ func (a *Agent) Run(ctx context.Context) error {
 	// create resource manager
 	resourceManager := resource.NewManager(
 		a.log,
+		exec,
+		rootReadWriter,
 	)
 
 	// create hook manager
@@ -344,9 +361,14 @@ func (a *Agent) Run(ctx context.Context) error {
 		statusManager.RegisterStatusExporter(newConfigWarningExporter(a.config.Warnings))
 	}
 
-	// create config controller
+	// create config controller. Secrets in the rendered config are encrypted to the device's key, so only
+	// its writer decrypts them.
+	configReadWriter := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(a.config.GetTestRootDir())),
+		fileio.NewWriter(fileio.WithWriterRootDir(a.config.GetTestRootDir()), fileio.WithContentKey(identityProvider.KeyAgreement)),
+	)
 	configController := config.NewController(
-		rootReadWriter,
+		configReadWriter,
 		a.log,
 	)
 
@@ -399,6 +421,7 @@ func (a *Agent) Run(ctx context.Context) error {
 		deviceName,
 		console.ConsoleUser,
 		exec,
+		podmanClientFactory,
 		specManager.Watch(),
 		a.log,
 	)
@@ -407,7 +430,10 @@ func (a *Agent) Run(ctx context.Context) error {
 		podmanClientFactory,
 		cliClients,
 		applicationsManager,
+		hookManager,
 		rwFactory,
+		rootReadWriter,
+		a.config.DataDir,
 		a.log,
 		systemInfoManager.BootTime(),
 	)
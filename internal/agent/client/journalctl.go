@@ -3,6 +3,8 @@ package client
 import (
 	"context"
 	"fmt"
+	"os/exec"
+	"strconv"
 	"strings"
 	"time"
 
@@ -81,3 +83,19 @@ func (j *Journalctl) Logs(ctx context.Context, options ...LogOptions) ([]string,
 
 	return result, nil
 }
+
+// LogsCmd returns a command that prints the journal of the given systemd unit with timestamps.
+// After creating the command, it should be started with exec.Start().
+func (j *Journalctl) LogsCmd(ctx context.Context, unit string, follow bool, since *time.Time, tail *int) *exec.Cmd {
+	args := []string{"--no-pager", "-o", "short-iso", "-u", unit}
+	if follow {
+		args = append(args, "--follow")
+	}
+	if since != nil {
+		args = append(args, "--since", since.Local().Format("2006-01-02 15:04:05"))
+	}
+	if tail != nil {
+		args = append(args, "--lines", strconv.Itoa(*tail))
+	}
+	return j.exec.CommandContext(ctx, journalctlCommand, args...)
+}
@@ -486,6 +486,51 @@ func (p *Podman) EventsSinceCmd(ctx context.Context, events []string, sinceTime
 	return p.exec.CommandContext(ctx, podmanCmd, args...)
 }
 
+// ListContainers returns the names of the containers, running or not, that match all of the given labels.
+func (p *Podman) ListContainers(ctx context.Context, labels []string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	args := []string{
+		"ps",
+		"-a",
+		"--format",
+		"{{.Names}}",
+	}
+	args = applyFilters(args, labels, []string{})
+
+	stdout, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	if exitCode != 0 {
+		return nil, fmt.Errorf("list containers: %w", errors.FromStderr(stderr, exitCode))
+	}
+
+	var containers []string
+	for _, line := range strings.Split(strings.TrimSpace(stdout), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			containers = append(containers, line)
+		}
+	}
+	return containers, nil
+}
+
+// LogsCmd returns a command that prints the logs of the given containers, each line prefixed with the
+// name of its container. After creating the command, it should be started with exec.Start().
+func (p *Podman) LogsCmd(ctx context.Context, containers []string, follow bool, since *time.Time, tail *int) *exec.Cmd {
+	args := []string{"logs", "--names", "--timestamps"}
+	if follow {
+		args = append(args, "--follow")
+	}
+	if since != nil {
+		args = append(args, "--since", since.Format(time.RFC3339))
+	}
+	if tail != nil {
+		args = append(args, "--tail", strconv.Itoa(*tail))
+	}
+	args = append(args, containers...)
+
+	return p.exec.CommandContext(ctx, podmanCmd, args...)
+}
+
 func (p *Podman) Mount(ctx context.Context, image string) (string, error) {
 	ctx, cancel := context.WithTimeout(ctx, p.timeout)
 	defer cancel()
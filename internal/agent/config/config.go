@@ -49,6 +49,8 @@ const (
 	DefaultDataDir = "/var/lib/flightctl"
 	// SystemInfoCustomScriptDir is the directory where custom system info scripts are stored.
 	SystemInfoCustomScriptDir = "/usr/lib/flightctl/custom-info.d"
+	// CustomMonitorScriptDir is the directory where the executables of custom resource monitors are stored.
+	CustomMonitorScriptDir = "/usr/lib/flightctl/custom-monitor.d"
 	// DefaultCertsDir is the default directory where the device's certificates are stored
 	DefaultCertsDirName = "certs"
 	// DefaultManagementEndpoint is the default address of the device management server
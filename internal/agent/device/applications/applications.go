@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"slices"
 	"strconv"
+	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
@@ -12,11 +13,17 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/dependency"
 	"github.com/flightctl/flightctl/internal/agent/device/status"
 	"github.com/flightctl/flightctl/internal/agent/shutdown"
+	"github.com/samber/lo"
 )
 
 const (
 	AppTypeLabel            = "appType"
 	DefaultImageManifestDir = "/"
+	// DefaultRestartMaxRetries is the number of restarts within the crash-loop window after which an
+	// application without a configured maximum is reported as crash looping.
+	DefaultRestartMaxRetries = 5
+	// CrashLoopWindow is the period in which the restarts of an application are counted.
+	CrashLoopWindow = 10 * time.Minute
 )
 
 type StatusType string
@@ -121,6 +128,15 @@ type Application interface {
 	Status() (*v1beta1.DeviceApplicationStatus, v1beta1.DeviceApplicationsSummaryStatus, error)
 	// ActionSpec returns the type-specific action configuration for this application.
 	ActionSpec() lifecycle.ActionSpec
+	// HealthProbes returns the health probes of the application, or nil if it
+	// declares none.
+	HealthProbes() *HealthProbes
+	// DependsOn returns the names of the applications that must be running and
+	// ready before the application is started.
+	DependsOn() []string
+	// SetBlockedBy records the names of the dependencies the application waits
+	// for before it is started. An empty list unblocks the application.
+	SetBlockedBy(names []string)
 }
 
 // Workload represents an application workload tracked by a Monitor.
@@ -130,6 +146,33 @@ type Workload struct {
 	Name     string
 	Status   StatusType
 	Restarts int
+	// Termination is the last termination of the workload, nil if it has not terminated yet
+	Termination *Termination
+	// restartedAt are the times at which the restart count of the workload was seen to increase
+	restartedAt []time.Time
+}
+
+// Termination describes how a workload terminated.
+type Termination struct {
+	ExitCode int
+	Reason   string
+	At       time.Time
+}
+
+// terminationReason returns a short reason for the termination of a workload with the given exit code.
+func terminationReason(exitCode int, oomKilled bool) string {
+	switch {
+	case oomKilled:
+		return "OOMKilled"
+	case exitCode == 0:
+		return "Completed"
+	case exitCode == 137:
+		return "Killed"
+	case exitCode == 143:
+		return "Terminated"
+	default:
+		return "Error"
+	}
 }
 
 type application struct {
@@ -139,6 +182,11 @@ type application struct {
 	volume     provider.VolumeManager
 	status     *v1beta1.DeviceApplicationStatus
 	actionSpec lifecycle.ActionSpec
+	probes     *HealthProbes
+	dependsOn  []string
+	// maxRetries is the number of restarts within the crash-loop window the application tolerates,
+	// nil if restarts are not tracked
+	maxRetries *int
 }
 
 // NewApplication creates a new application from an application provider.
@@ -154,10 +202,42 @@ func NewApplication(p provider.Provider) *application {
 			AppType:  spec.AppType,
 			RunAs:    spec.User,
 		},
-		volume: spec.Volume,
+		volume:     spec.Volume,
+		probes:     NewHealthProbes(spec),
+		dependsOn:  applicationDependsOn(spec),
+		maxRetries: lo.ToPtr(applicationMaxRetries(spec)),
 	}
 }
 
+// applicationMaxRetries returns the number of restarts within the crash-loop window the application tolerates.
+func applicationMaxRetries(spec *provider.ApplicationSpec) int {
+	var policy *v1beta1.ApplicationRestartPolicy
+	switch {
+	case spec.ContainerApp != nil:
+		policy = spec.ContainerApp.RestartPolicy
+	case spec.QuadletApp != nil:
+		policy = spec.QuadletApp.RestartPolicy
+	}
+	if policy == nil || policy.MaxRetries == nil {
+		return DefaultRestartMaxRetries
+	}
+	return *policy.MaxRetries
+}
+
+// applicationDependsOn returns the names of the applications the application depends on.
+func applicationDependsOn(spec *provider.ApplicationSpec) []string {
+	var dependsOn *[]string
+	switch {
+	case spec.ContainerApp != nil:
+		dependsOn = spec.ContainerApp.DependsOn
+	case spec.QuadletApp != nil:
+		dependsOn = spec.QuadletApp.DependsOn
+	case spec.ComposeApp != nil:
+		dependsOn = spec.ComposeApp.DependsOn
+	}
+	return slices.Clone(lo.FromPtr(dependsOn))
+}
+
 // NewHelmApplication creates a new application with Helm-specific configuration.
 func NewHelmApplication(p provider.Provider) *application {
 	spec := p.Spec()
@@ -244,6 +324,22 @@ func (a *application) ActionSpec() lifecycle.ActionSpec {
 	return a.actionSpec
 }
 
+func (a *application) HealthProbes() *HealthProbes {
+	return a.probes
+}
+
+func (a *application) DependsOn() []string {
+	return a.dependsOn
+}
+
+func (a *application) SetBlockedBy(names []string) {
+	if len(names) == 0 {
+		a.status.BlockedBy = nil
+		return
+	}
+	a.status.BlockedBy = lo.ToPtr(slices.Clone(names))
+}
+
 func (a *application) Path() string {
 	return a.path
 }
@@ -262,8 +358,19 @@ func (a *application) Status() (*v1beta1.DeviceApplicationStatus, v1beta1.Device
 	initializing := 0
 	restarts := 0
 	exited := 0
+	recentRestarts := 0
+	var lastTermination *Termination
+	windowStart := time.Now().Add(-CrashLoopWindow)
 	for _, workload := range a.workloads {
 		restarts += workload.Restarts
+		for _, restartedAt := range workload.restartedAt {
+			if restartedAt.After(windowStart) {
+				recentRestarts++
+			}
+		}
+		if workload.Termination != nil && (lastTermination == nil || workload.Termination.At.After(lastTermination.At)) {
+			lastTermination = workload.Termination
+		}
 		switch workload.Status {
 		case StatusInit, StatusCreate:
 			initializing++
@@ -308,6 +415,23 @@ func (a *application) Status() (*v1beta1.DeviceApplicationStatus, v1beta1.Device
 		return nil, summary, fmt.Errorf("unknown application status: %d/%d/%d", total, healthy, initializing)
 	}
 
+	if a.probes != nil {
+		a.probes.Apply(&newStatus, &summary)
+		a.status.Probes = lo.ToPtr(a.probes.Statuses())
+	}
+
+	// an application that keeps restarting is crash looping even if it is running in between
+	if a.maxRetries != nil && recentRestarts > *a.maxRetries {
+		newStatus = v1beta1.ApplicationStatusCrashLooping
+		summary.Status = v1beta1.ApplicationsSummaryStatusDegraded
+	}
+
+	// an application that waits for its dependencies has not been started yet
+	if len(lo.FromPtr(a.status.BlockedBy)) > 0 {
+		newStatus = v1beta1.ApplicationStatusBlocked
+		summary.Status = v1beta1.ApplicationsSummaryStatusUnknown
+	}
+
 	if a.status.Status != newStatus {
 		a.status.Status = newStatus
 	}
@@ -317,6 +441,10 @@ func (a *application) Status() (*v1beta1.DeviceApplicationStatus, v1beta1.Device
 	if a.status.Restarts != restarts {
 		a.status.Restarts = restarts
 	}
+	if lastTermination != nil {
+		a.status.LastExitCode = lo.ToPtr(lastTermination.ExitCode)
+		a.status.LastTerminationReason = lo.ToPtr(lastTermination.Reason)
+	}
 
 	// update volume status
 	a.volume.Status(a.status)
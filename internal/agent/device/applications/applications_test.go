@@ -3,6 +3,7 @@ package applications
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
@@ -19,6 +20,7 @@ import (
 
 func TestApplicationStatus(t *testing.T) {
 	require := require.New(t)
+	now := time.Now()
 
 	tests := []struct {
 		name                  string
@@ -28,6 +30,8 @@ func TestApplicationStatus(t *testing.T) {
 		expectedStatus        v1beta1.ApplicationStatusType
 		expectedSummaryStatus v1beta1.ApplicationsSummaryStatusType
 		expected              v1beta1.AppType
+		expectedLastExitCode  *int
+		expectedLastReason    *string
 	}{
 		{
 			name:                  "app created no workloads",
@@ -224,6 +228,46 @@ func TestApplicationStatus(t *testing.T) {
 			expectedStatus:        v1beta1.ApplicationStatusCompleted,
 			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusHealthy,
 		},
+		{
+			name: "app restarting more often than the max retries is crash looping",
+			workloads: []Workload{
+				{
+					Name:        "container1",
+					Status:      StatusRunning,
+					Restarts:    6,
+					Termination: &Termination{ExitCode: 1, Reason: "Error", At: now},
+					restartedAt: []time.Time{now, now, now, now, now, now},
+				},
+			},
+			expectedReady:         "1/1",
+			expectedRestarts:      6,
+			expectedStatus:        v1beta1.ApplicationStatusCrashLooping,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusDegraded,
+			expectedLastExitCode:  lo.ToPtr(1),
+			expectedLastReason:    lo.ToPtr("Error"),
+		},
+		{
+			name: "app restarts outside of the crash-loop window are not counted",
+			workloads: []Workload{
+				{
+					Name:        "container1",
+					Status:      StatusRunning,
+					Restarts:    7,
+					restartedAt: []time.Time{now.Add(-time.Hour), now.Add(-time.Hour), now.Add(-time.Hour), now.Add(-time.Hour), now.Add(-time.Hour), now.Add(-time.Hour), now},
+				},
+				{
+					Name:        "container2",
+					Status:      StatusRunning,
+					Termination: &Termination{ExitCode: 137, Reason: "Killed", At: now.Add(-time.Minute)},
+				},
+			},
+			expectedReady:         "2/2",
+			expectedRestarts:      7,
+			expectedStatus:        v1beta1.ApplicationStatusRunning,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusHealthy,
+			expectedLastExitCode:  lo.ToPtr(137),
+			expectedLastReason:    lo.ToPtr("Killed"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -287,10 +331,21 @@ func TestApplicationStatus(t *testing.T) {
 			require.Equal(tt.expectedRestarts, status.Restarts)
 			require.Equal(tt.expectedStatus, status.Status)
 			require.Equal(tt.expectedSummaryStatus, summary.Status)
+			require.Equal(tt.expectedLastExitCode, status.LastExitCode)
+			require.Equal(tt.expectedLastReason, status.LastTerminationReason)
 		})
 	}
 }
 
+func TestTerminationReason(t *testing.T) {
+	require := require.New(t)
+	require.Equal("Completed", terminationReason(0, false))
+	require.Equal("Error", terminationReason(1, false))
+	require.Equal("Killed", terminationReason(137, false))
+	require.Equal("Terminated", terminationReason(143, false))
+	require.Equal("OOMKilled", terminationReason(137, true))
+}
+
 func TestNewAppFromProvider(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
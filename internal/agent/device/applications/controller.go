@@ -2,21 +2,30 @@ package applications
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"path/filepath"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/device/applications/provider"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/hook"
 	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 )
 
+// PendingPostStartHooksFileName is the name of the file that stores the postStart hooks that run once the device
+// rebooted into a new OS image
+const PendingPostStartHooksFileName = "pending-poststart-hooks.json"
+
 type Controller struct {
 	podmanFactory client.PodmanFactory
 	clients       client.CLIClients
 	rwFactory     fileio.ReadWriterFactory
 	manager       Manager
+	hooks         *appHooks
 	log           *log.PrefixLogger
 	bootTime      string
 }
@@ -25,13 +34,21 @@ func NewController(
 	podmanFactory client.PodmanFactory,
 	clients client.CLIClients,
 	manager Manager,
+	hookManager hook.Manager,
 	rwFactory fileio.ReadWriterFactory,
+	readWriter fileio.ReadWriter,
+	dataDir string,
 	log *log.PrefixLogger,
 	bootTime string,
 ) *Controller {
 	return &Controller{
-		log:           log,
-		manager:       manager,
+		log:     log,
+		manager: manager,
+		hooks: &appHooks{
+			manager:    hookManager,
+			readWriter: readWriter,
+			path:       filepath.Join(dataDir, PendingPostStartHooksFileName),
+		},
 		podmanFactory: podmanFactory,
 		clients:       clients,
 		rwFactory:     rwFactory,
@@ -69,13 +86,70 @@ func (c *Controller) Sync(ctx context.Context, current, desired *v1beta1.DeviceS
 		return fmt.Errorf("desired %w: %w", errors.ErrAppProviders, err)
 	}
 
-	return syncProviders(ctx, c.log, c.manager, currentAppProviders, desiredAppProviders)
+	// applications that failed to start in a previous sync are not started by this one
+	c.hooks.started = nil
+	c.hooks.desired = lo.SliceToMap(desiredAppProviders, func(p provider.Provider) (string, struct{}) {
+		return p.Name(), struct{}{}
+	})
+	return syncProviders(ctx, c.log, c.manager, c.hooks, currentAppProviders, desiredAppProviders)
+}
+
+// AfterUpdate runs the postStart hooks of the applications that were added or
+// updated by the last sync, and of the ones that were deferred until the device
+// rebooted into a new OS image. It must be called after the applications were started.
+func (c *Controller) AfterUpdate(ctx context.Context) error {
+	started := c.hooks.started
+	c.hooks.started = nil
+
+	var errs []error
+	ran := make(map[string]struct{}, len(started))
+	for _, p := range started {
+		ran[p.Name()] = struct{}{}
+		if err := c.hooks.run(ctx, p, v1beta1.ApplicationLifecycleHookPostStart); err != nil {
+			c.log.Warnf("Failed to run postStart hook of application %s: %v", p.Name(), err)
+			errs = append(errs, fmt.Errorf("postStart hook: %w: %w", errors.WithElement(p.Name()), err))
+		}
+	}
+
+	pending, err := c.hooks.readPending()
+	if err != nil {
+		c.log.Warnf("Failed to read pending postStart hooks: %v", err)
+	}
+	for _, hook := range pending {
+		// the application was already started by this sync, or is no longer part of the device spec
+		if _, ok := ran[hook.Application]; ok {
+			continue
+		}
+		if _, ok := c.hooks.desired[hook.Application]; !ok {
+			continue
+		}
+		if err := c.hooks.manager.OnApplication(ctx, hook.Application, v1beta1.ApplicationLifecycleHookPostStart, hook.Actions); err != nil {
+			c.log.Warnf("Failed to run postStart hook of application %s: %v", hook.Application, err)
+			errs = append(errs, fmt.Errorf("postStart hook: %w: %w", errors.WithElement(hook.Application), err))
+		}
+	}
+	if len(pending) > 0 {
+		if err := c.hooks.clearPending(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DeferAfterUpdate persists the postStart hooks of the applications that were added or updated by the last sync.
+// The applications of an update that includes a new OS image are only started once the device rebooted into it,
+// so AfterUpdate runs their hooks after the reboot.
+func (c *Controller) DeferAfterUpdate() error {
+	started := c.hooks.started
+	c.hooks.started = nil
+	return c.hooks.writePending(started)
 }
 
 func syncProviders(
 	ctx context.Context,
 	log *log.PrefixLogger,
 	manager Manager,
+	hooks *appHooks,
 	currentProviders, desiredProviders []provider.Provider,
 ) error {
 	diff, err := provider.GetDiff(currentProviders, desiredProviders)
@@ -83,10 +157,21 @@ func syncProviders(
 		return err
 	}
 
+	currentByID := make(map[string]provider.Provider, len(currentProviders))
+	for _, p := range currentProviders {
+		currentByID[p.ID()] = p
+	}
+
 	var errs []error
 
 	for _, p := range diff.Removed {
 		log.Debugf("Removing application: %s", p.Name())
+		// an application whose preStop hook failed is not stopped
+		if err := hooks.run(ctx, p, v1beta1.ApplicationLifecycleHookPreStop); err != nil {
+			log.Warnf("Failed to run preStop hook of application %s: %v", p.Name(), err)
+			errs = append(errs, fmt.Errorf("removing: preStop hook: %w: %w", errors.WithElement(p.Name()), err))
+			continue
+		}
 		if err := manager.Remove(ctx, p); err != nil {
 			log.Warnf("Failed to remove application %s: %v", p.Name(), err)
 			errs = append(errs, fmt.Errorf("removing: %w: %w", errors.WithElement(p.Name()), err))
@@ -98,16 +183,166 @@ func syncProviders(
 		if err := manager.Ensure(ctx, p); err != nil {
 			log.Warnf("Failed to ensure application %s: %v", p.Name(), err)
 			errs = append(errs, fmt.Errorf("ensuring: %w: %w", errors.WithElement(p.Name()), err))
+			continue
+		}
+		if _, ok := currentByID[p.ID()]; !ok {
+			hooks.start(p)
 		}
 	}
 
 	for _, p := range diff.Changed {
 		log.Debugf("Updating application: %s", p.Name())
+		// the hooks that run before the update are the ones of the running application
+		if current, ok := currentByID[p.ID()]; ok {
+			if err := runPreUpdateHooks(ctx, hooks, current); err != nil {
+				log.Warnf("Failed to run hooks before updating application %s: %v", p.Name(), err)
+				errs = append(errs, fmt.Errorf("updating: %w: %w", errors.WithElement(p.Name()), err))
+				continue
+			}
+		}
 		if err := manager.Update(ctx, p); err != nil {
 			log.Warnf("Failed to update application %s: %v", p.Name(), err)
 			errs = append(errs, fmt.Errorf("updating: %w: %w", errors.WithElement(p.Name()), err))
+			continue
 		}
+		hooks.start(p)
 	}
 
 	return errors.Join(errs...)
 }
+
+func runPreUpdateHooks(ctx context.Context, hooks *appHooks, p provider.Provider) error {
+	if err := hooks.run(ctx, p, v1beta1.ApplicationLifecycleHookPreUpdate); err != nil {
+		return fmt.Errorf("preUpdate hook: %w", err)
+	}
+	if err := hooks.run(ctx, p, v1beta1.ApplicationLifecycleHookPreStop); err != nil {
+		return fmt.Errorf("preStop hook: %w", err)
+	}
+	return nil
+}
+
+// appHooks runs the lifecycle hooks that applications declare in their spec.
+type appHooks struct {
+	manager hook.Manager
+	// started are the applications whose postStart hooks run once the agent started them
+	started []provider.Provider
+	// desired are the names of the applications of the desired spec of the last sync
+	desired map[string]struct{}
+
+	// readWriter and path locate the postStart hooks that are deferred until the device rebooted
+	readWriter fileio.ReadWriter
+	path       string
+}
+
+// pendingPostStartHook is a postStart hook that is deferred until the device rebooted into a new OS image
+type pendingPostStartHook struct {
+	Application string               `json:"application"`
+	Actions     []v1beta1.HookAction `json:"actions"`
+}
+
+// run executes the actions of the given hook of the application. It is a no-op
+// for a nil receiver and for applications that do not declare the hook.
+func (h *appHooks) run(ctx context.Context, p provider.Provider, hookType v1beta1.ApplicationLifecycleHookType) error {
+	if h == nil {
+		return nil
+	}
+	actions := applicationHookActions(p.Spec(), hookType)
+	if len(actions) == 0 {
+		return nil
+	}
+	return h.manager.OnApplication(ctx, p.Name(), hookType, actions)
+}
+
+func (h *appHooks) start(p provider.Provider) {
+	if h == nil {
+		return
+	}
+	h.started = append(h.started, p)
+}
+
+// writePending persists the postStart hooks of the given applications, replacing the ones persisted before.
+func (h *appHooks) writePending(started []provider.Provider) error {
+	if h == nil || h.readWriter == nil {
+		return nil
+	}
+	var pending []pendingPostStartHook
+	for _, p := range started {
+		if actions := applicationHookActions(p.Spec(), v1beta1.ApplicationLifecycleHookPostStart); len(actions) > 0 {
+			pending = append(pending, pendingPostStartHook{Application: p.Name(), Actions: actions})
+		}
+	}
+	if len(pending) == 0 {
+		return h.clearPending()
+	}
+	data, err := json.Marshal(pending)
+	if err != nil {
+		return fmt.Errorf("marshal pending postStart hooks: %w", err)
+	}
+	if err := h.readWriter.WriteFile(h.path, data, fileio.DefaultFilePermissions); err != nil {
+		return fmt.Errorf("write pending postStart hooks: %w", err)
+	}
+	return nil
+}
+
+// readPending returns the persisted postStart hooks.
+func (h *appHooks) readPending() ([]pendingPostStartHook, error) {
+	if h == nil || h.readWriter == nil {
+		return nil, nil
+	}
+	exists, err := h.readWriter.PathExists(h.path)
+	if err != nil || !exists {
+		return nil, err
+	}
+	data, err := h.readWriter.ReadFile(h.path)
+	if err != nil {
+		return nil, err
+	}
+	var pending []pendingPostStartHook
+	if err := json.Unmarshal(data, &pending); err != nil {
+		return nil, fmt.Errorf("unmarshal pending postStart hooks: %w", err)
+	}
+	return pending, nil
+}
+
+func (h *appHooks) clearPending() error {
+	if h == nil || h.readWriter == nil {
+		return nil
+	}
+	if err := h.readWriter.RemoveFile(h.path); err != nil {
+		return fmt.Errorf("remove pending postStart hooks: %w", err)
+	}
+	return nil
+}
+
+// applicationHookActions returns the actions of the given hook declared by the application.
+func applicationHookActions(spec *provider.ApplicationSpec, hookType v1beta1.ApplicationLifecycleHookType) []v1beta1.HookAction {
+	if spec == nil {
+		return nil
+	}
+	var hooks *v1beta1.ApplicationLifecycleHooks
+	switch {
+	case spec.ContainerApp != nil:
+		hooks = spec.ContainerApp.Hooks
+	case spec.QuadletApp != nil:
+		hooks = spec.QuadletApp.Hooks
+	case spec.ComposeApp != nil:
+		hooks = spec.ComposeApp.Hooks
+	}
+	if hooks == nil {
+		return nil
+	}
+
+	var actions *[]v1beta1.HookAction
+	switch hookType {
+	case v1beta1.ApplicationLifecycleHookPreUpdate:
+		actions = hooks.PreUpdate
+	case v1beta1.ApplicationLifecycleHookPreStop:
+		actions = hooks.PreStop
+	case v1beta1.ApplicationLifecycleHookPostStart:
+		actions = hooks.PostStart
+	}
+	if actions == nil {
+		return nil
+	}
+	return *actions
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/applications/provider"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/hook"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/flightctl/flightctl/test/util"
@@ -405,7 +406,7 @@ func TestSyncProviders_ContinueOnError(t *testing.T) {
 
 			log := log.NewPrefixLogger("test")
 
-			err := syncProviders(context.Background(), log, mockManager, tt.currentProvs(ctrl), tt.desiredProvs(ctrl))
+			err := syncProviders(context.Background(), log, mockManager, nil, tt.currentProvs(ctrl), tt.desiredProvs(ctrl))
 
 			if tt.expectedErrors > 0 {
 				require.Error(err)
@@ -416,6 +417,136 @@ func TestSyncProviders_ContinueOnError(t *testing.T) {
 	}
 }
 
+func newMockProviderWithHooks(ctrl *gomock.Controller, id, image string, hooks *v1beta1.ApplicationLifecycleHooks) *provider.MockProvider {
+	mock := provider.NewMockProvider(ctrl)
+	mock.EXPECT().ID().Return(id).AnyTimes()
+	mock.EXPECT().Name().Return(id).AnyTimes()
+	mock.EXPECT().Spec().Return(&provider.ApplicationSpec{
+		ID:   id,
+		Name: id,
+		ContainerApp: &v1beta1.ContainerApplication{
+			Image: image,
+			Hooks: hooks,
+		},
+	}).AnyTimes()
+	return mock
+}
+
+func newRunAction(t *testing.T, command string) v1beta1.HookAction {
+	t.Helper()
+	var action v1beta1.HookAction
+	require.NoError(t, action.FromHookActionRun(v1beta1.HookActionRun{Run: command}))
+	return action
+}
+
+func TestSyncProviders_ApplicationHooks(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dump := []v1beta1.HookAction{newRunAction(t, "/usr/bin/pg_dump")}
+	drain := []v1beta1.HookAction{newRunAction(t, "/usr/local/bin/drain")}
+	warmup := []v1beta1.HookAction{newRunAction(t, "/usr/local/bin/warmup")}
+	dbHooks := &v1beta1.ApplicationLifecycleHooks{PreUpdate: &dump, PreStop: &drain}
+	webHooks := &v1beta1.ApplicationLifecycleHooks{PostStart: &warmup}
+
+	current := []provider.Provider{
+		newMockProviderWithHooks(ctrl, "db", "quay.io/db:v1", dbHooks),
+		newMockProviderWithHooks(ctrl, "queue", "quay.io/queue:v1", dbHooks),
+	}
+	desired := []provider.Provider{
+		newMockProviderWithHooks(ctrl, "db", "quay.io/db:v2", dbHooks),
+		newMockProviderWithHooks(ctrl, "web", "quay.io/web:v1", webHooks),
+	}
+
+	mockManager := NewMockManager(ctrl)
+	mockHookManager := hook.NewMockManager(ctrl)
+	hooks := &appHooks{manager: mockHookManager}
+
+	gomock.InOrder(
+		// the removed application is drained before it is stopped
+		mockHookManager.EXPECT().OnApplication(gomock.Any(), "queue", v1beta1.ApplicationLifecycleHookPreStop, drain).Return(nil),
+		mockManager.EXPECT().Remove(gomock.Any(), current[1]).Return(nil),
+		mockManager.EXPECT().Ensure(gomock.Any(), desired[1]).Return(nil),
+		// the updated application runs its preUpdate hook and then its preStop hook
+		mockHookManager.EXPECT().OnApplication(gomock.Any(), "db", v1beta1.ApplicationLifecycleHookPreUpdate, dump).Return(nil),
+		mockHookManager.EXPECT().OnApplication(gomock.Any(), "db", v1beta1.ApplicationLifecycleHookPreStop, drain).Return(nil),
+		mockManager.EXPECT().Update(gomock.Any(), desired[0]).Return(nil),
+	)
+	err := syncProviders(context.Background(), log.NewPrefixLogger("test"), mockManager, hooks, current, desired)
+	require.NoError(err)
+	require.Len(hooks.started, 2)
+
+	// postStart hooks run once the applications were started
+	mockHookManager.EXPECT().OnApplication(gomock.Any(), "web", v1beta1.ApplicationLifecycleHookPostStart, warmup).Return(nil)
+	controller := &Controller{hooks: hooks, log: log.NewPrefixLogger("test")}
+	require.NoError(controller.AfterUpdate(context.Background()))
+	require.Empty(hooks.started)
+
+	// an application whose hook fails is neither updated nor removed
+	mockHookManager.EXPECT().OnApplication(gomock.Any(), "queue", v1beta1.ApplicationLifecycleHookPreStop, drain).Return(errors.ErrFailedToExecute)
+	mockHookManager.EXPECT().OnApplication(gomock.Any(), "db", v1beta1.ApplicationLifecycleHookPreUpdate, dump).Return(errors.ErrFailedToExecute)
+	mockManager.EXPECT().Ensure(gomock.Any(), desired[1]).Return(nil)
+	err = syncProviders(context.Background(), log.NewPrefixLogger("test"), mockManager, hooks, current, desired)
+	require.ErrorIs(err, errors.ErrFailedToExecute)
+	require.Len(hooks.started, 1)
+}
+
+func TestControllerDeferAfterUpdate(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tmpDir := t.TempDir()
+	readWriter := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+	pendingPath := filepath.Join("/data", PendingPostStartHooksFileName)
+	require.NoError(readWriter.MkdirAll("/data", fileio.DefaultDirectoryPermissions))
+
+	warmup := []v1beta1.HookAction{newRunAction(t, "/usr/local/bin/warmup")}
+	webHooks := &v1beta1.ApplicationLifecycleHooks{PostStart: &warmup}
+	desired := []provider.Provider{
+		newMockProviderWithHooks(ctrl, "web", "quay.io/web:v1", webHooks),
+		newMockProviderWithHooks(ctrl, "api", "quay.io/api:v1", webHooks),
+	}
+
+	mockManager := NewMockManager(ctrl)
+	mockHookManager := hook.NewMockManager(ctrl)
+	mockManager.EXPECT().Ensure(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	// the update includes a new OS image, so the postStart hooks are deferred until the device rebooted
+	hooks := &appHooks{manager: mockHookManager, readWriter: readWriter, path: pendingPath}
+	err := syncProviders(context.Background(), log.NewPrefixLogger("test"), mockManager, hooks, nil, desired)
+	require.NoError(err)
+	controller := &Controller{hooks: hooks, log: log.NewPrefixLogger("test")}
+	require.NoError(controller.DeferAfterUpdate())
+	require.Empty(hooks.started)
+	exists, err := readWriter.PathExists(pendingPath)
+	require.NoError(err)
+	require.True(exists)
+
+	// after the reboot the agent restarts with nothing in memory, and the desired spec no longer has the api application
+	rebooted := &Controller{
+		hooks: &appHooks{
+			manager:    mockHookManager,
+			readWriter: readWriter,
+			path:       pendingPath,
+			desired:    map[string]struct{}{"web": {}},
+		},
+		log: log.NewPrefixLogger("test"),
+	}
+	mockHookManager.EXPECT().OnApplication(gomock.Any(), "web", v1beta1.ApplicationLifecycleHookPostStart, warmup).Return(nil)
+	require.NoError(rebooted.AfterUpdate(context.Background()))
+	exists, err = readWriter.PathExists(pendingPath)
+	require.NoError(err)
+	require.False(exists)
+
+	// the deferred hooks run only once
+	require.NoError(rebooted.AfterUpdate(context.Background()))
+}
+
 func TestControllerSync(t *testing.T) {
 	require := require.New(t)
 
@@ -609,7 +740,7 @@ func TestControllerSync(t *testing.T) {
 				return readWriter, nil
 			}
 
-			controller := NewController(podmanFactory, nil, mockAppManager, rwFactory, log, "2025-01-01T00:00:00Z")
+			controller := NewController(podmanFactory, nil, mockAppManager, hook.NewMockManager(ctrl), rwFactory, readWriter, "/data", log, "2025-01-01T00:00:00Z")
 
 			countainerMountDir := "/mount"
 			err = readWriter.MkdirAll(countainerMountDir, fileio.DefaultDirectoryPermissions)
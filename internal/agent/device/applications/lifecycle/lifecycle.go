@@ -58,6 +58,8 @@ type Action struct {
 	Volumes []Volume
 	// Spec holds type-specific configuration, discriminated by AppType.
 	Spec ActionSpec
+	// DependsOn are the names of the applications that must be running and ready before the application is started
+	DependsOn []string
 }
 
 // HelmSpec contains Helm-specific action configuration.
@@ -281,7 +281,7 @@ func TestManager(t *testing.T) {
 			desiredProviders, err := provider.FromDeviceSpec(ctx, log, podmanFactory, nil, rwFactory, tc.desired)
 			require.NoError(err)
 
-			err = syncProviders(ctx, log, manager, currentProviders, desiredProviders)
+			err = syncProviders(ctx, log, manager, nil, currentProviders, desiredProviders)
 			require.NoError(err)
 
 			err = manager.AfterUpdate(ctx)
@@ -388,7 +388,7 @@ func TestManagerRemoveApplication(t *testing.T) {
 	// Remove applications
 	desiredProviders, err := provider.FromDeviceSpec(ctx, log, podmanFactory, nil, rwFactory, desired)
 	require.NoError(err)
-	err = syncProviders(ctx, log, manager, currentProviders, desiredProviders)
+	err = syncProviders(ctx, log, manager, nil, currentProviders, desiredProviders)
 	require.NoError(err)
 
 	// Stop monitor since no apps remain
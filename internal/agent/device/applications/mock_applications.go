@@ -309,6 +309,34 @@ func (mr *MockApplicationMockRecorder) CopyWorkloadsFrom(other any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyWorkloadsFrom", reflect.TypeOf((*MockApplication)(nil).CopyWorkloadsFrom), other)
 }
 
+// DependsOn mocks base method.
+func (m *MockApplication) DependsOn() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DependsOn")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// DependsOn indicates an expected call of DependsOn.
+func (mr *MockApplicationMockRecorder) DependsOn() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DependsOn", reflect.TypeOf((*MockApplication)(nil).DependsOn))
+}
+
+// HealthProbes mocks base method.
+func (m *MockApplication) HealthProbes() *HealthProbes {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthProbes")
+	ret0, _ := ret[0].(*HealthProbes)
+	return ret0
+}
+
+// HealthProbes indicates an expected call of HealthProbes.
+func (mr *MockApplicationMockRecorder) HealthProbes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthProbes", reflect.TypeOf((*MockApplication)(nil).HealthProbes))
+}
+
 // ID mocks base method.
 func (m *MockApplication) ID() string {
 	m.ctrl.T.Helper()
@@ -379,6 +407,18 @@ func (mr *MockApplicationMockRecorder) RemoveWorkload(name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWorkload", reflect.TypeOf((*MockApplication)(nil).RemoveWorkload), name)
 }
 
+// SetBlockedBy mocks base method.
+func (m *MockApplication) SetBlockedBy(names []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBlockedBy", names)
+}
+
+// SetBlockedBy indicates an expected call of SetBlockedBy.
+func (mr *MockApplicationMockRecorder) SetBlockedBy(names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlockedBy", reflect.TypeOf((*MockApplication)(nil).SetBlockedBy), names)
+}
+
 // Status mocks base method.
 func (m *MockApplication) Status() (*v1beta1.DeviceApplicationStatus, v1beta1.DeviceApplicationsSummaryStatus, error) {
 	m.ctrl.T.Helper()
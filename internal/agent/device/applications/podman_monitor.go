@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"io"
 	"os/exec"
+	"slices"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -21,13 +24,14 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/internal/agent/device/systemd"
 	"github.com/flightctl/flightctl/pkg/log"
-	"github.com/samber/lo"
 )
 
 const (
 	expectedPodmanSigTermExitCode = 1
 	quadletSystemdLabel           = "PODMAN_SYSTEMD_UNIT"
 	podmanHealthStatusEvent       = "health_status"
+	// dependencyCheckInterval is how often the monitor checks whether the dependencies of blocked applications are ready
+	dependencyCheckInterval = 2 * time.Second
 )
 
 type PodmanMonitor struct {
@@ -43,6 +47,15 @@ type PodmanMonitor struct {
 	systemdFactory systemd.ManagerFactory
 	watchers       map[v1beta1.Username]*podmanEventWatcher
 	events         chan client.PodmanEvent
+	// probing is true once the health probes of the applications are being evaluated
+	probing bool
+	// blocked is a map of application ID to the add or update action of an
+	// application that waits for its dependencies to be ready.
+	blocked map[string]lifecycle.Action
+	// unblocking is true while the dependencies of blocked applications are being checked
+	unblocking bool
+	// execMu serializes the execution of actions by the lifecycle handlers
+	execMu sync.Mutex
 
 	log *log.PrefixLogger
 }
@@ -69,6 +82,7 @@ func NewPodmanMonitor(
 		},
 		watchers:               make(map[v1beta1.Username]*podmanEventWatcher),
 		apps:                   make(map[string]Application),
+		blocked:                make(map[string]lifecycle.Action),
 		startTime:              startTime,
 		lastActionsSuccessTime: startTime,
 		log:                    log,
@@ -142,6 +156,9 @@ func (m *PodmanMonitor) Drain(ctx context.Context) error {
 	// Drain may be called as the result of an OS upgrade. Any applications added during that spec update
 	// may have "start" actions pending. Clear out any pending actions so that they can be replaced with "stops"
 	m.drainActions()
+	m.mu.Lock()
+	clear(m.blocked)
+	m.mu.Unlock()
 
 	return m.drain(ctx)
 }
@@ -200,14 +217,15 @@ func (m *PodmanMonitor) Ensure(ctx context.Context, app Application) error {
 
 	appName := app.Name()
 	action := lifecycle.Action{
-		AppType:  app.AppType(),
-		Type:     lifecycle.ActionAdd,
-		User:     app.User(),
-		Name:     appName,
-		ID:       appID,
-		Path:     app.Path(),
-		Embedded: app.IsEmbedded(),
-		Volumes:  provider.ToLifecycleVolumes(app.Volume().List()),
+		AppType:   app.AppType(),
+		Type:      lifecycle.ActionAdd,
+		User:      app.User(),
+		Name:      appName,
+		ID:        appID,
+		Path:      app.Path(),
+		Embedded:  app.IsEmbedded(),
+		Volumes:   provider.ToLifecycleVolumes(app.Volume().List()),
+		DependsOn: app.DependsOn(),
 	}
 
 	m.actions = append(m.actions, action)
@@ -227,12 +245,13 @@ func (m *PodmanMonitor) QueueRemove(app Application) error {
 	appName := app.Name()
 
 	action := lifecycle.Action{
-		AppType: app.AppType(),
-		Type:    lifecycle.ActionRemove,
-		Name:    appName,
-		User:    app.User(),
-		ID:      appID,
-		Volumes: provider.ToLifecycleVolumes(app.Volume().List()),
+		AppType:   app.AppType(),
+		Type:      lifecycle.ActionRemove,
+		Name:      appName,
+		User:      app.User(),
+		ID:        appID,
+		Volumes:   provider.ToLifecycleVolumes(app.Volume().List()),
+		DependsOn: app.DependsOn(),
 	}
 
 	m.actions = append(m.actions, action)
@@ -254,13 +273,14 @@ func (m *PodmanMonitor) QueueUpdate(app Application) error {
 
 	// currently we don't support updating embedded applications
 	action := lifecycle.Action{
-		AppType: app.AppType(),
-		Type:    lifecycle.ActionUpdate,
-		Name:    app.Name(),
-		User:    app.User(),
-		ID:      appID,
-		Path:    app.Path(),
-		Volumes: provider.ToLifecycleVolumes(app.Volume().List()),
+		AppType:   app.AppType(),
+		Type:      lifecycle.ActionUpdate,
+		Name:      app.Name(),
+		User:      app.User(),
+		ID:        appID,
+		Path:      app.Path(),
+		Volumes:   provider.ToLifecycleVolumes(app.Volume().List()),
+		DependsOn: app.DependsOn(),
 	}
 
 	m.actions = append(m.actions, action)
@@ -293,29 +313,46 @@ func (m *PodmanMonitor) ExecuteActions(ctx context.Context) error {
 }
 
 func (m *PodmanMonitor) executeActions(ctx context.Context, systemShutdown bool) error {
+	m.execMu.Lock()
+	defer m.execMu.Unlock()
+
 	ctx = m.addBatchTimeToCtx(ctx)
 	actions := m.drainActions()
+	if !systemShutdown {
+		actions = m.holdBlockedActions(ctx, actions)
+	}
+	return m.runActions(ctx, actions, systemShutdown)
+}
 
-	groupedActions := make(map[v1beta1.AppType][]lifecycle.Action)
-	for i := range actions {
-		action := actions[i]
-		appType := normalizeActionAppType(action.AppType)
-
-		if systemShutdown && appType == v1beta1.AppTypeQuadlet {
-			m.log.Debugf("System shutdown: skipping quadlet action for %s", action.Name)
-			continue
-		}
+// runActions executes the actions by the lifecycle handlers of their application
+// types. It must be called with execMu held.
+func (m *PodmanMonitor) runActions(ctx context.Context, actions []lifecycle.Action, systemShutdown bool) error {
+	var batches []map[v1beta1.AppType][]lifecycle.Action
+	for _, batch := range orderActions(actions) {
+		groupedActions := make(map[v1beta1.AppType][]lifecycle.Action)
+		for i := range batch {
+			action := batch[i]
+			appType := normalizeActionAppType(action.AppType)
+
+			if systemShutdown && appType == v1beta1.AppTypeQuadlet {
+				m.log.Debugf("System shutdown: skipping quadlet action for %s", action.Name)
+				continue
+			}
 
-		_, ok := m.handlers[appType]
-		if !ok {
-			return fmt.Errorf("%w: no action handler registered: %s", errors.ErrUnsupportedAppType, action.AppType)
+			_, ok := m.handlers[appType]
+			if !ok {
+				return fmt.Errorf("%w: no action handler registered: %s", errors.ErrUnsupportedAppType, action.AppType)
+			}
+			groupedActions[appType] = append(groupedActions[appType], action)
 		}
-		groupedActions[appType] = append(groupedActions[appType], action)
+		batches = append(batches, groupedActions)
 	}
 
-	for appType, actions := range groupedActions {
-		if err := m.handlers[appType].Execute(ctx, actions); err != nil {
-			return err
+	for _, groupedActions := range batches {
+		for appType, actions := range groupedActions {
+			if err := m.handlers[appType].Execute(ctx, actions); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -330,6 +367,7 @@ func (m *PodmanMonitor) executeActions(ctx context.Context, systemShutdown bool)
 			if err := m.ensureMonitorForUser(ctx, a.User); err != nil {
 				return fmt.Errorf("failed to start podman monitor: %w", err)
 			}
+			m.ensureProbing(ctx)
 		case lifecycle.ActionRemove:
 			// Stop the monitor for the app user if no other apps for that user are running.
 			user := a.User
@@ -350,6 +388,204 @@ func (m *PodmanMonitor) executeActions(ctx context.Context, systemShutdown bool)
 	return nil
 }
 
+// orderActions splits the actions into batches that are executed in order. An
+// application is removed only after the applications that depend on it were
+// removed. Additions and updates are executed with the last removals.
+func orderActions(actions []lifecycle.Action) [][]lifecycle.Action {
+	var removes, others []lifecycle.Action
+	for _, a := range actions {
+		if a.Type == lifecycle.ActionRemove {
+			removes = append(removes, a)
+		} else {
+			others = append(others, a)
+		}
+	}
+
+	var batches [][]lifecycle.Action
+	for len(removes) > 0 {
+		// applications that a remaining removal depends on are removed in a later batch
+		dependencies := make(map[string]struct{})
+		for _, a := range removes {
+			for _, name := range a.DependsOn {
+				dependencies[name] = struct{}{}
+			}
+		}
+		var batch, rest []lifecycle.Action
+		for _, a := range removes {
+			if _, ok := dependencies[a.Name]; ok {
+				rest = append(rest, a)
+			} else {
+				batch = append(batch, a)
+			}
+		}
+		if len(batch) == 0 {
+			// the dependencies form a cycle, remove the remaining applications together
+			batch, rest = rest, nil
+		}
+		batches = append(batches, batch)
+		removes = rest
+	}
+
+	if len(batches) == 0 {
+		return [][]lifecycle.Action{others}
+	}
+	last := len(batches) - 1
+	batches[last] = append(batches[last], others...)
+	return batches
+}
+
+// holdBlockedActions returns the actions that can be executed now. Adding or
+// updating an application whose dependencies are not running and ready yet is
+// held back until they are.
+func (m *PodmanMonitor) holdBlockedActions(ctx context.Context, actions []lifecycle.Action) []lifecycle.Action {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// applications that are started by this batch are not ready yet
+	starting := make(map[string]struct{})
+	for _, a := range actions {
+		if a.Type != lifecycle.ActionRemove {
+			starting[a.Name] = struct{}{}
+		}
+	}
+
+	ready := make([]lifecycle.Action, 0, len(actions))
+	for _, a := range actions {
+		if a.Type != lifecycle.ActionRemove {
+			if blockedBy := m.blockedBy(a, starting); len(blockedBy) > 0 {
+				m.log.Infof("Application %s waits for its dependencies: %s", a.Name, strings.Join(blockedBy, ", "))
+				m.blocked[a.ID] = a
+				m.setBlockedBy(a.ID, blockedBy)
+				continue
+			}
+		}
+		delete(m.blocked, a.ID)
+		m.setBlockedBy(a.ID, nil)
+		ready = append(ready, a)
+	}
+
+	if len(m.blocked) > 0 {
+		m.ensureUnblocking(ctx)
+	}
+	return ready
+}
+
+// blockedBy returns the names of the dependencies of the action's application
+// that are not running and ready. It must be called with mu held.
+func (m *PodmanMonitor) blockedBy(action lifecycle.Action, starting map[string]struct{}) []string {
+	var blockedBy []string
+	for _, name := range action.DependsOn {
+		if _, ok := starting[name]; ok {
+			blockedBy = append(blockedBy, name)
+			continue
+		}
+		if !m.isReady(name) {
+			blockedBy = append(blockedBy, name)
+		}
+	}
+	return blockedBy
+}
+
+// isReady returns true if the application with the given name is running and
+// ready. It must be called with mu held.
+func (m *PodmanMonitor) isReady(name string) bool {
+	for id, app := range m.apps {
+		if app.Name() != name {
+			continue
+		}
+		if _, ok := m.blocked[id]; ok {
+			return false
+		}
+		_, summary, err := app.Status()
+		return err == nil && summary.Status == v1beta1.ApplicationsSummaryStatusHealthy
+	}
+	return false
+}
+
+// setBlockedBy records the dependencies an application waits for in its
+// status. It must be called with mu held.
+func (m *PodmanMonitor) setBlockedBy(id string, blockedBy []string) {
+	if app, ok := m.apps[id]; ok {
+		app.SetBlockedBy(blockedBy)
+	}
+}
+
+// ensureUnblocking starts checking the dependencies of the blocked
+// applications unless it is already running. It must be called with mu held.
+func (m *PodmanMonitor) ensureUnblocking(ctx context.Context) {
+	if m.unblocking {
+		return
+	}
+	m.unblocking = true
+	go m.runUnblocking(ctx)
+}
+
+func (m *PodmanMonitor) runUnblocking(ctx context.Context) {
+	ticker := time.NewTicker(dependencyCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			m.mu.Lock()
+			m.unblocking = false
+			m.mu.Unlock()
+			return
+		case <-ticker.C:
+			if !m.startUnblocked(ctx) {
+				return
+			}
+		}
+	}
+}
+
+// startUnblocked executes the held back actions of the applications whose
+// dependencies are now ready. Actions that fail are held back again. It
+// returns true if any application is still blocked.
+func (m *PodmanMonitor) startUnblocked(ctx context.Context) bool {
+	m.execMu.Lock()
+	defer m.execMu.Unlock()
+
+	m.mu.Lock()
+	var ready []lifecycle.Action
+	for id, a := range m.blocked {
+		blockedBy := m.blockedBy(a, nil)
+		m.setBlockedBy(id, blockedBy)
+		if len(blockedBy) == 0 {
+			ready = append(ready, a)
+		}
+	}
+	for _, a := range ready {
+		delete(m.blocked, a.ID)
+	}
+	blocked := len(m.blocked) > 0
+	if !blocked {
+		m.unblocking = false
+	}
+	m.mu.Unlock()
+
+	if len(ready) == 0 {
+		return blocked
+	}
+	sort.Slice(ready, func(i, j int) bool { return ready[i].Name < ready[j].Name })
+	for _, a := range ready {
+		m.log.Infof("Dependencies of application %s are ready, starting it", a.Name)
+	}
+	if err := m.runActions(m.addBatchTimeToCtx(ctx), ready, false); err != nil {
+		m.log.Errorf("Failed to start applications whose dependencies are ready, retrying: %v", err)
+		// the actions are held back again so that the next check retries them
+		m.mu.Lock()
+		for _, a := range ready {
+			if _, ok := m.blocked[a.ID]; !ok {
+				m.blocked[a.ID] = a
+			}
+		}
+		m.unblocking = true
+		m.mu.Unlock()
+		return true
+	}
+	return blocked
+}
+
 // drainActions returns a copy of the current actions and clears the existing. this
 // ensures actions can only be executed once and on failure the remaining
 // actions will not be executed.
@@ -387,6 +623,53 @@ func (m *PodmanMonitor) Status() ([]AppStatusResult, error) {
 	return results, nil
 }
 
+// ensureProbing starts evaluating the health probes of the applications unless it is already running.
+func (m *PodmanMonitor) ensureProbing(ctx context.Context) {
+	if m.probing {
+		return
+	}
+	m.probing = true
+	go m.runProbes(ctx)
+}
+
+func (m *PodmanMonitor) runProbes(ctx context.Context) {
+	ticker := time.NewTicker(probeTickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.probeApps(ctx, now)
+		}
+	}
+}
+
+// probeApps runs the due health probes of all applications
+func (m *PodmanMonitor) probeApps(ctx context.Context, now time.Time) {
+	for _, app := range m.getApps() {
+		probes := app.HealthProbes()
+		if probes == nil {
+			continue
+		}
+		m.mu.Lock()
+		workloads := app.Workloads()
+		m.mu.Unlock()
+		probes.Run(ctx, now, workloads, m.containerExec(app.User()))
+	}
+}
+
+// containerExec returns a function that runs a command inside a container of the given user
+func (m *PodmanMonitor) containerExec(username v1beta1.Username) containerExecFn {
+	return func(ctx context.Context, container string, command ...string) (string, string, int) {
+		podman, err := m.clientFactory(username)
+		if err != nil {
+			return "", fmt.Sprintf("creating podman client: %v", err), -1
+		}
+		return podman.ExecInContainer(ctx, container, command...)
+	}
+}
+
 func (m *PodmanMonitor) listenForEvents(ctx context.Context) {
 	for {
 		select {
@@ -458,7 +741,7 @@ func isFinishedStatus(status StatusType) bool {
 	return ok
 }
 
-func (m *PodmanMonitor) updateApplicationStatus(app Application, event *client.PodmanEvent, status StatusType, restarts int) {
+func (m *PodmanMonitor) updateApplicationStatus(app Application, event *client.PodmanEvent, status StatusType, restarts int, termination *Termination) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -468,8 +751,12 @@ func (m *PodmanMonitor) updateApplicationStatus(app Application, event *client.P
 		container.Status = status
 		// restarts can only increase
 		if restarts > container.Restarts {
+			container.restartedAt = appendRestarts(container.restartedAt, restarts-container.Restarts, eventTime(event))
 			container.Restarts = restarts
 		}
+		if termination != nil {
+			container.Termination = termination
+		}
 
 		return
 	}
@@ -477,11 +764,33 @@ func (m *PodmanMonitor) updateApplicationStatus(app Application, event *client.P
 	// add new container
 	m.log.Debugf("Adding container: %s to app %s", event.Name, app.Name())
 	app.AddWorkload(&Workload{
-		ID:       event.ID,
-		Name:     event.Name,
-		Status:   status,
-		Restarts: restarts,
+		ID:          event.ID,
+		Name:        event.Name,
+		Status:      status,
+		Restarts:    restarts,
+		Termination: termination,
+	})
+}
+
+// appendRestarts records count restarts at the given time and drops the restarts that are outside of the
+// crash-loop window.
+func appendRestarts(restartedAt []time.Time, count int, at time.Time) []time.Time {
+	windowStart := at.Add(-CrashLoopWindow)
+	restartedAt = slices.DeleteFunc(restartedAt, func(t time.Time) bool {
+		return !t.After(windowStart)
 	})
+	for range count {
+		restartedAt = append(restartedAt, at)
+	}
+	return restartedAt
+}
+
+// eventTime returns the time of the event, or the current time if the event does not carry one.
+func eventTime(event *client.PodmanEvent) time.Time {
+	if event.TimeNano == 0 {
+		return time.Now()
+	}
+	return time.Unix(0, event.TimeNano)
 }
 
 func (m *PodmanMonitor) updateQuadletContainerStatus(ctx context.Context, app Application, event *client.PodmanEvent) {
@@ -525,10 +834,19 @@ func (m *PodmanMonitor) updateQuadletContainerStatus(ctx context.Context, app Ap
 	}
 
 	status := StatusType(event.Status)
-	if isFinishedStatus(status) && lo.FromPtrOr(event.ContainerExitCode, -1) == 0 {
-		status = StatusExited
+	var termination *Termination
+	if isFinishedStatus(status) && event.ContainerExitCode != nil {
+		exitCode := *event.ContainerExitCode
+		termination = &Termination{
+			ExitCode: exitCode,
+			Reason:   terminationReason(exitCode, false),
+			At:       eventTime(event),
+		}
+		if exitCode == 0 {
+			status = StatusExited
+		}
 	}
-	m.updateApplicationStatus(app, event, status, restartCount)
+	m.updateApplicationStatus(app, event, status, restartCount, termination)
 }
 
 func (m *PodmanMonitor) updateComposeContainerStatus(ctx context.Context, app Application, event *client.PodmanEvent) {
@@ -562,7 +880,20 @@ func (m *PodmanMonitor) updateComposeContainerStatus(ctx context.Context, app Ap
 		return
 	}
 
-	m.updateApplicationStatus(app, event, status, restarts)
+	m.updateApplicationStatus(app, event, status, restarts, containerTermination(event, status, inspectData))
+}
+
+// containerTermination returns the termination of a container that finished according to its inspect data.
+func containerTermination(event *client.PodmanEvent, status StatusType, inspectData []client.PodmanInspect) *Termination {
+	if (!isFinishedStatus(status) && status != StatusExited) || len(inspectData) == 0 || inspectData[0].State.FinishedAt == "" {
+		return nil
+	}
+	state := inspectData[0].State
+	return &Termination{
+		ExitCode: state.ExitCode,
+		Reason:   terminationReason(state.ExitCode, state.OOMKilled),
+		At:       eventTime(event),
+	}
 }
 
 func (m *PodmanMonitor) getContainerRestarts(inspectData []client.PodmanInspect) (int, error) {
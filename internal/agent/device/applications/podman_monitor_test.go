@@ -691,6 +691,100 @@ func TestPodmanMonitorMultipleAddRemoveCycles(t *testing.T) {
 	require.False(podmanMonitor.Has(app1ID))
 }
 
+func TestOrderActions(t *testing.T) {
+	require := require.New(t)
+
+	api := lifecycle.Action{ID: "api", Name: "api", Type: lifecycle.ActionRemove, DependsOn: []string{"db"}}
+	db := lifecycle.Action{ID: "db", Name: "db", Type: lifecycle.ActionRemove}
+	web := lifecycle.Action{ID: "web", Name: "web", Type: lifecycle.ActionAdd}
+
+	// without dependencies all actions are executed together
+	require.Equal([][]lifecycle.Action{{db, web}}, orderActions([]lifecycle.Action{db, web}))
+	// an application is removed after the applications depending on it
+	require.Equal([][]lifecycle.Action{{api}, {db, web}}, orderActions([]lifecycle.Action{db, web, api}))
+	require.Equal([][]lifecycle.Action{{web}}, orderActions([]lifecycle.Action{web}))
+}
+
+func TestPodmanMonitorDependencies(t *testing.T) {
+	require := require.New(t)
+
+	// the monitor checks blocked applications in the background until the context is canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	log := log.NewPrefixLogger("test")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExec := executer.NewMockExecuter(ctrl)
+	mockPodmanClient := client.NewPodman(log, mockExec, fileio.NewMockReadWriter(ctrl), util.NewPollConfig())
+	mockExec.EXPECT().CommandContext(gomock.Any(), "podman", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+			return exec.CommandContext(ctx, "echo", fmt.Sprintf(`{"timeNano": %d}`, time.Now().UnixNano())) //nolint:gosec
+		}).AnyTimes()
+	var podmanFactory client.PodmanFactory = func(user v1beta1.Username) (*client.Podman, error) {
+		return mockPodmanClient, nil
+	}
+	var systemdFactory systemd.ManagerFactory = func(user v1beta1.Username) (systemd.Manager, error) {
+		return systemd.NewMockManager(ctrl), nil
+	}
+	tempDir := t.TempDir()
+	var rwFactory fileio.ReadWriterFactory = func(username v1beta1.Username) (fileio.ReadWriter, error) {
+		return fileio.NewReadWriter(
+			fileio.NewReader(fileio.WithReaderRootDir(tempDir)),
+			fileio.NewWriter(fileio.WithWriterRootDir(tempDir)),
+		), nil
+	}
+	podmanMonitor := NewPodmanMonitor(log, podmanFactory, systemdFactory, "", rwFactory)
+	mockComposeHandler := lifecycle.NewMockActionHandler(ctrl)
+	podmanMonitor.handlers[v1beta1.AppTypeCompose] = mockComposeHandler
+
+	db := NewApplication(newMockProvider(require, "db", v1beta1.CurrentProcessUsername, v1beta1.AppTypeCompose))
+	api := NewApplication(newMockProvider(require, "api", v1beta1.CurrentProcessUsername, v1beta1.AppTypeCompose))
+	api.dependsOn = []string{"db"}
+
+	actionNames := func(actions lifecycle.Actions) []string {
+		return lo.Map(actions, func(a lifecycle.Action, _ int) string { return a.Name })
+	}
+
+	// the api is held back while the database it depends on is started
+	mockComposeHandler.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, actions lifecycle.Actions) error {
+		require.Equal([]string{"db"}, actionNames(actions))
+		return nil
+	})
+	require.NoError(podmanMonitor.Ensure(ctx, db))
+	require.NoError(podmanMonitor.Ensure(ctx, api))
+	require.NoError(podmanMonitor.ExecuteActions(ctx))
+
+	status, _, err := api.Status()
+	require.NoError(err)
+	require.Equal(v1beta1.ApplicationStatusBlocked, status.Status)
+	require.Equal([]string{"db"}, lo.FromPtr(status.BlockedBy))
+
+	// nothing is started until the database is running
+	require.True(podmanMonitor.startUnblocked(ctx))
+
+	// the api is started once the database is running
+	podmanMonitor.mu.Lock()
+	db.AddWorkload(&Workload{Name: "db-container", Status: StatusRunning})
+	podmanMonitor.mu.Unlock()
+	// starting the api fails at first, so it is held back and retried by the next check
+	mockComposeHandler.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, actions lifecycle.Actions) error {
+		require.Equal([]string{"api"}, actionNames(actions))
+		return fmt.Errorf("failed to start api")
+	})
+	require.True(podmanMonitor.startUnblocked(ctx))
+	mockComposeHandler.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, actions lifecycle.Actions) error {
+		require.Equal([]string{"api"}, actionNames(actions))
+		return nil
+	})
+	require.False(podmanMonitor.startUnblocked(ctx))
+
+	status, _, err = api.Status()
+	require.NoError(err)
+	require.NotEqual(v1beta1.ApplicationStatusBlocked, status.Status)
+	require.Nil(status.BlockedBy)
+}
+
 func TestPodmanMonitorHandlerSelection(t *testing.T) {
 	require := require.New(t)
 
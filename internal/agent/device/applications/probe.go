@@ -0,0 +1,293 @@
+package applications
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/provider"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultProbePeriod           = 10 * time.Second
+	defaultProbeTimeout          = time.Second
+	defaultProbeFailureThreshold = 3
+	// probeTickInterval is how often the monitor checks whether any probe is due
+	probeTickInterval = time.Second
+	// maxProbeMessageLength bounds the failure message reported in the status of a probe
+	maxProbeMessageLength = 256
+)
+
+// containerExecFn runs a command inside a container and returns its stdout, stderr and exit code.
+type containerExecFn func(ctx context.Context, container string, command ...string) (string, string, int)
+
+// probe is a health probe of an application together with the state of its evaluation.
+type probe struct {
+	probeType        v1beta1.ApplicationProbeType
+	spec             v1beta1.ApplicationProbe
+	initialDelay     time.Duration
+	period           time.Duration
+	timeout          time.Duration
+	failureThreshold int
+
+	// nextRun is when the probe is due next. It is zero while the application is not running.
+	nextRun             time.Time
+	consecutiveFailures int
+	result              v1beta1.ApplicationProbeResult
+	message             string
+}
+
+func newProbe(probeType v1beta1.ApplicationProbeType, spec v1beta1.ApplicationProbe) *probe {
+	return &probe{
+		probeType:        probeType,
+		spec:             spec,
+		initialDelay:     parseProbeDuration(spec.InitialDelay, 0),
+		period:           parseProbeDuration(spec.Period, defaultProbePeriod),
+		timeout:          parseProbeDuration(spec.Timeout, defaultProbeTimeout),
+		failureThreshold: lo.FromPtrOr(spec.FailureThreshold, defaultProbeFailureThreshold),
+		result:           v1beta1.ApplicationProbeResultUnknown,
+	}
+}
+
+// parseProbeDuration parses a duration of a probe spec. The spec is validated by the service, so an unparsable
+// value falls back to the default.
+func parseProbeDuration(value *string, defaultValue time.Duration) time.Duration {
+	if value == nil {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(*value)
+	if err != nil {
+		return defaultValue
+	}
+	return duration
+}
+
+// reset returns the probe to its initial state, so that its initial delay applies again
+func (p *probe) reset() {
+	p.nextRun = time.Time{}
+	p.consecutiveFailures = 0
+	p.result = v1beta1.ApplicationProbeResultUnknown
+	p.message = ""
+}
+
+// record updates the state of the probe with the outcome of a run
+func (p *probe) record(err error) {
+	if err == nil {
+		p.consecutiveFailures = 0
+		p.result = v1beta1.ApplicationProbeResultSuccess
+		p.message = ""
+		return
+	}
+	p.consecutiveFailures++
+	if p.consecutiveFailures >= p.failureThreshold {
+		p.result = v1beta1.ApplicationProbeResultFailure
+		p.message = truncateProbeMessage(err.Error())
+	}
+}
+
+func truncateProbeMessage(message string) string {
+	if len(message) <= maxProbeMessageLength {
+		return message
+	}
+	return message[:maxProbeMessageLength-3] + "..."
+}
+
+// check runs the probe once and returns an error if it fails
+func (p *probe) check(ctx context.Context, workloads []Workload, exec containerExecFn) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	switch {
+	case p.spec.Http != nil:
+		return p.checkHttp(ctx, p.spec.Http)
+	case p.spec.Tcp != nil:
+		return p.checkTcp(ctx, p.spec.Tcp)
+	case p.spec.Exec != nil:
+		return p.checkExec(ctx, p.spec.Exec, workloads, exec)
+	default:
+		return fmt.Errorf("probe defines neither http, tcp nor exec")
+	}
+}
+
+func (p *probe) checkHttp(ctx context.Context, spec *v1beta1.ApplicationHttpProbe) error {
+	scheme := lo.FromPtrOr(spec.Scheme, v1beta1.ApplicationHttpProbeSchemeHttp)
+	url := fmt.Sprintf("%s://%s%s", scheme, net.JoinHostPort("localhost", strconv.Itoa(spec.Port)), lo.FromPtrOr(spec.Path, "/"))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("creating HTTP probe request: %w", err)
+	}
+
+	// the probe checks the application is serving, not the validity of its certificate
+	client := &http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}, //nolint:gosec
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	defer client.CloseIdleConnections()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("HTTP probe of %s failed: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("HTTP probe of %s failed with status code %d", url, resp.StatusCode)
+	}
+	return nil
+}
+
+func (p *probe) checkTcp(ctx context.Context, spec *v1beta1.ApplicationTcpProbe) error {
+	address := net.JoinHostPort("localhost", strconv.Itoa(spec.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("TCP probe of %s failed: %w", address, err)
+	}
+	return conn.Close()
+}
+
+func (p *probe) checkExec(ctx context.Context, spec *v1beta1.ApplicationExecProbe, workloads []Workload, exec containerExecFn) error {
+	container := lo.FromPtr(spec.Container)
+	if container == "" {
+		if len(workloads) != 1 {
+			return fmt.Errorf("exec probe must name the container to run in: the application runs %d containers", len(workloads))
+		}
+		container = workloads[0].Name
+	}
+	_, stderr, exitCode := exec(ctx, container, spec.Command...)
+	if exitCode != 0 {
+		if ctx.Err() != nil {
+			return fmt.Errorf("exec probe in container %s timed out after %s", container, p.timeout)
+		}
+		return fmt.Errorf("exec probe in container %s exited with code %d: %s", container, exitCode, strings.TrimSpace(stderr))
+	}
+	return nil
+}
+
+// HealthProbes evaluates the liveness and readiness probes of an application.
+type HealthProbes struct {
+	mu     sync.Mutex
+	probes []*probe
+}
+
+// NewHealthProbes returns the health probes declared by an application, or nil if it declares none.
+func NewHealthProbes(spec *provider.ApplicationSpec) *HealthProbes {
+	var liveness, readiness *v1beta1.ApplicationProbe
+	switch {
+	case spec.ContainerApp != nil:
+		liveness, readiness = spec.ContainerApp.LivenessProbe, spec.ContainerApp.ReadinessProbe
+	case spec.QuadletApp != nil:
+		liveness, readiness = spec.QuadletApp.LivenessProbe, spec.QuadletApp.ReadinessProbe
+	}
+
+	var probes []*probe
+	if liveness != nil {
+		probes = append(probes, newProbe(v1beta1.ApplicationProbeTypeLiveness, *liveness))
+	}
+	if readiness != nil {
+		probes = append(probes, newProbe(v1beta1.ApplicationProbeTypeReadiness, *readiness))
+	}
+	if len(probes) == 0 {
+		return nil
+	}
+	return &HealthProbes{probes: probes}
+}
+
+// Run runs the probes that are due. Probes only run while the application has a running workload. Their state is
+// reset whenever it has none, so that the initial delay applies again once the application is restarted.
+func (h *HealthProbes) Run(ctx context.Context, now time.Time, workloads []Workload, exec containerExecFn) {
+	running := lo.Filter(workloads, func(w Workload, _ int) bool { return w.Status == StatusRunning })
+
+	h.mu.Lock()
+	var due []*probe
+	for _, p := range h.probes {
+		switch {
+		case len(running) == 0:
+			p.reset()
+		case p.nextRun.IsZero():
+			p.nextRun = now.Add(p.initialDelay)
+			if p.initialDelay == 0 {
+				due = append(due, p)
+			}
+		case !now.Before(p.nextRun):
+			due = append(due, p)
+		}
+	}
+	h.mu.Unlock()
+
+	for _, p := range due {
+		err := p.check(ctx, running, exec)
+
+		h.mu.Lock()
+		p.record(err)
+		p.nextRun = now.Add(p.period)
+		h.mu.Unlock()
+	}
+}
+
+// result returns the result of the probe of the given type, and false if the application declares no such probe
+func (h *HealthProbes) result(probeType v1beta1.ApplicationProbeType) (v1beta1.ApplicationProbeResult, bool) {
+	for _, p := range h.probes {
+		if p.probeType == probeType {
+			return p.result, true
+		}
+	}
+	return "", false
+}
+
+// Statuses returns the current results of the probes
+func (h *HealthProbes) Statuses() []v1beta1.ApplicationProbeStatus {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	statuses := make([]v1beta1.ApplicationProbeStatus, 0, len(h.probes))
+	for _, p := range h.probes {
+		status := v1beta1.ApplicationProbeStatus{
+			Type:   p.probeType,
+			Result: p.result,
+		}
+		if p.message != "" {
+			status.Message = lo.ToPtr(p.message)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// Apply adjusts the status of a running application and its summary to the results of its probes. A failed
+// liveness probe makes the application errored. A readiness probe that has not succeeded yet keeps the application
+// starting, and a failed one degrades it.
+func (h *HealthProbes) Apply(status *v1beta1.ApplicationStatusType, summary *v1beta1.DeviceApplicationsSummaryStatus) {
+	if *status != v1beta1.ApplicationStatusRunning {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if result, ok := h.result(v1beta1.ApplicationProbeTypeLiveness); ok && result == v1beta1.ApplicationProbeResultFailure {
+		*status = v1beta1.ApplicationStatusError
+		summary.Status = v1beta1.ApplicationsSummaryStatusError
+		return
+	}
+	result, ok := h.result(v1beta1.ApplicationProbeTypeReadiness)
+	if !ok || summary.Status == v1beta1.ApplicationsSummaryStatusError {
+		return
+	}
+	switch result {
+	case v1beta1.ApplicationProbeResultUnknown:
+		*status = v1beta1.ApplicationStatusStarting
+		summary.Status = v1beta1.ApplicationsSummaryStatusDegraded
+	case v1beta1.ApplicationProbeResultFailure:
+		summary.Status = v1beta1.ApplicationsSummaryStatusDegraded
+	}
+}
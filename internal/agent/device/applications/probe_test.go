@@ -0,0 +1,241 @@
+package applications
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/provider"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func serverPort(t *testing.T, addr net.Addr) int {
+	t.Helper()
+	_, portStr, err := net.SplitHostPort(addr.String())
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+	return port
+}
+
+func noExec(context.Context, string, ...string) (string, string, int) {
+	return "", "unexpected exec", 1
+}
+
+func TestHealthProbesHttp(t *testing.T) {
+	require := require.New(t)
+
+	healthy := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("/healthz", r.URL.Path)
+		if !healthy {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	probes := NewHealthProbes(&provider.ApplicationSpec{
+		ContainerApp: &v1beta1.ContainerApplication{
+			ReadinessProbe: &v1beta1.ApplicationProbe{
+				Http:             &v1beta1.ApplicationHttpProbe{Port: serverPort(t, server.Listener.Addr()), Path: lo.ToPtr("/healthz")},
+				Period:           lo.ToPtr("10s"),
+				FailureThreshold: lo.ToPtr(2),
+			},
+		},
+	})
+	require.NotNil(probes)
+	running := []Workload{{Name: "app", Status: StatusRunning}}
+	ctx := context.Background()
+	now := time.Now()
+
+	require.Equal(v1beta1.ApplicationProbeResultUnknown, probes.Statuses()[0].Result)
+	probes.Run(ctx, now, running, noExec)
+	require.Equal(v1beta1.ApplicationProbeResultSuccess, probes.Statuses()[0].Result)
+
+	// the probe is not due again before its period has passed
+	healthy = false
+	probes.Run(ctx, now.Add(5*time.Second), running, noExec)
+	require.Equal(v1beta1.ApplicationProbeResultSuccess, probes.Statuses()[0].Result)
+
+	// a single failure is below the failure threshold
+	probes.Run(ctx, now.Add(10*time.Second), running, noExec)
+	require.Equal(v1beta1.ApplicationProbeResultSuccess, probes.Statuses()[0].Result)
+
+	probes.Run(ctx, now.Add(20*time.Second), running, noExec)
+	status := probes.Statuses()[0]
+	require.Equal(v1beta1.ApplicationProbeTypeReadiness, status.Type)
+	require.Equal(v1beta1.ApplicationProbeResultFailure, status.Result)
+	require.Contains(lo.FromPtr(status.Message), "status code 500")
+
+	// the probe is reset once the application no longer runs
+	probes.Run(ctx, now.Add(30*time.Second), []Workload{{Name: "app", Status: StatusDied}}, noExec)
+	status = probes.Statuses()[0]
+	require.Equal(v1beta1.ApplicationProbeResultUnknown, status.Result)
+	require.Nil(status.Message)
+}
+
+func TestHealthProbesTcp(t *testing.T) {
+	require := require.New(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	port := serverPort(t, listener.Addr())
+
+	probes := NewHealthProbes(&provider.ApplicationSpec{
+		QuadletApp: &v1beta1.QuadletApplication{
+			LivenessProbe: &v1beta1.ApplicationProbe{
+				Tcp:              &v1beta1.ApplicationTcpProbe{Port: port},
+				InitialDelay:     lo.ToPtr("30s"),
+				FailureThreshold: lo.ToPtr(1),
+			},
+		},
+	})
+	require.NotNil(probes)
+	running := []Workload{{Name: "app", Status: StatusRunning}}
+	ctx := context.Background()
+	now := time.Now()
+
+	// the first probe waits for the initial delay
+	probes.Run(ctx, now, running, noExec)
+	require.Equal(v1beta1.ApplicationProbeResultUnknown, probes.Statuses()[0].Result)
+
+	probes.Run(ctx, now.Add(30*time.Second), running, noExec)
+	require.Equal(v1beta1.ApplicationProbeResultSuccess, probes.Statuses()[0].Result)
+
+	require.NoError(listener.Close())
+	probes.Run(ctx, now.Add(40*time.Second), running, noExec)
+	require.Equal(v1beta1.ApplicationProbeResultFailure, probes.Statuses()[0].Result)
+}
+
+func TestHealthProbesExec(t *testing.T) {
+	require := require.New(t)
+
+	var execContainer string
+	exitCode := 0
+	exec := func(_ context.Context, container string, command ...string) (string, string, int) {
+		execContainer = container
+		require.Equal([]string{"pg_isready", "-q"}, command)
+		return "", "no response", exitCode
+	}
+	newProbes := func(container *string) *HealthProbes {
+		return NewHealthProbes(&provider.ApplicationSpec{
+			QuadletApp: &v1beta1.QuadletApplication{
+				ReadinessProbe: &v1beta1.ApplicationProbe{
+					Exec:             &v1beta1.ApplicationExecProbe{Command: []string{"pg_isready", "-q"}, Container: container},
+					FailureThreshold: lo.ToPtr(1),
+				},
+			},
+		})
+	}
+	ctx := context.Background()
+	now := time.Now()
+
+	// the command runs in the only container of the application
+	probes := newProbes(nil)
+	probes.Run(ctx, now, []Workload{{Name: "db", Status: StatusRunning}}, exec)
+	require.Equal("db", execContainer)
+	require.Equal(v1beta1.ApplicationProbeResultSuccess, probes.Statuses()[0].Result)
+
+	// an application with several containers must name the container
+	probes = newProbes(nil)
+	workloads := []Workload{{Name: "db", Status: StatusRunning}, {Name: "web", Status: StatusRunning}}
+	probes.Run(ctx, now, workloads, exec)
+	status := probes.Statuses()[0]
+	require.Equal(v1beta1.ApplicationProbeResultFailure, status.Result)
+	require.Contains(lo.FromPtr(status.Message), "must name the container")
+
+	exitCode = 2
+	probes = newProbes(lo.ToPtr("web"))
+	probes.Run(ctx, now, workloads, exec)
+	require.Equal("web", execContainer)
+	status = probes.Statuses()[0]
+	require.Equal(v1beta1.ApplicationProbeResultFailure, status.Result)
+	require.Contains(lo.FromPtr(status.Message), "exited with code 2: no response")
+}
+
+func TestHealthProbesApply(t *testing.T) {
+	tests := []struct {
+		name                  string
+		liveness              *v1beta1.ApplicationProbeResult
+		readiness             *v1beta1.ApplicationProbeResult
+		status                v1beta1.ApplicationStatusType
+		summary               v1beta1.ApplicationsSummaryStatusType
+		expectedStatus        v1beta1.ApplicationStatusType
+		expectedSummaryStatus v1beta1.ApplicationsSummaryStatusType
+	}{
+		{
+			name:                  "passing probes keep the application healthy",
+			liveness:              lo.ToPtr(v1beta1.ApplicationProbeResultSuccess),
+			readiness:             lo.ToPtr(v1beta1.ApplicationProbeResultSuccess),
+			status:                v1beta1.ApplicationStatusRunning,
+			summary:               v1beta1.ApplicationsSummaryStatusHealthy,
+			expectedStatus:        v1beta1.ApplicationStatusRunning,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusHealthy,
+		},
+		{
+			name:                  "failed liveness makes the application errored",
+			liveness:              lo.ToPtr(v1beta1.ApplicationProbeResultFailure),
+			readiness:             lo.ToPtr(v1beta1.ApplicationProbeResultSuccess),
+			status:                v1beta1.ApplicationStatusRunning,
+			summary:               v1beta1.ApplicationsSummaryStatusHealthy,
+			expectedStatus:        v1beta1.ApplicationStatusError,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusError,
+		},
+		{
+			name:                  "readiness not yet passed keeps the application starting",
+			readiness:             lo.ToPtr(v1beta1.ApplicationProbeResultUnknown),
+			status:                v1beta1.ApplicationStatusRunning,
+			summary:               v1beta1.ApplicationsSummaryStatusHealthy,
+			expectedStatus:        v1beta1.ApplicationStatusStarting,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusDegraded,
+		},
+		{
+			name:                  "failed readiness degrades the application",
+			readiness:             lo.ToPtr(v1beta1.ApplicationProbeResultFailure),
+			status:                v1beta1.ApplicationStatusRunning,
+			summary:               v1beta1.ApplicationsSummaryStatusHealthy,
+			expectedStatus:        v1beta1.ApplicationStatusRunning,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusDegraded,
+		},
+		{
+			name:                  "applications that are not running are left alone",
+			liveness:              lo.ToPtr(v1beta1.ApplicationProbeResultFailure),
+			status:                v1beta1.ApplicationStatusPreparing,
+			summary:               v1beta1.ApplicationsSummaryStatusUnknown,
+			expectedStatus:        v1beta1.ApplicationStatusPreparing,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probes := &HealthProbes{}
+			if tt.liveness != nil {
+				p := newProbe(v1beta1.ApplicationProbeTypeLiveness, v1beta1.ApplicationProbe{})
+				p.result = *tt.liveness
+				probes.probes = append(probes.probes, p)
+			}
+			if tt.readiness != nil {
+				p := newProbe(v1beta1.ApplicationProbeTypeReadiness, v1beta1.ApplicationProbe{})
+				p.result = *tt.readiness
+				probes.probes = append(probes.probes, p)
+			}
+			status := tt.status
+			summary := v1beta1.DeviceApplicationsSummaryStatus{Status: tt.summary}
+			probes.Apply(&status, &summary)
+			require.Equal(t, tt.expectedStatus, status)
+			require.Equal(t, tt.expectedSummaryStatus, summary.Status)
+		})
+	}
+}
+
+func TestNewHealthProbesWithoutProbes(t *testing.T) {
+	require.Nil(t, NewHealthProbes(&provider.ApplicationSpec{ContainerApp: &v1beta1.ContainerApplication{Image: "quay.io/app:v1"}}))
+	require.Nil(t, NewHealthProbes(&provider.ApplicationSpec{ComposeApp: &v1beta1.ComposeApplication{}}))
+}
@@ -150,7 +150,7 @@ func (p *containerProvider) Install(ctx context.Context) error {
 		return fmt.Errorf("generating quadlet: %w", err)
 	}
 
-	if err := installQuadlet(p.readWriter, p.log, p.spec.Path, quadletSystemdTargetPath(p.spec.User, p.spec.ID), p.spec.ID); err != nil {
+	if err := installQuadlet(p.readWriter, p.log, p.spec.Path, quadletSystemdTargetPath(p.spec.User, p.spec.ID), p.spec.ID, nil); err != nil {
 		return fmt.Errorf("installing container: %w", err)
 	}
 
@@ -240,8 +240,12 @@ func generateQuadlet(ctx context.Context, podman *client.Podman, rw fileio.ReadW
 		unit.Add(quadlet.ContainerGroup, quadlet.PublishPortKey, port)
 	}
 
-	unit.Add("Service", "Restart", "on-failure").
-		Add("Service", "RestartSec", "5").
+	restart, restartSec, err := restartDirectives(spec.RestartPolicy)
+	if err != nil {
+		return fmt.Errorf("restart policy: %w", err)
+	}
+	unit.Add("Service", "Restart", restart).
+		Add("Service", "RestartSec", restartSec).
 		Add("Install", "WantedBy", "default.target")
 
 	for _, vol := range lo.FromPtr(spec.Volumes) {
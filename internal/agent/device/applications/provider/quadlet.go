@@ -7,7 +7,9 @@ import (
 	"io/fs"
 	"path/filepath"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
@@ -310,7 +312,7 @@ func (p *quadletProvider) Install(ctx context.Context) error {
 		p.spec.Volume.AddVolumes(quadletVolumes)
 	}
 
-	if err := installQuadlet(p.readWriter, p.log, p.spec.Path, quadletSystemdTargetPath(p.spec.User, p.spec.ID), p.spec.ID); err != nil {
+	if err := installQuadlet(p.readWriter, p.log, p.spec.Path, quadletSystemdTargetPath(p.spec.User, p.spec.ID), p.spec.ID, p.spec.QuadletApp.RestartPolicy); err != nil {
 		return fmt.Errorf("installing quadlet: %w", err)
 	}
 
@@ -433,6 +435,8 @@ type quadletInstaller struct {
 	appUnitPath string
 	targetPath  string
 	appID       string
+	// restartPolicy overrides the restart behavior of the container and kube units, nil keeps their own
+	restartPolicy *v1beta1.ApplicationRestartPolicy
 }
 
 // installQuadlet prepares Podman quadlet files for use with flightctl by applying namespacing,
@@ -467,6 +471,7 @@ type quadletInstaller struct {
 //	For each quadlet type found, creates {appID}-.{type}.d/99-flightctl.conf containing:
 //	  - Label with project identifier for filtering (io.flightctl.quadlet.project={appID})
 //	  - EnvironmentFile directive pointing to .env (containers only, if .env exists)
+//	  - Restart and RestartSec directives of the restart policy (containers and kube, if set)
 //
 //	The 99- prefix ensures these overrides have high priority and are not overridden by
 //	user-provided drop-ins.
@@ -475,6 +480,7 @@ type quadletInstaller struct {
 //   - readWriter: File system interface for reading and writing files
 //   - path: Absolute path to directory containing quadlet files and drop-in directories
 //   - appID: Application identifier used as namespace prefix for all resources
+//   - restartPolicy: Restart policy of the application, nil to keep the restart behavior of the units
 //
 // Returns:
 //   - error if any operation fails during namespacing, reference updating, or override creation
@@ -500,13 +506,14 @@ type quadletInstaller struct {
 //	    myapp-.volume.d/
 //	      99-flightctl.conf      (flightctl overrides)
 //	    .env                     (preserved as-is)
-func installQuadlet(readWriter fileio.ReadWriter, logger *log.PrefixLogger, appUnitPath string, targetPath string, appID string) error {
+func installQuadlet(readWriter fileio.ReadWriter, logger *log.PrefixLogger, appUnitPath string, targetPath string, appID string, restartPolicy *v1beta1.ApplicationRestartPolicy) error {
 	q := &quadletInstaller{
-		readWriter:  readWriter,
-		logger:      logger,
-		appUnitPath: appUnitPath,
-		targetPath:  targetPath,
-		appID:       appID,
+		readWriter:    readWriter,
+		logger:        logger,
+		appUnitPath:   appUnitPath,
+		targetPath:    targetPath,
+		appID:         appID,
+		restartPolicy: restartPolicy,
 	}
 	return q.install()
 }
@@ -774,7 +781,8 @@ func (q *quadletInstaller) namespaceDropInDirectory(dirPath string) error {
 }
 
 // createQuadletDropIn creates a drop-in override directory and configuration file
-// for a specific quadlet type. It adds the project label, PartOf directive, and optionally the EnvironmentFile parameter.
+// for a specific quadlet type. It adds the project label, PartOf directive, and optionally the EnvironmentFile
+// parameter and the restart directives of the restart policy.
 func (q *quadletInstaller) createQuadletDropIn(extension string, hasEnvFile bool) error {
 	q.logger.Tracef("Creating drop-in for %s for app: %s", extension, q.appID)
 	dropInDir := filepath.Join(q.appUnitPath, fmt.Sprintf("%s-%s.d", q.appID, extension))
@@ -794,8 +802,12 @@ func (q *quadletInstaller) createQuadletDropIn(extension string, hasEnvFile bool
 	case quadlet.KubeExtension:
 		// [Kube] does not support Label=; pods/containers are cleaned up by
 		// podman kube down (ExecStop). Add boot-lifecycle settings instead.
-		unit.Add("Service", "Restart", "on-failure").
-			Add("Service", "RestartSec", "5").
+		restart, restartSec, err := restartDirectives(q.restartPolicy)
+		if err != nil {
+			return fmt.Errorf("restart policy: %w", err)
+		}
+		unit.Add("Service", "Restart", restart).
+			Add("Service", "RestartSec", restartSec).
 			Add("Install", "WantedBy", "default.target")
 	case quadlet.PodExtension:
 		// Pod quadlets don't have first class support for the LabelKey until v5.6
@@ -804,6 +816,16 @@ func (q *quadletInstaller) createQuadletDropIn(extension string, hasEnvFile bool
 		unit.Add(sectionName, quadlet.LabelKey, fmt.Sprintf("%s=%s", client.QuadletProjectLabelKey, q.appID))
 	}
 
+	// the restart policy of the application overrides the restart behavior of its containers
+	if q.restartPolicy != nil && extension == quadlet.ContainerExtension {
+		restart, restartSec, err := restartDirectives(q.restartPolicy)
+		if err != nil {
+			return fmt.Errorf("restart policy: %w", err)
+		}
+		unit.Add("Service", "Restart", restart).
+			Add("Service", "RestartSec", restartSec)
+	}
+
 	// Only containers support environment files
 	if hasEnvFile && extension == quadlet.ContainerExtension {
 		unit.Add(sectionName, quadlet.EnvironmentFileKey, filepath.Join(q.appUnitPath, ".env"))
@@ -821,6 +843,36 @@ func (q *quadletInstaller) createQuadletDropIn(extension string, hasEnvFile bool
 	return nil
 }
 
+// restartDirectives returns the values of the systemd Restart= and RestartSec= directives for the restart policy.
+// A nil policy and unset fields fall back to restarting on failure after five seconds.
+func restartDirectives(policy *v1beta1.ApplicationRestartPolicy) (string, string, error) {
+	restart, restartSec := "on-failure", "5"
+	if policy == nil {
+		return restart, restartSec, nil
+	}
+	switch lo.FromPtr(policy.Policy) {
+	case v1beta1.ApplicationRestartPolicyAlways:
+		restart = "always"
+	case v1beta1.ApplicationRestartPolicyNever:
+		restart = "no"
+	case v1beta1.ApplicationRestartPolicyOnFailure, "":
+	default:
+		return "", "", fmt.Errorf("unsupported restart policy %q", *policy.Policy)
+	}
+	if policy.RestartDelay != nil {
+		delay, err := time.ParseDuration(*policy.RestartDelay)
+		if err != nil {
+			return "", "", fmt.Errorf("parsing restart delay: %w", err)
+		}
+		restartSec = strconv.FormatInt(int64(delay/time.Second), 10)
+		if delay%time.Second != 0 {
+			// systemd takes sub-second delays with a unit, rounded up to the millisecond
+			restartSec = fmt.Sprintf("%dms", (delay+time.Millisecond-1)/time.Millisecond)
+		}
+	}
+	return restart, restartSec, nil
+}
+
 func defaultServiceName(basename, ext string) (string, error) {
 	switch ext {
 	case quadlet.ContainerExtension:
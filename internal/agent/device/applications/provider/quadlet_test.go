@@ -1467,7 +1467,7 @@ spec:
 			}
 
 			logger := log.NewPrefixLogger("test")
-			err = installQuadlet(rw, logger, "/", "/systemd-targets/myapp.target", tt.appID)
+			err = installQuadlet(rw, logger, "/", "/systemd-targets/myapp.target", tt.appID, nil)
 			require.NoError(t, err)
 
 			for _, expectedFile := range tt.expectedFiles {
@@ -1480,7 +1480,7 @@ spec:
 				}
 			}
 
-			err = installQuadlet(rw, logger, "/", "/systemd-targets/myapp.target", tt.appID)
+			err = installQuadlet(rw, logger, "/", "/systemd-targets/myapp.target", tt.appID, nil)
 			require.NoError(t, err, "second call to installQuadlet should succeed (idempotency)")
 
 			for _, expectedFile := range tt.expectedFiles {
@@ -1845,6 +1845,73 @@ func newContainerAppSpec(t *testing.T, image string, ports *[]v1beta1.Applicatio
 	}
 }
 
+func TestRestartDirectives(t *testing.T) {
+	tests := []struct {
+		name               string
+		policy             *v1beta1.ApplicationRestartPolicy
+		expectedRestart    string
+		expectedRestartSec string
+		wantErr            bool
+	}{
+		{
+			name:               "no policy",
+			expectedRestart:    "on-failure",
+			expectedRestartSec: "5",
+		},
+		{
+			name: "always with delay",
+			policy: &v1beta1.ApplicationRestartPolicy{
+				Policy:       lo.ToPtr(v1beta1.ApplicationRestartPolicyAlways),
+				RestartDelay: lo.ToPtr("1m"),
+			},
+			expectedRestart:    "always",
+			expectedRestartSec: "60",
+		},
+		{
+			name:               "sub-second delay",
+			policy:             &v1beta1.ApplicationRestartPolicy{RestartDelay: lo.ToPtr("500ms")},
+			expectedRestart:    "on-failure",
+			expectedRestartSec: "500ms",
+		},
+		{
+			name:               "fractional second delay",
+			policy:             &v1beta1.ApplicationRestartPolicy{RestartDelay: lo.ToPtr("1.5s")},
+			expectedRestart:    "on-failure",
+			expectedRestartSec: "1500ms",
+		},
+		{
+			name:               "sub-millisecond delay is rounded up",
+			policy:             &v1beta1.ApplicationRestartPolicy{RestartDelay: lo.ToPtr("1500us")},
+			expectedRestart:    "on-failure",
+			expectedRestartSec: "2ms",
+		},
+		{
+			name:               "never",
+			policy:             &v1beta1.ApplicationRestartPolicy{Policy: lo.ToPtr(v1beta1.ApplicationRestartPolicyNever)},
+			expectedRestart:    "no",
+			expectedRestartSec: "5",
+		},
+		{
+			name:    "unsupported policy",
+			policy:  &v1beta1.ApplicationRestartPolicy{Policy: lo.ToPtr(v1beta1.ApplicationRestartPolicyType("Sometimes"))},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restart, restartSec, err := restartDirectives(tt.policy)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedRestart, restart)
+			require.Equal(t, tt.expectedRestartSec, restartSec)
+		})
+	}
+}
+
 func TestGenerateQuadlet(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1868,6 +1935,23 @@ func TestGenerateQuadlet(t *testing.T) {
 				require.Contains(t, contentStr, "Image=nginx:latest")
 				require.NotContains(t, contentStr, "PodmanArgs")
 				require.NotContains(t, contentStr, "PublishPort")
+				require.Contains(t, contentStr, "Restart=on-failure")
+			},
+		},
+		{
+			name: "with restart policy",
+			spec: func() *v1beta1.ContainerApplication {
+				spec := newContainerAppSpec(t, "nginx:latest", nil, nil)
+				spec.RestartPolicy = &v1beta1.ApplicationRestartPolicy{
+					Policy:       lo.ToPtr(v1beta1.ApplicationRestartPolicyAlways),
+					RestartDelay: lo.ToPtr("30s"),
+				}
+				return spec
+			}(),
+			checkFileContents: func(t *testing.T, content []byte) {
+				contentStr := string(content)
+				require.Contains(t, contentStr, "Restart=always")
+				require.Contains(t, contentStr, "RestartSec=30")
 			},
 		},
 		{
@@ -79,6 +79,7 @@ func setupVars(t *testing.T) *vars {
 			"mydevice",
 			lo.Must(user.Current()).Username,
 			executor,
+			nil,
 			mockWatcher,
 			logger),
 		recvChan: make(chan lo.Tuple2[*grpc_v1.StreamResponse, error]),
@@ -0,0 +1,98 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/provider"
+)
+
+func validateLogsRequest(req *v1beta1.DeviceLogsRequest) error {
+	if (req.Application == "") == (req.Unit == "") {
+		return fmt.Errorf("exactly one of application and unit must be set")
+	}
+	if req.Tail != nil && *req.Tail < 0 {
+		return fmt.Errorf("tail must not be negative, got %d", *req.Tail)
+	}
+	return nil
+}
+
+// logsCommand returns the command that streams the logs requested by a console session. The journal of a
+// systemd unit is read by the agent, the logs of an application by the user the application runs as.
+func (c *Manager) logsCommand(ctx context.Context, req *v1beta1.DeviceLogsRequest) (*exec.Cmd, error) {
+	if err := validateLogsRequest(req); err != nil {
+		return nil, err
+	}
+	if req.Unit != "" {
+		journalctl := client.NewJournalctl(c.executor, v1beta1.RootUsername)
+		return journalctl.LogsCmd(ctx, req.Unit, req.Follow, req.Since, req.Tail), nil
+	}
+
+	label, user, err := c.applicationLogsSource(req.Application)
+	if err != nil {
+		return nil, err
+	}
+	if c.podmanFactory == nil {
+		return nil, fmt.Errorf("application logs are not supported")
+	}
+	podman, err := c.podmanFactory(user)
+	if err != nil {
+		return nil, fmt.Errorf("creating podman client for user %s: %w", user, err)
+	}
+	containers, err := podman.ListContainers(ctx, []string{label})
+	if err != nil {
+		return nil, err
+	}
+	if len(containers) == 0 {
+		return nil, fmt.Errorf("application %s has no containers", req.Application)
+	}
+	return podman.LogsCmd(ctx, containers, req.Follow, req.Since, req.Tail), nil
+}
+
+// applicationLogsSource returns the label of the containers of the application with the given name and the
+// user the application runs as.
+func (c *Manager) applicationLogsSource(name string) (string, v1beta1.Username, error) {
+	c.mu.Lock()
+	applications := c.applications
+	c.mu.Unlock()
+
+	for i := range applications {
+		appSpec := &applications[i]
+		appName, err := provider.ResolveImageAppName(appSpec)
+		if err != nil || appName != name {
+			continue
+		}
+		appType, err := appSpec.GetAppType()
+		if err != nil {
+			return "", "", fmt.Errorf("getting type of application %s: %w", name, err)
+		}
+
+		var user v1beta1.Username
+		labelKey := client.QuadletProjectLabelKey
+		switch appType {
+		case v1beta1.AppTypeCompose:
+			user = v1beta1.CurrentProcessUsername
+			labelKey = client.ComposeDockerProjectLabelKey
+		case v1beta1.AppTypeQuadlet:
+			app, err := appSpec.AsQuadletApplication()
+			if err != nil {
+				return "", "", fmt.Errorf("getting quadlet application %s: %w", name, err)
+			}
+			user = app.RunAsWithDefault()
+		case v1beta1.AppTypeContainer:
+			app, err := appSpec.AsContainerApplication()
+			if err != nil {
+				return "", "", fmt.Errorf("getting container application %s: %w", name, err)
+			}
+			user = app.RunAsWithDefault()
+		default:
+			return "", "", fmt.Errorf("logs of %s applications are not supported", appType)
+		}
+		return fmt.Sprintf("%s=%s", labelKey, lifecycle.GenerateAppID(name, user)), user, nil
+	}
+	return "", "", fmt.Errorf("application %s not found", name)
+}
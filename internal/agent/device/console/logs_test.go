@@ -0,0 +1,71 @@
+package console
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/flightctl/flightctl/pkg/poll"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLogsCommand(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	logger := log.NewPrefixLogger("test")
+
+	mockExec := executer.NewMockExecuter(ctrl)
+	var podmanFactory client.PodmanFactory = func(user v1beta1.Username) (*client.Podman, error) {
+		return client.NewPodman(logger, mockExec, nil, poll.Config{}), nil
+	}
+	manager := NewManager(nil, "mydevice", ConsoleUser, mockExec, podmanFactory, nil, logger)
+
+	var appSpec v1beta1.ApplicationProviderSpec
+	require.NoError(appSpec.FromContainerApplication(v1beta1.ContainerApplication{
+		Name:    lo.ToPtr("web"),
+		AppType: v1beta1.AppTypeContainer,
+		Image:   "quay.io/example/web:v1",
+	}))
+	manager.applications = []v1beta1.ApplicationProviderSpec{appSpec}
+
+	// the journal of a unit is read by the agent
+	since := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockExec.EXPECT().CommandContext(gomock.Any(), "/usr/bin/journalctl", "--no-pager", "-o", "short-iso", "-u", "crio.service",
+		"--since", since.Local().Format("2006-01-02 15:04:05")).Return(exec.Command("true"))
+	_, err := manager.logsCommand(ctx, &v1beta1.DeviceLogsRequest{Unit: "crio.service", Since: &since})
+	require.NoError(err)
+
+	// the logs of an application are the logs of its containers
+	label := client.QuadletProjectLabelKey + "=" + lifecycle.GenerateAppID("web", v1beta1.CurrentProcessUsername)
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", "ps", "-a", "--format", "{{.Names}}", "--filter", "label="+label).
+		Return("web\nweb-sidecar\n", "", 0)
+	mockExec.EXPECT().CommandContext(gomock.Any(), "podman", "logs", "--names", "--timestamps", "--follow", "--tail", "10", "web", "web-sidecar").
+		Return(exec.Command("true"))
+	_, err = manager.logsCommand(ctx, &v1beta1.DeviceLogsRequest{Application: "web", Follow: true, Tail: lo.ToPtr(10)})
+	require.NoError(err)
+
+	// an application without containers has no logs
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", "ps", "-a", "--format", "{{.Names}}", "--filter", "label="+label).
+		Return("", "", 0)
+	_, err = manager.logsCommand(ctx, &v1beta1.DeviceLogsRequest{Application: "web"})
+	require.ErrorContains(err, "has no containers")
+
+	_, err = manager.logsCommand(ctx, &v1beta1.DeviceLogsRequest{Application: "db"})
+	require.ErrorContains(err, "application db not found")
+
+	_, err = manager.logsCommand(ctx, &v1beta1.DeviceLogsRequest{Application: "web", Unit: "crio.service"})
+	require.ErrorContains(err, "exactly one of application and unit")
+
+	_, err = manager.logsCommand(ctx, &v1beta1.DeviceLogsRequest{Unit: "crio.service", Tail: lo.ToPtr(-1)})
+	require.ErrorContains(err, "tail must not be negative")
+}
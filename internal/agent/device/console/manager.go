@@ -9,6 +9,7 @@ import (
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/device/spec"
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/pkg/executer"
@@ -24,11 +25,14 @@ const (
 )
 
 type Manager struct {
-	grpcClient grpc_v1.RouterServiceClient
-	log        *log.PrefixLogger
-	deviceName string
-	watcher    spec.Watcher
-	user       string
+	grpcClient    grpc_v1.RouterServiceClient
+	log           *log.PrefixLogger
+	deviceName    string
+	watcher       spec.Watcher
+	user          string
+	podmanFactory client.PodmanFactory
+	// applications are the applications of the latest device spec, used to resolve log requests
+	applications []v1beta1.ApplicationProviderSpec
 
 	activeSessions   []*session
 	inactiveSessions []*session
@@ -47,16 +51,18 @@ func NewManager(
 	deviceName string,
 	user string,
 	executor executer.Executer,
+	podmanFactory client.PodmanFactory,
 	watcher spec.Watcher,
 	log *log.PrefixLogger,
 ) *Manager {
 	return &Manager{
-		grpcClient: grpcClient,
-		deviceName: deviceName,
-		user:       user,
-		executor:   executor,
-		watcher:    watcher,
-		log:        log,
+		grpcClient:    grpcClient,
+		deviceName:    deviceName,
+		user:          user,
+		executor:      executor,
+		podmanFactory: podmanFactory,
+		watcher:       watcher,
+		log:           log,
 	}
 }
 
@@ -122,10 +128,11 @@ func (c *Manager) selectProtocol(requestedProtocols []string) (string, error) {
 
 func (c *Manager) start(ctx context.Context, dc v1beta1.DeviceConsole) {
 	s := &session{
-		id:       dc.SessionID,
-		executor: c.executor,
-		log:      c.log,
-		user:     c.user,
+		id:          dc.SessionID,
+		executor:    c.executor,
+		log:         c.log,
+		user:        c.user,
+		logsCommand: c.logsCommand,
 	}
 	if !c.add(s) {
 		return
@@ -163,6 +170,10 @@ func (c *Manager) sync(ctx context.Context, desired *v1beta1.DeviceSpec) {
 	c.log.Debug("Syncing console status")
 	defer c.log.Debug("Finished syncing console status")
 
+	c.mu.Lock()
+	c.applications = lo.FromPtr(desired.Applications)
+	c.mu.Unlock()
+
 	desiredConsoles := desired.GetConsoles()
 
 	for _, d := range desiredConsoles {
@@ -23,6 +23,8 @@ type session struct {
 	executor          executer.Executer
 	inactiveTimestamp time.Time
 	user              string
+	// logsCommand builds the command of sessions that stream logs instead of running a shell
+	logsCommand func(context.Context, *api.DeviceLogsRequest) (*exec.Cmd, error)
 }
 
 func (s *session) getHomedir() (string, error) {
@@ -89,6 +91,20 @@ func (s *session) buildBashCommand(ctx context.Context, metadata *api.DeviceCons
 	return ret
 }
 
+// buildCommand returns the command that the session runs: the logs command of a logs session, a shell otherwise.
+func (s *session) buildCommand(ctx context.Context, metadata *api.DeviceConsoleSessionMetadata) (*exec.Cmd, error) {
+	if metadata.Logs == nil {
+		return s.buildBashCommand(ctx, metadata), nil
+	}
+	if metadata.TTY || metadata.Command != nil {
+		return nil, fmt.Errorf("logs sessions do not support a tty or a command")
+	}
+	if s.logsCommand == nil {
+		return nil, fmt.Errorf("logs sessions are not supported")
+	}
+	return s.logsCommand(ctx, metadata.Logs)
+}
+
 func (s *session) startProcess(metadata *api.DeviceConsoleSessionMetadata, cmd *exec.Cmd) (stdin io.WriteCloser, stdout, stderr io.ReadCloser, fd uintptr, err error) {
 	if metadata.TTY {
 		// create a new PTY
@@ -124,7 +140,10 @@ func (s *session) startProcess(metadata *api.DeviceConsoleSessionMetadata, cmd *
 }
 
 func (s *session) initialize(ctx context.Context, cancel context.CancelFunc, metadata *api.DeviceConsoleSessionMetadata) (*incomingStreams, *outgoingStreams, error) {
-	cmd := s.buildBashCommand(ctx, metadata)
+	cmd, err := s.buildCommand(ctx, metadata)
+	if err != nil {
+		return nil, nil, err
+	}
 	stdin, stdout, stderr, resizeFd, err := s.startProcess(metadata, cmd)
 	if err != nil {
 		return nil, nil, err
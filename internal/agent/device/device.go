@@ -568,6 +568,10 @@ func (a *Agent) afterUpdate(ctx context.Context, current, desired *v1beta1.Devic
 	// after the os is updated.This happens because the os update requires a
 	// reboot so the lower blocks are not executed until after reboot.
 	if !isOSReconciled && a.specManager.IsOSUpdate() {
+		// the applications start after the reboot, so their postStart hooks run then
+		if err := a.applicationsController.DeferAfterUpdate(); err != nil {
+			a.log.Warnf("Failed to defer application hooks: %v", err)
+		}
 		if err = a.afterUpdateOS(ctx, desired); err != nil {
 			a.log.Errorf("Error executing OS: %v", err)
 			return err
@@ -589,6 +593,12 @@ func (a *Agent) afterUpdate(ctx context.Context, current, desired *v1beta1.Devic
 		return err
 	}
 
+	// execute postStart hooks of the started applications
+	if err := a.applicationsController.AfterUpdate(ctx); err != nil {
+		a.log.Errorf("Error executing application hooks: %v", err)
+		return err
+	}
+
 	return nil
 }
 
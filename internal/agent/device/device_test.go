@@ -402,8 +402,8 @@ func TestSync(t *testing.T) {
 			var rwFactory fileio.ReadWriterFactory = func(username v1beta1.Username) (fileio.ReadWriter, error) {
 				return readWriter, nil
 			}
-			consoleManager := console.NewManager(mockRouterService, deviceName, "root", mockExec, mockWatcher, log)
-			appController := applications.NewController(podmanFactory, nil, mockAppManager, rwFactory, log, "2025-01-01T00:00:00Z")
+			consoleManager := console.NewManager(mockRouterService, deviceName, "root", mockExec, podmanFactory, mockWatcher, log)
+			appController := applications.NewController(podmanFactory, nil, mockAppManager, hook.NewMockManager(ctrl), rwFactory, readWriter, "/data", log, "2025-01-01T00:00:00Z")
 			statusManager := status.NewManager(deviceName, log)
 			statusManager.SetClient(mockManagementClient)
 			configController := config.NewController(readWriter, log)
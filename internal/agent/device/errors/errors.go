@@ -102,6 +102,8 @@ var (
 	ErrNotExist    = os.ErrNotExist
 	ErrInvalidPath = errors.New("invalid path")
 
+	ErrDecryptingContents = errors.New("decrypting contents")
+
 	// images
 	ErrImageNotFound     = errors.New("image not found")
 	ErrImageUnauthorized = errors.New("image unauthorized")
@@ -179,6 +181,8 @@ var (
 	ErrUnknownHookConditionType             = errors.New("unknown hook condition type")
 	ErrFailedToExecute                      = errors.New("failed to execute")
 	ErrLookingForHook                       = errors.New("looking for hook")
+	ErrHttpActionInvalid                    = errors.New("invalid http action")
+	ErrUnexpectedHttpStatus                 = errors.New("unexpected HTTP status")
 
 	// OS errors
 	ErrUnableToParseImageReference = errors.New("unable to parse image reference into a valid bootc target")
@@ -363,6 +367,8 @@ var (
 		ErrUnknownHookConditionType:             codes.Internal,
 		ErrFailedToExecute:                      codes.Unavailable,
 		ErrLookingForHook:                       codes.InvalidArgument,
+		ErrHttpActionInvalid:                    codes.InvalidArgument,
+		ErrUnexpectedHttpStatus:                 codes.Unavailable,
 
 		// OS errors
 		ErrUnableToParseImageReference: codes.InvalidArgument,
@@ -2,6 +2,7 @@ package fileio
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
 	"os"
 	"syscall"
@@ -9,23 +10,26 @@ import (
 	"github.com/ccoveille/go-safecast"
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 )
 
 type managedFile struct {
-	file     v1beta1.FileSpec
-	exists   bool
-	size     int64
-	perms    os.FileMode
-	uid      int
-	gid      int
-	contents []byte
-	writer   Writer
+	file       v1beta1.FileSpec
+	exists     bool
+	size       int64
+	perms      os.FileMode
+	uid        int
+	gid        int
+	contents   []byte
+	writer     Writer
+	contentKey ContentKeyFn
 }
 
-func newManagedFile(f v1beta1.FileSpec, writer Writer) (ManagedFile, error) {
+func newManagedFile(f v1beta1.FileSpec, writer Writer, contentKey ContentKeyFn) (ManagedFile, error) {
 	mf := &managedFile{
-		file:   f,
-		writer: writer,
+		file:       f,
+		writer:     writer,
+		contentKey: contentKey,
 	}
 	if err := mf.initExistingFileMetadata(); err != nil {
 		return nil, err
@@ -55,7 +59,7 @@ func (m *managedFile) decodeFile() error {
 	if m.contents != nil {
 		return nil
 	}
-	contents, err := m.file.ContentsDecoded()
+	contents, err := m.contentsDecoded()
 	if err != nil {
 		return fmt.Errorf("%w: %w", err, errors.WithElement(m.Path()))
 	}
@@ -74,6 +78,30 @@ func (m *managedFile) decodeFile() error {
 	return nil
 }
 
+// contentsDecoded decodes the contents of the file. Encrypted contents are decrypted with the content key
+// of the writer, authenticating the path of the file along with them.
+func (m *managedFile) contentsDecoded() ([]byte, error) {
+	if m.file.ContentEncoding == nil || *m.file.ContentEncoding != v1beta1.EncodingEncrypted {
+		return m.file.ContentsDecoded()
+	}
+	if m.contentKey == nil {
+		return nil, fmt.Errorf("%w: no key to decrypt contents with", errors.ErrDecryptingContents)
+	}
+	key, err := m.contentKey()
+	if err != nil {
+		return nil, fmt.Errorf("%w: getting key: %w", errors.ErrDecryptingContents, err)
+	}
+	envelope, err := base64.StdEncoding.DecodeString(m.file.Content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: decoding base64 content: %w", errors.ErrDecryptingContents, err)
+	}
+	contents, err := fccrypto.DecryptWithKeyAgreement(key, envelope, []byte(m.file.Path))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errors.ErrDecryptingContents, err)
+	}
+	return contents, nil
+}
+
 func (m *managedFile) isUpToDate() (bool, error) {
 	if err := m.decodeFile(); err != nil {
 		return false, err
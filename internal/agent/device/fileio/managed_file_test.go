@@ -1,11 +1,17 @@
 package fileio
 
 import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 )
@@ -51,7 +57,7 @@ func TestExists(t *testing.T) {
 				require.NoError(err)
 			}
 
-			managed, err := newManagedFile(tt.f, writer)
+			managed, err := newManagedFile(tt.f, writer, nil)
 			if tt.expectedError != nil {
 				require.Error(err)
 				require.ErrorIs(err, tt.expectedError)
@@ -134,6 +140,64 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
+func TestWriteEncryptedContent(t *testing.T) {
+	require := require.New(t)
+	testUid, testGid, err := getUserIdentity()
+	require.NoError(err)
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(err)
+
+	path := "/etc/app/password"
+	envelope, err := fccrypto.EncryptToPublicKey(key.PublicKey(), []byte("hunter2"), []byte(path))
+	require.NoError(err)
+	file := v1beta1.FileSpec{
+		Path:            path,
+		Content:         base64.StdEncoding.EncodeToString(envelope),
+		ContentEncoding: lo.ToPtr(v1beta1.EncodingEncrypted),
+		Mode:            lo.ToPtr(0o600),
+		User:            v1beta1.Username(strconv.Itoa(testUid)),
+		Group:           strconv.Itoa(testGid),
+	}
+
+	t.Run("decrypts the contents with the content key", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		writer := NewWriter(WithWriterRootDir(tmpDir), WithContentKey(func() (fccrypto.KeyAgreement, error) {
+			return key, nil
+		}))
+		managed, err := writer.CreateManagedFile(file)
+		require.NoError(err)
+		require.NoError(managed.Write())
+
+		contents, err := os.ReadFile(filepath.Join(tmpDir, path))
+		require.NoError(err)
+		require.Equal("hunter2", string(contents))
+
+		managed, err = writer.CreateManagedFile(file)
+		require.NoError(err)
+		upToDate, err := managed.IsUpToDate()
+		require.NoError(err)
+		require.True(upToDate)
+	})
+
+	t.Run("fails without a content key", func(t *testing.T) {
+		writer := NewWriter(WithWriterRootDir(t.TempDir()))
+		managed, err := writer.CreateManagedFile(file)
+		require.NoError(err)
+		require.ErrorIs(managed.Write(), errors.ErrDecryptingContents)
+	})
+
+	t.Run("fails for contents encrypted for another path", func(t *testing.T) {
+		writer := NewWriter(WithWriterRootDir(t.TempDir()), WithContentKey(func() (fccrypto.KeyAgreement, error) {
+			return key, nil
+		}))
+		moved := file
+		moved.Path = "/etc/app/other"
+		managed, err := writer.CreateManagedFile(moved)
+		require.NoError(err)
+		require.ErrorIs(managed.Write(), errors.ErrDecryptingContents)
+	})
+}
+
 func createTestFile(path, data string, mode, user, group int) *v1beta1.FileSpec {
 	return &v1beta1.FileSpec{
 		Path:    path,
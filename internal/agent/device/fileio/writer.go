@@ -17,6 +17,7 @@ import (
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/google/renameio"
 )
 
@@ -28,8 +29,13 @@ type writer struct {
 	uid int
 	// Default GID of the owner of any files created or moved. -1 disabled gid handling.
 	gid int
+	// contentKey returns the key that encrypted contents of managed files are decrypted with
+	contentKey ContentKeyFn
 }
 
+// ContentKeyFn returns the key that file contents encrypted to the device are decrypted with.
+type ContentKeyFn func() (fccrypto.KeyAgreement, error)
+
 type symlinkBehavior int
 
 const (
@@ -92,9 +98,10 @@ func WithPreserveSymlinkWithinRoot() CopyDirOption {
 }
 
 type writerOptions struct {
-	uid     int
-	gid     int
-	rootDir string
+	uid        int
+	gid        int
+	rootDir    string
+	contentKey ContentKeyFn
 }
 
 type WriterOption func(*writerOptions)
@@ -117,6 +124,14 @@ func WithWriterRootDir(rootDir string) WriterOption {
 	}
 }
 
+// WithContentKey sets the key that encrypted contents of managed files are decrypted with. Without it,
+// managed files with encrypted contents cannot be written.
+func WithContentKey(contentKey ContentKeyFn) WriterOption {
+	return func(wo *writerOptions) {
+		wo.contentKey = contentKey
+	}
+}
+
 // New creates a new writer
 func NewWriter(options ...WriterOption) *writer {
 	opts := writerOptions{
@@ -130,9 +145,10 @@ func NewWriter(options ...WriterOption) *writer {
 		o(&opts)
 	}
 	return &writer{
-		uid:     opts.uid,
-		gid:     opts.gid,
-		rootDir: opts.rootDir,
+		uid:        opts.uid,
+		gid:        opts.gid,
+		rootDir:    opts.rootDir,
+		contentKey: opts.contentKey,
 	}
 }
 
@@ -473,7 +489,7 @@ func (w *writer) preserveSymlinkWithinRoot(srcPath, dstPath string, opts *copyDi
 }
 
 func (w *writer) CreateManagedFile(file v1beta1.FileSpec) (ManagedFile, error) {
-	return newManagedFile(file, w)
+	return newManagedFile(file, w, w.contentKey)
 }
 
 func (w *writer) OverwriteAndWipe(file string) error {
@@ -3,6 +3,8 @@ package hook
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"os/exec"
 	"reflect"
@@ -15,12 +17,21 @@ import (
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 )
 
 type CommandLineVarKey string
 
 const (
 	DefaultHookActionTimeout = 10 * time.Second
+	// DefaultHttpActionRetryInterval is the time waited between the attempts of an HTTP action
+	DefaultHttpActionRetryInterval = time.Second
+	// maxHttpActionResponseLength bounds the part of an error response included in the error of an HTTP action
+	maxHttpActionResponseLength = 256
+
+	// HookKey defines the name of the variable that contains the name of
+	// the lifecycle hook the action runs in
+	HookKey CommandLineVarKey = "Hook"
 
 	// PathKey defines the name of the variable that contains the path operated on
 	PathKey CommandLineVarKey = "Path"
@@ -40,10 +51,16 @@ const (
 	// separated list of files backed up before removal from the system
 	// into a temporary location deleted after the action completes.
 	BackupKey CommandLineVarKey = "BackupFiles"
+	// ApplicationKey defines the name of the variable that contains the
+	// name of the application whose lifecycle hook the action runs in
+	ApplicationKey CommandLineVarKey = "Application"
 )
 
 type actionContext struct {
-	hook            api.DeviceLifecycleHookType
+	// hook is the name of the device or application lifecycle hook
+	hook string
+	// app is the name of the application for application lifecycle hooks
+	app             string
 	systemRebooted  bool
 	createdFiles    map[string]api.FileSpec
 	updatedFiles    map[string]api.FileSpec
@@ -53,7 +70,7 @@ type actionContext struct {
 
 func newActionContext(hook api.DeviceLifecycleHookType, current *api.DeviceSpec, desired *api.DeviceSpec, systemRebooted bool) *actionContext {
 	actionContext := &actionContext{
-		hook:            hook,
+		hook:            string(hook),
 		systemRebooted:  systemRebooted,
 		createdFiles:    make(map[string]api.FileSpec),
 		updatedFiles:    make(map[string]api.FileSpec),
@@ -73,11 +90,26 @@ func newActionContext(hook api.DeviceLifecycleHookType, current *api.DeviceSpec,
 	return actionContext
 }
 
+func newApplicationActionContext(app string, hook api.ApplicationLifecycleHookType) *actionContext {
+	actionContext := &actionContext{
+		hook:            string(hook),
+		app:             app,
+		createdFiles:    make(map[string]api.FileSpec),
+		updatedFiles:    make(map[string]api.FileSpec),
+		removedFiles:    make(map[string]api.FileSpec),
+		commandLineVars: make(map[CommandLineVarKey]string),
+	}
+	resetCommandLineVars(actionContext)
+	return actionContext
+}
+
 func resetCommandLineVars(actionCtx *actionContext) {
 	clear(actionCtx.commandLineVars)
 	for _, key := range []CommandLineVarKey{PathKey, FilesKey, CreatedKey, UpdatedKey, RemovedKey, BackupKey} {
 		actionCtx.commandLineVars[key] = ""
 	}
+	actionCtx.commandLineVars[HookKey] = actionCtx.hook
+	actionCtx.commandLineVars[ApplicationKey] = actionCtx.app
 }
 
 func computeFileDiff(actionCtx *actionContext, current *api.DeviceSpec, desired *api.DeviceSpec) {
@@ -123,6 +155,12 @@ func executeAction(ctx context.Context, exec executer.Executer, log *log.PrefixL
 			return err
 		}
 		return executeRunAction(ctx, exec, log, runAction, actionCtx)
+	case api.HookActionTypeHttp:
+		httpAction, err := action.AsHookActionHttp()
+		if err != nil {
+			return err
+		}
+		return executeHttpAction(ctx, log, httpAction, actionCtx)
 	default:
 		return fmt.Errorf("%w: %q", errors.ErrUnknownHookActionType, actionType)
 	}
@@ -167,6 +205,89 @@ func executeRunAction(ctx context.Context, exec executer.Executer, log *log.Pref
 	return nil
 }
 
+func executeHttpAction(ctx context.Context, log *log.PrefixLogger, action api.HookActionHttp, actionCtx *actionContext) error {
+	spec := action.Http
+	method := string(lo.FromPtrOr(spec.Method, api.HookActionHttpMethodPost))
+	// render variables in the url, headers and body if they exist
+	url := replaceTokens(spec.Url, actionCtx.commandLineVars)
+	body := replaceTokens(lo.FromPtr(spec.Body), actionCtx.commandLineVars)
+	headers := make(map[string]string, len(lo.FromPtr(spec.Headers)))
+	for key, value := range lo.FromPtr(spec.Headers) {
+		headers[key] = replaceTokens(value, actionCtx.commandLineVars)
+	}
+
+	retryInterval := DefaultHttpActionRetryInterval
+	if spec.RetryInterval != nil {
+		var err error
+		retryInterval, err = time.ParseDuration(*spec.RetryInterval)
+		if err != nil {
+			return fmt.Errorf("%w: retryInterval: %w", errors.ErrHttpActionInvalid, err)
+		}
+	}
+
+	retries := lo.FromPtr(spec.Retries)
+	var err error
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
+			log.Warnf("Hook %s request %s %s failed, retrying in %s (%d/%d): %v", actionCtx.hook, method, url, retryInterval, attempt, retries, err)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("%w %w: %w", ctx.Err(), errors.WithElement(url), err)
+			case <-time.After(retryInterval):
+			}
+		}
+		var retryable bool
+		retryable, err = sendHttpRequest(ctx, method, url, headers, body)
+		if err == nil {
+			log.Infof("Hook %s sent %s %s without error", actionCtx.hook, method, url)
+			return nil
+		}
+		if !retryable {
+			break
+		}
+	}
+
+	log.Errorf("Hook %s request %s %s failed: %v", actionCtx.hook, method, url, err)
+	return err
+}
+
+// sendHttpRequest sends the request of an HTTP action and returns an error unless the endpoint responds with a 2xx
+// status code. It also returns whether a failed request should be retried.
+func sendHttpRequest(ctx context.Context, method, url string, headers map[string]string, body string) (bool, error) {
+	var reqBody io.Reader
+	if body != "" {
+		reqBody = strings.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return false, fmt.Errorf("%w %w: %w", errors.ErrHttpActionInvalid, errors.WithElement(url), err)
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	client := &http.Client{}
+	defer client.CloseIdleConnections()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return false, fmt.Errorf("%w %w: %w", ctx.Err(), errors.WithElement(url), err)
+		}
+		return true, fmt.Errorf("%w %w: %w", errors.ErrNetwork, errors.WithElement(url), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxHttpActionResponseLength))
+	// client errors other than timeouts and rate limits fail the same way when they are retried
+	retryable := resp.StatusCode >= http.StatusInternalServerError ||
+		resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode == http.StatusTooManyRequests
+	return retryable, fmt.Errorf("%w %w: %d: %s", errors.ErrUnexpectedHttpStatus, errors.WithElement(url), resp.StatusCode, strings.TrimSpace(string(respBody)))
+}
+
 func dirExists(path string) (bool, error) {
 	info, err := os.Stat(path)
 	if err == nil {
@@ -331,6 +452,9 @@ func checkActionDependency(action api.HookAction) error {
 			return err
 		}
 		return checkRunActionDependency(runAction)
+	case api.HookActionTypeHttp:
+		// the endpoint is only known to be available once the request is sent
+		return nil
 	default:
 		return fmt.Errorf("%w: %q", errors.ErrUnknownHookActionType, actionType)
 	}
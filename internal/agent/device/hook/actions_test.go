@@ -1,13 +1,20 @@
 package hook
 
 import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"testing"
+	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 )
 
@@ -278,3 +285,76 @@ func BenchmarkReplaceTokensOptimized(b *testing.B) {
 		replaceTokens(testString, testTokens)
 	}
 }
+
+func TestExecuteHttpAction(t *testing.T) {
+	require := require.New(t)
+
+	var requests int
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		require.Equal(http.MethodPut, r.Method)
+		require.Equal("/gateway/BeforeRebooting", r.URL.Path)
+		require.Equal("Bearer token", r.Header.Get("Authorization"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(err)
+		require.Equal(`{"event":"BeforeRebooting"}`, string(body))
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("try again later"))
+	}))
+	defer server.Close()
+
+	action := v1beta1.HookActionHttp{
+		Http: v1beta1.HookActionHttpSpec{
+			Url:           server.URL + "/gateway/${ Hook }",
+			Method:        lo.ToPtr(v1beta1.HookActionHttpMethodPut),
+			Headers:       &map[string]string{"Authorization": "Bearer token"},
+			Body:          lo.ToPtr(`{"event":"${Hook}"}`),
+			Retries:       lo.ToPtr(2),
+			RetryInterval: lo.ToPtr("10ms"),
+		},
+	}
+	actionCtx := newActionContext(v1beta1.DeviceLifecycleHookBeforeRebooting, nil, nil, false)
+	logger := log.NewPrefixLogger("test")
+	ctx := context.Background()
+
+	require.NoError(executeHttpAction(ctx, logger, action, actionCtx))
+	require.Equal(1, requests)
+
+	// server errors are retried until the retries are used up
+	requests = 0
+	status = http.StatusServiceUnavailable
+	err := executeHttpAction(ctx, logger, action, actionCtx)
+	require.ErrorIs(err, errors.ErrUnexpectedHttpStatus)
+	require.Contains(err.Error(), "503: try again later")
+	require.Equal(server.URL+"/gateway/BeforeRebooting", errors.GetElement(err))
+	require.Equal(3, requests)
+
+	// client errors are not retried
+	requests = 0
+	status = http.StatusBadRequest
+	err = executeHttpAction(ctx, logger, action, actionCtx)
+	require.ErrorIs(err, errors.ErrUnexpectedHttpStatus)
+	require.Equal(1, requests)
+
+	// retries stop once the action times out
+	requests = 0
+	status = http.StatusInternalServerError
+	action.Http.RetryInterval = lo.ToPtr("1h")
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	err = executeHttpAction(timeoutCtx, logger, action, actionCtx)
+	require.ErrorIs(err, context.DeadlineExceeded)
+	require.Equal(1, requests)
+}
+
+func TestExecuteHttpActionUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	action := v1beta1.HookActionHttp{Http: v1beta1.HookActionHttpSpec{Url: url}}
+	actionCtx := newActionContext(v1beta1.DeviceLifecycleHookBeforeUpdating, nil, nil, false)
+	err := executeHttpAction(context.Background(), log.NewPrefixLogger("test"), action, actionCtx)
+	require.ErrorIs(t, err, errors.ErrNetwork)
+}
@@ -35,6 +35,8 @@ type Manager interface {
 	OnAfterUpdating(ctx context.Context, current *api.DeviceSpec, desired *api.DeviceSpec, systemRebooted bool) error
 	OnBeforeRebooting(ctx context.Context) error
 	OnAfterRebooting(ctx context.Context) error
+	// OnApplication performs the actions of a lifecycle hook of an application.
+	OnApplication(ctx context.Context, app string, hook api.ApplicationLifecycleHookType, actions []api.HookAction) error
 }
 
 type manager struct {
@@ -76,11 +78,19 @@ func (m *manager) OnAfterRebooting(ctx context.Context) error {
 	return m.loadAndExecuteActions(ctx, actionCtx)
 }
 
+func (m *manager) OnApplication(ctx context.Context, app string, hook api.ApplicationLifecycleHookType, actions []api.HookAction) error {
+	m.log.Debugf("Starting %s hook of application %s", hook, app)
+	defer m.log.Debugf("Finished %s hook of application %s", hook, app)
+
+	actionCtx := newApplicationActionContext(app, hook)
+	return m.executeActions(ctx, actions, actionCtx)
+}
+
 func (m *manager) loadAndExecuteActions(ctx context.Context, actionCtx *actionContext) error {
 	m.log.Debugf("Starting hook manager On%s()", actionCtx.hook)
 	defer m.log.Debugf("Finished hook manager On%s()", actionCtx.hook)
 
-	actions, err := m.loadAndMergeActions(actionCtx.hook)
+	actions, err := m.loadAndMergeActions(api.DeviceLifecycleHookType(actionCtx.hook))
 	if err != nil {
 		return err
 	}
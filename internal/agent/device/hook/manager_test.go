@@ -115,6 +115,21 @@ func TestHookManager(t *testing.T) {
 	}
 }
 
+func TestHookManagerOnApplication(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExecuter := executer.NewMockExecuter(ctrl)
+	hookManager := NewManager(createTempHooksDir(t, nil), mockExecuter, log.NewPrefixLogger("test"))
+
+	var action v1beta1.HookAction
+	require.NoError(action.FromHookActionRun(v1beta1.HookActionRun{Run: "logger -t flightctl ${ Hook } ${ Application }"}))
+	expectExecCalls(mockExecuter, []command{{"logger", []string{"-t", "flightctl", "PreStop", "orders-db"}}})
+
+	require.NoError(hookManager.OnApplication(context.Background(), "orders-db", v1beta1.ApplicationLifecycleHookPreStop, []v1beta1.HookAction{action}))
+}
+
 const testHookPathToFile = `
 - if:
   - path: /etc/someservice/some.config
@@ -68,6 +68,20 @@ func (mr *MockManagerMockRecorder) OnAfterUpdating(ctx, current, desired, system
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnAfterUpdating", reflect.TypeOf((*MockManager)(nil).OnAfterUpdating), ctx, current, desired, systemRebooted)
 }
 
+// OnApplication mocks base method.
+func (m *MockManager) OnApplication(ctx context.Context, app string, hook v1beta1.ApplicationLifecycleHookType, actions []v1beta1.HookAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnApplication", ctx, app, hook, actions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnApplication indicates an expected call of OnApplication.
+func (mr *MockManagerMockRecorder) OnApplication(ctx, app, hook, actions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnApplication", reflect.TypeOf((*MockManager)(nil).OnApplication), ctx, app, hook, actions)
+}
+
 // OnBeforeRebooting mocks base method.
 func (m *MockManager) OnBeforeRebooting(ctx context.Context) error {
 	m.ctrl.T.Helper()
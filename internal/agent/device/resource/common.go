@@ -7,6 +7,7 @@ import (
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 )
 
 func updateMonitor(
@@ -128,6 +129,46 @@ func getMonitorSpec(monitor *v1beta1.ResourceMonitor) (*MonitorSpec, error) {
 				AlertRules:       spec.AlertRules,
 			},
 		}, nil
+	case NetworkMonitorType:
+		spec, err := monitor.AsNetworkResourceMonitorSpec()
+		if err != nil {
+			return nil, err
+		}
+		return &MonitorSpec{
+			ResourceMonitorSpec: v1beta1.ResourceMonitorSpec{
+				SamplingInterval: spec.SamplingInterval,
+				AlertRules:       spec.AlertRules,
+			},
+			Interface: spec.Interface,
+			Metric:    string(spec.Metric),
+			LinkSpeed: lo.FromPtr(spec.LinkSpeed),
+		}, nil
+	case TemperatureMonitorType:
+		spec, err := monitor.AsTemperatureResourceMonitorSpec()
+		if err != nil {
+			return nil, err
+		}
+		return &MonitorSpec{
+			ResourceMonitorSpec: v1beta1.ResourceMonitorSpec{
+				SamplingInterval: spec.SamplingInterval,
+				AlertRules:       spec.AlertRules,
+			},
+			Zone:           spec.Zone,
+			MaxTemperature: lo.FromPtr(spec.MaxTemperature),
+		}, nil
+	case ProcessMonitorType:
+		spec, err := monitor.AsProcessResourceMonitorSpec()
+		if err != nil {
+			return nil, err
+		}
+		return &MonitorSpec{
+			ResourceMonitorSpec: v1beta1.ResourceMonitorSpec{
+				SamplingInterval: spec.SamplingInterval,
+				AlertRules:       spec.AlertRules,
+			},
+			Name:   spec.Name,
+			Metric: string(spec.Metric),
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown monitor type: %s", monitorType)
 	}
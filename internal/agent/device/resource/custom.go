@@ -0,0 +1,277 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+)
+
+const (
+	DefaultCustomMonitorTimeout = 10 * time.Second
+	// customMonitorTickInterval is how often the monitor checks whether any script is due
+	customMonitorTickInterval = time.Second
+)
+
+var _ Monitor[CustomUsage] = (*CustomMonitor)(nil)
+
+// customScript is a custom monitor of the device spec together with the state of its evaluation.
+type customScript struct {
+	alerts  map[v1beta1.ResourceAlertSeverityType]*Alert
+	timeout time.Duration
+	// maxValue is the value the output of the command is relative to, zero if the output is a percentage
+	maxValue float64
+
+	samplingInterval time.Duration
+	// nextRun is when the script is due next, zero if it has not run yet
+	nextRun time.Time
+}
+
+// CustomMonitor runs the executables of the custom monitors of the device spec and evaluates their output against
+// the alert rules of each monitor. Monitors are identified by their name, which is also the name of their
+// executable in the CustomMonitorScriptDir directory. The device spec can only select executables that were
+// installed on the device, it cannot choose the command or its arguments.
+type CustomMonitor struct {
+	mu      sync.Mutex
+	scripts map[string]*customScript
+
+	exec   executer.Executer
+	reader fileio.Reader
+	log    *log.PrefixLogger
+}
+
+func NewCustomMonitor(
+	log *log.PrefixLogger,
+	exec executer.Executer,
+	reader fileio.Reader,
+) *CustomMonitor {
+	return &CustomMonitor{
+		scripts: make(map[string]*customScript),
+		exec:    exec,
+		reader:  reader,
+		log:     log,
+	}
+}
+
+func (m *CustomMonitor) Run(ctx context.Context) {
+	defer m.log.Infof("Custom monitor stopped")
+	ticker := time.NewTicker(customMonitorTickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.sync(ctx, now)
+		}
+	}
+}
+
+func (m *CustomMonitor) Update(monitor *v1beta1.ResourceMonitor) (bool, error) {
+	spec, err := monitor.AsCustomResourceMonitorSpec()
+	if err != nil {
+		return false, err
+	}
+	if spec.Name == "" || spec.Name != filepath.Base(spec.Name) || spec.Name == ".." {
+		return false, fmt.Errorf("invalid custom monitor name %q", spec.Name)
+	}
+	samplingInterval, err := time.ParseDuration(spec.SamplingInterval)
+	if err != nil {
+		return false, err
+	}
+	timeout := DefaultCustomMonitorTimeout
+	if spec.Timeout != nil {
+		timeout, err = time.ParseDuration(*spec.Timeout)
+		if err != nil {
+			return false, err
+		}
+	}
+	maxValue := float64(lo.FromPtr(spec.MaxValue))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	script, ok := m.scripts[spec.Name]
+	if !ok {
+		script = &customScript{alerts: make(map[v1beta1.ResourceAlertSeverityType]*Alert)}
+	}
+
+	updated, err := updateAlerts(spec.AlertRules, script.alerts)
+	if err != nil {
+		return updated, err
+	}
+	if !ok {
+		m.scripts[spec.Name] = script
+		updated = true
+	}
+
+	if script.timeout != timeout || script.maxValue != maxValue || script.samplingInterval != samplingInterval {
+		script.timeout = timeout
+		script.maxValue = maxValue
+		script.samplingInterval = samplingInterval
+		// run the changed script on the next tick
+		script.nextRun = time.Time{}
+		updated = true
+	}
+
+	return updated, nil
+}
+
+// Retain removes the custom monitors whose names are not in the given set and returns true if any was removed.
+func (m *CustomMonitor) Retain(names map[string]struct{}) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	updated := false
+	for name := range m.scripts {
+		if _, ok := names[name]; !ok {
+			delete(m.scripts, name)
+			updated = true
+		}
+	}
+	return updated
+}
+
+func (m *CustomMonitor) Alerts() []v1beta1.ResourceAlertRule {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var firing []v1beta1.ResourceAlertRule
+	for name, script := range m.scripts {
+		for _, alert := range script.alerts {
+			if !alert.IsFiring() {
+				continue
+			}
+			rule := alert.ResourceAlertRule
+			// several custom monitors share the status, so the generated description names the monitor
+			if rule.Description == "" {
+				rule.Description = fmt.Sprintf("custom monitor %s is above %d%% for more than %s", name, int64(rule.Percentage), rule.Duration)
+			}
+			firing = append(firing, rule)
+		}
+	}
+	return firing
+}
+
+// Enabled returns true if the device spec configures any custom monitor.
+func (m *CustomMonitor) Enabled() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, script := range m.scripts {
+		if len(script.alerts) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// CollectUsage runs the executable of the custom monitor named in the usage and parses its output.
+func (m *CustomMonitor) CollectUsage(ctx context.Context, usage *CustomUsage) error {
+	m.mu.Lock()
+	script, ok := m.scripts[usage.Name]
+	if !ok {
+		m.mu.Unlock()
+		return fmt.Errorf("custom monitor %s not found", usage.Name)
+	}
+	timeout, maxValue := script.timeout, script.maxValue
+	m.mu.Unlock()
+
+	command := filepath.Join(m.reader.PathFor(config.CustomMonitorScriptDir), usage.Name)
+	info, err := os.Stat(command)
+	if err != nil {
+		return fmt.Errorf("custom monitor executable %s: %w", usage.Name, err)
+	}
+	if !info.Mode().IsRegular() || info.Mode()&0111 == 0 {
+		return fmt.Errorf("custom monitor executable %s is not an executable file", usage.Name)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	stdout, stderr, exitCode := m.exec.ExecuteWithContext(ctx, command)
+	if exitCode != 0 {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return errors.FromStderr(stderr, exitCode)
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(stdout), 64)
+	if err != nil {
+		return fmt.Errorf("parsing output of %s: %w", command, err)
+	}
+	usage.Value = value
+	usage.UsedPercent = int64(value)
+	if maxValue > 0 {
+		usage.UsedPercent = int64(value / maxValue * 100)
+	}
+	usage.lastCollectedAt = time.Now()
+	return nil
+}
+
+// sync runs the scripts that are due and evaluates their output against their alert rules.
+func (m *CustomMonitor) sync(ctx context.Context, now time.Time) {
+	m.mu.Lock()
+	var due []string
+	for name, script := range m.scripts {
+		if len(script.alerts) == 0 || now.Before(script.nextRun) {
+			continue
+		}
+		script.nextRun = now.Add(script.samplingInterval)
+		due = append(due, name)
+	}
+	m.mu.Unlock()
+
+	for _, name := range due {
+		m.log.Debugf("Checking custom monitor %s", name)
+		usage := CustomUsage{Name: name}
+		if err := m.CollectUsage(ctx, &usage); err != nil {
+			if errors.IsContext(err) {
+				m.log.Warnf("Custom monitor script %s timed out", name)
+			} else {
+				m.log.Errorf("Failed to collect custom monitor %s: %v", name, err)
+			}
+			continue
+		}
+		m.ensureAlerts(name, usage.UsedPercent)
+	}
+}
+
+func (m *CustomMonitor) ensureAlerts(name string, percentageUsed int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// the monitor can be removed while its script runs
+	script, ok := m.scripts[name]
+	if !ok {
+		return
+	}
+	m.log.Tracef("Custom monitor %s usage: %d%%", name, percentageUsed)
+	for _, alert := range script.alerts {
+		alert.Sync(percentageUsed)
+	}
+}
+
+// CustomUsage represents the output of the command of a custom monitor on this device
+type CustomUsage struct {
+	// Name is the name of the custom monitor
+	Name string
+	// Value is the number printed by the command
+	Value float64
+
+	UsedPercent int64
+
+	lastCollectedAt time.Time
+}
@@ -0,0 +1,156 @@
+package resource
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newCustomMonitor(t *testing.T, name string, maxValue *float32) *v1beta1.ResourceMonitor {
+	t.Helper()
+	rm := &v1beta1.ResourceMonitor{}
+	require.NoError(t, rm.FromCustomResourceMonitorSpec(v1beta1.CustomResourceMonitorSpec{
+		SamplingInterval: "1m",
+		Name:             name,
+		MaxValue:         maxValue,
+		AlertRules: []v1beta1.ResourceAlertRule{
+			{Severity: v1beta1.ResourceAlertSeverityTypeWarning, Percentage: 40, Duration: "0s"},
+			{Severity: v1beta1.ResourceAlertSeverityTypeCritical, Percentage: 60, Duration: "0s"},
+		},
+	}))
+	return rm
+}
+
+// newCustomMonitorReadWriter returns a read writer rooted in a temporary directory that holds the given
+// executables in the custom monitor directory.
+func newCustomMonitorReadWriter(t *testing.T, scripts map[string]string) (fileio.ReadWriter, string) {
+	t.Helper()
+	tmpDir := t.TempDir()
+	rw := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+	require.NoError(t, rw.MkdirAll(config.CustomMonitorScriptDir, fileio.DefaultDirectoryPermissions))
+	for name, content := range scripts {
+		require.NoError(t, rw.WriteFile(filepath.Join(config.CustomMonitorScriptDir, name), []byte(content), fileio.DefaultExecutablePermissions))
+	}
+	return rw, rw.PathFor(config.CustomMonitorScriptDir)
+}
+
+func TestCustomMonitor(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExec := executer.NewMockExecuter(ctrl)
+	rw, scriptDir := newCustomMonitorReadWriter(t, map[string]string{"ups": "#!/bin/sh\n"})
+	ups := filepath.Join(scriptDir, "ups")
+	customMonitor := NewCustomMonitor(log.NewPrefixLogger("test"), mockExec, rw)
+	require.False(customMonitor.Enabled())
+
+	// the output is relative to the max value
+	updated, err := customMonitor.Update(newCustomMonitor(t, "ups", lo.ToPtr(float32(200))))
+	require.NoError(err)
+	require.True(updated)
+	require.True(customMonitor.Enabled())
+
+	now := time.Now()
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), ups).Return(" 100\n", "", 0)
+	customMonitor.sync(ctx, now)
+	alerts := customMonitor.Alerts()
+	require.Len(alerts, 1)
+	require.Equal(v1beta1.ResourceAlertSeverityTypeWarning, alerts[0].Severity)
+	require.Contains(alerts[0].Description, "custom monitor ups")
+
+	// the script is not due again before its sampling interval has passed
+	customMonitor.sync(ctx, now.Add(30*time.Second))
+
+	// a failing script leaves the alerts as they are
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), ups).Return("", "no such device", 1)
+	customMonitor.sync(ctx, now.Add(time.Minute))
+	require.Len(customMonitor.Alerts(), 1)
+
+	// output that is not a number is an error
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), ups).Return("full", "", 0)
+	customMonitor.sync(ctx, now.Add(2*time.Minute))
+	require.Len(customMonitor.Alerts(), 1)
+
+	// without a max value the output is a percentage
+	updated, err = customMonitor.Update(newCustomMonitor(t, "ups", nil))
+	require.NoError(err)
+	require.True(updated)
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), ups).Return("75.5", "", 0)
+	customMonitor.sync(ctx, now.Add(2*time.Minute))
+	require.Len(customMonitor.Alerts(), 2)
+
+	// an unchanged spec is not an update
+	updated, err = customMonitor.Update(newCustomMonitor(t, "ups", nil))
+	require.NoError(err)
+	require.False(updated)
+
+	// monitors that are no longer configured are removed
+	require.False(customMonitor.Retain(map[string]struct{}{"ups": {}}))
+	require.True(customMonitor.Retain(nil))
+	require.False(customMonitor.Enabled())
+	require.Empty(customMonitor.Alerts())
+}
+
+func TestCustomMonitorTimeout(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	rw, _ := newCustomMonitorReadWriter(t, map[string]string{"slow": "#!/bin/sh\nexec sleep 10\n"})
+	customMonitor := NewCustomMonitor(log.NewPrefixLogger("test"), executer.NewCommonExecuter(), rw)
+	rm := &v1beta1.ResourceMonitor{}
+	require.NoError(rm.FromCustomResourceMonitorSpec(v1beta1.CustomResourceMonitorSpec{
+		SamplingInterval: "1m",
+		Name:             "slow",
+		Timeout:          lo.ToPtr("100ms"),
+		AlertRules: []v1beta1.ResourceAlertRule{
+			{Severity: v1beta1.ResourceAlertSeverityTypeCritical, Percentage: 0, Duration: "0s"},
+		},
+	}))
+	_, err := customMonitor.Update(rm)
+	require.NoError(err)
+
+	start := time.Now()
+	usage := CustomUsage{Name: "slow"}
+	err = customMonitor.CollectUsage(ctx, &usage)
+	require.Error(err)
+	require.Less(time.Since(start), 5*time.Second)
+}
+
+func TestCustomMonitorExecutable(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// the mock executer fails the test if anything is executed
+	mockExec := executer.NewMockExecuter(ctrl)
+	rw, _ := newCustomMonitorReadWriter(t, nil)
+	require.NoError(rw.WriteFile(filepath.Join(config.CustomMonitorScriptDir, "readonly"), []byte("#!/bin/sh\n"), fileio.DefaultFilePermissions))
+	customMonitor := NewCustomMonitor(log.NewPrefixLogger("test"), mockExec, rw)
+
+	for _, name := range []string{"missing", "readonly"} {
+		_, err := customMonitor.Update(newCustomMonitor(t, name, nil))
+		require.NoError(err)
+		usage := CustomUsage{Name: name}
+		require.Error(customMonitor.CollectUsage(ctx, &usage))
+	}
+
+	// names cannot leave the custom monitor directory
+	_, err := customMonitor.Update(newCustomMonitor(t, "../../../bin/sh", nil))
+	require.Error(err)
+}
@@ -207,6 +207,84 @@ func (mr *MockMonitorMockRecorder[T]) Update(monitor any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMonitor[T])(nil).Update), monitor)
 }
 
+// MockOptionalMonitor is a mock of OptionalMonitor interface.
+type MockOptionalMonitor[T any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockOptionalMonitorMockRecorder[T]
+}
+
+// MockOptionalMonitorMockRecorder is the mock recorder for MockOptionalMonitor.
+type MockOptionalMonitorMockRecorder[T any] struct {
+	mock *MockOptionalMonitor[T]
+}
+
+// NewMockOptionalMonitor creates a new mock instance.
+func NewMockOptionalMonitor[T any](ctrl *gomock.Controller) *MockOptionalMonitor[T] {
+	mock := &MockOptionalMonitor[T]{ctrl: ctrl}
+	mock.recorder = &MockOptionalMonitorMockRecorder[T]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOptionalMonitor[T]) EXPECT() *MockOptionalMonitorMockRecorder[T] {
+	return m.recorder
+}
+
+// Alerts mocks base method.
+func (m *MockOptionalMonitor[T]) Alerts() []v1beta1.ResourceAlertRule {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Alerts")
+	ret0, _ := ret[0].([]v1beta1.ResourceAlertRule)
+	return ret0
+}
+
+// Alerts indicates an expected call of Alerts.
+func (mr *MockOptionalMonitorMockRecorder[T]) Alerts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Alerts", reflect.TypeOf((*MockOptionalMonitor[T])(nil).Alerts))
+}
+
+// Enabled mocks base method.
+func (m *MockOptionalMonitor[T]) Enabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Enabled indicates an expected call of Enabled.
+func (mr *MockOptionalMonitorMockRecorder[T]) Enabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enabled", reflect.TypeOf((*MockOptionalMonitor[T])(nil).Enabled))
+}
+
+// Run mocks base method.
+func (m *MockOptionalMonitor[T]) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockOptionalMonitorMockRecorder[T]) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockOptionalMonitor[T])(nil).Run), ctx)
+}
+
+// Update mocks base method.
+func (m *MockOptionalMonitor[T]) Update(monitor *v1beta1.ResourceMonitor) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", monitor)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockOptionalMonitorMockRecorder[T]) Update(monitor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOptionalMonitor[T])(nil).Update), monitor)
+}
+
 // MockCollector is a mock of Collector interface.
 type MockCollector[T any] struct {
 	ctrl     *gomock.Controller
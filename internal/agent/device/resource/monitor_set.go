@@ -0,0 +1,160 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+)
+
+var _ OptionalMonitor[NetworkUsage] = (*monitorSet[NetworkUsage])(nil)
+
+// optionalMonitorSet is the part of a monitor set that does not depend on the type of its usage.
+type optionalMonitorSet interface {
+	Retain(keys map[string]struct{}) bool
+	keyOf(spec *MonitorSpec) string
+}
+
+// monitorSet runs an instance of an optional monitor for each network interface, thermal zone or process name that
+// the device spec monitors. Instances are created when the device spec configures their key and stopped once it no
+// longer does.
+type monitorSet[T any] struct {
+	mu          sync.Mutex
+	monitorType string
+	instances   map[string]*monitorInstance[T]
+	key         func(spec *MonitorSpec) string
+	newMonitor  func() OptionalMonitor[T]
+
+	// ctx is the context of Run, nil while the set is not running
+	ctx context.Context
+	wg  sync.WaitGroup
+
+	log *log.PrefixLogger
+}
+
+type monitorInstance[T any] struct {
+	monitor OptionalMonitor[T]
+	cancel  context.CancelFunc
+}
+
+func newMonitorSet[T any](
+	log *log.PrefixLogger,
+	monitorType string,
+	key func(spec *MonitorSpec) string,
+	newMonitor func() OptionalMonitor[T],
+) *monitorSet[T] {
+	return &monitorSet[T]{
+		monitorType: monitorType,
+		instances:   make(map[string]*monitorInstance[T]),
+		key:         key,
+		newMonitor:  newMonitor,
+		log:         log,
+	}
+}
+
+func (s *monitorSet[T]) Run(ctx context.Context) {
+	s.mu.Lock()
+	s.ctx = ctx
+	for _, instance := range s.instances {
+		s.start(instance)
+	}
+	s.mu.Unlock()
+
+	<-ctx.Done()
+
+	s.mu.Lock()
+	s.ctx = nil
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+// start runs the monitor of an instance until the instance is removed or the set stops. It must be called with the
+// lock held.
+func (s *monitorSet[T]) start(instance *monitorInstance[T]) {
+	if s.ctx == nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(s.ctx)
+	instance.cancel = cancel
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		instance.monitor.Run(ctx)
+	}()
+}
+
+// Update applies the monitor to the instance of its key and creates the instance if it does not exist yet.
+func (s *monitorSet[T]) Update(monitor *v1beta1.ResourceMonitor) (bool, error) {
+	spec, err := getMonitorSpec(monitor)
+	if err != nil {
+		return false, err
+	}
+	key := s.key(spec)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if instance, ok := s.instances[key]; ok {
+		return instance.monitor.Update(monitor)
+	}
+
+	instance := &monitorInstance[T]{monitor: s.newMonitor()}
+	if _, err := instance.monitor.Update(monitor); err != nil {
+		return false, err
+	}
+	s.instances[key] = instance
+	s.start(instance)
+	return true, nil
+}
+
+// Retain stops the instances whose keys are not in the given set and returns true if any was stopped.
+func (s *monitorSet[T]) Retain(keys map[string]struct{}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	updated := false
+	for key, instance := range s.instances {
+		if _, ok := keys[key]; ok {
+			continue
+		}
+		if instance.cancel != nil {
+			instance.cancel()
+		}
+		delete(s.instances, key)
+		updated = true
+	}
+	return updated
+}
+
+func (s *monitorSet[T]) Alerts() []v1beta1.ResourceAlertRule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var firing []v1beta1.ResourceAlertRule
+	for key, instance := range s.instances {
+		for _, rule := range instance.monitor.Alerts() {
+			// several instances share the status, so the generated description names the instance
+			if rule.Description == "" {
+				rule.Description = fmt.Sprintf("%s %s usage is above %d%% for more than %s", s.monitorType, key, int64(rule.Percentage), rule.Duration)
+			}
+			firing = append(firing, rule)
+		}
+	}
+	return firing
+}
+
+// Enabled returns true if the device spec configures any instance.
+func (s *monitorSet[T]) Enabled() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return lo.SomeBy(lo.Values(s.instances), func(instance *monitorInstance[T]) bool {
+		return instance.monitor.Enabled()
+	})
+}
+
+// keyOf returns the key of the instance that a monitor spec applies to.
+func (s *monitorSet[T]) keyOf(spec *MonitorSpec) string {
+	return s.key(spec)
+}
@@ -0,0 +1,277 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	DefaultNetworkSyncTimeout = 5 * time.Second
+	DefaultSysClassNetPath    = "/sys/class/net"
+)
+
+var _ Monitor[NetworkUsage] = (*NetworkMonitor)(nil)
+
+type NetworkMonitor struct {
+	mu     sync.Mutex
+	alerts map[v1beta1.ResourceAlertSeverityType]*Alert
+	// iface is the monitored network interface
+	iface  string
+	metric v1beta1.NetworkMonitorMetric
+	// linkSpeed is the configured link speed in Mbit/s, zero to use the speed reported by the interface
+	linkSpeed int
+
+	updateIntervalCh chan time.Duration
+	samplingInterval time.Duration
+	sysClassNetPath  string
+	prevUsage        *NetworkUsage
+
+	log *log.PrefixLogger
+}
+
+func NewNetworkMonitor(
+	log *log.PrefixLogger,
+) *NetworkMonitor {
+	return &NetworkMonitor{
+		alerts:           make(map[v1beta1.ResourceAlertSeverityType]*Alert),
+		updateIntervalCh: make(chan time.Duration, 1),
+		samplingInterval: DefaultSamplingInterval,
+		sysClassNetPath:  DefaultSysClassNetPath,
+		log:              log,
+	}
+}
+
+func (m *NetworkMonitor) Run(ctx context.Context) {
+	defer m.log.Infof("Network monitor stopped")
+	samplingInterval := m.getSamplingInterval()
+	ticker := time.NewTicker(samplingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case newInterval := <-m.updateIntervalCh:
+			ticker.Reset(newInterval)
+		case <-ticker.C:
+			m.log.Debug("Checking network usage")
+			usage := NetworkUsage{}
+			m.sync(ctx, &usage)
+		}
+	}
+}
+
+func (m *NetworkMonitor) Update(monitor *v1beta1.ResourceMonitor) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	spec, err := getMonitorSpec(monitor)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := updateMonitor(m.log, monitor, &m.samplingInterval, m.alerts, m.updateIntervalCh)
+	if err != nil {
+		return updated, err
+	}
+
+	metric := v1beta1.NetworkMonitorMetric(spec.Metric)
+	if spec.Interface != m.iface || metric != m.metric || spec.LinkSpeed != m.linkSpeed {
+		m.iface = spec.Interface
+		m.metric = metric
+		m.linkSpeed = spec.LinkSpeed
+		// counters of a different interface cannot be compared
+		m.prevUsage = nil
+		updated = true
+	}
+
+	return updated, nil
+}
+
+func (m *NetworkMonitor) Alerts() []v1beta1.ResourceAlertRule {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var firing []v1beta1.ResourceAlertRule
+	for _, alert := range m.alerts {
+		if alert.IsFiring() {
+			firing = append(firing, alert.ResourceAlertRule)
+		}
+	}
+	return firing
+}
+
+// Enabled returns true if the device spec configures the network monitor.
+func (m *NetworkMonitor) Enabled() bool {
+	return m.hasAlertRules()
+}
+
+func (m *NetworkMonitor) CollectUsage(ctx context.Context, usage *NetworkUsage) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		m.mu.Lock()
+		iface := m.iface
+		m.mu.Unlock()
+
+		if iface == "" {
+			return fmt.Errorf("no network interface configured")
+		}
+		return collectNetworkUsage(filepath.Join(m.sysClassNetPath, iface), usage)
+	}
+}
+
+func (m *NetworkMonitor) sync(ctx context.Context, current *NetworkUsage) {
+	if !m.hasAlertRules() {
+		m.log.Debug("Skipping network usage sync: no alert rules")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultNetworkSyncTimeout)
+	defer cancel()
+
+	if err := m.CollectUsage(ctx, current); err != nil {
+		m.log.Errorf("Failed to collect network usage: %v", err)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// init on first sync
+	if m.prevUsage == nil {
+		m.prevUsage = current
+		return
+	}
+
+	percentage, err := networkPercentage(m.prevUsage, current, m.metric, m.linkSpeed)
+	m.prevUsage = current
+	if err != nil {
+		m.log.Warnf("Failed to calculate network usage of %s: %v", m.iface, err)
+		return
+	}
+	current.UsedPercent = percentage
+
+	m.log.Tracef("Network %s usage of %s: %d%%", m.metric, m.iface, current.UsedPercent)
+	for _, alert := range m.alerts {
+		alert.Sync(current.UsedPercent)
+	}
+}
+
+func (m *NetworkMonitor) hasAlertRules() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.alerts) > 0
+}
+
+func (m *NetworkMonitor) getSamplingInterval() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.samplingInterval
+}
+
+// collectNetworkUsage reads the counters of the interface at the given sysfs path.
+func collectNetworkUsage(ifacePath string, usage *NetworkUsage) error {
+	counters := []struct {
+		name  string
+		value *uint64
+	}{
+		{"rx_bytes", &usage.RxBytes},
+		{"tx_bytes", &usage.TxBytes},
+		{"rx_packets", &usage.RxPackets},
+		{"tx_packets", &usage.TxPackets},
+		{"rx_errors", &usage.RxErrors},
+		{"tx_errors", &usage.TxErrors},
+	}
+	for _, counter := range counters {
+		value, err := readSysfsInt(filepath.Join(ifacePath, "statistics", counter.name))
+		if err != nil {
+			return err
+		}
+		if value < 0 {
+			return fmt.Errorf("invalid %s counter: %d", counter.name, value)
+		}
+		*counter.value = uint64(value)
+	}
+
+	// the speed is unknown for virtual interfaces and links that are down, reading it then fails or returns -1
+	speed, err := readSysfsInt(filepath.Join(ifacePath, "speed"))
+	if err == nil && speed > 0 {
+		usage.Speed = speed
+	}
+
+	usage.lastCollectedAt = time.Now()
+	return nil
+}
+
+// networkPercentage returns the usage of the metric between two samples of the interface counters.
+func networkPercentage(prev, current *NetworkUsage, metric v1beta1.NetworkMonitorMetric, linkSpeed int) (int64, error) {
+	if current.RxBytes < prev.RxBytes || current.TxBytes < prev.TxBytes ||
+		current.RxPackets < prev.RxPackets || current.TxPackets < prev.TxPackets ||
+		current.RxErrors < prev.RxErrors || current.TxErrors < prev.TxErrors {
+		return 0, fmt.Errorf("interface counters were reset")
+	}
+
+	switch metric {
+	case v1beta1.NetworkMonitorMetricThroughput:
+		speed := int64(linkSpeed)
+		if speed == 0 {
+			speed = current.Speed
+		}
+		if speed <= 0 {
+			return 0, fmt.Errorf("the interface does not report its speed: set linkSpeed")
+		}
+		elapsed := current.lastCollectedAt.Sub(prev.lastCollectedAt).Seconds()
+		if elapsed <= 0 {
+			return 0, fmt.Errorf("invalid sampling interval: %fs", elapsed)
+		}
+		// links are full duplex, so the busier direction is what saturates first
+		bytes := max(current.RxBytes-prev.RxBytes, current.TxBytes-prev.TxBytes)
+		bitsPerSecond := float64(bytes) * 8 / elapsed
+		return int64(bitsPerSecond / (float64(speed) * 1e6) * 100), nil
+	case v1beta1.NetworkMonitorMetricErrors:
+		// the packet counters only include packets that were transferred without errors
+		errs := (current.RxErrors - prev.RxErrors) + (current.TxErrors - prev.TxErrors)
+		packets := (current.RxPackets - prev.RxPackets) + (current.TxPackets - prev.TxPackets) + errs
+		if packets == 0 {
+			return 0, nil
+		}
+		return int64(float64(errs) / float64(packets) * 100), nil
+	default:
+		return 0, fmt.Errorf("unknown network metric: %q", metric)
+	}
+}
+
+// readSysfsInt reads a file of sysfs that contains a single integer.
+func readSysfsInt(path string) (int64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// NetworkUsage represents the counters of a network interface of this device
+type NetworkUsage struct {
+	RxBytes   uint64
+	TxBytes   uint64
+	RxPackets uint64
+	TxPackets uint64
+	RxErrors  uint64
+	TxErrors  uint64
+	// Speed is the speed reported by the interface in Mbit/s, zero if it is unknown
+	Speed int64
+
+	UsedPercent int64
+
+	lastCollectedAt time.Time
+}
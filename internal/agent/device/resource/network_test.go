@@ -0,0 +1,147 @@
+package resource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func writeNetworkCounters(t *testing.T, ifacePath string, counters map[string]int64) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Join(ifacePath, "statistics"), 0755))
+	for name, value := range counters {
+		path := filepath.Join(ifacePath, "statistics", name)
+		if name == "speed" {
+			path = filepath.Join(ifacePath, name)
+		}
+		require.NoError(t, os.WriteFile(path, []byte(strconv.FormatInt(value, 10)+"\n"), 0600))
+	}
+}
+
+func TestNetworkMonitor(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	sysClassNet := t.TempDir()
+	ifacePath := filepath.Join(sysClassNet, "eth0")
+	counters := map[string]int64{
+		"rx_bytes": 0, "tx_bytes": 0, "rx_packets": 1000, "tx_packets": 1000, "rx_errors": 0, "tx_errors": 0, "speed": 1000,
+	}
+	writeNetworkCounters(t, ifacePath, counters)
+
+	networkMonitor := NewNetworkMonitor(log.NewPrefixLogger("test"))
+	networkMonitor.sysClassNetPath = sysClassNet
+
+	rm := &v1beta1.ResourceMonitor{}
+	require.NoError(rm.FromNetworkResourceMonitorSpec(v1beta1.NetworkResourceMonitorSpec{
+		SamplingInterval: "1s",
+		Interface:        "eth0",
+		Metric:           v1beta1.NetworkMonitorMetricErrors,
+		AlertRules: []v1beta1.ResourceAlertRule{
+			{Severity: v1beta1.ResourceAlertSeverityTypeWarning, Percentage: 5, Duration: "0s"},
+			{Severity: v1beta1.ResourceAlertSeverityTypeCritical, Percentage: 50, Duration: "0s"},
+		},
+	}))
+	updated, err := networkMonitor.Update(rm)
+	require.NoError(err)
+	require.True(updated)
+	require.True(networkMonitor.Enabled())
+
+	// the first sample only initializes the counters
+	networkMonitor.sync(ctx, &NetworkUsage{})
+	require.Empty(networkMonitor.Alerts())
+
+	// 100 of 1100 packets failed
+	counters["rx_packets"] += 500
+	counters["tx_packets"] += 500
+	counters["rx_errors"] += 100
+	writeNetworkCounters(t, ifacePath, counters)
+	networkMonitor.sync(ctx, &NetworkUsage{})
+	alerts := networkMonitor.Alerts()
+	require.Len(alerts, 1)
+	require.Equal(v1beta1.ResourceAlertSeverityTypeWarning, alerts[0].Severity)
+
+	// no more errors
+	counters["rx_packets"] += 500
+	writeNetworkCounters(t, ifacePath, counters)
+	networkMonitor.sync(ctx, &NetworkUsage{})
+	require.Empty(networkMonitor.Alerts())
+
+	// a missing interface fails the collection without firing alerts
+	require.NoError(os.RemoveAll(ifacePath))
+	networkMonitor.sync(ctx, &NetworkUsage{})
+	require.Empty(networkMonitor.Alerts())
+}
+
+func TestNetworkPercentage(t *testing.T) {
+	now := time.Now()
+	prev := &NetworkUsage{RxBytes: 0, TxBytes: 0, RxPackets: 100, TxPackets: 100, lastCollectedAt: now}
+
+	tests := []struct {
+		name      string
+		current   NetworkUsage
+		metric    v1beta1.NetworkMonitorMetric
+		linkSpeed int
+		expected  int64
+		wantErr   string
+	}{
+		{
+			name: "throughput of the busier direction relative to the reported speed",
+			// 25 Mbit/s received over 10 seconds on a 100 Mbit/s link
+			current:  NetworkUsage{RxBytes: 31_250_000, TxBytes: 1_000, RxPackets: 100, TxPackets: 100, Speed: 100, lastCollectedAt: now.Add(10 * time.Second)},
+			metric:   v1beta1.NetworkMonitorMetricThroughput,
+			expected: 25,
+		},
+		{
+			name:      "throughput relative to the configured link speed",
+			current:   NetworkUsage{TxBytes: 31_250_000, RxPackets: 100, TxPackets: 100, Speed: 100, lastCollectedAt: now.Add(10 * time.Second)},
+			metric:    v1beta1.NetworkMonitorMetricThroughput,
+			linkSpeed: 50,
+			expected:  50,
+		},
+		{
+			name:    "throughput without a known link speed",
+			current: NetworkUsage{TxBytes: 1_000, RxPackets: 100, TxPackets: 100, lastCollectedAt: now.Add(10 * time.Second)},
+			metric:  v1beta1.NetworkMonitorMetricThroughput,
+			wantErr: "set linkSpeed",
+		},
+		{
+			name:     "errors without traffic",
+			current:  NetworkUsage{RxPackets: 100, TxPackets: 100, lastCollectedAt: now.Add(10 * time.Second)},
+			metric:   v1beta1.NetworkMonitorMetricErrors,
+			expected: 0,
+		},
+		{
+			name:     "errors relative to all transferred packets",
+			current:  NetworkUsage{RxPackets: 130, TxPackets: 150, RxErrors: 10, TxErrors: 10, lastCollectedAt: now.Add(10 * time.Second)},
+			metric:   v1beta1.NetworkMonitorMetricErrors,
+			expected: 20,
+		},
+		{
+			name:    "counters that were reset",
+			current: NetworkUsage{RxPackets: 10, TxPackets: 10, lastCollectedAt: now.Add(10 * time.Second)},
+			metric:  v1beta1.NetworkMonitorMetricErrors,
+			wantErr: "reset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			percentage, err := networkPercentage(prev, lo.ToPtr(tt.current), tt.metric, tt.linkSpeed)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, percentage)
+		})
+	}
+}
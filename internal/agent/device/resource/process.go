@@ -0,0 +1,243 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	DefaultProcessSyncTimeout = 5 * time.Second
+	DefaultProcPath           = "/proc"
+)
+
+var _ Monitor[ProcessUsage] = (*ProcessMonitor)(nil)
+
+type ProcessMonitor struct {
+	mu     sync.Mutex
+	alerts map[v1beta1.ResourceAlertSeverityType]*Alert
+	// name is the monitored process name as reported in /proc/[pid]/comm
+	name   string
+	metric v1beta1.ProcessMonitorMetric
+
+	updateIntervalCh chan time.Duration
+	samplingInterval time.Duration
+	procPath         string
+	pageSize         uint64
+
+	log *log.PrefixLogger
+}
+
+func NewProcessMonitor(
+	log *log.PrefixLogger,
+) *ProcessMonitor {
+	return &ProcessMonitor{
+		alerts:           make(map[v1beta1.ResourceAlertSeverityType]*Alert),
+		updateIntervalCh: make(chan time.Duration, 1),
+		samplingInterval: DefaultSamplingInterval,
+		procPath:         DefaultProcPath,
+		pageSize:         uint64(os.Getpagesize()),
+		log:              log,
+	}
+}
+
+func (m *ProcessMonitor) Run(ctx context.Context) {
+	defer m.log.Infof("Process monitor stopped")
+	samplingInterval := m.getSamplingInterval()
+	ticker := time.NewTicker(samplingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case newInterval := <-m.updateIntervalCh:
+			ticker.Reset(newInterval)
+		case <-ticker.C:
+			m.log.Debug("Checking process usage")
+			usage := ProcessUsage{}
+			m.sync(ctx, &usage)
+		}
+	}
+}
+
+func (m *ProcessMonitor) Update(monitor *v1beta1.ResourceMonitor) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	spec, err := getMonitorSpec(monitor)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := updateMonitor(m.log, monitor, &m.samplingInterval, m.alerts, m.updateIntervalCh)
+	if err != nil {
+		return updated, err
+	}
+
+	metric := v1beta1.ProcessMonitorMetric(spec.Metric)
+	if spec.Name != m.name || metric != m.metric {
+		m.name = spec.Name
+		m.metric = metric
+		updated = true
+	}
+
+	return updated, nil
+}
+
+func (m *ProcessMonitor) Alerts() []v1beta1.ResourceAlertRule {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var firing []v1beta1.ResourceAlertRule
+	for _, alert := range m.alerts {
+		if alert.IsFiring() {
+			firing = append(firing, alert.ResourceAlertRule)
+		}
+	}
+	return firing
+}
+
+// Enabled returns true if the device spec configures the process monitor.
+func (m *ProcessMonitor) Enabled() bool {
+	return m.hasAlertRules()
+}
+
+func (m *ProcessMonitor) CollectUsage(ctx context.Context, usage *ProcessUsage) error {
+	m.mu.Lock()
+	name := m.name
+	metric := m.metric
+	m.mu.Unlock()
+
+	if name == "" {
+		return fmt.Errorf("no process name configured")
+	}
+
+	entries, err := os.ReadDir(m.procPath)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := strconv.Atoi(entry.Name()); err != nil {
+			continue
+		}
+		// processes can exit while they are listed, so unreadable entries are skipped
+		comm, err := os.ReadFile(filepath.Join(m.procPath, entry.Name(), "comm"))
+		if err != nil || strings.TrimSpace(string(comm)) != name {
+			continue
+		}
+		rss, err := m.residentMemory(entry.Name())
+		if err != nil {
+			continue
+		}
+		usage.Processes++
+		usage.ResidentMemory += rss
+	}
+
+	switch metric {
+	case v1beta1.ProcessMonitorMetricPresence:
+		// a missing process is reported as 100% so that presence alert rules fire
+		if usage.Processes == 0 {
+			usage.UsedPercent = 100
+		}
+	case v1beta1.ProcessMonitorMetricMemory:
+		memInfo, err := os.ReadFile(filepath.Join(m.procPath, "meminfo"))
+		if err != nil {
+			return err
+		}
+		memory := MemoryUsage{}
+		if err := parseMemStats(strings.Split(string(memInfo), "\n"), &memory); err != nil {
+			return err
+		}
+		// MemTotal is reported in kB
+		if memory.MemTotal > 0 {
+			usage.UsedPercent = int64(float64(usage.ResidentMemory) / float64(memory.MemTotal*1024) * 100)
+		}
+	default:
+		return fmt.Errorf("unknown process metric: %q", metric)
+	}
+
+	usage.lastCollectedAt = time.Now()
+	return nil
+}
+
+// residentMemory returns the resident memory of the process in bytes.
+func (m *ProcessMonitor) residentMemory(pid string) (uint64, error) {
+	statm, err := os.ReadFile(filepath.Join(m.procPath, pid, "statm"))
+	if err != nil {
+		return 0, err
+	}
+	fields := strings.Fields(string(statm))
+	if len(fields) < 2 {
+		return 0, fmt.Errorf("invalid statm of process %s", pid)
+	}
+	pages, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return pages * m.pageSize, nil
+}
+
+func (m *ProcessMonitor) sync(ctx context.Context, usage *ProcessUsage) {
+	if !m.hasAlertRules() {
+		m.log.Debug("Skipping process usage sync: no alert rules")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultProcessSyncTimeout)
+	defer cancel()
+
+	if err := m.CollectUsage(ctx, usage); err != nil {
+		m.log.Errorf("Failed to collect process usage: %v", err)
+		return
+	}
+
+	m.ensureAlerts(usage.UsedPercent)
+}
+
+func (m *ProcessMonitor) ensureAlerts(percentageUsed int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.log.Tracef("Process %s %s usage: %d%%", m.name, m.metric, percentageUsed)
+	for _, alert := range m.alerts {
+		alert.Sync(percentageUsed)
+	}
+}
+
+func (m *ProcessMonitor) hasAlertRules() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.alerts) > 0
+}
+
+func (m *ProcessMonitor) getSamplingInterval() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.samplingInterval
+}
+
+// ProcessUsage represents the usage of the processes with the monitored name on this device
+type ProcessUsage struct {
+	// Processes is the number of running processes with the name
+	Processes int
+	// ResidentMemory is the resident memory of the processes in bytes
+	ResidentMemory uint64
+
+	UsedPercent int64
+
+	lastCollectedAt time.Time
+}
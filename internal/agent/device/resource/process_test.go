@@ -0,0 +1,77 @@
+package resource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func writeProcess(t *testing.T, procPath, pid, comm, statm string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Join(procPath, pid), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(procPath, pid, "comm"), []byte(comm+"\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(procPath, pid, "statm"), []byte(statm+"\n"), 0600))
+}
+
+func TestProcessMonitor(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	procPath := t.TempDir()
+	require.NoError(os.WriteFile(filepath.Join(procPath, "meminfo"), []byte(memoryInfoData), 0600))
+	// two postgres processes with a resident memory of 16 GiB each in 4 KiB pages
+	writeProcess(t, procPath, "100", "postgres", "5000000 4194304 1000 1 0 100 0")
+	writeProcess(t, procPath, "101", "postgres", "5000000 4194304 1000 1 0 100 0")
+	writeProcess(t, procPath, "200", "sshd", "3000 500 400 1 0 100 0")
+
+	processMonitor := NewProcessMonitor(log.NewPrefixLogger("test"))
+	processMonitor.procPath = procPath
+	processMonitor.pageSize = 4096
+
+	newMonitor := func(name string, metric v1beta1.ProcessMonitorMetric) *v1beta1.ResourceMonitor {
+		rm := &v1beta1.ResourceMonitor{}
+		require.NoError(rm.FromProcessResourceMonitorSpec(v1beta1.ProcessResourceMonitorSpec{
+			SamplingInterval: "1s",
+			Name:             name,
+			Metric:           metric,
+			AlertRules: []v1beta1.ResourceAlertRule{
+				{Severity: v1beta1.ResourceAlertSeverityTypeWarning, Percentage: 40, Duration: "0s"},
+				{Severity: v1beta1.ResourceAlertSeverityTypeCritical, Percentage: 60, Duration: "0s"},
+			},
+		}))
+		return rm
+	}
+
+	// the resident memory of all processes with the name is relative to the total memory
+	updated, err := processMonitor.Update(newMonitor("postgres", v1beta1.ProcessMonitorMetricMemory))
+	require.NoError(err)
+	require.True(updated)
+	usage := ProcessUsage{}
+	processMonitor.sync(ctx, &usage)
+	require.Equal(2, usage.Processes)
+	require.Equal(uint64(32*1024*1024*1024), usage.ResidentMemory)
+	require.Equal(int64(51), usage.UsedPercent)
+	alerts := processMonitor.Alerts()
+	require.Len(alerts, 1)
+	require.Equal(v1beta1.ResourceAlertSeverityTypeWarning, alerts[0].Severity)
+
+	// a running process is present
+	_, err = processMonitor.Update(newMonitor("sshd", v1beta1.ProcessMonitorMetricPresence))
+	require.NoError(err)
+	usage = ProcessUsage{}
+	processMonitor.sync(ctx, &usage)
+	require.Equal(int64(0), usage.UsedPercent)
+	require.Empty(processMonitor.Alerts())
+
+	// a missing process fires every presence alert
+	require.NoError(os.RemoveAll(filepath.Join(procPath, "200")))
+	usage = ProcessUsage{}
+	processMonitor.sync(ctx, &usage)
+	require.Equal(int64(100), usage.UsedPercent)
+	require.Len(processMonitor.Alerts(), 2)
+}
@@ -9,7 +9,9 @@ import (
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/internal/agent/device/status"
+	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/samber/lo"
 )
@@ -17,9 +19,13 @@ import (
 type MonitorType string
 
 const (
-	CPUMonitorType    = "CPU"
-	DiskMonitorType   = "Disk"
-	MemoryMonitorType = "Memory"
+	CPUMonitorType         = "CPU"
+	DiskMonitorType        = "Disk"
+	MemoryMonitorType      = "Memory"
+	NetworkMonitorType     = "Network"
+	TemperatureMonitorType = "Temperature"
+	ProcessMonitorType     = "Process"
+	CustomMonitorType      = "Custom"
 
 	DefaultSamplingInterval = 1 * time.Minute
 )
@@ -41,6 +47,12 @@ type Monitor[T any] interface {
 	Alerts() []v1beta1.ResourceAlertRule
 }
 
+// OptionalMonitor is a monitor that only reports a status while the device spec configures it.
+type OptionalMonitor[T any] interface {
+	Monitor[T]
+	Enabled() bool
+}
+
 type Collector[T any] interface {
 	CollectUsage(ctx context.Context, usage *T) error
 }
@@ -49,18 +61,38 @@ type ResourceManager struct {
 	cpuMonitor    Monitor[CPUUsage]
 	diskMonitor   Monitor[DiskUsage]
 	memoryMonitor Monitor[MemoryUsage]
-	log           *log.PrefixLogger
+
+	networkMonitor     *monitorSet[NetworkUsage]
+	temperatureMonitor *monitorSet[TemperatureUsage]
+	processMonitor     *monitorSet[ProcessUsage]
+	customMonitor      *CustomMonitor
+
+	log *log.PrefixLogger
 }
 
 // NewManager creates a new resource Manager.
 func NewManager(
 	log *log.PrefixLogger,
+	exec executer.Executer,
+	reader fileio.Reader,
 ) Manager {
 	return &ResourceManager{
 		cpuMonitor:    NewCPUMonitor(log),
 		diskMonitor:   NewDiskMonitor(log),
 		memoryMonitor: NewMemoryMonitor(log),
-		log:           log,
+
+		networkMonitor: newMonitorSet(log, NetworkMonitorType,
+			func(spec *MonitorSpec) string { return spec.Interface },
+			func() OptionalMonitor[NetworkUsage] { return NewNetworkMonitor(log) }),
+		temperatureMonitor: newMonitorSet(log, TemperatureMonitorType,
+			func(spec *MonitorSpec) string { return spec.Zone },
+			func() OptionalMonitor[TemperatureUsage] { return NewTemperatureMonitor(log) }),
+		processMonitor: newMonitorSet(log, ProcessMonitorType,
+			func(spec *MonitorSpec) string { return spec.Name },
+			func() OptionalMonitor[ProcessUsage] { return NewProcessMonitor(log) }),
+		customMonitor: NewCustomMonitor(log, exec, reader),
+
+		log: log,
 	}
 }
 
@@ -79,6 +111,10 @@ func (m *ResourceManager) Run(ctx context.Context) {
 	start(m.diskMonitor.Run)
 	start(m.cpuMonitor.Run)
 	start(m.memoryMonitor.Run)
+	start(m.networkMonitor.Run)
+	start(m.temperatureMonitor.Run)
+	start(m.processMonitor.Run)
+	start(m.customMonitor.Run)
 
 	wg.Wait()
 }
@@ -96,6 +132,14 @@ func (m *ResourceManager) Update(monitor *v1beta1.ResourceMonitor) (bool, error)
 		return m.diskMonitor.Update(monitor)
 	case MemoryMonitorType:
 		return m.memoryMonitor.Update(monitor)
+	case NetworkMonitorType:
+		return m.networkMonitor.Update(monitor)
+	case TemperatureMonitorType:
+		return m.temperatureMonitor.Update(monitor)
+	case ProcessMonitorType:
+		return m.processMonitor.Update(monitor)
+	case CustomMonitorType:
+		return m.customMonitor.Update(monitor)
 	default:
 		return false, fmt.Errorf("unknown monitor type: %s", monitorType)
 	}
@@ -143,6 +187,12 @@ func (m *ResourceManager) ResetAlertDefaults() error {
 		m.log.Debug("Reset memory monitor alerts")
 	}
 
+	// network, temperature, process and custom monitors are disabled unless configured
+	m.retainOptionalMonitors(nil)
+	if m.customMonitor.Retain(nil) {
+		m.log.Debug("Removed custom monitors")
+	}
+
 	if len(errs) > 0 {
 		return errors.Join(errs...)
 	}
@@ -184,6 +234,33 @@ func (m *ResourceManager) Status(ctx context.Context, status *v1beta1.DeviceStat
 		},
 	}
 
+	// network, temperature, process and custom are only reported while they are monitored
+	optionalMonitors := map[string]struct {
+		monitor interface{ Enabled() bool }
+		alerts  []v1beta1.ResourceAlertRule
+		status  **v1beta1.DeviceResourceStatusType
+	}{
+		NetworkMonitorType:     {monitor: m.networkMonitor, alerts: alerts.NetworkUsage, status: &status.Resources.Network},
+		TemperatureMonitorType: {monitor: m.temperatureMonitor, alerts: alerts.TemperatureUsage, status: &status.Resources.Temperature},
+		ProcessMonitorType:     {monitor: m.processMonitor, alerts: alerts.ProcessUsage, status: &status.Resources.Process},
+		CustomMonitorType:      {monitor: m.customMonitor, alerts: alerts.CustomUsage, status: &status.Resources.Custom},
+	}
+	for monitorType, optional := range optionalMonitors {
+		if !optional.monitor.Enabled() {
+			*optional.status = nil
+			continue
+		}
+		resourceMonitors[monitorType] = struct {
+			alerts      []v1beta1.ResourceAlertRule
+			setStatusFn func(v1beta1.DeviceResourceStatusType)
+		}{
+			alerts: optional.alerts,
+			setStatusFn: func(resourceStatus v1beta1.DeviceResourceStatusType) {
+				*optional.status = lo.ToPtr(resourceStatus)
+			},
+		}
+	}
+
 	// set the status for each monitor type
 	for monitorType, monitor := range resourceMonitors {
 		resourceStatus, alertMsg := getHighestSeverityResourceStatusFromAlerts(monitorType, monitor.alerts)
@@ -220,9 +297,13 @@ func (m *ResourceManager) Status(ctx context.Context, status *v1beta1.DeviceStat
 
 func (m *ResourceManager) Alerts() *Alerts {
 	return &Alerts{
-		DiskUsage:   m.diskMonitor.Alerts(),
-		CPUUsage:    m.cpuMonitor.Alerts(),
-		MemoryUsage: m.memoryMonitor.Alerts(),
+		DiskUsage:        m.diskMonitor.Alerts(),
+		CPUUsage:         m.cpuMonitor.Alerts(),
+		MemoryUsage:      m.memoryMonitor.Alerts(),
+		NetworkUsage:     m.networkMonitor.Alerts(),
+		TemperatureUsage: m.temperatureMonitor.Alerts(),
+		ProcessUsage:     m.processMonitor.Alerts(),
+		CustomUsage:      m.customMonitor.Alerts(),
 	}
 }
 
@@ -239,6 +320,14 @@ func (m *ResourceManager) isCriticalAlert(monitorType MonitorType) bool {
 		alertList = alerts.CPUUsage
 	case MemoryMonitorType:
 		alertList = alerts.MemoryUsage
+	case NetworkMonitorType:
+		alertList = alerts.NetworkUsage
+	case TemperatureMonitorType:
+		alertList = alerts.TemperatureUsage
+	case ProcessMonitorType:
+		alertList = alerts.ProcessUsage
+	case CustomMonitorType:
+		alertList = alerts.CustomUsage
 	default:
 		m.log.Warnf("Unknown monitor type: %s", monitorType)
 		return false
@@ -263,16 +352,62 @@ func (m *ResourceManager) sync(ctx context.Context, desired *v1beta1.DeviceSpec)
 		return m.ResetAlertDefaults()
 	}
 
+	// the keys of the monitors of each optional type, such as the monitored network interfaces
+	configured := make(map[string]map[string]struct{})
+	customNames := make(map[string]struct{})
 	for i := range *desired.Resources {
 		monitor := (*desired.Resources)[i]
 		if _, err := m.Update(&monitor); err != nil {
 			return err
 		}
+		monitorType, err := monitor.Discriminator()
+		if err != nil {
+			continue
+		}
+		if monitorType == CustomMonitorType {
+			if spec, err := monitor.AsCustomResourceMonitorSpec(); err == nil {
+				customNames[spec.Name] = struct{}{}
+			}
+			continue
+		}
+		spec, err := getMonitorSpec(&monitor)
+		if err != nil {
+			continue
+		}
+		if set, ok := m.optionalMonitors()[monitorType]; ok {
+			if configured[monitorType] == nil {
+				configured[monitorType] = make(map[string]struct{})
+			}
+			configured[monitorType][set.keyOf(spec)] = struct{}{}
+		}
 	}
 
+	if m.customMonitor.Retain(customNames) {
+		m.log.Debug("Removed custom monitors that are no longer configured")
+	}
+	m.retainOptionalMonitors(configured)
 	return nil
 }
 
+// optionalMonitors returns the sets of the network, temperature and process monitors by their monitor type.
+func (m *ResourceManager) optionalMonitors() map[string]optionalMonitorSet {
+	return map[string]optionalMonitorSet{
+		NetworkMonitorType:     m.networkMonitor,
+		TemperatureMonitorType: m.temperatureMonitor,
+		ProcessMonitorType:     m.processMonitor,
+	}
+}
+
+// retainOptionalMonitors stops the network, temperature and process monitors whose interface, zone or process
+// name is no longer configured, so that they stop sampling and reporting a status.
+func (m *ResourceManager) retainOptionalMonitors(configured map[string]map[string]struct{}) {
+	for monitorType, set := range m.optionalMonitors() {
+		if set.Retain(configured[monitorType]) {
+			m.log.Debugf("Removed %s monitors that are no longer configured", monitorType)
+		}
+	}
+}
+
 // BeforeUpdate syncs resource monitors with the desired spec.
 func (m *ResourceManager) BeforeUpdate(ctx context.Context, desired *v1beta1.DeviceSpec) error {
 	return m.sync(ctx, desired)
@@ -284,9 +419,13 @@ func (m *ResourceManager) IsCriticalAlert(monitorType MonitorType) bool {
 }
 
 type Alerts struct {
-	DiskUsage   []v1beta1.ResourceAlertRule
-	CPUUsage    []v1beta1.ResourceAlertRule
-	MemoryUsage []v1beta1.ResourceAlertRule
+	DiskUsage        []v1beta1.ResourceAlertRule
+	CPUUsage         []v1beta1.ResourceAlertRule
+	MemoryUsage      []v1beta1.ResourceAlertRule
+	NetworkUsage     []v1beta1.ResourceAlertRule
+	TemperatureUsage []v1beta1.ResourceAlertRule
+	ProcessUsage     []v1beta1.ResourceAlertRule
+	CustomUsage      []v1beta1.ResourceAlertRule
 }
 
 type Alert struct {
@@ -376,6 +515,19 @@ type MonitorSpec struct {
 
 	// Path is the absolute path used for the disk monitor.
 	Path string `json:"path,omitempty"`
+	// Interface is the network interface used for the network monitor.
+	Interface string `json:"interface,omitempty"`
+	// Metric is the metric used for the network and process monitors.
+	Metric string `json:"metric,omitempty"`
+	// LinkSpeed is the link speed in Mbit/s used for the network monitor, zero if the interface reports it.
+	LinkSpeed int `json:"linkSpeed,omitempty"`
+	// Zone is the thermal zone used for the temperature monitor.
+	Zone string `json:"zone,omitempty"`
+	// MaxTemperature is the reference temperature in degrees Celsius used for the temperature monitor, zero if the
+	// critical trip point of the zone is used.
+	MaxTemperature int `json:"maxTemperature,omitempty"`
+	// Name is the process name used for the process monitor.
+	Name string `json:"name,omitempty"`
 }
 
 // getHighestSeverityResourceStatusFromAlerts returns the highest severity statusDeviceResourceStatusType from a list of alerts along with the alert message.
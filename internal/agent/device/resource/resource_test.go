@@ -0,0 +1,90 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceManagerOptionalMonitors(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	manager := NewManager(log.NewPrefixLogger("test"), executer.NewCommonExecuter(), fileio.NewReadWriter(fileio.NewReader(), fileio.NewWriter())).(*ResourceManager)
+
+	process := v1beta1.ResourceMonitor{}
+	require.NoError(process.FromProcessResourceMonitorSpec(v1beta1.ProcessResourceMonitorSpec{
+		SamplingInterval: "1m",
+		Name:             "sshd",
+		Metric:           v1beta1.ProcessMonitorMetricPresence,
+		AlertRules: []v1beta1.ResourceAlertRule{
+			{Severity: v1beta1.ResourceAlertSeverityTypeCritical, Percentage: 0, Duration: "5m"},
+		},
+	}))
+	require.NoError(manager.BeforeUpdate(ctx, &v1beta1.DeviceSpec{Resources: &[]v1beta1.ResourceMonitor{process}}))
+
+	// only the configured monitor reports a status
+	status := v1beta1.NewDeviceStatus()
+	require.NoError(manager.Status(ctx, &status))
+	require.Equal(lo.ToPtr(v1beta1.DeviceResourceStatusHealthy), status.Resources.Process)
+	require.Nil(status.Resources.Network)
+	require.Nil(status.Resources.Temperature)
+	require.Nil(status.Resources.Custom)
+
+	// removing the monitor from the spec disables it
+	require.NoError(manager.BeforeUpdate(ctx, &v1beta1.DeviceSpec{Resources: &[]v1beta1.ResourceMonitor{}}))
+	require.NoError(manager.Status(ctx, &status))
+	require.Nil(status.Resources.Process)
+}
+
+func TestResourceManagerMonitorsPerInterface(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	manager := NewManager(log.NewPrefixLogger("test"), executer.NewCommonExecuter(), fileio.NewReadWriter(fileio.NewReader(), fileio.NewWriter())).(*ResourceManager)
+	done := make(chan struct{})
+	go func() {
+		manager.Run(ctx)
+		close(done)
+	}()
+
+	network := func(iface string) v1beta1.ResourceMonitor {
+		monitor := v1beta1.ResourceMonitor{}
+		require.NoError(monitor.FromNetworkResourceMonitorSpec(v1beta1.NetworkResourceMonitorSpec{
+			SamplingInterval: "1m",
+			Interface:        iface,
+			Metric:           v1beta1.NetworkMonitorMetricErrors,
+			AlertRules: []v1beta1.ResourceAlertRule{
+				{Severity: v1beta1.ResourceAlertSeverityTypeCritical, Percentage: 10, Duration: "5m"},
+			},
+		}))
+		return monitor
+	}
+	instances := func() []string {
+		manager.networkMonitor.mu.Lock()
+		defer manager.networkMonitor.mu.Unlock()
+		return lo.Keys(manager.networkMonitor.instances)
+	}
+
+	// each interface is monitored by its own instance
+	require.NoError(manager.BeforeUpdate(ctx, &v1beta1.DeviceSpec{Resources: &[]v1beta1.ResourceMonitor{network("eth0"), network("wlan0")}}))
+	require.ElementsMatch([]string{"eth0", "wlan0"}, instances())
+	require.True(manager.networkMonitor.Enabled())
+
+	// interfaces that are no longer monitored are removed
+	require.NoError(manager.BeforeUpdate(ctx, &v1beta1.DeviceSpec{Resources: &[]v1beta1.ResourceMonitor{network("wlan0")}}))
+	require.ElementsMatch([]string{"wlan0"}, instances())
+
+	require.NoError(manager.BeforeUpdate(ctx, &v1beta1.DeviceSpec{Resources: &[]v1beta1.ResourceMonitor{}}))
+	require.Empty(instances())
+	require.False(manager.networkMonitor.Enabled())
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,239 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	DefaultTemperatureSyncTimeout = 5 * time.Second
+	DefaultSysClassThermalPath    = "/sys/class/thermal"
+)
+
+var _ Monitor[TemperatureUsage] = (*TemperatureMonitor)(nil)
+
+type TemperatureMonitor struct {
+	mu     sync.Mutex
+	alerts map[v1beta1.ResourceAlertSeverityType]*Alert
+	// zone is the directory name or the type of the monitored thermal zone
+	zone string
+	// maxTemperature is the configured reference temperature in degrees Celsius, zero to use the critical trip point
+	maxTemperature int
+
+	updateIntervalCh    chan time.Duration
+	samplingInterval    time.Duration
+	sysClassThermalPath string
+
+	log *log.PrefixLogger
+}
+
+func NewTemperatureMonitor(
+	log *log.PrefixLogger,
+) *TemperatureMonitor {
+	return &TemperatureMonitor{
+		alerts:              make(map[v1beta1.ResourceAlertSeverityType]*Alert),
+		updateIntervalCh:    make(chan time.Duration, 1),
+		samplingInterval:    DefaultSamplingInterval,
+		sysClassThermalPath: DefaultSysClassThermalPath,
+		log:                 log,
+	}
+}
+
+func (m *TemperatureMonitor) Run(ctx context.Context) {
+	defer m.log.Infof("Temperature monitor stopped")
+	samplingInterval := m.getSamplingInterval()
+	ticker := time.NewTicker(samplingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case newInterval := <-m.updateIntervalCh:
+			ticker.Reset(newInterval)
+		case <-ticker.C:
+			m.log.Debug("Checking temperature")
+			usage := TemperatureUsage{}
+			m.sync(ctx, &usage)
+		}
+	}
+}
+
+func (m *TemperatureMonitor) Update(monitor *v1beta1.ResourceMonitor) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	spec, err := getMonitorSpec(monitor)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := updateMonitor(m.log, monitor, &m.samplingInterval, m.alerts, m.updateIntervalCh)
+	if err != nil {
+		return updated, err
+	}
+
+	if spec.Zone != m.zone || spec.MaxTemperature != m.maxTemperature {
+		m.zone = spec.Zone
+		m.maxTemperature = spec.MaxTemperature
+		updated = true
+	}
+
+	return updated, nil
+}
+
+func (m *TemperatureMonitor) Alerts() []v1beta1.ResourceAlertRule {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var firing []v1beta1.ResourceAlertRule
+	for _, alert := range m.alerts {
+		if alert.IsFiring() {
+			firing = append(firing, alert.ResourceAlertRule)
+		}
+	}
+	return firing
+}
+
+// Enabled returns true if the device spec configures the temperature monitor.
+func (m *TemperatureMonitor) Enabled() bool {
+	return m.hasAlertRules()
+}
+
+func (m *TemperatureMonitor) CollectUsage(ctx context.Context, usage *TemperatureUsage) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		m.mu.Lock()
+		zone := m.zone
+		maxTemperature := m.maxTemperature
+		m.mu.Unlock()
+
+		zonePath, err := findThermalZone(m.sysClassThermalPath, zone)
+		if err != nil {
+			return err
+		}
+		temperature, err := readSysfsInt(filepath.Join(zonePath, "temp"))
+		if err != nil {
+			return err
+		}
+		usage.Temperature = temperature
+
+		usage.MaxTemperature = int64(maxTemperature) * 1000
+		if usage.MaxTemperature == 0 {
+			usage.MaxTemperature, err = criticalTripPoint(zonePath)
+			if err != nil {
+				return err
+			}
+		}
+		usage.UsedPercent = usage.Temperature * 100 / usage.MaxTemperature
+		usage.lastCollectedAt = time.Now()
+	}
+	return nil
+}
+
+func (m *TemperatureMonitor) sync(ctx context.Context, usage *TemperatureUsage) {
+	if !m.hasAlertRules() {
+		m.log.Debug("Skipping temperature sync: no alert rules")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultTemperatureSyncTimeout)
+	defer cancel()
+
+	if err := m.CollectUsage(ctx, usage); err != nil {
+		m.log.Errorf("Failed to collect temperature: %v", err)
+	}
+
+	m.ensureAlerts(usage.UsedPercent)
+}
+
+func (m *TemperatureMonitor) ensureAlerts(percentageUsed int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.log.Tracef("Temperature of %s: %d%%", m.zone, percentageUsed)
+	for _, alert := range m.alerts {
+		alert.Sync(percentageUsed)
+	}
+}
+
+func (m *TemperatureMonitor) hasAlertRules() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.alerts) > 0
+}
+
+func (m *TemperatureMonitor) getSamplingInterval() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.samplingInterval
+}
+
+// findThermalZone returns the path of the thermal zone with the given directory name or type.
+func findThermalZone(thermalPath, zone string) (string, error) {
+	if zone == "" {
+		return "", fmt.Errorf("no thermal zone configured")
+	}
+	zonePath := filepath.Join(thermalPath, zone)
+	if _, err := os.Stat(filepath.Join(zonePath, "temp")); err == nil {
+		return zonePath, nil
+	}
+
+	zonePaths, err := filepath.Glob(filepath.Join(thermalPath, "thermal_zone*"))
+	if err != nil {
+		return "", err
+	}
+	for _, zonePath := range zonePaths {
+		zoneType, err := os.ReadFile(filepath.Join(zonePath, "type"))
+		if err != nil {
+			continue
+		}
+		if strings.TrimSpace(string(zoneType)) == zone {
+			return zonePath, nil
+		}
+	}
+	return "", fmt.Errorf("thermal zone %q not found", zone)
+}
+
+// criticalTripPoint returns the temperature of the critical trip point of the thermal zone in millidegrees Celsius.
+func criticalTripPoint(zonePath string) (int64, error) {
+	tripTypes, err := filepath.Glob(filepath.Join(zonePath, "trip_point_*_type"))
+	if err != nil {
+		return 0, err
+	}
+	for _, tripType := range tripTypes {
+		value, err := os.ReadFile(tripType)
+		if err != nil || strings.TrimSpace(string(value)) != "critical" {
+			continue
+		}
+		temperature, err := readSysfsInt(strings.TrimSuffix(tripType, "_type") + "_temp")
+		if err != nil {
+			return 0, err
+		}
+		if temperature > 0 {
+			return temperature, nil
+		}
+	}
+	return 0, fmt.Errorf("thermal zone %s has no critical trip point: set maxTemperature", filepath.Base(zonePath))
+}
+
+// TemperatureUsage represents the temperature of a thermal zone of this device
+type TemperatureUsage struct {
+	// Temperature is the current temperature in millidegrees Celsius
+	Temperature int64
+	// MaxTemperature is the reference temperature in millidegrees Celsius
+	MaxTemperature int64
+
+	UsedPercent int64
+
+	lastCollectedAt time.Time
+}
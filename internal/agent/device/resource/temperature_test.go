@@ -0,0 +1,87 @@
+package resource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func writeThermalZone(t *testing.T, zonePath string, files map[string]string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(zonePath, 0755))
+	for name, value := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(zonePath, name), []byte(value+"\n"), 0600))
+	}
+}
+
+func TestTemperatureMonitor(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	sysClassThermal := t.TempDir()
+	writeThermalZone(t, filepath.Join(sysClassThermal, "thermal_zone0"), map[string]string{
+		"type": "acpitz",
+		"temp": "40000",
+	})
+	writeThermalZone(t, filepath.Join(sysClassThermal, "thermal_zone1"), map[string]string{
+		"type":              "x86_pkg_temp",
+		"temp":              "85000",
+		"trip_point_0_type": "passive",
+		"trip_point_0_temp": "80000",
+		"trip_point_1_type": "critical",
+		"trip_point_1_temp": "100000",
+	})
+
+	temperatureMonitor := NewTemperatureMonitor(log.NewPrefixLogger("test"))
+	temperatureMonitor.sysClassThermalPath = sysClassThermal
+
+	newMonitor := func(zone string, maxTemperature *int) *v1beta1.ResourceMonitor {
+		rm := &v1beta1.ResourceMonitor{}
+		require.NoError(rm.FromTemperatureResourceMonitorSpec(v1beta1.TemperatureResourceMonitorSpec{
+			SamplingInterval: "1s",
+			Zone:             zone,
+			MaxTemperature:   maxTemperature,
+			AlertRules: []v1beta1.ResourceAlertRule{
+				{Severity: v1beta1.ResourceAlertSeverityTypeWarning, Percentage: 80, Duration: "0s"},
+				{Severity: v1beta1.ResourceAlertSeverityTypeCritical, Percentage: 90, Duration: "0s"},
+			},
+		}))
+		return rm
+	}
+
+	// the zone is found by its type and compared to its critical trip point
+	updated, err := temperatureMonitor.Update(newMonitor("x86_pkg_temp", nil))
+	require.NoError(err)
+	require.True(updated)
+	usage := TemperatureUsage{}
+	temperatureMonitor.sync(ctx, &usage)
+	require.Equal(int64(85), usage.UsedPercent)
+	alerts := temperatureMonitor.Alerts()
+	require.Len(alerts, 1)
+	require.Equal(v1beta1.ResourceAlertSeverityTypeWarning, alerts[0].Severity)
+
+	// the configured maximum overrides the trip point
+	_, err = temperatureMonitor.Update(newMonitor("thermal_zone1", lo.ToPtr(90)))
+	require.NoError(err)
+	temperatureMonitor.sync(ctx, &TemperatureUsage{})
+	require.Len(temperatureMonitor.Alerts(), 2)
+
+	// a zone without a critical trip point needs a configured maximum
+	_, err = temperatureMonitor.Update(newMonitor("thermal_zone0", nil))
+	require.NoError(err)
+	err = temperatureMonitor.CollectUsage(ctx, &TemperatureUsage{})
+	require.ErrorContains(err, "no critical trip point")
+	temperatureMonitor.sync(ctx, &TemperatureUsage{})
+	require.Empty(temperatureMonitor.Alerts())
+
+	_, err = temperatureMonitor.Update(newMonitor("cpu-thermal", nil))
+	require.NoError(err)
+	err = temperatureMonitor.CollectUsage(ctx, &TemperatureUsage{})
+	require.ErrorContains(err, `thermal zone "cpu-thermal" not found`)
+}
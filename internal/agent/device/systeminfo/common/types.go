@@ -1,6 +1,10 @@
 package common
 
-import "sort"
+import (
+	"sort"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+)
 
 const (
 	// Info key constants for system information collection
@@ -41,6 +45,7 @@ const (
 	ManagementCertNotAfterKey = "managementCertNotAfter"
 	ManagementCertSerialKey   = "managementCertSerial"
 	TPMVendorInfoKey          = "tpmVendorInfo"
+	SecretEncryptionKeyKey    = v1beta1.DeviceSystemInfoSecretEncryptionKey
 )
 
 // KeySet represents a set of system-info keys.
@@ -88,6 +93,7 @@ var runtimeKeys = newKeySet(
 	ManagementCertNotAfterKey,
 	ManagementCertSerialKey,
 	TPMVendorInfoKey,
+	SecretEncryptionKeyKey,
 )
 
 // builtInKeys are collected unconditionally by built-in collectors.
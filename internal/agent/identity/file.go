@@ -3,6 +3,7 @@ package identity
 import (
 	"context"
 	"crypto"
+	"crypto/ecdsa"
 	"fmt"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
@@ -108,6 +109,22 @@ func (f *fileProvider) GenerateCSR(deviceName string) ([]byte, error) {
 	return fccrypto.MakeCSR(signer, deviceName)
 }
 
+// KeyAgreement returns the management key of the device, which secrets are encrypted to.
+func (f *fileProvider) KeyAgreement() (fccrypto.KeyAgreement, error) {
+	if f.privateKey == nil {
+		return nil, ErrNotInitialized
+	}
+	ecdsaKey, ok := f.privateKey.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key of type %T does not support key agreement", f.privateKey)
+	}
+	key, err := ecdsaKey.ECDH()
+	if err != nil {
+		return nil, fmt.Errorf("converting private key for key agreement: %w", err)
+	}
+	return key, nil
+}
+
 func (f *fileProvider) ProveIdentity(ctx context.Context, enrollmentRequest *v1beta1.EnrollmentRequest) error {
 	// no-op for file provider since identity is proven by CSR signing with private key
 	return nil
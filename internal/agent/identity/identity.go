@@ -92,6 +92,8 @@ type Provider interface {
 	WipeCredentials() error
 	// WipeCertificateOnly securely removes only the certificate (not keys or CSR)
 	WipeCertificateOnly() error
+	// KeyAgreement returns the key that secrets in the rendered device spec are encrypted to
+	KeyAgreement() (fccrypto.KeyAgreement, error)
 }
 
 // NewProvider creates an identity provider
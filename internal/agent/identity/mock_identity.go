@@ -17,6 +17,7 @@ import (
 	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
 	client "github.com/flightctl/flightctl/internal/agent/client"
 	client0 "github.com/flightctl/flightctl/internal/client"
+	crypto "github.com/flightctl/flightctl/pkg/crypto"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -184,6 +185,21 @@ func (mr *MockProviderMockRecorder) Initialize(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockProvider)(nil).Initialize), ctx)
 }
 
+// KeyAgreement mocks base method.
+func (m *MockProvider) KeyAgreement() (crypto.KeyAgreement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KeyAgreement")
+	ret0, _ := ret[0].(crypto.KeyAgreement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// KeyAgreement indicates an expected call of KeyAgreement.
+func (mr *MockProviderMockRecorder) KeyAgreement() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KeyAgreement", reflect.TypeOf((*MockProvider)(nil).KeyAgreement))
+}
+
 // ProveIdentity mocks base method.
 func (m *MockProvider) ProveIdentity(ctx context.Context, enrollmentRequest *v1beta1.EnrollmentRequest) error {
 	m.ctrl.T.Helper()
@@ -20,6 +20,7 @@ import (
 	agent_client "github.com/flightctl/flightctl/internal/api/client/agent"
 	base_client "github.com/flightctl/flightctl/internal/client"
 	"github.com/flightctl/flightctl/internal/tpm"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/samber/lo"
 	"google.golang.org/grpc"
@@ -120,6 +121,11 @@ func (t *tpmProvider) GetDeviceName() (string, error) {
 	return t.deviceName, nil
 }
 
+// KeyAgreement returns the TPM-bound decryption key, which secrets are encrypted to.
+func (t *tpmProvider) KeyAgreement() (fccrypto.KeyAgreement, error) {
+	return t.client.KeyAgreement()
+}
+
 func (t *tpmProvider) GenerateCSR(deviceName string) ([]byte, error) {
 	// Use default qualifying data (nonce) for attestation freshness
 	qualifyingData := make([]byte, 8)
@@ -243,6 +243,18 @@ func getListEventsParams(newerThan string) domain.ListEventsParams {
 		domain.EventReasonDeviceDiskCritical,
 		domain.EventReasonDeviceDiskNormal,
 		domain.EventReasonDeviceDiskWarning,
+		domain.EventReasonDeviceNetworkCritical,
+		domain.EventReasonDeviceNetworkNormal,
+		domain.EventReasonDeviceNetworkWarning,
+		domain.EventReasonDeviceTemperatureCritical,
+		domain.EventReasonDeviceTemperatureNormal,
+		domain.EventReasonDeviceTemperatureWarning,
+		domain.EventReasonDeviceProcessCritical,
+		domain.EventReasonDeviceProcessNormal,
+		domain.EventReasonDeviceProcessWarning,
+		domain.EventReasonDeviceCustomCritical,
+		domain.EventReasonDeviceCustomNormal,
+		domain.EventReasonDeviceCustomWarning,
 		domain.EventReasonResourceDeleted,
 		domain.EventReasonDeviceDecommissioned,
 	}
@@ -266,10 +278,14 @@ func getListEventsParams(newerThan string) domain.ListEventsParams {
 }
 
 var (
-	appStatusGroup = []string{string(domain.EventReasonDeviceApplicationError), string(domain.EventReasonDeviceApplicationDegraded)}
-	cpuGroup       = []string{string(domain.EventReasonDeviceCPUCritical), string(domain.EventReasonDeviceCPUWarning)}
-	memoryGroup    = []string{string(domain.EventReasonDeviceMemoryCritical), string(domain.EventReasonDeviceMemoryWarning)}
-	diskGroup      = []string{string(domain.EventReasonDeviceDiskCritical), string(domain.EventReasonDeviceDiskWarning)}
+	appStatusGroup   = []string{string(domain.EventReasonDeviceApplicationError), string(domain.EventReasonDeviceApplicationDegraded)}
+	cpuGroup         = []string{string(domain.EventReasonDeviceCPUCritical), string(domain.EventReasonDeviceCPUWarning)}
+	memoryGroup      = []string{string(domain.EventReasonDeviceMemoryCritical), string(domain.EventReasonDeviceMemoryWarning)}
+	diskGroup        = []string{string(domain.EventReasonDeviceDiskCritical), string(domain.EventReasonDeviceDiskWarning)}
+	networkGroup     = []string{string(domain.EventReasonDeviceNetworkCritical), string(domain.EventReasonDeviceNetworkWarning)}
+	temperatureGroup = []string{string(domain.EventReasonDeviceTemperatureCritical), string(domain.EventReasonDeviceTemperatureWarning)}
+	processGroup     = []string{string(domain.EventReasonDeviceProcessCritical), string(domain.EventReasonDeviceProcessWarning)}
+	customGroup      = []string{string(domain.EventReasonDeviceCustomCritical), string(domain.EventReasonDeviceCustomWarning)}
 )
 
 func (c *CheckpointContext) processEvent(event domain.Event, orgID uuid.UUID) {
@@ -304,6 +320,34 @@ func (c *CheckpointContext) processEvent(event domain.Event, orgID uuid.UUID) {
 		c.setAlert(event, string(domain.EventReasonDeviceDiskWarning), diskGroup, orgID)
 	case domain.EventReasonDeviceDiskNormal:
 		c.clearAlertGroup(event, diskGroup, orgID)
+	// Network
+	case domain.EventReasonDeviceNetworkCritical:
+		c.setAlert(event, string(domain.EventReasonDeviceNetworkCritical), networkGroup, orgID)
+	case domain.EventReasonDeviceNetworkWarning:
+		c.setAlert(event, string(domain.EventReasonDeviceNetworkWarning), networkGroup, orgID)
+	case domain.EventReasonDeviceNetworkNormal:
+		c.clearAlertGroup(event, networkGroup, orgID)
+	// Temperature
+	case domain.EventReasonDeviceTemperatureCritical:
+		c.setAlert(event, string(domain.EventReasonDeviceTemperatureCritical), temperatureGroup, orgID)
+	case domain.EventReasonDeviceTemperatureWarning:
+		c.setAlert(event, string(domain.EventReasonDeviceTemperatureWarning), temperatureGroup, orgID)
+	case domain.EventReasonDeviceTemperatureNormal:
+		c.clearAlertGroup(event, temperatureGroup, orgID)
+	// Process
+	case domain.EventReasonDeviceProcessCritical:
+		c.setAlert(event, string(domain.EventReasonDeviceProcessCritical), processGroup, orgID)
+	case domain.EventReasonDeviceProcessWarning:
+		c.setAlert(event, string(domain.EventReasonDeviceProcessWarning), processGroup, orgID)
+	case domain.EventReasonDeviceProcessNormal:
+		c.clearAlertGroup(event, processGroup, orgID)
+	// Custom
+	case domain.EventReasonDeviceCustomCritical:
+		c.setAlert(event, string(domain.EventReasonDeviceCustomCritical), customGroup, orgID)
+	case domain.EventReasonDeviceCustomWarning:
+		c.setAlert(event, string(domain.EventReasonDeviceCustomWarning), customGroup, orgID)
+	case domain.EventReasonDeviceCustomNormal:
+		c.clearAlertGroup(event, customGroup, orgID)
 	// Device connection status
 	case domain.EventReasonDeviceDisconnected:
 		c.setAlert(event, string(domain.EventReasonDeviceDisconnected), nil, orgID)
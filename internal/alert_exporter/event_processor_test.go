@@ -123,6 +123,31 @@ func TestProcessEvent_AppHealthy(t *testing.T) {
 	}
 }
 
+func TestProcessEvent_Temperature(t *testing.T) {
+	testOrgID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	warning := fakeEvent("org", "Device", "dev1", string(domain.EventReasonDeviceTemperatureWarning))
+	key := AlertKeyFromEvent(warning, testOrgID)
+	checkpointCtx := &CheckpointContext{
+		alerts: make(map[AlertKey]map[string]*AlertInfo),
+	}
+
+	checkpointCtx.processEvent(warning, testOrgID)
+	checkpointCtx.processEvent(fakeEvent("org", "Device", "dev1", string(domain.EventReasonDeviceTemperatureCritical)), testOrgID)
+
+	reasons := checkpointCtx.alerts[key]
+	if reasons[string(domain.EventReasonDeviceTemperatureWarning)].EndsAt == nil {
+		t.Errorf("expected DeviceTemperatureWarning to be resolved")
+	}
+	if reasons[string(domain.EventReasonDeviceTemperatureCritical)].EndsAt != nil {
+		t.Errorf("expected DeviceTemperatureCritical to be active")
+	}
+
+	checkpointCtx.processEvent(fakeEvent("org", "Device", "dev1", string(domain.EventReasonDeviceTemperatureNormal)), testOrgID)
+	if reasons[string(domain.EventReasonDeviceTemperatureCritical)].EndsAt == nil {
+		t.Errorf("expected DeviceTemperatureCritical to be resolved")
+	}
+}
+
 func TestProcessEvent_Connected(t *testing.T) {
 	testOrgID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
 	event := fakeEvent("org", "Device", "dev1", string(domain.EventReasonDeviceConnected))
@@ -6,8 +6,12 @@ type Converter interface {
 	Fleet() FleetConverter
 	Repository() RepositoryConverter
 	EnrollmentRequest() EnrollmentRequestConverter
+	EnrollmentPolicy() EnrollmentPolicyConverter
+	DeviceRegistration() DeviceRegistrationConverter
 	CertificateSigningRequest() CertificateSigningRequestConverter
 	AuthProvider() AuthProviderConverter
+	Secret() SecretConverter
+	NotificationSink() NotificationSinkConverter
 	ResourceSync() ResourceSyncConverter
 	TemplateVersion() TemplateVersionConverter
 	Event() EventConverter
@@ -21,8 +25,12 @@ type converterImpl struct {
 	fleet                     FleetConverter
 	repository                RepositoryConverter
 	enrollmentRequest         EnrollmentRequestConverter
+	enrollmentPolicy          EnrollmentPolicyConverter
+	deviceRegistration        DeviceRegistrationConverter
 	certificateSigningRequest CertificateSigningRequestConverter
 	authProvider              AuthProviderConverter
+	secret                    SecretConverter
+	notificationSink          NotificationSinkConverter
 	resourceSync              ResourceSyncConverter
 	templateVersion           TemplateVersionConverter
 	event                     EventConverter
@@ -38,8 +46,12 @@ func NewConverter() Converter {
 		fleet:                     NewFleetConverter(),
 		repository:                NewRepositoryConverter(),
 		enrollmentRequest:         NewEnrollmentRequestConverter(),
+		enrollmentPolicy:          NewEnrollmentPolicyConverter(),
+		deviceRegistration:        NewDeviceRegistrationConverter(),
 		certificateSigningRequest: NewCertificateSigningRequestConverter(),
 		authProvider:              NewAuthProviderConverter(),
+		secret:                    NewSecretConverter(),
+		notificationSink:          NewNotificationSinkConverter(),
 		resourceSync:              NewResourceSyncConverter(),
 		templateVersion:           NewTemplateVersionConverter(),
 		event:                     NewEventConverter(),
@@ -65,6 +77,14 @@ func (c *converterImpl) EnrollmentRequest() EnrollmentRequestConverter {
 	return c.enrollmentRequest
 }
 
+func (c *converterImpl) EnrollmentPolicy() EnrollmentPolicyConverter {
+	return c.enrollmentPolicy
+}
+
+func (c *converterImpl) DeviceRegistration() DeviceRegistrationConverter {
+	return c.deviceRegistration
+}
+
 func (c *converterImpl) CertificateSigningRequest() CertificateSigningRequestConverter {
 	return c.certificateSigningRequest
 }
@@ -73,6 +93,14 @@ func (c *converterImpl) AuthProvider() AuthProviderConverter {
 	return c.authProvider
 }
 
+func (c *converterImpl) Secret() SecretConverter {
+	return c.secret
+}
+
+func (c *converterImpl) NotificationSink() NotificationSinkConverter {
+	return c.notificationSink
+}
+
 func (c *converterImpl) ResourceSync() ResourceSyncConverter {
 	return c.resourceSync
 }
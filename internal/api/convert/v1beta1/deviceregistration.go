@@ -0,0 +1,39 @@
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// DeviceRegistrationConverter converts between v1beta1 API types and domain types for DeviceRegistration resources.
+type DeviceRegistrationConverter interface {
+	ToDomain(apiv1beta1.DeviceRegistration) domain.DeviceRegistration
+	FromDomain(*domain.DeviceRegistration) *apiv1beta1.DeviceRegistration
+	ListFromDomain(*domain.DeviceRegistrationList) *apiv1beta1.DeviceRegistrationList
+
+	// Params conversions
+	ListParamsToDomain(apiv1beta1.ListDeviceRegistrationsParams) domain.ListDeviceRegistrationsParams
+}
+
+type deviceRegistrationConverter struct{}
+
+// NewDeviceRegistrationConverter creates a new DeviceRegistrationConverter.
+func NewDeviceRegistrationConverter() DeviceRegistrationConverter {
+	return &deviceRegistrationConverter{}
+}
+
+func (c *deviceRegistrationConverter) ToDomain(s apiv1beta1.DeviceRegistration) domain.DeviceRegistration {
+	return s
+}
+
+func (c *deviceRegistrationConverter) FromDomain(s *domain.DeviceRegistration) *apiv1beta1.DeviceRegistration {
+	return s
+}
+
+func (c *deviceRegistrationConverter) ListFromDomain(l *domain.DeviceRegistrationList) *apiv1beta1.DeviceRegistrationList {
+	return l
+}
+
+func (c *deviceRegistrationConverter) ListParamsToDomain(p apiv1beta1.ListDeviceRegistrationsParams) domain.ListDeviceRegistrationsParams {
+	return p
+}
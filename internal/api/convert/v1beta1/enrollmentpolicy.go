@@ -0,0 +1,39 @@
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// EnrollmentPolicyConverter converts between v1beta1 API types and domain types for EnrollmentPolicy resources.
+type EnrollmentPolicyConverter interface {
+	ToDomain(apiv1beta1.EnrollmentPolicy) domain.EnrollmentPolicy
+	FromDomain(*domain.EnrollmentPolicy) *apiv1beta1.EnrollmentPolicy
+	ListFromDomain(*domain.EnrollmentPolicyList) *apiv1beta1.EnrollmentPolicyList
+
+	// Params conversions
+	ListParamsToDomain(apiv1beta1.ListEnrollmentPoliciesParams) domain.ListEnrollmentPoliciesParams
+}
+
+type enrollmentPolicyConverter struct{}
+
+// NewEnrollmentPolicyConverter creates a new EnrollmentPolicyConverter.
+func NewEnrollmentPolicyConverter() EnrollmentPolicyConverter {
+	return &enrollmentPolicyConverter{}
+}
+
+func (c *enrollmentPolicyConverter) ToDomain(s apiv1beta1.EnrollmentPolicy) domain.EnrollmentPolicy {
+	return s
+}
+
+func (c *enrollmentPolicyConverter) FromDomain(s *domain.EnrollmentPolicy) *apiv1beta1.EnrollmentPolicy {
+	return s
+}
+
+func (c *enrollmentPolicyConverter) ListFromDomain(l *domain.EnrollmentPolicyList) *apiv1beta1.EnrollmentPolicyList {
+	return l
+}
+
+func (c *enrollmentPolicyConverter) ListParamsToDomain(p apiv1beta1.ListEnrollmentPoliciesParams) domain.ListEnrollmentPoliciesParams {
+	return p
+}
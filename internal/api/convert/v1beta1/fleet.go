@@ -10,6 +10,10 @@ type FleetConverter interface {
 	ToDomain(apiv1beta1.Fleet) domain.Fleet
 	FromDomain(*domain.Fleet) *apiv1beta1.Fleet
 	ListFromDomain(*domain.FleetList) *apiv1beta1.FleetList
+	BatchApprovalToDomain(apiv1beta1.FleetRolloutBatchApproval) domain.FleetRolloutBatchApproval
+	RolloutControlToDomain(apiv1beta1.FleetRolloutControl) domain.FleetRolloutControl
+	SpecToDomain(apiv1beta1.FleetSpec) domain.FleetSpec
+	RolloutPreviewFromDomain(*domain.RolloutPreview) *apiv1beta1.RolloutPreview
 
 	// Params conversions
 	ListParamsToDomain(apiv1beta1.ListFleetsParams) domain.ListFleetsParams
@@ -35,6 +39,22 @@ func (c *fleetConverter) ListFromDomain(l *domain.FleetList) *apiv1beta1.FleetLi
 	return l
 }
 
+func (c *fleetConverter) BatchApprovalToDomain(a apiv1beta1.FleetRolloutBatchApproval) domain.FleetRolloutBatchApproval {
+	return a
+}
+
+func (c *fleetConverter) RolloutControlToDomain(r apiv1beta1.FleetRolloutControl) domain.FleetRolloutControl {
+	return r
+}
+
+func (c *fleetConverter) SpecToDomain(s apiv1beta1.FleetSpec) domain.FleetSpec {
+	return s
+}
+
+func (c *fleetConverter) RolloutPreviewFromDomain(p *domain.RolloutPreview) *apiv1beta1.RolloutPreview {
+	return p
+}
+
 func (c *fleetConverter) ListParamsToDomain(p apiv1beta1.ListFleetsParams) domain.ListFleetsParams {
 	return p
 }
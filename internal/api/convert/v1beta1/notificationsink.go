@@ -0,0 +1,39 @@
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// NotificationSinkConverter converts between v1beta1 API types and domain types for NotificationSink resources.
+type NotificationSinkConverter interface {
+	ToDomain(apiv1beta1.NotificationSink) domain.NotificationSink
+	FromDomain(*domain.NotificationSink) *apiv1beta1.NotificationSink
+	ListFromDomain(*domain.NotificationSinkList) *apiv1beta1.NotificationSinkList
+
+	// Params conversions
+	ListParamsToDomain(apiv1beta1.ListNotificationSinksParams) domain.ListNotificationSinksParams
+}
+
+type notificationSinkConverter struct{}
+
+// NewNotificationSinkConverter creates a new NotificationSinkConverter.
+func NewNotificationSinkConverter() NotificationSinkConverter {
+	return &notificationSinkConverter{}
+}
+
+func (c *notificationSinkConverter) ToDomain(s apiv1beta1.NotificationSink) domain.NotificationSink {
+	return s
+}
+
+func (c *notificationSinkConverter) FromDomain(s *domain.NotificationSink) *apiv1beta1.NotificationSink {
+	return s
+}
+
+func (c *notificationSinkConverter) ListFromDomain(l *domain.NotificationSinkList) *apiv1beta1.NotificationSinkList {
+	return l
+}
+
+func (c *notificationSinkConverter) ListParamsToDomain(p apiv1beta1.ListNotificationSinksParams) domain.ListNotificationSinksParams {
+	return p
+}
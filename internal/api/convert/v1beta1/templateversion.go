@@ -9,6 +9,7 @@ import (
 type TemplateVersionConverter interface {
 	FromDomain(*domain.TemplateVersion) *apiv1beta1.TemplateVersion
 	ListFromDomain(*domain.TemplateVersionList) *apiv1beta1.TemplateVersionList
+	DiffFromDomain(*domain.TemplateVersionDiff) *apiv1beta1.TemplateVersionDiff
 
 	// Params conversions
 	ListParamsToDomain(apiv1beta1.ListTemplateVersionsParams) domain.ListTemplateVersionsParams
@@ -29,6 +30,10 @@ func (c *templateVersionConverter) ListFromDomain(l *domain.TemplateVersionList)
 	return l
 }
 
+func (c *templateVersionConverter) DiffFromDomain(d *domain.TemplateVersionDiff) *apiv1beta1.TemplateVersionDiff {
+	return d
+}
+
 func (c *templateVersionConverter) ListParamsToDomain(p apiv1beta1.ListTemplateVersionsParams) domain.ListTemplateVersionsParams {
 	return p
 }
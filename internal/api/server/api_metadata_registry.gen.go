@@ -14,6 +14,7 @@ const (
 	API_RESOURCE_CATALOGS_ITEMS = "catalogs/items"
 	API_RESOURCE_CERTIFICATESIGNINGREQUESTS = "certificatesigningrequests"
 	API_RESOURCE_CERTIFICATESIGNINGREQUESTS_APPROVAL = "certificatesigningrequests/approval"
+	API_RESOURCE_DEVICEREGISTRATIONS = "deviceregistrations"
 	API_RESOURCE_DEVICES = "devices"
 	API_RESOURCE_DEVICES_APPLICATIONS_CONSOLE = "devices/applications/console"
 	API_RESOURCE_DEVICES_CONSOLE = "devices/console"
@@ -22,19 +23,25 @@ const (
 	API_RESOURCE_DEVICES_RENDERED = "devices/rendered"
 	API_RESOURCE_DEVICES_RESUME = "devices/resume"
 	API_RESOURCE_DEVICES_STATUS = "devices/status"
+	API_RESOURCE_ENROLLMENTPOLICIES = "enrollmentpolicies"
 	API_RESOURCE_ENROLLMENTREQUESTS = "enrollmentrequests"
 	API_RESOURCE_ENROLLMENTREQUESTS_APPROVAL = "enrollmentrequests/approval"
 	API_RESOURCE_ENROLLMENTREQUESTS_STATUS = "enrollmentrequests/status"
 	API_RESOURCE_EVENTS = "events"
 	API_RESOURCE_FLEETS = "fleets"
+	API_RESOURCE_FLEETS_APPROVAL = "fleets/approval"
+	API_RESOURCE_FLEETS_ROLLOUT = "fleets/rollout"
+	API_RESOURCE_FLEETS_ROLLOUTPREVIEW = "fleets/rolloutpreview"
 	API_RESOURCE_FLEETS_STATUS = "fleets/status"
 	API_RESOURCE_FLEETS_TEMPLATEVERSIONS = "fleets/templateversions"
 	API_RESOURCE_LABELS = "labels"
+	API_RESOURCE_NOTIFICATIONSINKS = "notificationsinks"
 	API_RESOURCE_ORGANIZATIONS = "organizations"
 	API_RESOURCE_REPOSITORIES = "repositories"
 	API_RESOURCE_REPOSITORIES_CHECK_OCI_IMAGE = "repositories/check-oci-image"
 	API_RESOURCE_REPOSITORIES_CHECK_OCI_TAG = "repositories/check-oci-tag"
 	API_RESOURCE_RESOURCESYNCS = "resourcesyncs"
+	API_RESOURCE_SECRETS = "secrets"
 	API_RESOURCE_VULNERABILITIES = "vulnerabilities"
 )
 const (
@@ -339,6 +346,46 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/deviceregistrations": {
+		OperationID: "listDeviceRegistrations",
+		Resource:    "deviceregistrations",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/deviceregistrations": {
+		OperationID: "createDeviceRegistration",
+		Resource:    "deviceregistrations",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/deviceregistrations/{name}": {
+		OperationID: "deleteDeviceRegistration",
+		Resource:    "deviceregistrations",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/deviceregistrations/{name}": {
+		OperationID: "getDeviceRegistration",
+		Resource:    "deviceregistrations",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/deviceregistrations/{name}": {
+		OperationID: "replaceDeviceRegistration",
+		Resource:    "deviceregistrations",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/devices": {
 		OperationID: "listDevices",
 		Resource:    "devices",
@@ -443,6 +490,46 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/enrollmentpolicies": {
+		OperationID: "listEnrollmentPolicies",
+		Resource:    "enrollmentpolicies",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/enrollmentpolicies": {
+		OperationID: "createEnrollmentPolicy",
+		Resource:    "enrollmentpolicies",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/enrollmentpolicies/{name}": {
+		OperationID: "deleteEnrollmentPolicy",
+		Resource:    "enrollmentpolicies",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/enrollmentpolicies/{name}": {
+		OperationID: "getEnrollmentPolicy",
+		Resource:    "enrollmentpolicies",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/enrollmentpolicies/{name}": {
+		OperationID: "replaceEnrollmentPolicy",
+		Resource:    "enrollmentpolicies",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/enrollmentrequests": {
 		OperationID: "listEnrollmentRequests",
 		Resource:    "enrollmentrequests",
@@ -571,6 +658,14 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/fleets/{fleet}/templateversions/{name}/diff/{other}": {
+		OperationID: "diffTemplateVersions",
+		Resource:    "fleets/templateversions",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"DELETE:/fleets/{name}": {
 		OperationID: "deleteFleet",
 		Resource:    "fleets",
@@ -603,6 +698,30 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"PUT:/fleets/{name}/approval": {
+		OperationID: "approveFleetRolloutBatch",
+		Resource:    "fleets/approval",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/fleets/{name}/rollout": {
+		OperationID: "controlFleetRollout",
+		Resource:    "fleets/rollout",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/fleets/{name}/rolloutpreview": {
+		OperationID: "previewFleetRollout",
+		Resource:    "fleets/rolloutpreview",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/fleets/{name}/status": {
 		OperationID: "getFleetStatus",
 		Resource:    "fleets/status",
@@ -635,6 +754,46 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/notificationsinks": {
+		OperationID: "listNotificationSinks",
+		Resource:    "notificationsinks",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/notificationsinks": {
+		OperationID: "createNotificationSink",
+		Resource:    "notificationsinks",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/notificationsinks/{name}": {
+		OperationID: "deleteNotificationSink",
+		Resource:    "notificationsinks",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/notificationsinks/{name}": {
+		OperationID: "getNotificationSink",
+		Resource:    "notificationsinks",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/notificationsinks/{name}": {
+		OperationID: "replaceNotificationSink",
+		Resource:    "notificationsinks",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/organizations": {
 		OperationID: "listOrganizations",
 		Resource:    "organizations",
@@ -755,6 +914,46 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/secrets": {
+		OperationID: "listSecrets",
+		Resource:    "secrets",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/secrets": {
+		OperationID: "createSecret",
+		Resource:    "secrets",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/secrets/{name}": {
+		OperationID: "deleteSecret",
+		Resource:    "secrets",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/secrets/{name}": {
+		OperationID: "getSecret",
+		Resource:    "secrets",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/secrets/{name}": {
+		OperationID: "replaceSecret",
+		Resource:    "secrets",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/version": {
 		OperationID: "getVersion",
 		Resource:    "",
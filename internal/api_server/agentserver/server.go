@@ -24,6 +24,7 @@ import (
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/store"
 	agenttransportv1beta1 "github.com/flightctl/flightctl/internal/transport/agent/v1beta1"
+	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/internal/worker_client"
 	"github.com/flightctl/flightctl/pkg/queues"
 	"github.com/go-chi/chi/v5"
@@ -97,7 +98,7 @@ func (s *AgentServer) init(ctx context.Context) error {
 
 	// Agent does not expose vulnerability features; keep disabled for agent-facing endpoints.
 	s.serviceHandler = service.WrapWithTracing(
-		service.NewServiceHandler(s.store, workerClient, s.kvStore, s.ca, s.log, s.cfg.Service.AgentEndpointAddress, s.cfg.Service.BaseUIUrl, s.cfg.Service.TPMCAPaths, false))
+		service.NewServiceHandler(s.store, workerClient, s.kvStore, s.ca, s.log, s.cfg.Service.AgentEndpointAddress, s.cfg.Service.BaseUIUrl, s.cfg.Service.TPMCAPaths, false, s.cfg.Service.RequireDeviceRegistration))
 
 	s.agentGrpcServer = NewAgentGrpcServer(s.log, s.cfg, s.serviceHandler)
 	return nil
@@ -193,6 +194,13 @@ func addAgentContext(next http.Handler) http.Handler {
 		// Add userID into the context
 		ctx := context.WithValue(r.Context(), consts.AgentCtxKey, "true")
 
+		// Add the address of the agent, which enrollment policies can match on
+		remoteAddr := r.RemoteAddr
+		if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+			remoteAddr = host
+		}
+		ctx = util.WithRemoteAddr(ctx, remoteAddr)
+
 		// Create a new request with the updated context
 		r = r.WithContext(ctx)
 
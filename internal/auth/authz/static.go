@@ -30,8 +30,11 @@ var resourcePermissions = map[string]map[string][]string{
 		// Operator has full CRUD on these resources (specific entries override wildcard)
 		"devices":                      {"get", "list", "create", "update", "patch", "delete"},
 		"fleets":                       {"get", "list", "create", "update", "patch", "delete"},
+		"fleets/approval":              {"update"},
+		"fleets/rollout":               {"update"},
 		"resourcesyncs":                {"get", "list", "create", "update", "patch", "delete"},
 		"repositories":                 {"get", "list", "create", "update", "patch", "delete"},
+		"secrets":                      {"get", "list", "create", "update", "delete"},
 		"catalogs":                     {"get", "list"},
 		"catalogitems":                 {"get", "list"},
 		"imagebuilds":                  {"get", "list", "create", "update", "patch", "delete"},
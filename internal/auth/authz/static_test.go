@@ -181,6 +181,41 @@ func TestStaticAuthZ_CheckPermission(t *testing.T) {
 			op:       "get",
 			expected: true,
 		},
+		{
+			name:     "operator can approve fleet rollout batches",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "fleets/approval",
+			op:       "update",
+			expected: true,
+		},
+		{
+			name:     "viewer cannot approve fleet rollout batches",
+			roles:    []string{v1beta1.RoleViewer},
+			resource: "fleets/approval",
+			op:       "update",
+			expected: false,
+		},
+		{
+			name:     "operator can pause, resume and abort fleet rollouts",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "fleets/rollout",
+			op:       "update",
+			expected: true,
+		},
+		{
+			name:     "viewer cannot pause, resume and abort fleet rollouts",
+			roles:    []string{v1beta1.RoleViewer},
+			resource: "fleets/rollout",
+			op:       "update",
+			expected: false,
+		},
+		{
+			name:     "viewer can preview fleet rollouts",
+			roles:    []string{v1beta1.RoleViewer},
+			resource: "fleets/rolloutpreview",
+			op:       "get",
+			expected: true,
+		},
 		{
 			name:     "viewer cannot access device console",
 			roles:    []string{v1beta1.RoleViewer},
@@ -244,6 +279,48 @@ func TestStaticAuthZ_CheckPermission(t *testing.T) {
 			op:       "create",
 			expected: true,
 		},
+		{
+			name:     "operator can create secrets",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "secrets",
+			op:       "create",
+			expected: true,
+		},
+		{
+			name:     "operator cannot create enrollmentpolicies",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "enrollmentpolicies",
+			op:       "create",
+			expected: false,
+		},
+		{
+			name:     "operator can list enrollmentpolicies",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "enrollmentpolicies",
+			op:       "list",
+			expected: true,
+		},
+		{
+			name:     "operator cannot create notificationsinks",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "notificationsinks",
+			op:       "create",
+			expected: false,
+		},
+		{
+			name:     "viewer can list notificationsinks",
+			roles:    []string{v1beta1.RoleViewer},
+			resource: "notificationsinks",
+			op:       "list",
+			expected: true,
+		},
+		{
+			name:     "operator cannot create deviceregistrations",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "deviceregistrations",
+			op:       "create",
+			expected: false,
+		},
 		{
 			name:     "operator can create imagebuilds",
 			roles:    []string{v1beta1.RoleOperator},
@@ -402,6 +479,14 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "fleets",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
 				},
+				{
+					Resource:   "fleets/approval",
+					Operations: []string{"update"},
+				},
+				{
+					Resource:   "fleets/rollout",
+					Operations: []string{"update"},
+				},
 				{
 					Resource:   "imagebuilds",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
@@ -446,6 +531,10 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "resourcesyncs",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
 				},
+				{
+					Resource:   "secrets",
+					Operations: []string{"create", "delete", "get", "list", "update"},
+				},
 			},
 		},
 		{
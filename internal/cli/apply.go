@@ -313,6 +313,18 @@ func applyResourceByKind(ctx context.Context, c *client.Client, ibClient *client
 	case AuthProviderKind:
 		response, err := c.ReplaceAuthProviderWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
 		return extractApplyResult(response, err)
+	case SecretKind:
+		response, err := c.ReplaceSecretWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
+	case EnrollmentPolicyKind:
+		response, err := c.ReplaceEnrollmentPolicyWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
+	case DeviceRegistrationKind:
+		response, err := c.ReplaceDeviceRegistrationWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
+	case NotificationSinkKind:
+		response, err := c.ReplaceNotificationSinkWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
 	case ImageBuildKind:
 		if ibClient == nil {
 			return applyResult{err: fmt.Errorf("imagebuilder service is not configured. Please configure 'imageBuilderService.server' in your client config")}
@@ -380,6 +392,14 @@ func extractApplyResult(response interface{}, err error) applyResult {
 		return buildApplyResult(r.HTTPResponse, r.Body)
 	case *apiclient.ReplaceAuthProviderResponse:
 		return buildApplyResult(r.HTTPResponse, r.Body)
+	case *apiclient.ReplaceSecretResponse:
+		return buildApplyResult(r.HTTPResponse, r.Body)
+	case *apiclient.ReplaceEnrollmentPolicyResponse:
+		return buildApplyResult(r.HTTPResponse, r.Body)
+	case *apiclient.ReplaceDeviceRegistrationResponse:
+		return buildApplyResult(r.HTTPResponse, r.Body)
+	case *apiclient.ReplaceNotificationSinkResponse:
+		return buildApplyResult(r.HTTPResponse, r.Body)
 	case *apiclientv1alpha1.ReplaceCatalogResponse:
 		return buildApplyResult(r.HTTPResponse, r.Body)
 	case *apiclientv1alpha1.ReplaceCatalogItemResponse:
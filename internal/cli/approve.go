@@ -19,6 +19,7 @@ type ApproveOptions struct {
 
 	ApproveLabels []string
 	ReplaceLabels bool
+	Batch         int
 }
 
 func DefaultApproveOptions() *ApproveOptions {
@@ -32,12 +33,12 @@ func NewCmdApprove() *cobra.Command {
 	o := DefaultApproveOptions()
 	cmd := &cobra.Command{
 		Use:   "approve TYPE/NAME or TYPE NAME",
-		Short: "Approve a certificate signing or enrollment request.",
+		Short: "Approve a certificate signing or enrollment request, or a fleet rollout batch.",
 		Args:  cobra.RangeArgs(1, 2),
 		ValidArgsFunction: KindNameAutocomplete{
 			Options:            o,
 			AllowMultipleNames: false,
-			AllowedKinds:       []ResourceKind{EnrollmentRequestKind, CertificateSigningRequestKind},
+			AllowedKinds:       []ResourceKind{EnrollmentRequestKind, CertificateSigningRequestKind, FleetKind},
 		}.ValidArgsFunction,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := o.Complete(cmd, args); err != nil {
@@ -61,6 +62,7 @@ func (o *ApproveOptions) Bind(fs *pflag.FlagSet) {
 
 	fs.StringArrayVarP(&o.ApproveLabels, "label", "l", []string{}, "Labels to set on the device, as a comma-separated list of key=value.")
 	fs.BoolVar(&o.ReplaceLabels, "replace-labels", false, "Use labels as the complete final set, don't merge with agent-provided labels.")
+	fs.IntVar(&o.Batch, "batch", 0, "The number of the fleet rollout batch to approve.")
 }
 
 func (o *ApproveOptions) Complete(cmd *cobra.Command, args []string) error {
@@ -81,12 +83,20 @@ func (o *ApproveOptions) Validate(args []string) error {
 		return err
 	}
 
-	if kind != EnrollmentRequestKind && kind != CertificateSigningRequestKind {
-		return fmt.Errorf("kind must be either %s or %s", EnrollmentRequestKind, CertificateSigningRequestKind)
+	if kind != EnrollmentRequestKind && kind != CertificateSigningRequestKind && kind != FleetKind {
+		return fmt.Errorf("kind must be one of %s, %s or %s", EnrollmentRequestKind, CertificateSigningRequestKind, FleetKind)
 	}
 
 	if len(name) == 0 {
-		return fmt.Errorf("specify a specific request resource to approve")
+		return fmt.Errorf("specify a specific resource to approve")
+	}
+
+	if kind == FleetKind && o.Batch < 1 {
+		return fmt.Errorf("--batch must be set to the number of the rollout batch to approve")
+	}
+
+	if o.Batch != 0 && kind != FleetKind {
+		return fmt.Errorf("--batch only applies to %s approval", FleetKind)
 	}
 
 	if len(o.ApproveLabels) > 0 && kind != EnrollmentRequestKind {
@@ -150,6 +160,11 @@ func (o *ApproveOptions) Run(ctx context.Context, args []string) error {
 		api.RemoveStatusCondition(&csr.Status.Conditions, api.ConditionTypeCertificateSigningRequestDenied)
 		api.RemoveStatusCondition(&csr.Status.Conditions, api.ConditionTypeCertificateSigningRequestFailed)
 		response, err = c.UpdateCertificateSigningRequestApproval(ctx, name, *csr)
+	case kind == FleetKind:
+		approval := api.FleetRolloutBatchApproval{
+			Batch: o.Batch,
+		}
+		response, err = c.ApproveFleetRolloutBatch(ctx, name, approval)
 	default:
 		return fmt.Errorf("unsupported resource kind: %s", kind)
 	}
@@ -330,6 +330,42 @@ func (kna *KindNameAutocomplete) getAutocompleteNames(cmd *cobra.Command, o Clie
 				}
 			}
 		}
+	case SecretKind:
+		resp, err := c.ListSecretsWithResponse(ctx, &api.ListSecretsParams{})
+		if err == nil && resp.JSON200 != nil {
+			for _, er := range resp.JSON200.Items {
+				if er.Metadata.Name != nil {
+					names = append(names, *er.Metadata.Name)
+				}
+			}
+		}
+	case EnrollmentPolicyKind:
+		resp, err := c.ListEnrollmentPoliciesWithResponse(ctx, &api.ListEnrollmentPoliciesParams{})
+		if err == nil && resp.JSON200 != nil {
+			for _, er := range resp.JSON200.Items {
+				if er.Metadata.Name != nil {
+					names = append(names, *er.Metadata.Name)
+				}
+			}
+		}
+	case DeviceRegistrationKind:
+		resp, err := c.ListDeviceRegistrationsWithResponse(ctx, &api.ListDeviceRegistrationsParams{})
+		if err == nil && resp.JSON200 != nil {
+			for _, dr := range resp.JSON200.Items {
+				if dr.Metadata.Name != nil {
+					names = append(names, *dr.Metadata.Name)
+				}
+			}
+		}
+	case NotificationSinkKind:
+		resp, err := c.ListNotificationSinksWithResponse(ctx, &api.ListNotificationSinksParams{})
+		if err == nil && resp.JSON200 != nil {
+			for _, ns := range resp.JSON200.Items {
+				if ns.Metadata.Name != nil {
+					names = append(names, *ns.Metadata.Name)
+				}
+			}
+		}
 	case TemplateVersionKind:
 		if kna.FleetName != nil {
 			resp, err := c.ListTemplateVersionsWithResponse(ctx, *kna.FleetName, &api.ListTemplateVersionsParams{})
@@ -34,6 +34,8 @@ type ConsoleOptions struct {
 	noTTY     bool
 	remoteTTY bool
 	protocols []string
+	// logs turns the session into a logs session that streams the requested logs instead of running a shell
+	logs *api.DeviceLogsRequest
 }
 
 func DefaultConsoleOptions() *ConsoleOptions {
@@ -223,6 +225,7 @@ func (o *ConsoleOptions) createSessionMetadata(t k8sTerm.TTY, passThroughArgs []
 		}
 	}
 	metadata.TTY = o.remoteTTY
+	metadata.Logs = o.logs
 	b, _ := json.Marshal(&metadata)
 	return string(b)
 }
@@ -310,7 +313,7 @@ func (o *ConsoleOptions) connectViaWS(ctx context.Context, config *client.Config
 	var oldState *term.State
 	if t.Raw {
 		options.Stdin = newRawReader(cancel, &oldState)
-	} else if !t.IsTerminalIn() {
+	} else if !t.IsTerminalIn() && o.logs == nil {
 		options.Stdin = os.Stdin
 	}
 	if !o.remoteTTY {
@@ -322,6 +322,14 @@ func (o *DeleteOptions) deleteOne(ctx context.Context, c *client.Client, kind Re
 		response, err = c.DeleteCertificateSigningRequestWithResponse(ctx, name)
 	case AuthProviderKind:
 		response, err = c.DeleteAuthProviderWithResponse(ctx, name)
+	case SecretKind:
+		response, err = c.DeleteSecretWithResponse(ctx, name)
+	case EnrollmentPolicyKind:
+		response, err = c.DeleteEnrollmentPolicyWithResponse(ctx, name)
+	case DeviceRegistrationKind:
+		response, err = c.DeleteDeviceRegistrationWithResponse(ctx, name)
+	case NotificationSinkKind:
+		response, err = c.DeleteNotificationSinkWithResponse(ctx, name)
 	case CatalogKind:
 		response, err = c.V1Alpha1().DeleteCatalogWithResponse(ctx, name)
 	case CatalogItemKind:
@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/cli/display"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var legalDiffOutputTypes = []string{string(display.JSONFormat), string(display.YAMLFormat)}
+
+type DiffOptions struct {
+	GlobalOptions
+}
+
+func DefaultDiffOptions() *DiffOptions {
+	return &DiffOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func NewCmdDiff() *cobra.Command {
+	o := DefaultDiffOptions()
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show differences between resources",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+
+	cmd.AddCommand(NewCmdDiffTemplateVersion())
+
+	return cmd
+}
+
+func (o *DiffOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+}
+
+type DiffTemplateVersionOptions struct {
+	GlobalOptions
+
+	FleetName string
+	Output    string
+}
+
+func DefaultDiffTemplateVersionOptions() *DiffTemplateVersionOptions {
+	return &DiffTemplateVersionOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+// NewCmdDiffTemplateVersion creates a command to show the differences between two template versions of a fleet
+func NewCmdDiffTemplateVersion() *cobra.Command {
+	o := DefaultDiffTemplateVersionOptions()
+	cmd := &cobra.Command{
+		Use:   "templateversion FROM TO --fleetname FLEET",
+		Short: "Show the differences between two template versions of a fleet.",
+		Long: "Show the differences between the device specs rendered by two template versions of a fleet.  " +
+			"Each difference is reported with the path of the field that was added, removed or modified.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *DiffTemplateVersionOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVar(&o.FleetName, FlagFleetName, o.FleetName, "Fleet name of the template versions.")
+	fs.StringVarP(&o.Output, "output", "o", o.Output, fmt.Sprintf("Output format. One of: (%s).", strings.Join(legalDiffOutputTypes, ", ")))
+}
+
+func (o *DiffTemplateVersionOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *DiffTemplateVersionOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	if len(o.FleetName) == 0 {
+		return fmt.Errorf("fleetname must be specified when diffing templateversions")
+	}
+	for _, name := range args {
+		if len(name) == 0 {
+			return fmt.Errorf("specify the names of the two templateversions to diff")
+		}
+	}
+	if len(o.Output) > 0 && !slices.Contains(legalDiffOutputTypes, o.Output) {
+		return fmt.Errorf("output format must be one of (%s)", strings.Join(legalDiffOutputTypes, ", "))
+	}
+	return nil
+}
+
+func (o *DiffTemplateVersionOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	from, to := args[0], args[1]
+	response, err := c.DiffTemplateVersionsWithResponse(ctx, o.FleetName, from, to)
+	if err != nil {
+		return fmt.Errorf("diffing %s/%s and %s/%s: %w", TemplateVersionKind, from, TemplateVersionKind, to, err)
+	}
+	if err := validateResponse(response); err != nil {
+		return fmt.Errorf("diffing %s/%s and %s/%s: %w", TemplateVersionKind, from, TemplateVersionKind, to, err)
+	}
+
+	formatter := display.NewFormatter(display.OutputFormat(o.Output))
+	options := display.FormatOptions{
+		Kind:   api.TemplateVersionDiffKind,
+		Writer: os.Stdout,
+	}
+	if o.Output == string(display.JSONFormat) || o.Output == string(display.YAMLFormat) {
+		return formatter.Format(response.JSON200, options)
+	}
+	return formatter.Format(response, options)
+}
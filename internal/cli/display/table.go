@@ -1,6 +1,7 @@
 package display
 
 import (
+	"encoding/json"
 	"fmt"
 	"slices"
 	"strings"
@@ -14,7 +15,9 @@ import (
 	apiclient "github.com/flightctl/flightctl/internal/api/client"
 	apiclientv1alpha1 "github.com/flightctl/flightctl/internal/api/client/v1alpha1"
 	imagebuilderclient "github.com/flightctl/flightctl/internal/api/imagebuilder/client"
+	"github.com/flightctl/flightctl/internal/rollout"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
 )
 
 const NoneString = "<none>"
@@ -81,6 +84,14 @@ func (f *TableFormatter) formatList(w *tabwriter.Writer, data interface{}, optio
 		return f.printEventsTable(w, data.(*apiclient.ListEventsResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, api.AuthProviderKind):
 		return f.printAuthProvidersTable(w, data.(*apiclient.ListAuthProvidersResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.SecretKind):
+		return f.printSecretsTable(w, data.(*apiclient.ListSecretsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.EnrollmentPolicyKind):
+		return f.printEnrollmentPoliciesTable(w, data.(*apiclient.ListEnrollmentPoliciesResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.DeviceRegistrationKind):
+		return f.printDeviceRegistrationsTable(w, data.(*apiclient.ListDeviceRegistrationsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.NotificationSinkKind):
+		return f.printNotificationSinksTable(w, data.(*apiclient.ListNotificationSinksResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, string(imagebuilderapi.ResourceKindImageBuild)):
 		return f.printImageBuildsTable(w, options.WithExports, data.(*imagebuilderclient.ListImageBuildsResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, string(imagebuilderapi.ResourceKindImageExport)):
@@ -98,6 +109,10 @@ func (f *TableFormatter) formatList(w *tabwriter.Writer, data interface{}, optio
 		return f.printCatalogsTable(w, data.(*apiclientv1alpha1.ListCatalogsResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, apiv1alpha1.CatalogItemKind):
 		return f.printCatalogItemsTable(w, options.CatalogName == "", data.(*apiclientv1alpha1.ListAllCatalogItemsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.RolloutPreviewKind):
+		return f.printRolloutPreviewTable(w, data.(*apiclient.PreviewFleetRolloutResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.TemplateVersionDiffKind):
+		return f.printTemplateVersionDiffTable(w, data.(*apiclient.DiffTemplateVersionsResponse).JSON200)
 	case strings.EqualFold(options.Kind, apiv1alpha1.VulnerabilityGroupKind):
 		if resp, ok := data.(*apiclientv1alpha1.ListVulnerabilitiesResponse); ok {
 			return f.printVulnerabilityGroupsTable(w, false, resp.JSON200.Items...)
@@ -163,6 +178,14 @@ func (f *TableFormatter) formatSingle(w *tabwriter.Writer, data interface{}, opt
 		return f.printCSRTable(w, *data.(*apiclient.GetCertificateSigningRequestResponse).JSON200)
 	case strings.EqualFold(options.Kind, api.AuthProviderKind):
 		return f.printAuthProvidersTable(w, *data.(*apiclient.GetAuthProviderResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.SecretKind):
+		return f.printSecretsTable(w, *data.(*apiclient.GetSecretResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.EnrollmentPolicyKind):
+		return f.printEnrollmentPoliciesTable(w, *data.(*apiclient.GetEnrollmentPolicyResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.DeviceRegistrationKind):
+		return f.printDeviceRegistrationsTable(w, *data.(*apiclient.GetDeviceRegistrationResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.NotificationSinkKind):
+		return f.printNotificationSinksTable(w, *data.(*apiclient.GetNotificationSinkResponse).JSON200)
 	case strings.EqualFold(options.Kind, string(imagebuilderapi.ResourceKindImageBuild)):
 		return f.printImageBuildsTable(w, options.WithExports, *data.(*imagebuilderclient.GetImageBuildResponse).JSON200)
 	case strings.EqualFold(options.Kind, string(imagebuilderapi.ResourceKindImageExport)):
@@ -275,6 +298,8 @@ func (f *TableFormatter) printEnrollmentRequestsTable(w *tabwriter.Writer, ers .
 			approval = util.BoolToStr(e.Status.Approval.Approved, "Approved", "Denied")
 			approver = e.Status.Approval.ApprovedBy
 			approvedLabels = strings.Join(util.LabelMapToArray(e.Status.Approval.Labels), ",")
+		} else if api.IsStatusConditionTrue(e.Status.Conditions, api.ConditionTypeEnrollmentRequestDenied) {
+			approval = "Denied"
 		}
 		f.printTableRowLn(w,
 			*e.Metadata.Name,
@@ -287,11 +312,14 @@ func (f *TableFormatter) printEnrollmentRequestsTable(w *tabwriter.Writer, ers .
 }
 
 func (f *TableFormatter) printFleetsTable(w *tabwriter.Writer, showSummary bool, fleets ...api.Fleet) error {
+	headers := []string{"NAME", "OWNER", "SELECTOR", "VALID"}
+	if f.wide {
+		headers = append(headers, "MAINTENANCE WINDOW")
+	}
 	if showSummary {
-		f.printHeaderRowLn(w, "NAME", "OWNER", "SELECTOR", "VALID", "DEVICES")
-	} else {
-		f.printHeaderRowLn(w, "NAME", "OWNER", "SELECTOR", "VALID")
+		headers = append(headers, "DEVICES")
 	}
+	f.printHeaderRowLn(w, headers...)
 	for i := range fleets {
 		fleet := fleets[i]
 		selector := NoneString
@@ -317,6 +345,9 @@ func (f *TableFormatter) printFleetsTable(w *tabwriter.Writer, showSummary bool,
 			valid,
 		)
 
+		if f.wide {
+			f.printTableRow(w, "", formatMaintenanceWindow(fleet, time.Now()))
+		}
 		if showSummary {
 			f.printTableRow(w, "", numDevices)
 		}
@@ -325,6 +356,24 @@ func (f *TableFormatter) printFleetsTable(w *tabwriter.Writer, showSummary bool,
 	return nil
 }
 
+// formatMaintenanceWindow describes the maintenance window of the fleet that is open, or the one that opens next
+func formatMaintenanceWindow(fleet api.Fleet, now time.Time) string {
+	if fleet.Spec.RolloutPolicy == nil {
+		return NoneString
+	}
+	window, err := rollout.CurrentOrNextMaintenanceWindow(lo.FromPtr(fleet.Spec.RolloutPolicy.MaintenanceWindows), now)
+	switch {
+	case err != nil:
+		return "Invalid"
+	case window == nil:
+		return NoneString
+	case window.IsOpen(now):
+		return fmt.Sprintf("Open until %s", window.End.Format(time.RFC3339))
+	default:
+		return fmt.Sprintf("Opens %s (%s)", window.Start.Format(time.RFC3339), humanize.Time(window.Start))
+	}
+}
+
 func (f *TableFormatter) printOrganizationsTable(w *tabwriter.Writer, orgs ...api.Organization) error {
 	f.printHeaderRowLn(w, "NAME", "DISPLAY NAME", "EXTERNAL ID")
 	for _, org := range orgs {
@@ -340,13 +389,40 @@ func (f *TableFormatter) printOrganizationsTable(w *tabwriter.Writer, orgs ...ap
 }
 
 func (f *TableFormatter) printTemplateVersionsTable(w *tabwriter.Writer, tvs ...api.TemplateVersion) error {
-	f.printHeaderRowLn(w, "FLEET", "NAME")
+	f.printHeaderRowLn(w, "FLEET", "NAME", "ROLLOUT")
 	for _, tv := range tvs {
-		f.printTableRowLn(w, tv.Spec.Fleet, *tv.Metadata.Name)
+		rollout := NoneString
+		if tv.Status != nil && tv.Status.Rollout != nil {
+			rollout = string(tv.Status.Rollout.Outcome)
+		}
+		f.printTableRowLn(w, tv.Spec.Fleet, *tv.Metadata.Name, rollout)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printTemplateVersionDiffTable(w *tabwriter.Writer, diff *api.TemplateVersionDiff) error {
+	f.printHeaderRowLn(w, "PATH", "CHANGE", "FROM", "TO")
+	for _, change := range diff.Changes {
+		f.printTableRowLn(w, change.Path, string(change.Type), diffValueString(change.From), diffValueString(change.To))
 	}
 	return nil
 }
 
+// diffValueString renders a value of a template version change as a single table cell
+func diffValueString(value interface{}) string {
+	if value == nil {
+		return NoneString
+	}
+	if str, ok := value.(string); ok {
+		return str
+	}
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(bytes)
+}
+
 func (f *TableFormatter) printRepositoriesTable(w *tabwriter.Writer, repos ...api.Repository) error {
 	f.printHeaderRowLn(w, "NAME", "TYPE", "REPOSITORY URL", "ACCESSIBLE")
 	for _, r := range repos {
@@ -373,6 +449,86 @@ func (f *TableFormatter) printRepositoriesTable(w *tabwriter.Writer, repos ...ap
 	return nil
 }
 
+func (f *TableFormatter) printSecretsTable(w *tabwriter.Writer, secrets ...api.Secret) error {
+	f.printHeaderRowLn(w, "NAME", "KEYS", "AGE")
+	for _, s := range secrets {
+		age := NoneString
+		if s.Metadata.CreationTimestamp != nil {
+			age = time.Since(*s.Metadata.CreationTimestamp).Round(time.Second).String()
+		}
+		f.printTableRowLn(w,
+			lo.FromPtr(s.Metadata.Name),
+			fmt.Sprintf("%d", len(s.Spec.Data)),
+			age,
+		)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printEnrollmentPoliciesTable(w *tabwriter.Writer, policies ...api.EnrollmentPolicy) error {
+	f.printHeaderRowLn(w, "NAME", "FLEET", "LABELS", "AGE")
+	for _, p := range policies {
+		age := NoneString
+		if p.Metadata.CreationTimestamp != nil {
+			age = time.Since(*p.Metadata.CreationTimestamp).Round(time.Second).String()
+		}
+		f.printTableRowLn(w,
+			lo.FromPtr(p.Metadata.Name),
+			util.DefaultIfNil(p.Spec.Fleet, NoneString),
+			strings.Join(util.LabelMapToArray(p.Spec.Labels), ","),
+			age,
+		)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printDeviceRegistrationsTable(w *tabwriter.Writer, registrations ...api.DeviceRegistration) error {
+	f.printHeaderRowLn(w, "NAME", "TPM EK", "SYSTEM INFO", "LABELS", "ENROLLMENT REQUEST", "AGE")
+	for _, r := range registrations {
+		age := NoneString
+		if r.Metadata.CreationTimestamp != nil {
+			age = time.Since(*r.Metadata.CreationTimestamp).Round(time.Second).String()
+		}
+		enrollmentRequest := NoneString
+		if r.Status != nil {
+			enrollmentRequest = util.DefaultIfNil(r.Status.EnrollmentRequest, NoneString)
+		}
+		f.printTableRowLn(w,
+			lo.FromPtr(r.Metadata.Name),
+			lo.Ternary(r.Spec.TpmEkCertificate != nil, "Yes", "No"),
+			strings.Join(util.LabelMapToArray(r.Spec.SystemInfo), ","),
+			strings.Join(util.LabelMapToArray(r.Spec.Labels), ","),
+			enrollmentRequest,
+			age,
+		)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printNotificationSinksTable(w *tabwriter.Writer, sinks ...api.NotificationSink) error {
+	f.printHeaderRowLn(w, "NAME", "TYPE", "TARGET", "AGE")
+	for _, s := range sinks {
+		age := NoneString
+		if s.Metadata.CreationTimestamp != nil {
+			age = time.Since(*s.Metadata.CreationTimestamp).Round(time.Second).String()
+		}
+		target := NoneString
+		switch {
+		case s.Spec.Http != nil:
+			target = s.Spec.Http.Url
+		case s.Spec.Email != nil:
+			target = strings.Join(s.Spec.Email.To, ",")
+		}
+		f.printTableRowLn(w,
+			lo.FromPtr(s.Metadata.Name),
+			string(s.Spec.Type),
+			target,
+			age,
+		)
+	}
+	return nil
+}
+
 func (f *TableFormatter) printResourceSyncsTable(w *tabwriter.Writer, resourcesyncs ...api.ResourceSync) error {
 	f.printHeaderRowLn(w, "NAME", "REPOSITORY", "PATH", "REVISION", "ACCESSIBLE", "SYNCED", "LAST SYNC")
 
@@ -1115,3 +1271,39 @@ func (f *TableFormatter) printFleetVulnerabilitySummaryTable(w *tabwriter.Writer
 		summary.Summary.UniqueDigests)
 	return nil
 }
+
+func (f *TableFormatter) printRolloutPreviewTable(w *tabwriter.Writer, preview *api.RolloutPreview) error {
+	columns := []string{"BATCH", "DEVICES", "DISRUPTION BUDGET"}
+	if f.wide {
+		columns = append(columns, "DEVICE NAMES")
+	}
+	f.printHeaderRowLn(w, columns...)
+	for _, batch := range preview.Batches {
+		budget := NoneString
+		if batch.DisruptionBudgetGroups != nil {
+			budget = strings.Join(lo.Map(*batch.DisruptionBudgetGroups, func(g api.RolloutPreviewDisruptionBudgetGroup, _ int) string {
+				labels := util.LabelMapToArray(&g.Labels)
+				slices.Sort(labels)
+				group := strings.Join(labels, ",")
+				if len(group) == 0 {
+					group = "<all>"
+				}
+				return fmt.Sprintf("%s (%d/%d, max %d)", group, g.Devices, g.Total, g.MaxConcurrent)
+			}), " ")
+		}
+		row := []string{batch.Name, fmt.Sprintf("%d", len(batch.Devices)), budget}
+		if f.wide {
+			row = append(row, strings.Join(batch.Devices, ","))
+		}
+		f.printTableRowLn(w, row...)
+	}
+	if len(preview.UnselectedDevices) == 0 {
+		return nil
+	}
+	fmt.Fprintln(w)
+	f.printHeaderRowLn(w, "UNSELECTED DEVICE", "REASON")
+	for _, device := range preview.UnselectedDevices {
+		f.printTableRowLn(w, device.Name, device.Reason)
+	}
+	return nil
+}
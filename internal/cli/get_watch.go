@@ -0,0 +1,522 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	apiclient "github.com/flightctl/flightctl/internal/api/client"
+	"github.com/flightctl/flightctl/internal/cli/display"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+	"golang.org/x/term"
+)
+
+const (
+	// watchPollInterval is how often resources are re-listed when the server cannot stream changes.
+	watchPollInterval = 2 * time.Second
+	// watchRenderDelay batches changes that arrive together, e.g. the initial state of a watch, into one redraw.
+	watchRenderDelay = 200 * time.Millisecond
+)
+
+// watchableKinds are the resource kinds supported by 'get --watch'.
+var watchableKinds = []ResourceKind{DeviceKind, FleetKind, EnrollmentRequestKind, EventKind}
+
+// errWatchNotSupported is returned when the server cannot stream changes for a resource kind.
+var errWatchNotSupported = errors.New("watch is not supported by the server")
+
+// validateWatch checks the usage of the --watch flag.
+func (o *GetOptions) validateWatch(kind ResourceKind) error {
+	if !o.Watch {
+		return nil
+	}
+	if !slices.Contains(watchableKinds, kind) {
+		return fmt.Errorf("'--watch' can only be specified when getting devices, fleets, enrollmentrequests or events")
+	}
+	if len(o.Output) > 0 && o.Output != string(display.WideFormat) {
+		return fmt.Errorf("'--watch' only supports table output ('-o wide' or no output format)")
+	}
+	if o.Summary || o.SummaryOnly || o.Rendered || o.LastSeen {
+		return fmt.Errorf("'--watch' cannot be combined with '--summary', '--summary-only', '--rendered', or '--last-seen'")
+	}
+	if o.Limit > 0 || len(o.Continue) > 0 {
+		return fmt.Errorf("flags '--limit' and '--continue' are not supported when '--watch' is specified")
+	}
+	return nil
+}
+
+// runWatch prints the requested resources and keeps the table up to date until ctx is done.
+func (o *GetOptions) runWatch(ctx context.Context, kind ResourceKind, names []string) error {
+	o.FieldSelector = watchFieldSelector(o.FieldSelector, names)
+
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	if err := o.watch(ctx, c, kind, newWatchPrinter(o, kind, os.Stdout)); err != nil {
+		return fmt.Errorf("watching %s: %w", kind.ToPlural(), err)
+	}
+	return nil
+}
+
+// watchFieldSelector restricts the field selector to the named resources, if any.
+func watchFieldSelector(fieldSelector string, names []string) string {
+	if len(names) == 0 {
+		return fieldSelector
+	}
+	nameSelector := fmt.Sprintf("metadata.name in (%s)", strings.Join(names, ","))
+	if len(fieldSelector) == 0 {
+		return nameSelector
+	}
+	return fieldSelector + "," + nameSelector
+}
+
+// watch streams changes from the server, falling back to polling when the server cannot stream them.
+func (o *GetOptions) watch(ctx context.Context, c *client.Client, kind ResourceKind, printer *watchPrinter) error {
+	table := newWatchTable(kind)
+
+	body, err := o.openWatch(ctx, c, kind)
+	if errors.Is(err, errWatchNotSupported) {
+		return o.pollWatch(ctx, c, table, printer)
+	}
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	if kind == EventKind {
+		// An event watch only reports new events, so show the recent ones first.
+		if _, err := o.pollOnce(ctx, c, table); err != nil {
+			return err
+		}
+	}
+	if err := printer.render(table); err != nil {
+		return err
+	}
+	return o.streamWatch(ctx, body, table, printer)
+}
+
+// openWatch starts a watch request and returns the stream of newline-delimited watch events.
+func (o *GetOptions) openWatch(ctx context.Context, c *client.Client, kind ResourceKind) (io.ReadCloser, error) {
+	var resp *http.Response
+	var err error
+	switch kind {
+	case DeviceKind:
+		resp, err = c.ListDevices(ctx, &api.ListDevicesParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			CveId:         util.ToPtrWithNilDefault(o.CveId),
+			Watch:         lo.ToPtr(true),
+		})
+	case FleetKind:
+		resp, err = c.ListFleets(ctx, &api.ListFleetsParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Watch:         lo.ToPtr(true),
+		})
+	case EventKind:
+		resp, err = c.ListEvents(ctx, &api.ListEventsParams{
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Watch:         lo.ToPtr(true),
+		})
+	default:
+		return nil, errWatchNotSupported
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if resp.StatusCode == http.StatusOK && strings.HasPrefix(contentType, "application/x-ndjson") {
+		return resp.Body, nil
+	}
+	defer resp.Body.Close()
+
+	// Servers without watch support either reject the request or ignore the
+	// parameter and return a regular list.
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNotImplemented {
+		return nil, errWatchNotSupported
+	}
+	if strings.Contains(contentType, "json") {
+		var status api.Status
+		if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+			return nil, fmt.Errorf("unmarshalling error: %w", err)
+		}
+		return nil, fmt.Errorf("response status: %d, message: %s", resp.StatusCode, status.Message)
+	}
+	return nil, fmt.Errorf("response status: %d", resp.StatusCode)
+}
+
+// streamWatch applies the watch events read from body to the table until the stream ends.
+func (o *GetOptions) streamWatch(ctx context.Context, body io.Reader, table *watchTable, printer *watchPrinter) error {
+	done := make(chan struct{})
+	defer close(done)
+
+	events := make(chan api.WatchEvent)
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(events)
+		decoder := json.NewDecoder(body)
+		for {
+			var event api.WatchEvent
+			if err := decoder.Decode(&event); err != nil {
+				if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+					readErr <- fmt.Errorf("reading watch events: %w", err)
+				}
+				return
+			}
+			select {
+			case events <- event:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var render <-chan time.Time
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				if render != nil {
+					if err := printer.render(table); err != nil {
+						return err
+					}
+				}
+				select {
+				case err := <-readErr:
+					return err
+				default:
+					return nil
+				}
+			}
+			changed, err := table.apply(event)
+			if err != nil {
+				return err
+			}
+			if changed && render == nil {
+				render = time.After(watchRenderDelay)
+			}
+		case <-render:
+			render = nil
+			if err := printer.render(table); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// pollWatch re-lists the resources periodically and redraws the table when they change.
+func (o *GetOptions) pollWatch(ctx context.Context, c *client.Client, table *watchTable, printer *watchPrinter) error {
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for first := true; ; first = false {
+		changed, err := o.pollOnce(ctx, c, table)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if changed || first {
+			if err := printer.render(table); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollOnce lists the watched resources and updates the table with them.
+// Events are append-only, so only the most recent page of them is listed.
+func (o *GetOptions) pollOnce(ctx context.Context, c *client.Client, table *watchTable) (bool, error) {
+	listOptions := *o
+	listOptions.Limit = 0
+	listOptions.Continue = ""
+
+	var items []json.RawMessage
+	for {
+		response, err := listOptions.getResourceList(ctx, c, table.kind)
+		if err != nil {
+			return false, err
+		}
+		json200, err := ExtractJSON200(response)
+		if err != nil {
+			return false, err
+		}
+		page, err := decodeWatchPage(json200)
+		if err != nil {
+			return false, err
+		}
+		items = append(items, page.Items...)
+		if table.kind == EventKind || page.Metadata.Continue == nil {
+			break
+		}
+		listOptions.Continue = *page.Metadata.Continue
+	}
+
+	if table.kind == EventKind {
+		// Events are listed newest first, but new rows are appended at the bottom.
+		slices.Reverse(items)
+		return table.merge(items)
+	}
+	return table.replace(items)
+}
+
+type watchPage struct {
+	Metadata api.ListMeta      `json:"metadata"`
+	Items    []json.RawMessage `json:"items"`
+}
+
+func decodeWatchPage(list interface{}) (*watchPage, error) {
+	data, err := json.Marshal(list)
+	if err != nil {
+		return nil, err
+	}
+	var page watchPage
+	if err := json.Unmarshal(data, &page); err != nil {
+		return nil, fmt.Errorf("decoding list: %w", err)
+	}
+	return &page, nil
+}
+
+// watchTable holds the rows shown by 'get --watch' in the order they were first seen.
+type watchTable struct {
+	kind    ResourceKind
+	names   []string
+	items   map[string]json.RawMessage
+	changed []string
+}
+
+func newWatchTable(kind ResourceKind) *watchTable {
+	return &watchTable{
+		kind:  kind,
+		items: make(map[string]json.RawMessage),
+	}
+}
+
+// apply updates the table with a watch event and reports whether a row changed.
+func (t *watchTable) apply(event api.WatchEvent) (bool, error) {
+	switch event.Type {
+	case api.WatchEventTypeAdded, api.WatchEventTypeModified:
+		name, err := watchObjectName(event.Object)
+		if err != nil {
+			return false, err
+		}
+		return t.set(name, event.Object), nil
+	case api.WatchEventTypeDeleted:
+		name, err := watchObjectName(event.Object)
+		if err != nil {
+			return false, err
+		}
+		return t.remove(name), nil
+	case api.WatchEventTypeError:
+		var status api.Status
+		if err := json.Unmarshal(event.Object, &status); err != nil {
+			return false, fmt.Errorf("watch ended with an error")
+		}
+		return false, fmt.Errorf("watch ended: %s", status.Message)
+	default:
+		return false, fmt.Errorf("unknown watch event type %q", event.Type)
+	}
+}
+
+// replace sets the table to exactly the given objects.
+func (t *watchTable) replace(objects []json.RawMessage) (bool, error) {
+	present := make(map[string]struct{}, len(objects))
+	changed := false
+	for _, object := range objects {
+		name, err := watchObjectName(object)
+		if err != nil {
+			return false, err
+		}
+		present[name] = struct{}{}
+		changed = t.set(name, object) || changed
+	}
+	for _, name := range slices.Clone(t.names) {
+		if _, ok := present[name]; !ok {
+			changed = t.remove(name) || changed
+		}
+	}
+	return changed, nil
+}
+
+// merge adds or updates the given objects without removing any rows.
+func (t *watchTable) merge(objects []json.RawMessage) (bool, error) {
+	changed := false
+	for _, object := range objects {
+		name, err := watchObjectName(object)
+		if err != nil {
+			return false, err
+		}
+		changed = t.set(name, object) || changed
+	}
+	return changed, nil
+}
+
+func (t *watchTable) set(name string, object json.RawMessage) bool {
+	current, ok := t.items[name]
+	if ok && bytes.Equal(current, object) {
+		return false
+	}
+	if !ok {
+		t.names = append(t.names, name)
+	}
+	t.items[name] = object
+	t.changed = append(t.changed, name)
+	return true
+}
+
+func (t *watchTable) remove(name string) bool {
+	if _, ok := t.items[name]; !ok {
+		return false
+	}
+	delete(t.items, name)
+	t.names = slices.DeleteFunc(t.names, func(n string) bool { return n == name })
+	return true
+}
+
+// takeChanged returns the rows added or updated since the last call, in table order.
+func (t *watchTable) takeChanged() []string {
+	changed := lo.Uniq(t.changed)
+	t.changed = nil
+	return lo.Filter(t.names, func(name string, _ int) bool { return slices.Contains(changed, name) })
+}
+
+// response wraps the named rows into the list response the table formatter expects for the kind.
+func (t *watchTable) response(names []string) (interface{}, error) {
+	page := watchPage{Items: make([]json.RawMessage, 0, len(names))}
+	for _, name := range names {
+		page.Items = append(page.Items, t.items[name])
+	}
+	data, err := json.Marshal(page)
+	if err != nil {
+		return nil, err
+	}
+
+	switch t.kind {
+	case DeviceKind:
+		list, err := decodeWatchList[api.DeviceList](data)
+		return &apiclient.ListDevicesResponse{JSON200: list}, err
+	case FleetKind:
+		list, err := decodeWatchList[api.FleetList](data)
+		return &apiclient.ListFleetsResponse{JSON200: list}, err
+	case EnrollmentRequestKind:
+		list, err := decodeWatchList[api.EnrollmentRequestList](data)
+		return &apiclient.ListEnrollmentRequestsResponse{JSON200: list}, err
+	case EventKind:
+		list, err := decodeWatchList[api.EventList](data)
+		return &apiclient.ListEventsResponse{JSON200: list}, err
+	default:
+		return nil, fmt.Errorf("unsupported resource kind: %s", t.kind)
+	}
+}
+
+func decodeWatchList[T any](data []byte) (*T, error) {
+	var list T
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("decoding watched resources: %w", err)
+	}
+	return &list, nil
+}
+
+func watchObjectName(object json.RawMessage) (string, error) {
+	var partial struct {
+		Metadata api.ObjectMeta `json:"metadata"`
+	}
+	if err := json.Unmarshal(object, &partial); err != nil {
+		return "", fmt.Errorf("decoding watched resource: %w", err)
+	}
+	if partial.Metadata.Name == nil {
+		return "", fmt.Errorf("watched resource has no name")
+	}
+	return *partial.Metadata.Name, nil
+}
+
+// watchPrinter draws the table of a watch. On a terminal the whole table is
+// redrawn in place; otherwise only the rows that changed are appended.
+type watchPrinter struct {
+	o            *GetOptions
+	kind         ResourceKind
+	out          io.Writer
+	inPlace      bool
+	height       int
+	printedLines int
+	formatter    display.OutputFormatter
+}
+
+func newWatchPrinter(o *GetOptions, kind ResourceKind, out io.Writer) *watchPrinter {
+	p := &watchPrinter{
+		o:         o,
+		kind:      kind,
+		out:       out,
+		formatter: display.NewFormatter(display.OutputFormat(o.Output)),
+	}
+	if f, ok := out.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		p.inPlace = true
+		if _, height, err := term.GetSize(int(f.Fd())); err == nil {
+			p.height = height
+		}
+	}
+	return p
+}
+
+func (p *watchPrinter) render(table *watchTable) error {
+	changed := table.takeChanged()
+	if !p.inPlace {
+		response, err := table.response(changed)
+		if err != nil {
+			return err
+		}
+		return p.formatter.Format(response, p.formatOptions(p.out))
+	}
+
+	response, err := table.response(table.names)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	// A new formatter prints the header again for every redraw.
+	if err := display.NewFormatter(display.OutputFormat(p.o.Output)).Format(response, p.formatOptions(&buf)); err != nil {
+		return err
+	}
+
+	if p.printedLines > 0 {
+		if p.height > 0 && p.printedLines < p.height {
+			// Move the cursor back to the start of the previous table and clear it.
+			fmt.Fprintf(p.out, "\033[%dA\033[J", p.printedLines)
+		} else {
+			// The previous table scrolled off the screen, so start over at the top.
+			fmt.Fprint(p.out, "\033[H\033[2J")
+		}
+	}
+	p.printedLines = bytes.Count(buf.Bytes(), []byte("\n"))
+	_, err = p.out.Write(buf.Bytes())
+	return err
+}
+
+func (p *watchPrinter) formatOptions(w io.Writer) display.FormatOptions {
+	return display.FormatOptions{
+		Kind:   p.kind.String(),
+		Wide:   p.o.Output == string(display.WideFormat),
+		Writer: w,
+	}
+}
@@ -0,0 +1,233 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/cli/display"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func makeWatchStream(t *testing.T, events ...api.WatchEvent) *http.Response {
+	t.Helper()
+
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, event := range events {
+		require.NoError(t, encoder.Encode(event))
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/x-ndjson"}},
+		Body:       io.NopCloser(&body),
+	}
+}
+
+func makeWatchEvent(t *testing.T, eventType api.WatchEventType, object any) api.WatchEvent {
+	t.Helper()
+
+	data, err := json.Marshal(object)
+	require.NoError(t, err)
+	return api.WatchEvent{Type: eventType, Object: data}
+}
+
+func makeWatchDevice(name string, labels map[string]string) api.Device {
+	return api.Device{
+		ApiVersion: "v1beta1",
+		Kind:       api.DeviceKind,
+		Metadata:   api.ObjectMeta{Name: lo.ToPtr(name), Labels: lo.ToPtr(labels)},
+	}
+}
+
+func makeWatchEventResource(name string, created time.Time, message string) api.Event {
+	return api.Event{
+		ApiVersion:     "v1beta1",
+		Kind:           api.EventKind,
+		Metadata:       api.ObjectMeta{Name: lo.ToPtr(name), CreationTimestamp: lo.ToPtr(created)},
+		InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: "dev-1"},
+		Type:           api.Normal,
+		Message:        message,
+	}
+}
+
+func TestValidateWatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    ResourceKind
+		modify  func(o *GetOptions)
+		wantErr string
+	}{
+		{name: "watch disabled", kind: RepositoryKind},
+		{name: "devices", kind: DeviceKind, modify: func(o *GetOptions) { o.Watch = true }},
+		{name: "enrollment requests wide", kind: EnrollmentRequestKind, modify: func(o *GetOptions) { o.Watch, o.Output = true, string(display.WideFormat) }},
+		{name: "unsupported kind", kind: RepositoryKind, modify: func(o *GetOptions) { o.Watch = true }, wantErr: "can only be specified"},
+		{name: "structured output", kind: FleetKind, modify: func(o *GetOptions) { o.Watch, o.Output = true, string(display.JSONFormat) }, wantErr: "only supports table output"},
+		{name: "summary", kind: FleetKind, modify: func(o *GetOptions) { o.Watch, o.Summary = true, true }, wantErr: "cannot be combined"},
+		{name: "limit", kind: EventKind, modify: func(o *GetOptions) { o.Watch, o.Limit = true, 10 }, wantErr: "not supported"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := DefaultGetOptions()
+			if tt.modify != nil {
+				tt.modify(o)
+			}
+			err := o.validateWatch(tt.kind)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestWatchFieldSelector(t *testing.T) {
+	tests := []struct {
+		name          string
+		fieldSelector string
+		names         []string
+		expected      string
+	}{
+		{name: "no names", fieldSelector: "status.updated.status=UpToDate", expected: "status.updated.status=UpToDate"},
+		{name: "names only", names: []string{"d1", "d2"}, expected: "metadata.name in (d1,d2)"},
+		{name: "names and field selector", fieldSelector: "status.updated.status=UpToDate", names: []string{"d1"}, expected: "status.updated.status=UpToDate,metadata.name in (d1)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, watchFieldSelector(tt.fieldSelector, tt.names))
+		})
+	}
+}
+
+func TestWatchStreamsChanges(t *testing.T) {
+	require := require.New(t)
+
+	stream := makeWatchStream(t,
+		makeWatchEvent(t, api.WatchEventTypeAdded, makeWatchDevice("dev-1", map[string]string{"alias": "first"})),
+		makeWatchEvent(t, api.WatchEventTypeAdded, makeWatchDevice("dev-2", nil)),
+		makeWatchEvent(t, api.WatchEventTypeModified, makeWatchDevice("dev-1", map[string]string{"alias": "renamed"})),
+		makeWatchEvent(t, api.WatchEventTypeDeleted, makeWatchDevice("dev-2", nil)),
+	)
+	apiClient, fake := newTestClient(t, stream)
+
+	o := DefaultGetOptions()
+	o.Watch = true
+	var out bytes.Buffer
+	err := o.watch(context.Background(), client.NewTestClient(apiClient), DeviceKind, newWatchPrinter(o, DeviceKind, &out))
+	require.NoError(err)
+	require.Equal(1, fake.callCount)
+
+	output := out.String()
+	require.Equal(1, strings.Count(output, "NAME"), "header should be printed once")
+	require.Contains(output, "renamed")
+	require.NotContains(output, "first")
+	require.NotContains(output, "dev-2")
+}
+
+func TestWatchEndsOnErrorEvent(t *testing.T) {
+	stream := makeWatchStream(t,
+		makeWatchEvent(t, api.WatchEventTypeError, api.Status{Code: http.StatusServiceUnavailable, Message: "watch fell behind and was closed"}),
+	)
+	apiClient, _ := newTestClient(t, stream)
+
+	o := DefaultGetOptions()
+	o.Watch = true
+	err := o.watch(context.Background(), client.NewTestClient(apiClient), FleetKind, newWatchPrinter(o, FleetKind, io.Discard))
+	require.ErrorContains(t, err, "watch fell behind and was closed")
+}
+
+func TestWatchEventsShowsRecentEventsFirst(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Now()
+	body, err := json.Marshal(api.EventList{
+		ApiVersion: "v1beta1",
+		Kind:       api.EventListKind,
+		// Events are listed newest first.
+		Items: []api.Event{
+			makeWatchEventResource("event-2", now.Add(-time.Minute), "second"),
+			makeWatchEventResource("event-1", now.Add(-2*time.Minute), "first"),
+		},
+	})
+	require.NoError(err)
+	list := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}
+	stream := makeWatchStream(t,
+		makeWatchEvent(t, api.WatchEventTypeAdded, makeWatchEventResource("event-3", now, "third")),
+	)
+	apiClient, fake := newTestClient(t, stream, list)
+
+	o := DefaultGetOptions()
+	o.Watch = true
+	var out bytes.Buffer
+	err = o.watch(context.Background(), client.NewTestClient(apiClient), EventKind, newWatchPrinter(o, EventKind, &out))
+	require.NoError(err)
+	require.Equal(2, fake.callCount)
+
+	output := out.String()
+	first := strings.Index(output, "first")
+	second := strings.Index(output, "second")
+	third := strings.Index(output, "third")
+	require.True(first >= 0 && first < second && second < third, "unexpected event order:\n%s", output)
+}
+
+func TestWatchFallsBackToPolling(t *testing.T) {
+	require := require.New(t)
+
+	notImplemented := &http.Response{
+		StatusCode: http.StatusNotImplemented,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"code":501,"message":"watch is not available"}`)),
+	}
+	apiClient, fake := newTestClient(t, notImplemented, makeDeviceListPage(t, 2, nil, nil))
+
+	o := DefaultGetOptions()
+	o.Watch = true
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	var out bytes.Buffer
+	err := o.watch(ctx, client.NewTestClient(apiClient), DeviceKind, newWatchPrinter(o, DeviceKind, &out))
+	require.NoError(err)
+	require.Equal(2, fake.callCount)
+	require.Contains(out.String(), "dev-0")
+	require.Contains(out.String(), "dev-1")
+}
+
+func TestWatchTableReplace(t *testing.T) {
+	require := require.New(t)
+
+	marshal := func(d api.Device) json.RawMessage {
+		data, err := json.Marshal(d)
+		require.NoError(err)
+		return data
+	}
+	table := newWatchTable(DeviceKind)
+
+	changed, err := table.replace([]json.RawMessage{marshal(makeWatchDevice("a", nil)), marshal(makeWatchDevice("b", nil))})
+	require.NoError(err)
+	require.True(changed)
+	require.Equal([]string{"a", "b"}, table.takeChanged())
+
+	changed, err = table.replace([]json.RawMessage{marshal(makeWatchDevice("a", nil)), marshal(makeWatchDevice("b", nil))})
+	require.NoError(err)
+	require.False(changed)
+	require.Empty(table.takeChanged())
+
+	changed, err = table.replace([]json.RawMessage{marshal(makeWatchDevice("b", map[string]string{"k": "v"})), marshal(makeWatchDevice("c", nil))})
+	require.NoError(err)
+	require.True(changed)
+	require.Equal([]string{"b", "c"}, table.names)
+	require.Equal([]string{"b", "c"}, table.takeChanged())
+}
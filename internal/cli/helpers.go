@@ -28,6 +28,8 @@ const (
 	CatalogItemKind               ResourceKind = "catalogitem"
 	CertificateSigningRequestKind ResourceKind = "certificatesigningrequest"
 	DeviceKind                    ResourceKind = "device"
+	DeviceRegistrationKind        ResourceKind = "deviceregistration"
+	EnrollmentPolicyKind          ResourceKind = "enrollmentpolicy"
 	EnrollmentRequestKind         ResourceKind = "enrollmentrequest"
 	EventKind                     ResourceKind = "event"
 	AuthProviderKind              ResourceKind = "authprovider"
@@ -35,9 +37,11 @@ const (
 	ImageBuildKind                ResourceKind = "imagebuild"
 	ImageExportKind               ResourceKind = "imageexport"
 	ImagePromotionKind            ResourceKind = "imagepromotion"
+	NotificationSinkKind          ResourceKind = "notificationsink"
 	OrganizationKind              ResourceKind = "organization"
 	RepositoryKind                ResourceKind = "repository"
 	ResourceSyncKind              ResourceKind = "resourcesync"
+	SecretKind                    ResourceKind = "secret"
 	TemplateVersionKind           ResourceKind = "templateversion"
 	VulnerabilityKind             ResourceKind = "vulnerability"
 )
@@ -70,6 +74,8 @@ var (
 		CatalogItemKind:               {},
 		CertificateSigningRequestKind: {},
 		DeviceKind:                    {},
+		DeviceRegistrationKind:        {},
+		EnrollmentPolicyKind:          {},
 		EnrollmentRequestKind:         {},
 		EventKind:                     {},
 		AuthProviderKind:              {},
@@ -77,9 +83,11 @@ var (
 		ImageBuildKind:                {},
 		ImageExportKind:               {},
 		ImagePromotionKind:            {},
+		NotificationSinkKind:          {},
 		OrganizationKind:              {},
 		RepositoryKind:                {},
 		ResourceSyncKind:              {},
+		SecretKind:                    {},
 		TemplateVersionKind:           {},
 		VulnerabilityKind:             {},
 	}
@@ -91,6 +99,8 @@ var (
 		"catalogitems":               CatalogItemKind,
 		"certificatesigningrequests": CertificateSigningRequestKind,
 		"devices":                    DeviceKind,
+		"deviceregistrations":        DeviceRegistrationKind,
+		"enrollmentpolicies":         EnrollmentPolicyKind,
 		"enrollmentrequests":         EnrollmentRequestKind,
 		"events":                     EventKind,
 		"authproviders":              AuthProviderKind,
@@ -98,9 +108,11 @@ var (
 		"imagebuilds":                ImageBuildKind,
 		"imageexports":               ImageExportKind,
 		"imagepromotions":            ImagePromotionKind,
+		"notificationsinks":          NotificationSinkKind,
 		"organizations":              OrganizationKind,
 		"repositories":               RepositoryKind,
 		"resourcesyncs":              ResourceSyncKind,
+		"secrets":                    SecretKind,
 		"templateversions":           TemplateVersionKind,
 		"vulnerabilities":            VulnerabilityKind,
 	}
@@ -110,6 +122,8 @@ var (
 		CatalogItemKind:               "catalogitems",
 		CertificateSigningRequestKind: "certificatesigningrequests",
 		DeviceKind:                    "devices",
+		DeviceRegistrationKind:        "deviceregistrations",
+		EnrollmentPolicyKind:          "enrollmentpolicies",
 		EnrollmentRequestKind:         "enrollmentrequests",
 		EventKind:                     "events",
 		AuthProviderKind:              "authproviders",
@@ -117,9 +131,11 @@ var (
 		ImageBuildKind:                "imagebuilds",
 		ImageExportKind:               "imageexports",
 		ImagePromotionKind:            "imagepromotions",
+		NotificationSinkKind:          "notificationsinks",
 		OrganizationKind:              "organizations",
 		RepositoryKind:                "repositories",
 		ResourceSyncKind:              "resourcesyncs",
+		SecretKind:                    "secrets",
 		TemplateVersionKind:           "templateversions",
 		VulnerabilityKind:             "vulnerabilities",
 	}
@@ -129,6 +145,8 @@ var (
 		"ci":   CatalogItemKind,
 		"csr":  CertificateSigningRequestKind,
 		"dev":  DeviceKind,
+		"dr":   DeviceRegistrationKind,
+		"ep":   EnrollmentPolicyKind,
 		"er":   EnrollmentRequestKind,
 		"ev":   EventKind,
 		"ap":   AuthProviderKind,
@@ -136,9 +154,11 @@ var (
 		"ib":   ImageBuildKind,
 		"ie":   ImageExportKind,
 		"ip":   ImagePromotionKind,
+		"ns":   NotificationSinkKind,
 		"org":  OrganizationKind,
 		"repo": RepositoryKind,
 		"rs":   ResourceSyncKind,
+		"sec":  SecretKind,
 		"tv":   TemplateVersionKind,
 		"vuln": VulnerabilityKind,
 	}
@@ -398,6 +418,14 @@ func GetSingleResource(ctx context.Context, c *client.Client, kind ResourceKind,
 		return c.GetCertificateSigningRequestWithResponse(ctx, name)
 	case AuthProviderKind:
 		return c.GetAuthProviderWithResponse(ctx, name)
+	case SecretKind:
+		return c.GetSecretWithResponse(ctx, name)
+	case EnrollmentPolicyKind:
+		return c.GetEnrollmentPolicyWithResponse(ctx, name)
+	case DeviceRegistrationKind:
+		return c.GetDeviceRegistrationWithResponse(ctx, name)
+	case NotificationSinkKind:
+		return c.GetNotificationSinkWithResponse(ctx, name)
 	default:
 		return nil, fmt.Errorf("unsupported resource kind: %s", kind)
 	}
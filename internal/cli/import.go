@@ -0,0 +1,292 @@
+package cli
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Columns of the CSV file that devices are imported from. Further columns named systemInfo.<key> set the
+// system info the device must report for key.
+const (
+	importColumnName             = "name"
+	importColumnSerialNumber     = "serialNumber"
+	importColumnTPMEKCertificate = "tpmEkCertificate"
+	importColumnLabels           = "labels"
+	importColumnSystemInfoPrefix = "systemInfo."
+
+	// serialNumberSystemInfoKey is the system info key the agent reports the serial number of the device with.
+	serialNumberSystemInfoKey = "productSerial"
+)
+
+type ImportOptions struct {
+	GlobalOptions
+}
+
+func DefaultImportOptions() *ImportOptions {
+	return &ImportOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func NewCmdImport() *cobra.Command {
+	o := DefaultImportOptions()
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import resources from files.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+
+	cmd.AddCommand(NewCmdImportDevices())
+
+	return cmd
+}
+
+func (o *ImportOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+}
+
+type ImportDevicesOptions struct {
+	GlobalOptions
+
+	Filename string
+	Labels   []string
+	DryRun   bool
+}
+
+func DefaultImportDevicesOptions() *ImportDevicesOptions {
+	return &ImportDevicesOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		Labels:        []string{},
+	}
+}
+
+// NewCmdImportDevices creates a command to pre-register devices listed in a CSV file
+func NewCmdImportDevices() *cobra.Command {
+	o := DefaultImportDevicesOptions()
+	cmd := &cobra.Command{
+		Use:   "devices -f FILENAME",
+		Short: "Pre-register the devices listed in a CSV file, so their enrollment requests are approved automatically.",
+		Long: `Pre-register the devices listed in a CSV file, so their enrollment requests are approved automatically.
+
+The first row of the file names the columns:
+  name              the name of the device registration; defaults to the lower-cased serial number
+  serialNumber      the product serial number the device reports
+  tpmEkCertificate  the TPM endorsement key certificate of the device, PEM or base64-encoded DER
+  labels            labels to set on the device, as a comma-separated list of key=value
+  systemInfo.<key>  the value the device reports for the system info key
+
+Each row must set a serial number, a TPM endorsement key certificate or a system info value.
+Devices that are already registered are updated.`,
+		Example: `  flightctl import devices -f devices.csv --label site=berlin`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *ImportDevicesOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVarP(&o.Filename, "filename", "f", o.Filename, "The CSV file that lists the devices, or - for stdin.")
+	fs.StringSliceVarP(&o.Labels, "label", "l", o.Labels, "Labels to set on every device, as a comma-separated list of key=value.")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Only print the devices that would be registered, without registering them.")
+}
+
+func (o *ImportDevicesOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *ImportDevicesOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	if len(o.Filename) == 0 {
+		return fmt.Errorf("must specify -f FILENAME")
+	}
+	return nil
+}
+
+func (o *ImportDevicesOptions) Run(ctx context.Context, args []string) error {
+	var r io.Reader = os.Stdin
+	if o.Filename != "-" {
+		f, err := os.Open(o.Filename)
+		if err != nil {
+			return fmt.Errorf("opening %s: %w", o.Filename, err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	registrations, err := parseDeviceRegistrationsCSV(r, util.LabelArrayToMap(o.Labels))
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", o.Filename, err)
+	}
+
+	if o.DryRun {
+		for _, registration := range registrations {
+			fmt.Printf("importing %s/%s (dry run only)\n", DeviceRegistrationKind, lo.FromPtr(registration.Metadata.Name))
+		}
+		return nil
+	}
+
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	errs := make([]error, 0)
+	for _, registration := range registrations {
+		name := lo.FromPtr(registration.Metadata.Name)
+		fmt.Printf("importing %s/%s: ", DeviceRegistrationKind, name)
+		response, err := c.ReplaceDeviceRegistrationWithResponse(ctx, name, registration)
+		if err != nil {
+			fmt.Printf("failed\n")
+			errs = append(errs, fmt.Errorf("importing %s/%s: %w", DeviceRegistrationKind, name, err))
+			continue
+		}
+		if response.StatusCode() != http.StatusOK && response.StatusCode() != http.StatusCreated {
+			fmt.Printf("failed\n")
+			errs = append(errs, fmt.Errorf("importing %s/%s: %w", DeviceRegistrationKind, name, &APIError{Status: ParseStatusFromBody(response.Body)}))
+			continue
+		}
+		fmt.Printf("%s\n", response.HTTPResponse.Status)
+	}
+	return errors.Join(errs...)
+}
+
+// parseDeviceRegistrationsCSV returns the device registrations listed in the CSV data, adding the given labels to each.
+func parseDeviceRegistrationsCSV(r io.Reader, labels map[string]string) ([]api.DeviceRegistration, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("missing header row")
+	}
+
+	header := records[0]
+	for _, column := range header {
+		switch {
+		case column == importColumnName, column == importColumnSerialNumber, column == importColumnTPMEKCertificate, column == importColumnLabels:
+		case strings.HasPrefix(column, importColumnSystemInfoPrefix) && len(column) > len(importColumnSystemInfoPrefix):
+		default:
+			return nil, fmt.Errorf("unknown column %q", column)
+		}
+	}
+
+	registrations := make([]api.DeviceRegistration, 0, len(records)-1)
+	names := map[string]int{}
+	for i, record := range records[1:] {
+		row := i + 2
+		registration, err := deviceRegistrationFromCSVRecord(header, record, labels)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+		name := lo.FromPtr(registration.Metadata.Name)
+		if previous, ok := names[name]; ok {
+			return nil, fmt.Errorf("row %d: device %q is already listed in row %d", row, name, previous)
+		}
+		names[name] = row
+		registrations = append(registrations, *registration)
+	}
+	return registrations, nil
+}
+
+func deviceRegistrationFromCSVRecord(header, record []string, labels map[string]string) (*api.DeviceRegistration, error) {
+	var name string
+	spec := api.DeviceRegistrationSpec{}
+	deviceLabels := lo.Assign(labels)
+	systemInfo := map[string]string{}
+
+	for i, column := range header {
+		value := strings.TrimSpace(record[i])
+		if value == "" {
+			continue
+		}
+		switch {
+		case column == importColumnName:
+			name = value
+		case column == importColumnSerialNumber:
+			systemInfo[serialNumberSystemInfoKey] = value
+		case column == importColumnTPMEKCertificate:
+			certificate, err := ekCertificateToPEM(value)
+			if err != nil {
+				return nil, err
+			}
+			spec.TpmEkCertificate = &certificate
+		case column == importColumnLabels:
+			deviceLabels = lo.Assign(deviceLabels, util.LabelArrayToMap(strings.Split(value, ",")))
+		default:
+			systemInfo[strings.TrimPrefix(column, importColumnSystemInfoPrefix)] = value
+		}
+	}
+
+	if name == "" {
+		name = strings.ToLower(systemInfo[serialNumberSystemInfoKey])
+	}
+	if name == "" {
+		return nil, fmt.Errorf("must set %s or %s", importColumnName, importColumnSerialNumber)
+	}
+	if len(systemInfo) > 0 {
+		spec.SystemInfo = &systemInfo
+	}
+	if len(deviceLabels) > 0 {
+		spec.Labels = &deviceLabels
+	}
+	if spec.TpmEkCertificate == nil && spec.SystemInfo == nil {
+		return nil, fmt.Errorf("device %q: must set %s, %s or a system info value", name, importColumnSerialNumber, importColumnTPMEKCertificate)
+	}
+
+	return &api.DeviceRegistration{
+		ApiVersion: apiVersionPrefix + api.DeviceRegistrationAPIVersion,
+		Kind:       api.DeviceRegistrationKind,
+		Metadata:   api.ObjectMeta{Name: &name},
+		Spec:       spec,
+	}, nil
+}
+
+// ekCertificateToPEM returns the certificate as PEM, accepting PEM or base64-encoded DER.
+func ekCertificateToPEM(value string) (string, error) {
+	if strings.HasPrefix(value, "-----BEGIN") {
+		return value, nil
+	}
+	der, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", fmt.Errorf("%s must be PEM or base64-encoded DER: %w", importColumnTPMEKCertificate, err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDeviceRegistrationsCSV(t *testing.T) {
+	der := []byte("not really a certificate")
+	pemCertificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	tests := []struct {
+		name        string
+		csv         string
+		labels      map[string]string
+		errContains string
+	}{
+		{
+			name: "serial numbers and EK certificates",
+			csv: "serialNumber,tpmEkCertificate,labels,systemInfo.productModel\n" +
+				"SN-0001," + base64.StdEncoding.EncodeToString(der) + ",\"model=x1,rack=a\",X1\n" +
+				"SN-0002,,,\n",
+			labels: map[string]string{"site": "berlin"},
+		},
+		{
+			name:        "unknown column",
+			csv:         "name,serial\ndevice-1,SN-0001\n",
+			errContains: `unknown column "serial"`,
+		},
+		{
+			name:        "no identity",
+			csv:         "name,labels\ndevice-1,site=berlin\n",
+			errContains: "row 2: device \"device-1\": must set serialNumber, tpmEkCertificate or a system info value",
+		},
+		{
+			name:        "no name",
+			csv:         "name,tpmEkCertificate\n,\"" + pemCertificate + "\"\n",
+			errContains: "row 2: must set name or serialNumber",
+		},
+		{
+			name:        "invalid EK certificate",
+			csv:         "name,tpmEkCertificate\ndevice-1,not-base64!\n",
+			errContains: "tpmEkCertificate must be PEM or base64-encoded DER",
+		},
+		{
+			name:        "duplicate device",
+			csv:         "name,serialNumber\ndevice-1,SN-0001\ndevice-1,SN-0002\n",
+			errContains: "row 3: device \"device-1\" is already listed in row 2",
+		},
+		{
+			name:        "empty file",
+			csv:         "",
+			errContains: "missing header row",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registrations, err := parseDeviceRegistrationsCSV(strings.NewReader(tt.csv), tt.labels)
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, registrations, 2)
+
+			first := registrations[0]
+			require.Equal(t, "sn-0001", lo.FromPtr(first.Metadata.Name))
+			require.Equal(t, "flightctl.io/v1beta1", first.ApiVersion)
+			require.Equal(t, "DeviceRegistration", first.Kind)
+			require.Equal(t, pemCertificate, lo.FromPtr(first.Spec.TpmEkCertificate))
+			require.Equal(t, map[string]string{"productSerial": "SN-0001", "productModel": "X1"}, lo.FromPtr(first.Spec.SystemInfo))
+			require.Equal(t, map[string]string{"site": "berlin", "model": "x1", "rack": "a"}, lo.FromPtr(first.Spec.Labels))
+
+			second := registrations[1]
+			require.Equal(t, "sn-0002", lo.FromPtr(second.Metadata.Name))
+			require.Nil(t, second.Spec.TpmEkCertificate)
+			require.Equal(t, map[string]string{"productSerial": "SN-0002"}, lo.FromPtr(second.Spec.SystemInfo))
+			require.Equal(t, map[string]string{"site": "berlin"}, lo.FromPtr(second.Spec.Labels))
+		})
+	}
+}
@@ -13,8 +13,10 @@ import (
 	"syscall"
 	"time"
 
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	imagebuilderapi "github.com/flightctl/flightctl/api/imagebuilder/v1alpha1"
 	"github.com/flightctl/flightctl/internal/client"
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -29,12 +31,17 @@ const (
 type LogsOptions struct {
 	GlobalOptions
 	Follow bool
+	App    string
+	Unit   string
+	Since  string
+	Tail   int
 }
 
 func DefaultLogsOptions() *LogsOptions {
 	return &LogsOptions{
 		GlobalOptions: DefaultGlobalOptions(),
 		Follow:        false,
+		Tail:          -1,
 	}
 }
 
@@ -43,7 +50,7 @@ func NewCmdLogs() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "logs (TYPE/NAME | TYPE NAME) [flags]",
 		Short: "Print the logs for a resource",
-		Long:  "Print the logs for a resource. Supports imagebuild and imageexport resources.",
+		Long:  "Print the logs for a resource. Supports imagebuild and imageexport resources, and the applications and systemd units of devices.",
 		Example: `  # Get logs for an imagebuild
   flightctl logs imagebuild/my-build
 
@@ -54,7 +61,13 @@ func NewCmdLogs() *cobra.Command {
   flightctl logs imageexport/my-export
 
   # Follow logs for an active imageexport
-  flightctl logs imageexport/my-export -f`,
+  flightctl logs imageexport/my-export -f
+
+  # Get the last 100 log lines of an application of a device
+  flightctl logs device/my-device --app my-app --tail 100
+
+  # Follow the journal of a systemd unit of a device, starting 10 minutes ago
+  flightctl logs device/my-device --unit crio.service --since 10m -f`,
 		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := o.Complete(cmd, args); err != nil {
@@ -76,6 +89,10 @@ func NewCmdLogs() *cobra.Command {
 func (o *LogsOptions) Bind(fs *pflag.FlagSet) {
 	o.GlobalOptions.Bind(fs)
 	fs.BoolVarP(&o.Follow, "follow", "f", o.Follow, "Specify if the logs should be streamed. Follows the logs until the build completes or the command is interrupted.")
+	fs.StringVar(&o.App, "app", o.App, "Name of the application of the device to print the logs of.")
+	fs.StringVar(&o.Unit, "unit", o.Unit, "Name of the systemd unit of the device to print the journal of.")
+	fs.StringVar(&o.Since, "since", o.Since, "Only print device logs newer than a relative duration like 10m or an RFC3339 timestamp.")
+	fs.IntVar(&o.Tail, "tail", o.Tail, "Number of most recent device log lines to print, -1 to print all.")
 }
 
 func (o *LogsOptions) Complete(cmd *cobra.Command, args []string) error {
@@ -93,24 +110,64 @@ func (o *LogsOptions) Validate(args []string) error {
 		return err
 	}
 
-	// Support imagebuild and imageexport
-	if kind != ImageBuildKind && kind != ImageExportKind {
-		return fmt.Errorf("logs command only supports imagebuild and imageexport resources, got: %s", kind)
+	// Support imagebuild, imageexport and device
+	if kind != ImageBuildKind && kind != ImageExportKind && kind != DeviceKind {
+		return fmt.Errorf("logs command only supports imagebuild, imageexport and device resources, got: %s", kind)
 	}
 
 	if name == "" {
 		return fmt.Errorf("resource name is required")
 	}
 
+	if kind != DeviceKind {
+		if o.App != "" || o.Unit != "" || o.Since != "" || o.Tail != -1 {
+			return fmt.Errorf("--app, --unit, --since and --tail are only supported for devices")
+		}
+		return nil
+	}
+
+	if (o.App == "") == (o.Unit == "") {
+		return fmt.Errorf("exactly one of --app and --unit must be specified for devices")
+	}
+	if o.Tail < -1 {
+		return fmt.Errorf("--tail must be -1 or greater, got %d", o.Tail)
+	}
+	if _, err := o.sinceTime(time.Now()); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// sinceTime returns the time the --since flag refers to, nil if it is not set.
+func (o *LogsOptions) sinceTime(now time.Time) (*time.Time, error) {
+	if o.Since == "" {
+		return nil, nil
+	}
+	if d, err := time.ParseDuration(o.Since); err == nil {
+		if d < 0 {
+			return nil, fmt.Errorf("--since must not be a negative duration, got %s", o.Since)
+		}
+		since := now.Add(-d)
+		return &since, nil
+	}
+	since, err := time.Parse(time.RFC3339, o.Since)
+	if err != nil {
+		return nil, fmt.Errorf("--since must be a duration like 10m or an RFC3339 timestamp, got %q", o.Since)
+	}
+	return &since, nil
+}
+
 func (o *LogsOptions) Run(ctx context.Context, args []string) error {
 	kind, name, err := parseResourceArgs(args)
 	if err != nil {
 		return err
 	}
 
+	if kind == DeviceKind {
+		return o.runDeviceLogs(ctx, name)
+	}
+
 	// Build imagebuilder client
 	ibClient, err := o.BuildImageBuilderClient()
 	if err != nil {
@@ -185,6 +242,29 @@ func (o *LogsOptions) streamLogs(ctx context.Context, ibClient *client.ImageBuil
 	return err
 }
 
+// runDeviceLogs streams the logs of an application or a systemd unit of a device over a console session.
+func (o *LogsOptions) runDeviceLogs(ctx context.Context, name string) error {
+	since, err := o.sinceTime(time.Now())
+	if err != nil {
+		return err
+	}
+	logs := &api.DeviceLogsRequest{
+		Application: o.App,
+		Unit:        o.Unit,
+		Follow:      o.Follow,
+		Since:       since,
+	}
+	if o.Tail >= 0 {
+		logs.Tail = lo.ToPtr(o.Tail)
+	}
+
+	console := DefaultConsoleOptions()
+	console.GlobalOptions = o.GlobalOptions
+	console.noTTY = true
+	console.logs = logs
+	return console.Run(ctx, []string{fmt.Sprintf("%s/%s", DeviceKind, name)}, nil)
+}
+
 // isTransientStreamError returns true if the error is a transient network/TLS error
 // that is likely to succeed on retry (e.g. tls: bad record MAC on long-lived connections).
 func isTransientStreamError(err error) bool {
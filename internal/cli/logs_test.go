@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogsOptions_Validate(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "client.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte{}, 0600))
+
+	tests := []struct {
+		name          string
+		args          []string
+		app           string
+		unit          string
+		since         string
+		tail          int
+		errorContains string
+	}{
+		{name: "imagebuild", args: []string{"imagebuild/my-build"}, tail: -1},
+		{name: "imagebuild with device flags", args: []string{"imagebuild/my-build"}, app: "web", tail: -1, errorContains: "only supported for devices"},
+		{name: "device application", args: []string{"device/my-device"}, app: "web", since: "10m", tail: 100},
+		{name: "device unit", args: []string{"device", "my-device"}, unit: "crio.service", since: "2026-01-02T03:04:05Z", tail: -1},
+		{name: "device without app or unit", args: []string{"device/my-device"}, tail: -1, errorContains: "exactly one of --app and --unit"},
+		{name: "device with app and unit", args: []string{"device/my-device"}, app: "web", unit: "crio.service", tail: -1, errorContains: "exactly one of --app and --unit"},
+		{name: "device with invalid tail", args: []string{"device/my-device"}, app: "web", tail: -2, errorContains: "--tail must be -1 or greater"},
+		{name: "device with invalid since", args: []string{"device/my-device"}, app: "web", since: "yesterday", tail: -1, errorContains: "--since must be a duration"},
+		{name: "fleet", args: []string{"fleet/my-fleet"}, tail: -1, errorContains: "only supports imagebuild, imageexport and device"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := DefaultLogsOptions()
+			o.ConfigFilePath = configFile
+			o.App = tt.app
+			o.Unit = tt.unit
+			o.Since = tt.since
+			o.Tail = tt.tail
+
+			err := o.Validate(tt.args)
+			if tt.errorContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.errorContains)
+		})
+	}
+}
+
+func TestLogsOptions_SinceTime(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	o := DefaultLogsOptions()
+
+	since, err := o.sinceTime(now)
+	require.NoError(t, err)
+	require.Nil(t, since)
+
+	o.Since = "90s"
+	since, err = o.sinceTime(now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-90*time.Second), *since)
+
+	o.Since = "2026-01-01T00:00:00Z"
+	since, err = o.sinceTime(now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), since.UTC())
+
+	o.Since = "-5m"
+	_, err = o.sinceTime(now)
+	require.ErrorContains(t, err, "must not be a negative duration")
+}
@@ -0,0 +1,310 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/cli/display"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+)
+
+var legalRolloutPreviewOutputTypes = []string{string(display.JSONFormat), string(display.YAMLFormat), string(display.WideFormat)}
+
+type RolloutOptions struct {
+	GlobalOptions
+}
+
+func DefaultRolloutOptions() *RolloutOptions {
+	return &RolloutOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func NewCmdRollout() *cobra.Command {
+	o := DefaultRolloutOptions()
+	cmd := &cobra.Command{
+		Use:   "rollout",
+		Short: "Manage fleet rollouts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+
+	cmd.AddCommand(NewCmdRolloutPreview())
+	cmd.AddCommand(NewCmdRolloutPause())
+	cmd.AddCommand(NewCmdRolloutResume())
+	cmd.AddCommand(NewCmdRolloutAbort())
+
+	return cmd
+}
+
+func (o *RolloutOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+}
+
+type RolloutControlOptions struct {
+	GlobalOptions
+
+	Action api.FleetRolloutControlAction
+}
+
+func DefaultRolloutControlOptions(action api.FleetRolloutControlAction) *RolloutControlOptions {
+	return &RolloutControlOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		Action:        action,
+	}
+}
+
+// NewCmdRolloutPause creates a command to pause the rollout of a fleet
+func NewCmdRolloutPause() *cobra.Command {
+	return newCmdRolloutControl(api.FleetRolloutControlActionPause, "pause",
+		"Pause the rollout of a fleet. No further devices are rolled out until it is resumed.")
+}
+
+// NewCmdRolloutResume creates a command to resume the paused rollout of a fleet
+func NewCmdRolloutResume() *cobra.Command {
+	return newCmdRolloutControl(api.FleetRolloutControlActionResume, "resume",
+		"Resume the paused rollout of a fleet.")
+}
+
+// NewCmdRolloutAbort creates a command to abort the rollout of a fleet
+func NewCmdRolloutAbort() *cobra.Command {
+	return newCmdRolloutControl(api.FleetRolloutControlActionAbort, "abort",
+		"Abort the rollout of a fleet. Devices that were already updated keep the new template version.")
+}
+
+func newCmdRolloutControl(action api.FleetRolloutControlAction, use, short string) *cobra.Command {
+	o := DefaultRolloutControlOptions(action)
+	cmd := &cobra.Command{
+		Use:   use + " fleet/NAME",
+		Short: short,
+		Args:  cobra.RangeArgs(1, 2),
+		ValidArgsFunction: KindNameAutocomplete{
+			Options:            o,
+			AllowMultipleNames: false,
+			AllowedKinds:       []ResourceKind{FleetKind},
+		}.ValidArgsFunction,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *RolloutControlOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+}
+
+func (o *RolloutControlOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *RolloutControlOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	return validateRolloutFleetArgs(args)
+}
+
+func (o *RolloutControlOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	_, name, err := parseAndValidateKindNameFromArgsSingle(args)
+	if err != nil {
+		return err
+	}
+
+	action := strings.ToLower(string(o.Action))
+	response, err := c.ControlFleetRolloutWithResponse(ctx, name, api.FleetRolloutControl{Action: o.Action})
+	if err != nil {
+		return fmt.Errorf("%s rollout of %s/%s: %w", action, FleetKind, name, err)
+	}
+	if err := validateResponse(response); err != nil {
+		return fmt.Errorf("%s rollout of %s/%s: %w", action, FleetKind, name, err)
+	}
+
+	fmt.Printf("Rollout %s requested: %s/%s\n", action, FleetKind, name)
+	return nil
+}
+
+// validateRolloutFleetArgs validates that the arguments of a rollout subcommand name a single fleet
+func validateRolloutFleetArgs(args []string) error {
+	kind, name, err := parseAndValidateKindNameFromArgsSingle(args)
+	if err != nil {
+		return err
+	}
+	if kind != FleetKind {
+		return fmt.Errorf("kind must be %s", FleetKind)
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("specify the fleet whose rollout to manage")
+	}
+	return nil
+}
+
+type RolloutPreviewOptions struct {
+	GlobalOptions
+
+	FileName string
+	Output   string
+}
+
+func DefaultRolloutPreviewOptions() *RolloutPreviewOptions {
+	return &RolloutPreviewOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+// NewCmdRolloutPreview creates a command to preview how a rollout of a fleet would select devices
+func NewCmdRolloutPreview() *cobra.Command {
+	o := DefaultRolloutPreviewOptions()
+	cmd := &cobra.Command{
+		Use:   "preview fleet/NAME [-f FILENAME]",
+		Short: "Preview which devices each batch of a fleet rollout would select.",
+		Long: "Preview which devices each batch of a fleet rollout would select, how the disruption budget would throttle them, " +
+			"and which devices no batch would select.  The current spec of the fleet is previewed unless a modified fleet is given with -f.",
+		Args: cobra.RangeArgs(1, 2),
+		ValidArgsFunction: KindNameAutocomplete{
+			Options:            o,
+			AllowMultipleNames: false,
+			AllowedKinds:       []ResourceKind{FleetKind},
+		}.ValidArgsFunction,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *RolloutPreviewOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVarP(&o.FileName, "filename", "f", o.FileName, "Fleet to preview the rollout of, instead of the fleet's current spec.")
+	fs.StringVarP(&o.Output, "output", "o", o.Output, fmt.Sprintf("Output format. One of: (%s).", strings.Join(legalRolloutPreviewOutputTypes, ", ")))
+}
+
+func (o *RolloutPreviewOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *RolloutPreviewOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	if err := validateRolloutFleetArgs(args); err != nil {
+		return err
+	}
+	if len(o.Output) > 0 && !slices.Contains(legalRolloutPreviewOutputTypes, o.Output) {
+		return fmt.Errorf("output format must be one of (%s)", strings.Join(legalRolloutPreviewOutputTypes, ", "))
+	}
+	return nil
+}
+
+func (o *RolloutPreviewOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	_, name, err := parseAndValidateKindNameFromArgsSingle(args)
+	if err != nil {
+		return err
+	}
+
+	spec, err := o.fleetSpec(ctx, c, name)
+	if err != nil {
+		return err
+	}
+
+	response, err := c.PreviewFleetRolloutWithResponse(ctx, name, *spec)
+	if err != nil {
+		return fmt.Errorf("previewing rollout of %s/%s: %w", FleetKind, name, err)
+	}
+	if err := validateResponse(response); err != nil {
+		return fmt.Errorf("previewing rollout of %s/%s: %w", FleetKind, name, err)
+	}
+
+	formatter := display.NewFormatter(display.OutputFormat(o.Output))
+	options := display.FormatOptions{
+		Kind:   api.RolloutPreviewKind,
+		Wide:   o.Output == string(display.WideFormat),
+		Writer: os.Stdout,
+	}
+	if o.Output == string(display.JSONFormat) || o.Output == string(display.YAMLFormat) {
+		return formatter.Format(response.JSON200, options)
+	}
+	return formatter.Format(response, options)
+}
+
+// fleetSpec returns the spec to preview: the one of the fleet in the given file if set, or else the current spec
+// of the fleet
+func (o *RolloutPreviewOptions) fleetSpec(ctx context.Context, c *client.Client, name string) (*api.FleetSpec, error) {
+	if len(o.FileName) == 0 {
+		response, err := c.GetFleetWithResponse(ctx, name, &api.GetFleetParams{})
+		if err != nil {
+			return nil, fmt.Errorf("getting %s/%s: %w", FleetKind, name, err)
+		}
+		if response.StatusCode() != http.StatusOK || response.JSON200 == nil {
+			if err := validateResponse(response); err != nil {
+				return nil, fmt.Errorf("getting %s/%s: %w", FleetKind, name, err)
+			}
+			return nil, fmt.Errorf("getting %s/%s: empty response", FleetKind, name)
+		}
+		return &response.JSON200.Spec, nil
+	}
+
+	contents, err := os.ReadFile(o.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", o.FileName, err)
+	}
+	var fleet api.Fleet
+	if err := yaml.Unmarshal(contents, &fleet); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", o.FileName, err)
+	}
+	if fleet.Kind != api.FleetKind {
+		return nil, fmt.Errorf("%s: kind must be %s", o.FileName, api.FleetKind)
+	}
+	if fleetName := lo.FromPtr(fleet.Metadata.Name); len(fleetName) > 0 && fleetName != name {
+		return nil, fmt.Errorf("%s: fleet %s does not match %s/%s", o.FileName, fleetName, FleetKind, name)
+	}
+	return &fleet.Spec, nil
+}
@@ -41,6 +41,8 @@ type Config struct {
 	TelemetryGateway       *telemetryGatewayConfig    `json:"telemetrygateway,omitempty"`
 	VulnerabilityReporting *VulnerabilityConfig       `json:"vulnerabilityReporting,omitempty"`
 	DependenciesSync       *DependenciesSyncConfig    `json:"dependenciesSync,omitempty"`
+	Secrets                *SecretsConfig             `json:"secrets,omitempty"`
+	EventExporter          *EventExporterConfig       `json:"eventExporter,omitempty"`
 }
 
 // CryptoPolicyConfig contains cryptographic policy configuration for all protocols.
@@ -114,33 +116,36 @@ type dbConfig struct {
 }
 
 type svcConfig struct {
-	Address                string           `json:"address,omitempty"`
-	AgentEndpointAddress   string           `json:"agentEndpointAddress,omitempty"`
-	CertStore              string           `json:"cert,omitempty"`
-	BaseUrl                string           `json:"baseUrl,omitempty"`
-	BaseAgentEndpointUrl   string           `json:"baseAgentEndpointUrl,omitempty"`
-	BaseUIUrl              string           `json:"baseUIUrl,omitempty"`
-	DisableTLS             bool             `json:"disableTLS,omitempty"`
-	SrvCertFile            string           `json:"srvCertificateFile,omitempty"`
-	SrvKeyFile             string           `json:"srvKeyFile,omitempty"`
-	ServerCertName         string           `json:"serverCertName,omitempty"`
-	ServerCertValidityDays int              `json:"serverCertValidityDays,omitempty"`
-	AltNames               []string         `json:"altNames,omitempty"`
-	LogLevel               string           `json:"logLevel,omitempty"`
-	HttpReadTimeout        util.Duration    `json:"httpReadTimeout,omitempty"`
-	HttpReadHeaderTimeout  util.Duration    `json:"httpReadHeaderTimeout,omitempty"`
-	HttpWriteTimeout       util.Duration    `json:"httpWriteTimeout,omitempty"`
-	HttpIdleTimeout        util.Duration    `json:"httpIdleTimeout,omitempty"`
-	HttpMaxNumHeaders      int              `json:"httpMaxNumHeaders,omitempty"`
-	HttpMaxHeaderBytes     int              `json:"httpMaxHeaderBytes,omitempty"`
-	HttpMaxUrlLength       int              `json:"httpMaxUrlLength,omitempty"`
-	HttpMaxRequestSize     int              `json:"httpMaxRequestSize,omitempty"`
-	EventRetentionPeriod   util.Duration    `json:"eventRetentionPeriod,omitempty"`
-	AlertPollingInterval   util.Duration    `json:"alertPollingInterval,omitempty"`
-	RenderedWaitTimeout    util.Duration    `json:"renderedWaitTimeout,omitempty"`
-	RateLimit              *RateLimitConfig `json:"rateLimit,omitempty"`
-	TPMCAPaths             []string         `json:"tpmCAPaths,omitempty"`
-	HealthChecks           *HealthChecks    `json:"healthChecks,omitempty"`
+	Address                   string           `json:"address,omitempty"`
+	AgentEndpointAddress      string           `json:"agentEndpointAddress,omitempty"`
+	CertStore                 string           `json:"cert,omitempty"`
+	BaseUrl                   string           `json:"baseUrl,omitempty"`
+	BaseAgentEndpointUrl      string           `json:"baseAgentEndpointUrl,omitempty"`
+	BaseUIUrl                 string           `json:"baseUIUrl,omitempty"`
+	DisableTLS                bool             `json:"disableTLS,omitempty"`
+	SrvCertFile               string           `json:"srvCertificateFile,omitempty"`
+	SrvKeyFile                string           `json:"srvKeyFile,omitempty"`
+	ServerCertName            string           `json:"serverCertName,omitempty"`
+	ServerCertValidityDays    int              `json:"serverCertValidityDays,omitempty"`
+	AltNames                  []string         `json:"altNames,omitempty"`
+	LogLevel                  string           `json:"logLevel,omitempty"`
+	HttpReadTimeout           util.Duration    `json:"httpReadTimeout,omitempty"`
+	HttpReadHeaderTimeout     util.Duration    `json:"httpReadHeaderTimeout,omitempty"`
+	HttpWriteTimeout          util.Duration    `json:"httpWriteTimeout,omitempty"`
+	HttpIdleTimeout           util.Duration    `json:"httpIdleTimeout,omitempty"`
+	HttpMaxNumHeaders         int              `json:"httpMaxNumHeaders,omitempty"`
+	HttpMaxHeaderBytes        int              `json:"httpMaxHeaderBytes,omitempty"`
+	HttpMaxUrlLength          int              `json:"httpMaxUrlLength,omitempty"`
+	HttpMaxRequestSize        int              `json:"httpMaxRequestSize,omitempty"`
+	EventRetentionPeriod      util.Duration    `json:"eventRetentionPeriod,omitempty"`
+	EnrollmentRequestTTL      util.Duration    `json:"enrollmentRequestTTL,omitempty"`
+	CSRTTL                    util.Duration    `json:"certificateSigningRequestTTL,omitempty"`
+	AlertPollingInterval      util.Duration    `json:"alertPollingInterval,omitempty"`
+	RenderedWaitTimeout       util.Duration    `json:"renderedWaitTimeout,omitempty"`
+	RateLimit                 *RateLimitConfig `json:"rateLimit,omitempty"`
+	TPMCAPaths                []string         `json:"tpmCAPaths,omitempty"`
+	RequireDeviceRegistration bool             `json:"requireDeviceRegistration,omitempty"`
+	HealthChecks              *HealthChecks    `json:"healthChecks,omitempty"`
 }
 
 // HealthChecks holds health check endpoint configuration.
@@ -658,6 +663,131 @@ func (c *Config) GetDependenciesSyncPollInterval() time.Duration {
 	return DefaultDependenciesSyncPollInterval
 }
 
+// SecretsConfig holds the settings of Secret resources.
+type SecretsConfig struct {
+	// EncryptionKey is the key the values of Secret resources are encrypted with at rest.
+	// Secret resources cannot be used unless it is set.
+	EncryptionKey api.SecureString `json:"encryptionKey,omitempty"`
+}
+
+// MinSecretsEncryptionKeyLength is the minimum length of the secret encryption key.
+const MinSecretsEncryptionKeyLength = 32
+
+// GetSecretsEncryptionKey returns the configured secret encryption key, or an empty string if unset.
+func (c *Config) GetSecretsEncryptionKey() string {
+	if c.Secrets == nil {
+		return ""
+	}
+	return string(c.Secrets.EncryptionKey)
+}
+
+// EventExporterConfig holds the settings of the event exporter, which publishes the events of all organizations
+// as CloudEvents to a sink.
+type EventExporterConfig struct {
+	// PollingInterval is the interval at which new events are exported. Default: 10s.
+	PollingInterval util.Duration `json:"pollingInterval,omitempty"`
+	// BatchSize is the maximum number of events published to the sink at once. Default: 500.
+	BatchSize int `json:"batchSize,omitempty"`
+	// Sink is where the events are published to.
+	Sink *EventExporterSinkConfig `json:"sink,omitempty"`
+}
+
+const (
+	// DefaultEventExporterPollingInterval is the default interval at which new events are exported.
+	DefaultEventExporterPollingInterval = 10 * time.Second
+	// DefaultEventExporterBatchSize is the default maximum number of events published at once.
+	DefaultEventExporterBatchSize = 500
+
+	EventExporterSinkTypeKafka = "kafka"
+	EventExporterSinkTypeNATS  = "nats"
+	EventExporterSinkTypeFile  = "file"
+
+	// EventExporterStdoutPath is the path of a file sink that writes to the standard output.
+	EventExporterStdoutPath = "-"
+)
+
+// EventExporterSinkConfig selects the sink of the event exporter. Only the settings of the selected type are used.
+type EventExporterSinkConfig struct {
+	// Type is one of "kafka", "nats" or "file".
+	Type  string                        `json:"type,omitempty"`
+	Kafka *EventExporterKafkaSinkConfig `json:"kafka,omitempty"`
+	NATS  *EventExporterNATSSinkConfig  `json:"nats,omitempty"`
+	File  *EventExporterFileSinkConfig  `json:"file,omitempty"`
+}
+
+// EventExporterKafkaSinkConfig holds the settings of a sink that produces events to a Kafka topic.
+type EventExporterKafkaSinkConfig struct {
+	// Brokers are the addresses (host:port) of the brokers used to discover the cluster.
+	Brokers []string `json:"brokers,omitempty"`
+	// Topic is the topic events are produced to. The event exporter must be the only producer of the topic.
+	Topic string `json:"topic,omitempty"`
+	// ClientID is the client ID reported to the brokers. Default: flightctl-event-exporter.
+	ClientID string `json:"clientId,omitempty"`
+	// TLS enables TLS for the connections to the brokers.
+	TLS *EventExporterTLSConfig `json:"tls,omitempty"`
+	// SASL enables SASL authentication with the brokers.
+	SASL *EventExporterSASLConfig `json:"sasl,omitempty"`
+}
+
+// EventExporterSASLConfig holds the SASL credentials of the Kafka sink.
+type EventExporterSASLConfig struct {
+	// Mechanism is one of "PLAIN", "SCRAM-SHA-256" or "SCRAM-SHA-512".
+	Mechanism string           `json:"mechanism,omitempty"`
+	Username  string           `json:"username,omitempty"`
+	Password  api.SecureString `json:"password,omitempty"`
+}
+
+// EventExporterNATSSinkConfig holds the settings of a sink that publishes events to a NATS JetStream subject.
+type EventExporterNATSSinkConfig struct {
+	// URL is the URL of the NATS server, e.g. nats://nats:4222.
+	URL string `json:"url,omitempty"`
+	// Subject is the subject events are published to. It must be captured by a JetStream stream that only the
+	// event exporter publishes to.
+	Subject  string                  `json:"subject,omitempty"`
+	Username string                  `json:"username,omitempty"`
+	Password api.SecureString        `json:"password,omitempty"`
+	TLS      *EventExporterTLSConfig `json:"tls,omitempty"`
+}
+
+// EventExporterFileSinkConfig holds the settings of a sink that appends events to a file, one per line.
+type EventExporterFileSinkConfig struct {
+	// Path is the file events are appended to, or "-" for the standard output.
+	Path string `json:"path,omitempty"`
+}
+
+// EventExporterTLSConfig holds the TLS settings of a sink.
+type EventExporterTLSConfig struct {
+	// CACert is the path of the CA bundle that verifies the server. If empty, the system roots are used.
+	CACert                string `json:"caCert,omitempty"`
+	InsecureSkipTlsVerify bool   `json:"insecureSkipTlsVerify,omitempty"`
+}
+
+func validateEventExporter(cfg *EventExporterConfig) error {
+	if cfg == nil || cfg.Sink == nil {
+		return nil
+	}
+	switch cfg.Sink.Type {
+	case EventExporterSinkTypeKafka:
+		if cfg.Sink.Kafka == nil || len(cfg.Sink.Kafka.Brokers) == 0 || cfg.Sink.Kafka.Topic == "" {
+			return fmt.Errorf("eventExporter.sink.kafka.brokers and topic must be set")
+		}
+		if sasl := cfg.Sink.Kafka.SASL; sasl != nil && !slices.Contains([]string{"PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512"}, sasl.Mechanism) {
+			return fmt.Errorf("eventExporter.sink.kafka.sasl.mechanism must be one of PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512")
+		}
+	case EventExporterSinkTypeNATS:
+		if cfg.Sink.NATS == nil || cfg.Sink.NATS.URL == "" || cfg.Sink.NATS.Subject == "" {
+			return fmt.Errorf("eventExporter.sink.nats.url and subject must be set")
+		}
+	case EventExporterSinkTypeFile:
+		if cfg.Sink.File == nil || cfg.Sink.File.Path == "" {
+			return fmt.Errorf("eventExporter.sink.file.path must be set")
+		}
+	default:
+		return fmt.Errorf("eventExporter.sink.type must be one of kafka, nats or file")
+	}
+	return nil
+}
+
 // VulnerabilityConfig holds configuration for the vulnerability integration feature.
 type VulnerabilityConfig struct {
 	// Enabled enables vulnerability integration (sync task + API endpoints).
@@ -849,6 +979,8 @@ func NewDefault(opts ...ConfigOption) *Config {
 			HttpMaxUrlLength:       2000,
 			HttpMaxRequestSize:     50 * 1024 * 1024,                  // 50MB
 			EventRetentionPeriod:   util.Duration(7 * 24 * time.Hour), // 1 week
+			EnrollmentRequestTTL:   util.Duration(7 * 24 * time.Hour), // 1 week
+			CSRTTL:                 util.Duration(7 * 24 * time.Hour), // 1 week
 			AlertPollingInterval:   util.Duration(1 * time.Minute),
 			RenderedWaitTimeout:    util.Duration(2 * time.Minute),
 			HealthChecks: &HealthChecks{
@@ -934,6 +1066,14 @@ func NewDefault(opts ...ConfigOption) *Config {
 		Auth: &authConfig{
 			DynamicProviderCacheTTL: util.Duration(5 * time.Second),
 		},
+		EventExporter: &EventExporterConfig{
+			PollingInterval: util.Duration(DefaultEventExporterPollingInterval),
+			BatchSize:       DefaultEventExporterBatchSize,
+			Sink: &EventExporterSinkConfig{
+				Type: EventExporterSinkTypeFile,
+				File: &EventExporterFileSinkConfig{Path: EventExporterStdoutPath},
+			},
+		},
 	}
 	c.CA = ca.NewDefault(CertificateDir())
 	// CA certs are stored in the same location as Server Certs by default
@@ -1016,6 +1156,20 @@ func applyEnvVarOverrides(c *Config) {
 	if dbMigrationPass := os.Getenv("DB_MIGRATION_PASSWORD"); dbMigrationPass != "" {
 		c.Database.MigrationPassword = api.SecureString(dbMigrationPass)
 	}
+	if secretsKey := os.Getenv("SECRETS_ENCRYPTION_KEY"); secretsKey != "" {
+		if c.Secrets == nil {
+			c.Secrets = &SecretsConfig{}
+		}
+		c.Secrets.EncryptionKey = api.SecureString(secretsKey)
+	}
+	if sinkPass := os.Getenv("EVENT_EXPORTER_SINK_PASSWORD"); sinkPass != "" && c.EventExporter != nil && c.EventExporter.Sink != nil {
+		if c.EventExporter.Sink.Kafka != nil && c.EventExporter.Sink.Kafka.SASL != nil {
+			c.EventExporter.Sink.Kafka.SASL.Password = api.SecureString(sinkPass)
+		}
+		if c.EventExporter.Sink.NATS != nil {
+			c.EventExporter.Sink.NATS.Password = api.SecureString(sinkPass)
+		}
+	}
 	applyVulnerabilityReportingEnvVarOverrides(c)
 	// CRYPTO_FORCE_FIPS environment variable sets the global crypto policy FIPS mode.
 	// This overrides auto-detection and applies to all cryptographic protocols.
@@ -1339,6 +1493,14 @@ func Validate(cfg *Config) error {
 		}
 	}
 
+	if key := cfg.GetSecretsEncryptionKey(); key != "" && len(key) < MinSecretsEncryptionKeyLength {
+		return fmt.Errorf("secrets.encryptionKey must be at least %d characters long", MinSecretsEncryptionKeyLength)
+	}
+
+	if err := validateEventExporter(cfg.EventExporter); err != nil {
+		return err
+	}
+
 	// Validate OIDC and OAuth2 provider role assignments
 	if cfg.Auth != nil {
 		if cfg.Auth.OIDC != nil {
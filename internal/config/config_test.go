@@ -200,3 +200,64 @@ func TestConfig_String_HandlesEmptyClientSecrets(t *testing.T) {
 		t.Error("Should handle empty client secrets gracefully")
 	}
 }
+
+func TestValidate_EventExporterSink(t *testing.T) {
+	tests := []struct {
+		name    string
+		sink    *EventExporterSinkConfig
+		wantErr bool
+	}{
+		{
+			name: "default stdout sink",
+			sink: NewDefault().EventExporter.Sink,
+		},
+		{
+			name: "kafka sink",
+			sink: &EventExporterSinkConfig{
+				Type:  EventExporterSinkTypeKafka,
+				Kafka: &EventExporterKafkaSinkConfig{Brokers: []string{"kafka:9092"}, Topic: "flightctl-events"},
+			},
+		},
+		{
+			name:    "kafka sink without topic",
+			sink:    &EventExporterSinkConfig{Type: EventExporterSinkTypeKafka, Kafka: &EventExporterKafkaSinkConfig{Brokers: []string{"kafka:9092"}}},
+			wantErr: true,
+		},
+		{
+			name: "kafka sink with unknown SASL mechanism",
+			sink: &EventExporterSinkConfig{
+				Type: EventExporterSinkTypeKafka,
+				Kafka: &EventExporterKafkaSinkConfig{
+					Brokers: []string{"kafka:9092"},
+					Topic:   "flightctl-events",
+					SASL:    &EventExporterSASLConfig{Mechanism: "GSSAPI"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "nats sink without subject",
+			sink:    &EventExporterSinkConfig{Type: EventExporterSinkTypeNATS, NATS: &EventExporterNATSSinkConfig{URL: "nats://nats:4222"}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown sink type",
+			sink:    &EventExporterSinkConfig{Type: "amqp"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewDefault()
+			cfg.EventExporter.Sink = tt.sink
+			err := Validate(cfg)
+			if tt.wantErr && err == nil {
+				t.Error("expected a validation error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected validation error: %v", err)
+			}
+		})
+	}
+}
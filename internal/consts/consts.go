@@ -49,6 +49,7 @@ const (
 	// Tasks
 	TaskQueue           = "task-queue"
 	ImageBuildTaskQueue = "imagebuild-queue"
+	NotificationQueue   = "notification-queue"
 
 	// Checkpoints
 	CheckpointConsumerEventProcessor = "event_processor"
@@ -64,6 +65,7 @@ const (
 	TLSPeerCertificateCtxKey   ctxKey = "tls-peer-certificate"
 	OrganizationIDCtxKey       ctxKey = "organization-id"
 	UserAgentCtxKey            ctxKey = "user-agent"
+	RemoteAddrCtxKey           ctxKey = "remote-addr"
 	AgentCtxKey                ctxKey = "agent"
 	TokenCtxKey                ctxKey = "token"
 	IdentityCtxKey             ctxKey = "identity"
@@ -0,0 +1,86 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+)
+
+const (
+	secretEncryptionSalt = "flightctl-secret-encryption"
+	secretDigestSalt     = "flightctl-secret-digest"
+)
+
+// SecretCipher encrypts the values of Secret resources at rest using AES-256-GCM.
+// Its keys are derived from the configured secret encryption key with HKDF.
+type SecretCipher struct {
+	aead      cipher.AEAD
+	digestKey []byte
+}
+
+func NewSecretCipher(key []byte) (*SecretCipher, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("secret encryption key is empty")
+	}
+	encryptionKey, err := hkdf.Key(sha256.New, key, []byte(secretEncryptionSalt), "", 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive encryption key: %w", err)
+	}
+	digestKey, err := hkdf.Key(sha256.New, key, []byte(secretDigestSalt), "", 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive digest key: %w", err)
+	}
+
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+	return &SecretCipher{aead: aead, digestKey: digestKey}, nil
+}
+
+// Encrypt encrypts the plaintext and returns the base64 encoded nonce and ciphertext. The additional
+// data is authenticated but not encrypted, it binds the ciphertext to where it is stored.
+func (c *SecretCipher) Encrypt(plaintext, additionalData []byte) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	ciphertext := c.aead.Seal(nonce, nonce, plaintext, additionalData)
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// Decrypt decrypts a value returned by Encrypt with the same additional data.
+func (c *SecretCipher) Decrypt(encrypted string, additionalData []byte) ([]byte, error) {
+	ciphertext, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	nonceSize := c.aead.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	plaintext, err := c.aead.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+	return plaintext, nil
+}
+
+// Digest returns a keyed hash of the data. Unlike the ciphertext it is stable, so it tells whether two
+// plaintexts are equal without revealing them.
+func (c *SecretCipher) Digest(data []byte) string {
+	mac := hmac.New(sha256.New, c.digestKey)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,45 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretCipher(t *testing.T) {
+	require := require.New(t)
+
+	c, err := NewSecretCipher([]byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(err)
+
+	encrypted, err := c.Encrypt([]byte("hunter2"), []byte("db/password"))
+	require.NoError(err)
+	require.NotContains(encrypted, "hunter2")
+
+	// encrypting the same value twice yields different ciphertexts
+	again, err := c.Encrypt([]byte("hunter2"), []byte("db/password"))
+	require.NoError(err)
+	require.NotEqual(encrypted, again)
+
+	plaintext, err := c.Decrypt(encrypted, []byte("db/password"))
+	require.NoError(err)
+	require.Equal("hunter2", string(plaintext))
+
+	// the ciphertext is bound to its additional data
+	_, err = c.Decrypt(encrypted, []byte("db/username"))
+	require.Error(err)
+
+	// a different key cannot decrypt
+	other, err := NewSecretCipher([]byte("fedcba9876543210fedcba9876543210"))
+	require.NoError(err)
+	_, err = other.Decrypt(encrypted, []byte("db/password"))
+	require.Error(err)
+
+	// the digest is stable for a key and differs between keys
+	require.Equal(c.Digest([]byte("hunter2")), c.Digest([]byte("hunter2")))
+	require.NotEqual(c.Digest([]byte("hunter2")), c.Digest([]byte("hunter3")))
+	require.NotEqual(c.Digest([]byte("hunter2")), other.Digest([]byte("hunter2")))
+
+	_, err = NewSecretCipher(nil)
+	require.Error(err)
+}
@@ -63,14 +63,16 @@ type ApplicationStatusType = v1beta1.ApplicationStatusType
 type ApplicationsSummaryStatusType = v1beta1.ApplicationsSummaryStatusType
 
 const (
-	ApplicationStatusCompleted = v1beta1.ApplicationStatusCompleted
-	ApplicationStatusError     = v1beta1.ApplicationStatusError
-	ApplicationStatusPreparing = v1beta1.ApplicationStatusPreparing
-	ApplicationStatusRunning   = v1beta1.ApplicationStatusRunning
-	ApplicationStatusStarting  = v1beta1.ApplicationStatusStarting
-	ApplicationStatusStopped   = v1beta1.ApplicationStatusStopped
-	ApplicationStatusStopping  = v1beta1.ApplicationStatusStopping
-	ApplicationStatusUnknown   = v1beta1.ApplicationStatusUnknown
+	ApplicationStatusBlocked      = v1beta1.ApplicationStatusBlocked
+	ApplicationStatusCompleted    = v1beta1.ApplicationStatusCompleted
+	ApplicationStatusCrashLooping = v1beta1.ApplicationStatusCrashLooping
+	ApplicationStatusError        = v1beta1.ApplicationStatusError
+	ApplicationStatusPreparing    = v1beta1.ApplicationStatusPreparing
+	ApplicationStatusRunning      = v1beta1.ApplicationStatusRunning
+	ApplicationStatusStarting     = v1beta1.ApplicationStatusStarting
+	ApplicationStatusStopped      = v1beta1.ApplicationStatusStopped
+	ApplicationStatusStopping     = v1beta1.ApplicationStatusStopping
+	ApplicationStatusUnknown      = v1beta1.ApplicationStatusUnknown
 )
 
 const (
@@ -32,6 +32,7 @@ const (
 	ConditionTypeDeviceSpecValid                      = v1beta1.ConditionTypeDeviceSpecValid
 	ConditionTypeDeviceUpdating                       = v1beta1.ConditionTypeDeviceUpdating
 	ConditionTypeEnrollmentRequestApproved            = v1beta1.ConditionTypeEnrollmentRequestApproved
+	ConditionTypeEnrollmentRequestDenied              = v1beta1.ConditionTypeEnrollmentRequestDenied
 	ConditionTypeEnrollmentRequestTPMVerified         = v1beta1.ConditionTypeEnrollmentRequestTPMVerified
 	ConditionTypeFleetRolloutInProgress               = v1beta1.ConditionTypeFleetRolloutInProgress
 	ConditionTypeFleetValid                           = v1beta1.ConditionTypeFleetValid
@@ -100,6 +101,18 @@ const (
 type DependencySyncStatus = v1beta1.DependencySyncStatus
 type DependencySyncConfigRefStatus = v1beta1.DependencySyncConfigRefStatus
 
+// ========== Watch ==========
+
+type WatchEvent = v1beta1.WatchEvent
+type WatchEventType = v1beta1.WatchEventType
+
+const (
+	WatchEventTypeAdded    = v1beta1.WatchEventTypeAdded
+	WatchEventTypeModified = v1beta1.WatchEventTypeModified
+	WatchEventTypeDeleted  = v1beta1.WatchEventTypeDeleted
+	WatchEventTypeError    = v1beta1.WatchEventTypeError
+)
+
 // ========== Interfaces ==========
 
 type SensitiveDataHider = v1beta1.SensitiveDataHider
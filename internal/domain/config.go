@@ -9,6 +9,7 @@ type GitConfigProviderSpec = v1beta1.GitConfigProviderSpec
 type HttpConfigProviderSpec = v1beta1.HttpConfigProviderSpec
 type InlineConfigProviderSpec = v1beta1.InlineConfigProviderSpec
 type KubernetesSecretProviderSpec = v1beta1.KubernetesSecretProviderSpec
+type SecretProviderSpec = v1beta1.SecretProviderSpec
 
 // ConfigProviderType discriminator type
 type ConfigProviderType = v1beta1.ConfigProviderType
@@ -18,6 +19,7 @@ const (
 	HttpConfigProviderType       = v1beta1.HttpConfigProviderType
 	InlineConfigProviderType     = v1beta1.InlineConfigProviderType
 	KubernetesSecretProviderType = v1beta1.KubernetesSecretProviderType
+	SecretProviderType           = v1beta1.SecretProviderType
 )
 
 // ========== File Types ==========
@@ -40,14 +42,18 @@ const (
 type EncodingType = v1beta1.EncodingType
 
 const (
-	EncodingBase64 = v1beta1.EncodingBase64
-	EncodingPlain  = v1beta1.EncodingPlain
+	EncodingBase64    = v1beta1.EncodingBase64
+	EncodingEncrypted = v1beta1.EncodingEncrypted
+	EncodingPlain     = v1beta1.EncodingPlain
 )
 
 // ========== Hooks ==========
 
 type HookAction = v1beta1.HookAction
 type HookActionRun = v1beta1.HookActionRun
+type HookActionHttp = v1beta1.HookActionHttp
+type HookActionHttpSpec = v1beta1.HookActionHttpSpec
+type HookActionHttpMethod = v1beta1.HookActionHttpMethod
 type HookCondition = v1beta1.HookCondition
 type HookConditionExpression = v1beta1.HookConditionExpression
 type HookConditionPathOp = v1beta1.HookConditionPathOp
@@ -56,7 +62,16 @@ type HookConditionPathOp = v1beta1.HookConditionPathOp
 type HookActionType = v1beta1.HookActionType
 
 const (
-	HookActionTypeRun = v1beta1.HookActionTypeRun
+	HookActionTypeRun  = v1beta1.HookActionTypeRun
+	HookActionTypeHttp = v1beta1.HookActionTypeHttp
+)
+
+const (
+	HookActionHttpMethodGet    = v1beta1.HookActionHttpMethodGet
+	HookActionHttpMethodPost   = v1beta1.HookActionHttpMethodPost
+	HookActionHttpMethodPut    = v1beta1.HookActionHttpMethodPut
+	HookActionHttpMethodPatch  = v1beta1.HookActionHttpMethodPatch
+	HookActionHttpMethodDelete = v1beta1.HookActionHttpMethodDelete
 )
 
 // HookConditionType discriminator
@@ -74,6 +89,12 @@ type ResourceMonitorSpec = v1beta1.ResourceMonitorSpec
 type CpuResourceMonitorSpec = v1beta1.CpuResourceMonitorSpec
 type MemoryResourceMonitorSpec = v1beta1.MemoryResourceMonitorSpec
 type DiskResourceMonitorSpec = v1beta1.DiskResourceMonitorSpec
+type NetworkResourceMonitorSpec = v1beta1.NetworkResourceMonitorSpec
+type TemperatureResourceMonitorSpec = v1beta1.TemperatureResourceMonitorSpec
+type ProcessResourceMonitorSpec = v1beta1.ProcessResourceMonitorSpec
+type CustomResourceMonitorSpec = v1beta1.CustomResourceMonitorSpec
+type NetworkMonitorMetric = v1beta1.NetworkMonitorMetric
+type ProcessMonitorMetric = v1beta1.ProcessMonitorMetric
 type ResourceAlertRule = v1beta1.ResourceAlertRule
 type ResourceAlertSeverityType = v1beta1.ResourceAlertSeverityType
 
@@ -88,6 +109,14 @@ const (
 	ResourceAlertSeverityTypeWarning  = v1beta1.ResourceAlertSeverityTypeWarning
 )
 
+const (
+	NetworkMonitorMetricErrors     = v1beta1.NetworkMonitorMetricErrors
+	NetworkMonitorMetricThroughput = v1beta1.NetworkMonitorMetricThroughput
+
+	ProcessMonitorMetricMemory   = v1beta1.ProcessMonitorMetricMemory
+	ProcessMonitorMetricPresence = v1beta1.ProcessMonitorMetricPresence
+)
+
 // ========== Systemd Types ==========
 
 type SystemdUnitStatus = v1beta1.SystemdUnitStatus
@@ -37,8 +37,11 @@ const (
 	DeviceAnnotationRenderedSpecHash        = v1beta1.DeviceAnnotationRenderedSpecHash
 	DeviceAnnotationSelectedForRollout      = v1beta1.DeviceAnnotationSelectedForRollout
 	DeviceAnnotationLastRolloutError        = v1beta1.DeviceAnnotationLastRolloutError
+	DeviceAnnotationEnrollmentPolicy        = v1beta1.DeviceAnnotationEnrollmentPolicy
+	DeviceAnnotationDeviceRegistration      = v1beta1.DeviceAnnotationDeviceRegistration
 )
 
+const DeviceSystemInfoSecretEncryptionKey = v1beta1.DeviceSystemInfoSecretEncryptionKey
 const DeviceDisconnectedTimeout = v1beta1.DeviceDisconnectedTimeout
 const DeviceQueryConsoleSessionMetadata = v1beta1.DeviceQueryConsoleSessionMetadata
 
@@ -48,6 +51,24 @@ const (
 	EnrollmentRequestAPIVersion = v1beta1.EnrollmentRequestAPIVersion
 	EnrollmentRequestKind       = v1beta1.EnrollmentRequestKind
 	EnrollmentRequestListKind   = v1beta1.EnrollmentRequestListKind
+
+	EnrollmentRequestAnnotationSourceAddress = v1beta1.EnrollmentRequestAnnotationSourceAddress
+)
+
+// ========== DeviceRegistration ==========
+
+const (
+	DeviceRegistrationAPIVersion = v1beta1.DeviceRegistrationAPIVersion
+	DeviceRegistrationKind       = v1beta1.DeviceRegistrationKind
+	DeviceRegistrationListKind   = v1beta1.DeviceRegistrationListKind
+)
+
+// ========== EnrollmentPolicy ==========
+
+const (
+	EnrollmentPolicyAPIVersion = v1beta1.EnrollmentPolicyAPIVersion
+	EnrollmentPolicyKind       = v1beta1.EnrollmentPolicyKind
+	EnrollmentPolicyListKind   = v1beta1.EnrollmentPolicyListKind
 )
 
 // ========== Fleet ==========
@@ -67,6 +88,12 @@ const (
 	FleetAnnotationRolloutApprovalMethod       = v1beta1.FleetAnnotationRolloutApprovalMethod
 	FleetAnnotationLastBatchCompletionReport   = v1beta1.FleetAnnotationLastBatchCompletionReport
 	FleetAnnotationDeviceSelectionConfigDigest = v1beta1.FleetAnnotationDeviceSelectionConfigDigest
+	FleetAnnotationRollbackTemplateVersion     = v1beta1.FleetAnnotationRollbackTemplateVersion
+	FleetAnnotationCanaryHealthySince          = v1beta1.FleetAnnotationCanaryHealthySince
+	FleetAnnotationCanarySoakStartedAt         = v1beta1.FleetAnnotationCanarySoakStartedAt
+	FleetAnnotationProgressiveStepStartedAt    = v1beta1.FleetAnnotationProgressiveStepStartedAt
+	FleetAnnotationRolloutPaused               = v1beta1.FleetAnnotationRolloutPaused
+	FleetAnnotationRolloutAborted              = v1beta1.FleetAnnotationRolloutAborted
 )
 
 // ========== Event ==========
@@ -115,6 +142,24 @@ const (
 	ResourceSyncTypeCatalog = v1beta1.ResourceSyncTypeCatalog
 )
 
+// ========== Secret ==========
+
+const (
+	SecretAPIVersion = v1beta1.SecretAPIVersion
+	SecretKind       = v1beta1.SecretKind
+	SecretListKind   = v1beta1.SecretListKind
+
+	MaskedValuePlaceholder = v1beta1.MaskedValuePlaceholder
+)
+
+// ========== NotificationSink ==========
+
+const (
+	NotificationSinkAPIVersion = v1beta1.NotificationSinkAPIVersion
+	NotificationSinkKind       = v1beta1.NotificationSinkKind
+	NotificationSinkListKind   = v1beta1.NotificationSinkListKind
+)
+
 // ========== Catalog ==========
 
 const (
@@ -203,10 +248,15 @@ const (
 // ========== Rollout Reasons ==========
 
 const (
-	RolloutInactiveReason  = v1beta1.RolloutInactiveReason
-	RolloutActiveReason    = v1beta1.RolloutActiveReason
-	RolloutSuspendedReason = v1beta1.RolloutSuspendedReason
-	RolloutWaitingReason   = v1beta1.RolloutWaitingReason
+	RolloutInactiveReason                 = v1beta1.RolloutInactiveReason
+	RolloutActiveReason                   = v1beta1.RolloutActiveReason
+	RolloutSuspendedReason                = v1beta1.RolloutSuspendedReason
+	RolloutWaitingReason                  = v1beta1.RolloutWaitingReason
+	RolloutRolledBackReason               = v1beta1.RolloutRolledBackReason
+	RolloutOutsideMaintenanceWindowReason = v1beta1.RolloutOutsideMaintenanceWindowReason
+	RolloutAwaitingApprovalReason         = v1beta1.RolloutAwaitingApprovalReason
+	RolloutPausedReason                   = v1beta1.RolloutPausedReason
+	RolloutAbortedReason                  = v1beta1.RolloutAbortedReason
 )
 
 // ========== Batch Names ==========
@@ -214,6 +264,7 @@ const (
 const (
 	PreliminaryBatchName   = v1beta1.PreliminaryBatchName
 	FinalImplicitBatchName = v1beta1.FinalImplicitBatchName
+	CanaryBatchName        = v1beta1.CanaryBatchName
 )
 
 // ========== System Resource Names ==========
@@ -229,6 +280,10 @@ const (
 	TPMChallengeSucceededReason = v1beta1.TPMChallengeSucceededReason
 )
 
+// ========== Request Expiry Reasons ==========
+
+const RequestExpiredReason = v1beta1.RequestExpiredReason
+
 // ========== ResourceSync Reasons ==========
 
 const ResourceSyncNewHashDetectedReason = v1beta1.ResourceSyncNewHashDetectedReason
@@ -155,6 +155,7 @@ const (
 type TerminalSize = v1beta1.TerminalSize
 type DeviceConsoleSessionMetadata = v1beta1.DeviceConsoleSessionMetadata
 type DeviceCommand = v1beta1.DeviceCommand
+type DeviceLogsRequest = v1beta1.DeviceLogsRequest
 
 // NewDeviceStatus creates a new DeviceStatus with default values
 func NewDeviceStatus() DeviceStatus {
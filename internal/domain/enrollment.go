@@ -14,6 +14,21 @@ type EnrollmentRequestStatus = v1beta1.EnrollmentRequestStatus
 type EnrollmentRequestApproval = v1beta1.EnrollmentRequestApproval
 type EnrollmentRequestApprovalStatus = v1beta1.EnrollmentRequestApprovalStatus
 
+// ========== Enrollment Policy ==========
+
+type EnrollmentPolicy = v1beta1.EnrollmentPolicy
+type EnrollmentPolicyList = v1beta1.EnrollmentPolicyList
+type EnrollmentPolicySpec = v1beta1.EnrollmentPolicySpec
+type EnrollmentPolicyMatch = v1beta1.EnrollmentPolicyMatch
+type EnrollmentPolicyTimeWindow = v1beta1.EnrollmentPolicyTimeWindow
+
+// ========== Device Registration ==========
+
+type DeviceRegistration = v1beta1.DeviceRegistration
+type DeviceRegistrationList = v1beta1.DeviceRegistrationList
+type DeviceRegistrationSpec = v1beta1.DeviceRegistrationSpec
+type DeviceRegistrationStatus = v1beta1.DeviceRegistrationStatus
+
 // ========== Enrollment Config ==========
 
 type EnrollmentConfig = v1beta1.EnrollmentConfig
@@ -34,69 +34,88 @@ const (
 
 // Event reason constants
 const (
-	EventReasonDeviceApplicationDegraded       = v1beta1.EventReasonDeviceApplicationDegraded
-	EventReasonDeviceApplicationError          = v1beta1.EventReasonDeviceApplicationError
-	EventReasonDeviceApplicationHealthy        = v1beta1.EventReasonDeviceApplicationHealthy
-	EventReasonDeviceCPUCritical               = v1beta1.EventReasonDeviceCPUCritical
-	EventReasonDeviceCPUNormal                 = v1beta1.EventReasonDeviceCPUNormal
-	EventReasonDeviceCPUWarning                = v1beta1.EventReasonDeviceCPUWarning
-	EventReasonDeviceConflictPaused            = v1beta1.EventReasonDeviceConflictPaused
-	EventReasonDeviceConflictResolved          = v1beta1.EventReasonDeviceConflictResolved
-	EventReasonDeviceConnected                 = v1beta1.EventReasonDeviceConnected
-	EventReasonDeviceContentOutOfDate          = v1beta1.EventReasonDeviceContentOutOfDate
-	EventReasonDeviceContentUpToDate           = v1beta1.EventReasonDeviceContentUpToDate
-	EventReasonDeviceContentUpdating           = v1beta1.EventReasonDeviceContentUpdating
-	EventReasonDeviceDecommissionFailed        = v1beta1.EventReasonDeviceDecommissionFailed
-	EventReasonDeviceDecommissioned            = v1beta1.EventReasonDeviceDecommissioned
-	EventReasonDeviceDisconnected              = v1beta1.EventReasonDeviceDisconnected
-	EventReasonDeviceDiskCritical              = v1beta1.EventReasonDeviceDiskCritical
-	EventReasonDeviceDiskNormal                = v1beta1.EventReasonDeviceDiskNormal
-	EventReasonDeviceDiskWarning               = v1beta1.EventReasonDeviceDiskWarning
-	EventReasonDeviceIsRebooting               = v1beta1.EventReasonDeviceIsRebooting
-	EventReasonDeviceMemoryCritical            = v1beta1.EventReasonDeviceMemoryCritical
-	EventReasonDeviceMemoryNormal              = v1beta1.EventReasonDeviceMemoryNormal
-	EventReasonDeviceMemoryWarning             = v1beta1.EventReasonDeviceMemoryWarning
-	EventReasonDeviceMultipleOwnersDetected    = v1beta1.EventReasonDeviceMultipleOwnersDetected
-	EventReasonDeviceMultipleOwnersResolved    = v1beta1.EventReasonDeviceMultipleOwnersResolved
-	EventReasonDeviceSpecInvalid               = v1beta1.EventReasonDeviceSpecInvalid
-	EventReasonDeviceSpecValid                 = v1beta1.EventReasonDeviceSpecValid
-	EventReasonDeviceUpdateFailed              = v1beta1.EventReasonDeviceUpdateFailed
-	EventReasonDeviceVulnerabilityCVECritical  = v1beta1.EventReasonDeviceVulnerabilityCVECritical
-	EventReasonDeviceVulnerabilityCVEResolved  = v1beta1.EventReasonDeviceVulnerabilityCVEResolved
-	EventReasonDeviceVulnerabilityCVEWarning   = v1beta1.EventReasonDeviceVulnerabilityCVEWarning
-	EventReasonDeviceOSImageChanged            = v1beta1.EventReasonDeviceOSImageChanged
-	EventReasonEnrollmentRequestApprovalFailed = v1beta1.EventReasonEnrollmentRequestApprovalFailed
-	EventReasonEnrollmentRequestApproved       = v1beta1.EventReasonEnrollmentRequestApproved
-	EventReasonFleetInvalid                    = v1beta1.EventReasonFleetInvalid
-	EventReasonFleetRolloutBatchCompleted      = v1beta1.EventReasonFleetRolloutBatchCompleted
-	EventReasonFleetRolloutBatchDispatched     = v1beta1.EventReasonFleetRolloutBatchDispatched
-	EventReasonFleetRolloutCompleted           = v1beta1.EventReasonFleetRolloutCompleted
-	EventReasonFleetRolloutCreated             = v1beta1.EventReasonFleetRolloutCreated
-	EventReasonFleetRolloutDeviceSelected      = v1beta1.EventReasonFleetRolloutDeviceSelected
-	EventReasonFleetRolloutFailed              = v1beta1.EventReasonFleetRolloutFailed
-	EventReasonFleetRolloutStarted             = v1beta1.EventReasonFleetRolloutStarted
-	EventReasonFleetValid                      = v1beta1.EventReasonFleetValid
-	EventReasonInternalTaskFailed              = v1beta1.EventReasonInternalTaskFailed
-	EventReasonInternalTaskPermanentlyFailed   = v1beta1.EventReasonInternalTaskPermanentlyFailed
-	EventReasonDependencyChangeDetected        = v1beta1.EventReasonDependencyChangeDetected
-	EventReasonDependencySyncProbeFailed       = v1beta1.EventReasonDependencySyncProbeFailed
-	EventReasonReferencedRepositoryUpdated     = v1beta1.EventReasonReferencedRepositoryUpdated
-	EventReasonRepositoryAccessible            = v1beta1.EventReasonRepositoryAccessible
-	EventReasonRepositoryInaccessible          = v1beta1.EventReasonRepositoryInaccessible
-	EventReasonResourceCreated                 = v1beta1.EventReasonResourceCreated
-	EventReasonResourceCreationFailed          = v1beta1.EventReasonResourceCreationFailed
-	EventReasonResourceDeleted                 = v1beta1.EventReasonResourceDeleted
-	EventReasonResourceDeletionFailed          = v1beta1.EventReasonResourceDeletionFailed
-	EventReasonResourceSyncAccessible          = v1beta1.EventReasonResourceSyncAccessible
-	EventReasonResourceSyncCommitDetected      = v1beta1.EventReasonResourceSyncCommitDetected
-	EventReasonResourceSyncInaccessible        = v1beta1.EventReasonResourceSyncInaccessible
-	EventReasonResourceSyncParsed              = v1beta1.EventReasonResourceSyncParsed
-	EventReasonResourceSyncParsingFailed       = v1beta1.EventReasonResourceSyncParsingFailed
-	EventReasonResourceSyncSyncFailed          = v1beta1.EventReasonResourceSyncSyncFailed
-	EventReasonResourceSyncSynced              = v1beta1.EventReasonResourceSyncSynced
-	EventReasonResourceUpdateFailed            = v1beta1.EventReasonResourceUpdateFailed
-	EventReasonResourceUpdated                 = v1beta1.EventReasonResourceUpdated
-	EventReasonSystemRestored                  = v1beta1.EventReasonSystemRestored
+	EventReasonCertificateSigningRequestExpired = v1beta1.EventReasonCertificateSigningRequestExpired
+	EventReasonDeviceApplicationDegraded        = v1beta1.EventReasonDeviceApplicationDegraded
+	EventReasonDeviceApplicationError           = v1beta1.EventReasonDeviceApplicationError
+	EventReasonDeviceApplicationHealthy         = v1beta1.EventReasonDeviceApplicationHealthy
+	EventReasonDeviceCPUCritical                = v1beta1.EventReasonDeviceCPUCritical
+	EventReasonDeviceCPUNormal                  = v1beta1.EventReasonDeviceCPUNormal
+	EventReasonDeviceCPUWarning                 = v1beta1.EventReasonDeviceCPUWarning
+	EventReasonDeviceConflictPaused             = v1beta1.EventReasonDeviceConflictPaused
+	EventReasonDeviceConflictResolved           = v1beta1.EventReasonDeviceConflictResolved
+	EventReasonDeviceConnected                  = v1beta1.EventReasonDeviceConnected
+	EventReasonDeviceContentOutOfDate           = v1beta1.EventReasonDeviceContentOutOfDate
+	EventReasonDeviceContentUpToDate            = v1beta1.EventReasonDeviceContentUpToDate
+	EventReasonDeviceContentUpdating            = v1beta1.EventReasonDeviceContentUpdating
+	EventReasonDeviceCustomCritical             = v1beta1.EventReasonDeviceCustomCritical
+	EventReasonDeviceCustomNormal               = v1beta1.EventReasonDeviceCustomNormal
+	EventReasonDeviceCustomWarning              = v1beta1.EventReasonDeviceCustomWarning
+	EventReasonDeviceDecommissionFailed         = v1beta1.EventReasonDeviceDecommissionFailed
+	EventReasonDeviceDecommissioned             = v1beta1.EventReasonDeviceDecommissioned
+	EventReasonDeviceDisconnected               = v1beta1.EventReasonDeviceDisconnected
+	EventReasonDeviceDiskCritical               = v1beta1.EventReasonDeviceDiskCritical
+	EventReasonDeviceDiskNormal                 = v1beta1.EventReasonDeviceDiskNormal
+	EventReasonDeviceDiskWarning                = v1beta1.EventReasonDeviceDiskWarning
+	EventReasonDeviceIsRebooting                = v1beta1.EventReasonDeviceIsRebooting
+	EventReasonDeviceMemoryCritical             = v1beta1.EventReasonDeviceMemoryCritical
+	EventReasonDeviceMemoryNormal               = v1beta1.EventReasonDeviceMemoryNormal
+	EventReasonDeviceMemoryWarning              = v1beta1.EventReasonDeviceMemoryWarning
+	EventReasonDeviceMultipleOwnersDetected     = v1beta1.EventReasonDeviceMultipleOwnersDetected
+	EventReasonDeviceMultipleOwnersResolved     = v1beta1.EventReasonDeviceMultipleOwnersResolved
+	EventReasonDeviceNetworkCritical            = v1beta1.EventReasonDeviceNetworkCritical
+	EventReasonDeviceNetworkNormal              = v1beta1.EventReasonDeviceNetworkNormal
+	EventReasonDeviceNetworkWarning             = v1beta1.EventReasonDeviceNetworkWarning
+	EventReasonDeviceProcessCritical            = v1beta1.EventReasonDeviceProcessCritical
+	EventReasonDeviceProcessNormal              = v1beta1.EventReasonDeviceProcessNormal
+	EventReasonDeviceProcessWarning             = v1beta1.EventReasonDeviceProcessWarning
+	EventReasonDeviceSpecInvalid                = v1beta1.EventReasonDeviceSpecInvalid
+	EventReasonDeviceSpecValid                  = v1beta1.EventReasonDeviceSpecValid
+	EventReasonDeviceTemperatureCritical        = v1beta1.EventReasonDeviceTemperatureCritical
+	EventReasonDeviceTemperatureNormal          = v1beta1.EventReasonDeviceTemperatureNormal
+	EventReasonDeviceTemperatureWarning         = v1beta1.EventReasonDeviceTemperatureWarning
+	EventReasonDeviceUpdateFailed               = v1beta1.EventReasonDeviceUpdateFailed
+	EventReasonDeviceVulnerabilityCVECritical   = v1beta1.EventReasonDeviceVulnerabilityCVECritical
+	EventReasonDeviceVulnerabilityCVEResolved   = v1beta1.EventReasonDeviceVulnerabilityCVEResolved
+	EventReasonDeviceVulnerabilityCVEWarning    = v1beta1.EventReasonDeviceVulnerabilityCVEWarning
+	EventReasonDeviceOSImageChanged             = v1beta1.EventReasonDeviceOSImageChanged
+	EventReasonEnrollmentRequestApprovalFailed  = v1beta1.EventReasonEnrollmentRequestApprovalFailed
+	EventReasonEnrollmentRequestApproved        = v1beta1.EventReasonEnrollmentRequestApproved
+	EventReasonEnrollmentRequestExpired         = v1beta1.EventReasonEnrollmentRequestExpired
+	EventReasonFleetInvalid                     = v1beta1.EventReasonFleetInvalid
+	EventReasonFleetRolloutBatchCompleted       = v1beta1.EventReasonFleetRolloutBatchCompleted
+	EventReasonFleetRolloutBatchDispatched      = v1beta1.EventReasonFleetRolloutBatchDispatched
+	EventReasonFleetRolloutCompleted            = v1beta1.EventReasonFleetRolloutCompleted
+	EventReasonFleetRolloutCreated              = v1beta1.EventReasonFleetRolloutCreated
+	EventReasonFleetRolloutDeviceSelected       = v1beta1.EventReasonFleetRolloutDeviceSelected
+	EventReasonFleetRolloutFailed               = v1beta1.EventReasonFleetRolloutFailed
+	EventReasonFleetRolloutRolledBack           = v1beta1.EventReasonFleetRolloutRolledBack
+	EventReasonFleetRolloutPaused               = v1beta1.EventReasonFleetRolloutPaused
+	EventReasonFleetRolloutResumed              = v1beta1.EventReasonFleetRolloutResumed
+	EventReasonFleetRolloutAborted              = v1beta1.EventReasonFleetRolloutAborted
+	EventReasonFleetRolloutStarted              = v1beta1.EventReasonFleetRolloutStarted
+	EventReasonFleetValid                       = v1beta1.EventReasonFleetValid
+	EventReasonInternalTaskFailed               = v1beta1.EventReasonInternalTaskFailed
+	EventReasonInternalTaskPermanentlyFailed    = v1beta1.EventReasonInternalTaskPermanentlyFailed
+	EventReasonDependencyChangeDetected         = v1beta1.EventReasonDependencyChangeDetected
+	EventReasonDependencySyncProbeFailed        = v1beta1.EventReasonDependencySyncProbeFailed
+	EventReasonNotificationDeliveryFailed       = v1beta1.EventReasonNotificationDeliveryFailed
+	EventReasonReferencedRepositoryUpdated      = v1beta1.EventReasonReferencedRepositoryUpdated
+	EventReasonRepositoryAccessible             = v1beta1.EventReasonRepositoryAccessible
+	EventReasonRepositoryInaccessible           = v1beta1.EventReasonRepositoryInaccessible
+	EventReasonResourceCreated                  = v1beta1.EventReasonResourceCreated
+	EventReasonResourceCreationFailed           = v1beta1.EventReasonResourceCreationFailed
+	EventReasonResourceDeleted                  = v1beta1.EventReasonResourceDeleted
+	EventReasonResourceDeletionFailed           = v1beta1.EventReasonResourceDeletionFailed
+	EventReasonResourceSyncAccessible           = v1beta1.EventReasonResourceSyncAccessible
+	EventReasonResourceSyncCommitDetected       = v1beta1.EventReasonResourceSyncCommitDetected
+	EventReasonResourceSyncInaccessible         = v1beta1.EventReasonResourceSyncInaccessible
+	EventReasonResourceSyncParsed               = v1beta1.EventReasonResourceSyncParsed
+	EventReasonResourceSyncParsingFailed        = v1beta1.EventReasonResourceSyncParsingFailed
+	EventReasonResourceSyncSyncFailed           = v1beta1.EventReasonResourceSyncSyncFailed
+	EventReasonResourceSyncSynced               = v1beta1.EventReasonResourceSyncSynced
+	EventReasonResourceUpdateFailed             = v1beta1.EventReasonResourceUpdateFailed
+	EventReasonResourceUpdated                  = v1beta1.EventReasonResourceUpdated
+	EventReasonSystemRestored                   = v1beta1.EventReasonSystemRestored
 )
 
 // ========== Event Details Types ==========
@@ -144,34 +163,46 @@ const (
 
 // warningReasons contains all event reasons that should result in Warning events
 var warningReasons = map[EventReason]struct{}{
-	EventReasonResourceCreationFailed:          {},
-	EventReasonResourceUpdateFailed:            {},
-	EventReasonResourceDeletionFailed:          {},
-	EventReasonDeviceDecommissionFailed:        {},
-	EventReasonEnrollmentRequestApprovalFailed: {},
-	EventReasonDeviceApplicationDegraded:       {},
-	EventReasonDeviceApplicationError:          {},
-	EventReasonDeviceCPUCritical:               {},
-	EventReasonDeviceCPUWarning:                {},
-	EventReasonDeviceMemoryCritical:            {},
-	EventReasonDeviceMemoryWarning:             {},
-	EventReasonDeviceDiskCritical:              {},
-	EventReasonDeviceDiskWarning:               {},
-	EventReasonDeviceVulnerabilityCVECritical:  {},
-	EventReasonDeviceVulnerabilityCVEWarning:   {},
-	EventReasonDeviceDisconnected:              {},
-	EventReasonDeviceConflictPaused:            {},
-	EventReasonDeviceSpecInvalid:               {},
-	EventReasonFleetInvalid:                    {},
-	EventReasonDeviceMultipleOwnersDetected:    {},
-	EventReasonDeviceUpdateFailed:              {},
-	EventReasonInternalTaskFailed:              {},
-	EventReasonInternalTaskPermanentlyFailed:   {},
-	EventReasonResourceSyncInaccessible:        {},
-	EventReasonResourceSyncParsingFailed:       {},
-	EventReasonResourceSyncSyncFailed:          {},
-	EventReasonFleetRolloutFailed:              {},
-	EventReasonDependencySyncProbeFailed:       {},
+	EventReasonResourceCreationFailed:           {},
+	EventReasonResourceUpdateFailed:             {},
+	EventReasonResourceDeletionFailed:           {},
+	EventReasonDeviceDecommissionFailed:         {},
+	EventReasonEnrollmentRequestApprovalFailed:  {},
+	EventReasonEnrollmentRequestExpired:         {},
+	EventReasonCertificateSigningRequestExpired: {},
+	EventReasonDeviceApplicationDegraded:        {},
+	EventReasonDeviceApplicationError:           {},
+	EventReasonDeviceCPUCritical:                {},
+	EventReasonDeviceCPUWarning:                 {},
+	EventReasonDeviceMemoryCritical:             {},
+	EventReasonDeviceMemoryWarning:              {},
+	EventReasonDeviceDiskCritical:               {},
+	EventReasonDeviceDiskWarning:                {},
+	EventReasonDeviceNetworkCritical:            {},
+	EventReasonDeviceNetworkWarning:             {},
+	EventReasonDeviceTemperatureCritical:        {},
+	EventReasonDeviceTemperatureWarning:         {},
+	EventReasonDeviceProcessCritical:            {},
+	EventReasonDeviceProcessWarning:             {},
+	EventReasonDeviceCustomCritical:             {},
+	EventReasonDeviceCustomWarning:              {},
+	EventReasonDeviceVulnerabilityCVECritical:   {},
+	EventReasonDeviceVulnerabilityCVEWarning:    {},
+	EventReasonDeviceDisconnected:               {},
+	EventReasonDeviceConflictPaused:             {},
+	EventReasonDeviceSpecInvalid:                {},
+	EventReasonFleetInvalid:                     {},
+	EventReasonDeviceMultipleOwnersDetected:     {},
+	EventReasonDeviceUpdateFailed:               {},
+	EventReasonInternalTaskFailed:               {},
+	EventReasonInternalTaskPermanentlyFailed:    {},
+	EventReasonResourceSyncInaccessible:         {},
+	EventReasonResourceSyncParsingFailed:        {},
+	EventReasonResourceSyncSyncFailed:           {},
+	EventReasonFleetRolloutFailed:               {},
+	EventReasonFleetRolloutRolledBack:           {},
+	EventReasonDependencySyncProbeFailed:        {},
+	EventReasonNotificationDeliveryFailed:       {},
 }
 
 // GetEventType determines the event type based on the event reason
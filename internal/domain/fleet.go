@@ -17,14 +17,41 @@ type RolloutStrategy = v1beta1.RolloutStrategy
 type FleetRolloutStatus = v1beta1.FleetRolloutStatus
 type Batch = v1beta1.Batch
 type BatchSequence = v1beta1.BatchSequence
+type Canary = v1beta1.Canary
+type Progressive = v1beta1.Progressive
 type Batch_Limit = v1beta1.Batch_Limit
 type BatchLimit1 = v1beta1.BatchLimit1
 type DisruptionBudget = v1beta1.DisruptionBudget
+type RolloutFailurePolicy = v1beta1.RolloutFailurePolicy
+type FleetRolloutBatchApproval = v1beta1.FleetRolloutBatchApproval
+type FleetRolloutControl = v1beta1.FleetRolloutControl
+type FleetRolloutControlAction = v1beta1.FleetRolloutControlAction
+type RolloutPreview = v1beta1.RolloutPreview
+type RolloutPreviewBatch = v1beta1.RolloutPreviewBatch
+type RolloutPreviewDisruptionBudgetGroup = v1beta1.RolloutPreviewDisruptionBudgetGroup
+type RolloutPreviewUnselectedDevice = v1beta1.RolloutPreviewUnselectedDevice
 
 // ========== Rollout Strategy Constants ==========
 
 const (
 	RolloutStrategyBatchSequence = v1beta1.RolloutStrategyBatchSequence
+	RolloutStrategyCanary        = v1beta1.RolloutStrategyCanary
+	RolloutStrategyProgressive   = v1beta1.RolloutStrategyProgressive
+)
+
+// ========== Rollout Failure Policy Constants ==========
+
+const (
+	RolloutFailurePolicyPause    = v1beta1.RolloutFailurePolicyPause
+	RolloutFailurePolicyRollback = v1beta1.RolloutFailurePolicyRollback
+)
+
+// ========== Rollout Control Action Constants ==========
+
+const (
+	FleetRolloutControlActionPause  = v1beta1.FleetRolloutControlActionPause
+	FleetRolloutControlActionResume = v1beta1.FleetRolloutControlActionResume
+	FleetRolloutControlActionAbort  = v1beta1.FleetRolloutControlActionAbort
 )
 
 // ========== Fleet Event Details Types ==========
@@ -39,6 +66,14 @@ type FleetRolloutDeviceSelectedDetails = v1beta1.FleetRolloutDeviceSelectedDetai
 type FleetRolloutDeviceSelectedDetailsDetailType = v1beta1.FleetRolloutDeviceSelectedDetailsDetailType
 type FleetRolloutFailedDetails = v1beta1.FleetRolloutFailedDetails
 type FleetRolloutFailedDetailsDetailType = v1beta1.FleetRolloutFailedDetailsDetailType
+type FleetRolloutRolledBackDetails = v1beta1.FleetRolloutRolledBackDetails
+type FleetRolloutRolledBackDetailsDetailType = v1beta1.FleetRolloutRolledBackDetailsDetailType
+type FleetRolloutPausedDetails = v1beta1.FleetRolloutPausedDetails
+type FleetRolloutPausedDetailsDetailType = v1beta1.FleetRolloutPausedDetailsDetailType
+type FleetRolloutResumedDetails = v1beta1.FleetRolloutResumedDetails
+type FleetRolloutResumedDetailsDetailType = v1beta1.FleetRolloutResumedDetailsDetailType
+type FleetRolloutAbortedDetails = v1beta1.FleetRolloutAbortedDetails
+type FleetRolloutAbortedDetailsDetailType = v1beta1.FleetRolloutAbortedDetailsDetailType
 type FleetRolloutStartedDetails = v1beta1.FleetRolloutStartedDetails
 type FleetRolloutStartedDetailsDetailType = v1beta1.FleetRolloutStartedDetailsDetailType
 type FleetRolloutStartedDetailsRolloutStrategy = v1beta1.FleetRolloutStartedDetailsRolloutStrategy
@@ -49,6 +84,10 @@ const (
 	FleetRolloutCompleted       = v1beta1.FleetRolloutCompleted
 	FleetRolloutDeviceSelected  = v1beta1.FleetRolloutDeviceSelected
 	FleetRolloutFailed          = v1beta1.FleetRolloutFailed
+	FleetRolloutRolledBack      = v1beta1.FleetRolloutRolledBack
+	FleetRolloutPaused          = v1beta1.FleetRolloutPaused
+	FleetRolloutResumed         = v1beta1.FleetRolloutResumed
+	FleetRolloutAborted         = v1beta1.FleetRolloutAborted
 	FleetRolloutStarted         = v1beta1.FleetRolloutStarted
 	FleetRolloutStrategyBatched = v1beta1.Batched
 	FleetRolloutStrategyNone    = v1beta1.None
@@ -0,0 +1,23 @@
+package domain
+
+import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+
+// ========== Resource Types ==========
+
+type NotificationSink = v1beta1.NotificationSink
+type NotificationSinkList = v1beta1.NotificationSinkList
+type NotificationSinkSpec = v1beta1.NotificationSinkSpec
+type NotificationSinkType = v1beta1.NotificationSinkType
+type NotificationSinkHttpTarget = v1beta1.NotificationSinkHttpTarget
+type NotificationSinkEmailTarget = v1beta1.NotificationSinkEmailTarget
+type NotificationSinkFilter = v1beta1.NotificationSinkFilter
+type SecretKeyReference = v1beta1.SecretKeyReference
+
+// ========== Notification Sink Type Constants ==========
+
+const (
+	NotificationSinkTypeWebhook     = v1beta1.NotificationSinkTypeWebhook
+	NotificationSinkTypeSlack       = v1beta1.NotificationSinkTypeSlack
+	NotificationSinkTypeEmail       = v1beta1.NotificationSinkTypeEmail
+	NotificationSinkTypeCloudEvents = v1beta1.NotificationSinkTypeCloudEvents
+)
@@ -7,13 +7,17 @@ import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
 type ListAuthProvidersParams = v1beta1.ListAuthProvidersParams
 type ListCertificateSigningRequestsParams = v1beta1.ListCertificateSigningRequestsParams
 type ListDevicesParams = v1beta1.ListDevicesParams
+type ListDeviceRegistrationsParams = v1beta1.ListDeviceRegistrationsParams
+type ListEnrollmentPoliciesParams = v1beta1.ListEnrollmentPoliciesParams
 type ListEnrollmentRequestsParams = v1beta1.ListEnrollmentRequestsParams
 type ListEventsParams = v1beta1.ListEventsParams
 type ListFleetsParams = v1beta1.ListFleetsParams
 type ListLabelsParams = v1beta1.ListLabelsParams
+type ListNotificationSinksParams = v1beta1.ListNotificationSinksParams
 type ListOrganizationsParams = v1beta1.ListOrganizationsParams
 type ListRepositoriesParams = v1beta1.ListRepositoriesParams
 type ListResourceSyncsParams = v1beta1.ListResourceSyncsParams
+type ListSecretsParams = v1beta1.ListSecretsParams
 type ListTemplateVersionsParams = v1beta1.ListTemplateVersionsParams
 
 // ========== Get Params ==========
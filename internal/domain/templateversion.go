@@ -8,3 +8,27 @@ type TemplateVersion = v1beta1.TemplateVersion
 type TemplateVersionList = v1beta1.TemplateVersionList
 type TemplateVersionSpec = v1beta1.TemplateVersionSpec
 type TemplateVersionStatus = v1beta1.TemplateVersionStatus
+type TemplateVersionRolloutRecord = v1beta1.TemplateVersionRolloutRecord
+type RolloutBatchRecord = v1beta1.RolloutBatchRecord
+type RolloutOutcome = v1beta1.RolloutOutcome
+type TemplateVersionDiff = v1beta1.TemplateVersionDiff
+type TemplateVersionChange = v1beta1.TemplateVersionChange
+type TemplateVersionChangeType = v1beta1.TemplateVersionChangeType
+
+// ========== Rollout Outcome Constants ==========
+
+const (
+	RolloutOutcomeInProgress = v1beta1.RolloutOutcomeInProgress
+	RolloutOutcomeCompleted  = v1beta1.RolloutOutcomeCompleted
+	RolloutOutcomeRolledBack = v1beta1.RolloutOutcomeRolledBack
+	RolloutOutcomeAborted    = v1beta1.RolloutOutcomeAborted
+	RolloutOutcomeSuperseded = v1beta1.RolloutOutcomeSuperseded
+)
+
+// ========== Template Version Change Type Constants ==========
+
+const (
+	TemplateVersionChangeAdded    = v1beta1.TemplateVersionChangeAdded
+	TemplateVersionChangeRemoved  = v1beta1.TemplateVersionChangeRemoved
+	TemplateVersionChangeModified = v1beta1.TemplateVersionChangeModified
+)
@@ -0,0 +1,91 @@
+package event_exporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const EventCheckpointConsumer = "event-exporter"
+
+const CurrentEventCheckpointVersion = 1
+
+// EventCheckpoint is the position from which the events of an organization are exported.  Events are exported in
+// the order of their creation time, but may become visible after events that were created later, so the position
+// trails the last exported event and the names of the exported events that were created since are kept to skip them.
+type EventCheckpoint struct {
+	Version int `json:"version"`
+	// Timestamp is the creation time from which events are exported.  Events created before it were exported.
+	Timestamp time.Time `json:"timestamp"`
+	// Exported are the names of the events created at or after Timestamp that were exported
+	Exported []string `json:"exported,omitempty"`
+	// Pending are the record IDs of a batch that was being published but not confirmed by the sink.  After a restart,
+	// the sink is asked which of them it holds, so that they are neither published twice nor dropped.
+	Pending []string `json:"pending,omitempty"`
+	// Published are the record IDs of an interrupted batch that the sink holds.  They are skipped once encountered.
+	Published []string `json:"published,omitempty"`
+}
+
+type CheckpointManager struct {
+	log     *logrus.Logger
+	handler service.Service
+}
+
+func NewCheckpointManager(log *logrus.Logger, handler service.Service) *CheckpointManager {
+	return &CheckpointManager{
+		log:     log,
+		handler: handler,
+	}
+}
+
+// LoadCheckpoint retrieves the export position of an organization.  Organizations without a checkpoint have not
+// been exported yet, so all of their retained events are exported.  Unlike the alert exporter, an unreadable
+// checkpoint is an error: starting over would publish events again, and starting from now would drop events.
+func (c *CheckpointManager) LoadCheckpoint(ctx context.Context, orgID uuid.UUID) (*EventCheckpoint, error) {
+	data, status := c.handler.GetCheckpoint(ctx, EventCheckpointConsumer, orgID.String())
+	if status.Code == http.StatusNotFound {
+		CheckpointOperationsTotal.WithLabelValues("load", "not_found").Inc()
+		c.log.WithField("org_id", orgID).Info("No event checkpoint found, exporting all retained events of the organization")
+		return &EventCheckpoint{Version: CurrentEventCheckpointVersion}, nil
+	}
+	if status.Code != http.StatusOK {
+		CheckpointOperationsTotal.WithLabelValues("load", "error").Inc()
+		return nil, fmt.Errorf("failed to get event checkpoint: %s", status.Message)
+	}
+
+	var checkpoint EventCheckpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		CheckpointOperationsTotal.WithLabelValues("load", "unmarshal_error").Inc()
+		return nil, fmt.Errorf("failed to unmarshal event checkpoint: %w", err)
+	}
+	if checkpoint.Version != CurrentEventCheckpointVersion {
+		CheckpointOperationsTotal.WithLabelValues("load", "unmarshal_error").Inc()
+		return nil, fmt.Errorf("unsupported event checkpoint version %d", checkpoint.Version)
+	}
+
+	CheckpointOperationsTotal.WithLabelValues("load", "success").Inc()
+	return &checkpoint, nil
+}
+
+func (c *CheckpointManager) StoreCheckpoint(ctx context.Context, orgID uuid.UUID, checkpoint *EventCheckpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		CheckpointOperationsTotal.WithLabelValues("store", "marshal_error").Inc()
+		return fmt.Errorf("failed to marshal event checkpoint: %w", err)
+	}
+
+	status := c.handler.SetCheckpoint(ctx, EventCheckpointConsumer, orgID.String(), data)
+	if status.Code != http.StatusOK {
+		CheckpointOperationsTotal.WithLabelValues("store", "error").Inc()
+		return fmt.Errorf("failed to store event checkpoint: %s", status.Message)
+	}
+
+	CheckpointOperationsTotal.WithLabelValues("store", "success").Inc()
+	return nil
+}
@@ -0,0 +1,357 @@
+// Package event_exporter publishes the events of all organizations as CloudEvents to a sink, such as a Kafka topic,
+// a NATS JetStream subject or a file.
+//
+// Export Order:
+//   - The events of every organization are exported in the order of their creation time, in batches.
+//   - The creation time of an event is set before it is committed, so an event may become visible after events
+//     that were created later.  Every cycle therefore reads the events of a trailing window before its checkpoint
+//     again and exports the ones that became visible since.
+//
+// Checkpointing:
+//   - The position of every organization is stored as a checkpoint, as the alert exporter does.  It is the start of
+//     the trailing window and the names of the exported events that were created since, which are skipped.
+//   - Before a batch is published, its record IDs are stored in the checkpoint as pending.  After the sink confirmed
+//     the batch, the position is advanced and the pending IDs are cleared.
+//   - If the exporter restarts while a batch is pending, it asks the sink which of the pending records it holds and
+//     publishes only the others.  Events are thereby neither published twice nor dropped across restarts.
+//   - The sink finds pending records among its most recent records, so the pending batches of all organizations are
+//     resolved before anything else is published, and the exporter must be the only publisher to the sink.
+//
+// Records:
+//   - Every event is published as a CloudEvent in structured mode, whose id is the name of the event and whose
+//     source identifies the organization.
+
+package event_exporter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// lateEventWindow is how long after its creation time an event may still become visible.  Every cycle reads the
+	// events created within this window before the last exported event again, so that events whose creation was
+	// still being committed are not skipped.
+	lateEventWindow = time.Minute
+
+	// cycleTimeout bounds a processing cycle, including the publishing of its batches
+	cycleTimeout = 5 * time.Minute
+)
+
+type EventExporter struct {
+	log         *logrus.Logger
+	handler     service.Service
+	sink        Sink
+	checkpoints *CheckpointManager
+	interval    time.Duration
+	batchSize   int
+}
+
+func NewEventExporter(log *logrus.Logger, handler service.Service, sink Sink, cfg *config.Config) *EventExporter {
+	interval := config.DefaultEventExporterPollingInterval
+	batchSize := config.DefaultEventExporterBatchSize
+	if cfg.EventExporter != nil {
+		if cfg.EventExporter.PollingInterval > 0 {
+			interval = time.Duration(cfg.EventExporter.PollingInterval)
+		}
+		if cfg.EventExporter.BatchSize > 0 {
+			batchSize = cfg.EventExporter.BatchSize
+		}
+	}
+	return &EventExporter{
+		log:         log,
+		handler:     handler,
+		sink:        sink,
+		checkpoints: NewCheckpointManager(log, handler),
+		interval:    interval,
+		batchSize:   batchSize,
+	}
+}
+
+// Poll exports new events at the polling interval until the context is canceled.
+func (e *EventExporter) Poll(ctx context.Context) error {
+	e.log.WithFields(logrus.Fields{
+		"component":        "event_exporter",
+		"polling_interval": e.interval,
+		"batch_size":       e.batchSize,
+	}).Info("Starting event exporter polling")
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	cycleCount := 0
+	for {
+		cycleCount++
+		e.processingCycle(ctx, cycleCount)
+
+		select {
+		case <-ctx.Done():
+			e.log.WithFields(logrus.Fields{
+				"component":   "event_exporter",
+				"cycles_run":  cycleCount,
+				"context_err": ctx.Err(),
+			}).Info("Event exporter stopping due to context cancellation")
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EventExporter) processingCycle(ctx context.Context, cycleNumber int) {
+	startTime := time.Now()
+	defer func() {
+		ProcessingCyclesTotal.Inc()
+		ProcessingDurationSeconds.Observe(time.Since(startTime).Seconds())
+	}()
+
+	ctx, span := tracing.StartSpan(ctx, "flightctl/event-exporter", "ProcessingCycle")
+	defer span.End()
+	span.SetAttributes(attribute.Int("cycle_number", cycleNumber))
+
+	logger := e.log.WithFields(logrus.Fields{
+		"component":    "event_exporter",
+		"cycle_number": cycleNumber,
+		"trace_id":     span.SpanContext().TraceID().String(),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, cycleTimeout)
+	defer cancel()
+
+	until, status := e.handler.GetDatabaseTime(ctx)
+	if status.Code != http.StatusOK {
+		ErrorsTotal.WithLabelValues("event_exporter", "database_time").Inc()
+		logger.WithField("status_msg", status.Message).Error("Failed to get database time")
+		return
+	}
+
+	orgs, status := e.handler.ListOrganizations(ctx, domain.ListOrganizationsParams{})
+	if status.Code != http.StatusOK {
+		ErrorsTotal.WithLabelValues("event_exporter", "list_organizations").Inc()
+		logger.WithField("status_msg", status.Message).Error("Failed to list organizations")
+		return
+	}
+
+	orgIDs := make([]uuid.UUID, 0, len(orgs.Items))
+	for _, org := range orgs.Items {
+		orgID, err := uuid.Parse(lo.FromPtr(org.Metadata.Name))
+		if err != nil {
+			logger.WithField("org_id", lo.FromPtr(org.Metadata.Name)).WithError(err).Error("Failed to parse organization ID")
+			continue
+		}
+		orgIDs = append(orgIDs, orgID)
+	}
+
+	// Publishing anything before all interrupted batches are resolved could bury their records in the sink
+	for _, orgID := range orgIDs {
+		checkpoint, err := e.checkpoints.LoadCheckpoint(ctx, orgID)
+		if err == nil && len(checkpoint.Pending) > 0 {
+			err = e.resolvePending(ctx, orgID, checkpoint)
+		}
+		if err != nil {
+			span.RecordError(err)
+			ErrorsTotal.WithLabelValues("event_exporter", "resolve_pending").Inc()
+			logger.WithField("org_id", orgID).WithError(err).Error("Failed to resolve interrupted batch, skipping cycle")
+			return
+		}
+	}
+
+	exported, failed := 0, 0
+	for _, orgID := range orgIDs {
+		orgExported, err := e.ExportOrganization(ctx, orgID, until)
+		exported += orgExported
+		if err != nil {
+			// The checkpoint of the organization is kept, so the next cycle continues where this one stopped
+			failed++
+			span.RecordError(err)
+			ErrorsTotal.WithLabelValues("event_exporter", "export").Inc()
+			logger.WithField("org_id", orgID).WithError(err).Error("Failed to export events of organization")
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("events_exported", exported),
+		attribute.Int("orgs_failed", failed),
+	)
+	if failed == 0 {
+		LastSuccessfulProcessingTimestamp.SetToCurrentTime()
+	}
+
+	logger.WithFields(logrus.Fields{
+		"events_exported": exported,
+		"orgs_processed":  len(orgIDs),
+		"orgs_failed":     failed,
+		"exported_until":  until.Format(time.RFC3339Nano),
+		"cycle_time_ms":   time.Since(startTime).Milliseconds(),
+	}).Info("Processing cycle completed")
+}
+
+// ExportOrganization publishes the events of an organization that were created after its checkpoint and before
+// until and were not exported yet, and advances the checkpoint after every batch the sink confirmed.  It returns the
+// number of published events.
+func (e *EventExporter) ExportOrganization(ctx context.Context, orgID uuid.UUID, until time.Time) (int, error) {
+	checkpoint, err := e.checkpoints.LoadCheckpoint(ctx, orgID)
+	if err != nil {
+		return 0, err
+	}
+	if len(checkpoint.Pending) > 0 {
+		if err := e.resolvePending(ctx, orgID, checkpoint); err != nil {
+			return 0, err
+		}
+	}
+	if !checkpoint.Timestamp.Before(until) {
+		return 0, nil
+	}
+
+	// Records of an interrupted batch that the sink already holds are skipped once they are encountered
+	published := lo.SliceToMap(checkpoint.Published, func(id string) (string, struct{}) { return id, struct{}{} })
+	// Events that were exported by an earlier cycle are skipped as well
+	alreadyExported := lo.SliceToMap(checkpoint.Exported, func(name string) (string, struct{}) { return name, struct{}{} })
+	// seen are the creation times of the events that were exported or skipped, by name
+	seen := map[string]time.Time{}
+
+	since := checkpoint.Timestamp
+	selectors := []string{fmt.Sprintf("metadata.creationTimestamp<%s", until.Format(time.RFC3339Nano))}
+	if !since.IsZero() {
+		selectors = append([]string{fmt.Sprintf("metadata.creationTimestamp>=%s", since.Format(time.RFC3339Nano))}, selectors...)
+	}
+	fieldSelector := strings.Join(selectors, ",")
+	params := domain.ListEventsParams{
+		FieldSelector: &fieldSelector,
+		Order:         lo.ToPtr(domain.Asc),
+		Limit:         lo.ToPtr(int32(e.batchSize)),
+	}
+
+	exported := 0
+	for {
+		events, status := e.handler.ListEvents(ctx, orgID, params)
+		if status.Code != http.StatusOK {
+			return exported, fmt.Errorf("failed to list events: %s", status.Message)
+		}
+
+		batch := make([]Record, 0, len(events.Items))
+		var last time.Time
+		for _, event := range events.Items {
+			name := lo.FromPtr(event.Metadata.Name)
+			last = lo.FromPtr(event.Metadata.CreationTimestamp)
+			seen[name] = last
+			if _, ok := alreadyExported[name]; ok {
+				continue
+			}
+
+			record, err := NewRecord(orgID, event)
+			if err != nil {
+				return exported, err
+			}
+			if _, ok := published[record.ID]; ok {
+				delete(published, record.ID)
+				EventsSkippedTotal.Inc()
+			} else {
+				batch = append(batch, record)
+			}
+		}
+
+		if len(batch) > 0 {
+			if err := e.publish(ctx, orgID, *checkpoint, batch, published); err != nil {
+				return exported, err
+			}
+			exported += len(batch)
+		}
+
+		if events.Metadata.Continue == nil {
+			break
+		}
+		// The events of earlier cycles that were not encountered yet were created after the listed ones, so they
+		// are still skipped when the export continues from this position
+		checkpoint = trailingPosition(since, last, seen, lo.Filter(checkpoint.Exported, func(name string, _ int) bool {
+			_, ok := seen[name]
+			return !ok
+		}))
+		checkpoint.Published = lo.Keys(published)
+		if err := e.checkpoints.StoreCheckpoint(ctx, orgID, checkpoint); err != nil {
+			return exported, err
+		}
+		params.Continue = events.Metadata.Continue
+	}
+
+	// All events visible before until were exported, so the next cycle reads the events of the trailing window before
+	// until again.  Published records of an interrupted batch that were not encountered were deleted in the meantime.
+	if err := e.checkpoints.StoreCheckpoint(ctx, orgID, trailingPosition(since, until, seen, nil)); err != nil {
+		return exported, err
+	}
+	return exported, nil
+}
+
+// trailingPosition returns the checkpoint that continues the export after the events created before until: it starts
+// lateEventWindow before until, but not before since, and skips the seen events that were created since then as well
+// as the events of earlier cycles that were not seen yet.
+func trailingPosition(since time.Time, until time.Time, seen map[string]time.Time, notSeen []string) *EventCheckpoint {
+	position := &EventCheckpoint{
+		Version:   CurrentEventCheckpointVersion,
+		Timestamp: until.Add(-lateEventWindow),
+	}
+	if position.Timestamp.Before(since) {
+		position.Timestamp = since
+	}
+	for name, created := range seen {
+		if created.Before(position.Timestamp) {
+			delete(seen, name)
+			continue
+		}
+		position.Exported = append(position.Exported, name)
+	}
+	slices.Sort(position.Exported)
+	position.Exported = append(position.Exported, notSeen...)
+	return position
+}
+
+// resolvePending asks the sink which records of the interrupted batch of an organization it holds, and stores them
+// in the checkpoint so that they are skipped.  The other records of the batch are published again.
+func (e *EventExporter) resolvePending(ctx context.Context, orgID uuid.UUID, checkpoint *EventCheckpoint) error {
+	held, err := e.sink.Published(ctx, checkpoint.Pending)
+	if err != nil {
+		return fmt.Errorf("failed to check interrupted batch: %w", err)
+	}
+	e.log.WithFields(logrus.Fields{
+		"org_id":    orgID,
+		"pending":   len(checkpoint.Pending),
+		"published": len(held),
+	}).Info("Resolved interrupted batch")
+
+	checkpoint.Published = append(checkpoint.Published, lo.Keys(held)...)
+	checkpoint.Pending = nil
+	return e.checkpoints.StoreCheckpoint(ctx, orgID, checkpoint)
+}
+
+// publish marks a batch as pending in the stored checkpoint of the organization and publishes it.  Records of an
+// interrupted batch that the sink holds and that were not encountered yet stay in the checkpoint.
+func (e *EventExporter) publish(ctx context.Context, orgID uuid.UUID, checkpoint EventCheckpoint, batch []Record, published map[string]struct{}) error {
+	checkpoint.Pending = lo.Map(batch, func(r Record, _ int) string { return r.ID })
+	checkpoint.Published = lo.Keys(published)
+	if err := e.checkpoints.StoreCheckpoint(ctx, orgID, &checkpoint); err != nil {
+		return err
+	}
+
+	start := time.Now()
+	err := e.sink.Publish(ctx, batch)
+	SinkRequestDurationSeconds.Observe(time.Since(start).Seconds())
+	if err != nil {
+		SinkRequestsTotal.WithLabelValues("error").Inc()
+		return fmt.Errorf("failed to publish events: %w", err)
+	}
+	SinkRequestsTotal.WithLabelValues("success").Inc()
+	EventsExportedTotal.Add(float64(len(batch)))
+	return nil
+}
@@ -0,0 +1,274 @@
+package event_exporter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// fakeSink holds published records in memory.  It fails once it holds failAfter records, if failAfter is set.
+type fakeSink struct {
+	records   []Record
+	failAfter int
+}
+
+func (s *fakeSink) Publish(_ context.Context, records []Record) error {
+	for _, record := range records {
+		if s.failAfter > 0 && len(s.records) >= s.failAfter {
+			return errors.New("sink unavailable")
+		}
+		s.records = append(s.records, record)
+	}
+	return nil
+}
+
+func (s *fakeSink) Published(_ context.Context, ids []string) (map[string]struct{}, error) {
+	published := map[string]struct{}{}
+	for _, record := range s.records {
+		if lo.Contains(ids, record.ID) {
+			published[record.ID] = struct{}{}
+		}
+	}
+	return published, nil
+}
+
+func (s *fakeSink) Close() error { return nil }
+
+func (s *fakeSink) names() []string {
+	return lo.Map(s.records, func(r Record, _ int) string { return r.ID[strings.Index(r.ID, "/")+1:] })
+}
+
+// fakeEventService serves the events of an organization and stores checkpoints in memory.  The store with the
+// number failStore (counting from 1) fails, as if the exporter had crashed before it.
+type fakeEventService struct {
+	events      []domain.Event
+	checkpoints map[string][]byte
+	stores      int
+	failStore   int
+}
+
+func (f *fakeEventService) expect(mockSvc *service.MockService, orgId uuid.UUID) {
+	okStatus := domain.Status{Code: http.StatusOK}
+	mockSvc.EXPECT().GetCheckpoint(gomock.Any(), EventCheckpointConsumer, orgId.String()).DoAndReturn(func(_ context.Context, _, key string) ([]byte, domain.Status) {
+		data, ok := f.checkpoints[key]
+		if !ok {
+			return nil, domain.StatusResourceNotFound("Checkpoint", key)
+		}
+		return data, okStatus
+	}).AnyTimes()
+	mockSvc.EXPECT().SetCheckpoint(gomock.Any(), EventCheckpointConsumer, orgId.String(), gomock.Any()).DoAndReturn(func(_ context.Context, _, key string, data []byte) domain.Status {
+		f.stores++
+		if f.stores == f.failStore {
+			return domain.StatusInternalServerError("database unavailable")
+		}
+		f.checkpoints[key] = data
+		return okStatus
+	}).AnyTimes()
+	mockSvc.EXPECT().ListEvents(gomock.Any(), orgId, gomock.Any()).DoAndReturn(func(_ context.Context, _ uuid.UUID, params domain.ListEventsParams) (*domain.EventList, domain.Status) {
+		var matching []domain.Event
+		for _, event := range f.events {
+			if matchesCreationTimestamp(event, lo.FromPtr(params.FieldSelector)) {
+				matching = append(matching, event)
+			}
+		}
+		offset := 0
+		if params.Continue != nil {
+			offset, _ = strconv.Atoi(*params.Continue)
+		}
+		end := min(offset+int(lo.FromPtr(params.Limit)), len(matching))
+		list := &domain.EventList{Items: matching[offset:end]}
+		if end < len(matching) {
+			list.Metadata.Continue = lo.ToPtr(strconv.Itoa(end))
+		}
+		return list, okStatus
+	}).AnyTimes()
+}
+
+func matchesCreationTimestamp(event domain.Event, fieldSelector string) bool {
+	created := lo.FromPtr(event.Metadata.CreationTimestamp)
+	for _, selector := range strings.Split(fieldSelector, ",") {
+		if value, ok := strings.CutPrefix(selector, "metadata.creationTimestamp>="); ok {
+			ts, _ := time.Parse(time.RFC3339Nano, value)
+			if created.Before(ts) {
+				return false
+			}
+		} else if value, ok := strings.CutPrefix(selector, "metadata.creationTimestamp<"); ok {
+			ts, _ := time.Parse(time.RFC3339Nano, value)
+			if !created.Before(ts) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (f *fakeEventService) checkpoint(t *testing.T, orgId uuid.UUID) EventCheckpoint {
+	var checkpoint EventCheckpoint
+	require.NoError(t, json.Unmarshal(f.checkpoints[orgId.String()], &checkpoint))
+	return checkpoint
+}
+
+func TestExportOrganization(t *testing.T) {
+	orgId := uuid.New()
+	base := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	until := base.Add(time.Minute)
+
+	event := func(name string, offset time.Duration) domain.Event {
+		return domain.Event{
+			Metadata:       domain.ObjectMeta{Name: lo.ToPtr(name), CreationTimestamp: lo.ToPtr(base.Add(offset))},
+			Reason:         domain.EventReasonDeviceDisconnected,
+			InvolvedObject: domain.ObjectReference{Kind: domain.DeviceKind, Name: "dev1"},
+		}
+	}
+	// e2 and e3 share their creation time and are split across batches of two
+	events := []domain.Event{
+		event("e1", time.Second),
+		event("e2", 2*time.Second),
+		event("e3", 2*time.Second),
+		event("e4", 3*time.Second),
+		event("e5", 4*time.Second),
+	}
+	allNames := []string{"e1", "e2", "e3", "e4", "e5"}
+
+	newExporter := func(mockSvc *service.MockService, sink Sink) *EventExporter {
+		cfg := config.NewDefault()
+		cfg.EventExporter.BatchSize = 2
+		return NewEventExporter(logrus.New(), mockSvc, sink, cfg)
+	}
+
+	t.Run("When there is no checkpoint it should publish all events in order and advance the checkpoint", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+		svc := &fakeEventService{events: events, checkpoints: map[string][]byte{}}
+		svc.expect(mockSvc, orgId)
+		sink := &fakeSink{}
+
+		exported, err := newExporter(mockSvc, sink).ExportOrganization(context.Background(), orgId, until)
+		require.NoError(t, err)
+		require.Equal(t, 5, exported)
+		require.Equal(t, allNames, sink.names())
+
+		// The checkpoint trails until, so that events that become visible late are exported by the next cycle
+		checkpoint := svc.checkpoint(t, orgId)
+		require.True(t, checkpoint.Timestamp.Equal(until.Add(-lateEventWindow)))
+		require.Equal(t, allNames, checkpoint.Exported)
+		require.Empty(t, checkpoint.Pending)
+
+		var cloudEvent map[string]any
+		require.NoError(t, json.Unmarshal(sink.records[0].Value, &cloudEvent))
+		require.Equal(t, "e1", cloudEvent["id"])
+		require.Equal(t, "io.flightctl.event.DeviceDisconnected", cloudEvent["type"])
+		require.Equal(t, orgId.String()+"/Device/dev1", sink.records[0].Key)
+	})
+
+	t.Run("When events were created at the checkpoint time it should only publish the ones not exported yet", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+		data, err := json.Marshal(EventCheckpoint{Version: CurrentEventCheckpointVersion, Timestamp: base.Add(2 * time.Second), Exported: []string{"e2"}})
+		require.NoError(t, err)
+		svc := &fakeEventService{events: events, checkpoints: map[string][]byte{orgId.String(): data}}
+		svc.expect(mockSvc, orgId)
+		sink := &fakeSink{}
+
+		_, err = newExporter(mockSvc, sink).ExportOrganization(context.Background(), orgId, until)
+		require.NoError(t, err)
+		require.Equal(t, []string{"e3", "e4", "e5"}, sink.names())
+	})
+
+	t.Run("When the sink fails within a batch it should resume without duplicating or dropping events", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+		svc := &fakeEventService{events: events, checkpoints: map[string][]byte{}}
+		svc.expect(mockSvc, orgId)
+		// The second batch is only published in part
+		sink := &fakeSink{failAfter: 3}
+
+		_, err := newExporter(mockSvc, sink).ExportOrganization(context.Background(), orgId, until)
+		require.Error(t, err)
+		require.Equal(t, []string{"e1", "e2", "e3"}, sink.names())
+		require.Len(t, svc.checkpoint(t, orgId).Pending, 2)
+
+		sink.failAfter = 0
+		_, err = newExporter(mockSvc, sink).ExportOrganization(context.Background(), orgId, until)
+		require.NoError(t, err)
+		require.Equal(t, allNames, sink.names())
+		require.Empty(t, svc.checkpoint(t, orgId).Pending)
+	})
+
+	t.Run("When the exporter stops before the checkpoint of a published batch is stored it should not publish the batch again", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+		// The first store marks the first batch as pending, the second one would record that it was published
+		svc := &fakeEventService{events: events, checkpoints: map[string][]byte{}, failStore: 2}
+		svc.expect(mockSvc, orgId)
+		sink := &fakeSink{}
+
+		_, err := newExporter(mockSvc, sink).ExportOrganization(context.Background(), orgId, until)
+		require.Error(t, err)
+		require.Equal(t, []string{"e1", "e2"}, sink.names())
+
+		_, err = newExporter(mockSvc, sink).ExportOrganization(context.Background(), orgId, until)
+		require.NoError(t, err)
+		require.Equal(t, allNames, sink.names())
+
+		// New events are exported from the advanced checkpoint
+		svc.events = append(svc.events, event("e6", 2*time.Minute))
+		_, err = newExporter(mockSvc, sink).ExportOrganization(context.Background(), orgId, until.Add(2*time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, append(allNames, "e6"), sink.names())
+	})
+
+	t.Run("When an event becomes visible after a cycle passed its creation time it should export it once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+		svc := &fakeEventService{events: events, checkpoints: map[string][]byte{}}
+		svc.expect(mockSvc, orgId)
+		sink := &fakeSink{}
+
+		_, err := newExporter(mockSvc, sink).ExportOrganization(context.Background(), orgId, until)
+		require.NoError(t, err)
+
+		// The event was created before until, but committed after the first cycle listed the events
+		svc.events = append(svc.events[:4:4], event("late", 3500*time.Millisecond), events[4])
+		exported, err := newExporter(mockSvc, sink).ExportOrganization(context.Background(), orgId, until.Add(10*time.Second))
+		require.NoError(t, err)
+		require.Equal(t, 1, exported)
+		require.Equal(t, append(allNames, "late"), sink.names())
+
+		exported, err = newExporter(mockSvc, sink).ExportOrganization(context.Background(), orgId, until.Add(20*time.Second))
+		require.NoError(t, err)
+		require.Zero(t, exported)
+	})
+
+	t.Run("When the checkpoint cannot be read it should not export", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+		svc := &fakeEventService{events: events, checkpoints: map[string][]byte{orgId.String(): []byte("not json")}}
+		svc.expect(mockSvc, orgId)
+		sink := &fakeSink{}
+
+		_, err := newExporter(mockSvc, sink).ExportOrganization(context.Background(), orgId, until)
+		require.Error(t, err)
+		require.Empty(t, sink.records)
+	})
+}
@@ -0,0 +1,65 @@
+package event_exporter
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Prometheus metrics for the event exporter
+var (
+	// Processing metrics
+	ProcessingCyclesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "flightctl_event_exporter_processing_cycles_total",
+		Help: "Total number of processing cycles completed",
+	})
+
+	ProcessingDurationSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "flightctl_event_exporter_processing_duration_seconds",
+		Help:    "Time spent exporting events in seconds",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	EventsExportedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "flightctl_event_exporter_events_exported_total",
+		Help: "Total number of events published to the sink",
+	})
+
+	EventsSkippedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "flightctl_event_exporter_events_skipped_total",
+		Help: "Total number of events that were not published again because the sink already held them",
+	})
+
+	// Sink interaction metrics
+	SinkRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "flightctl_event_exporter_sink_requests_total",
+		Help: "Total number of batches published to the sink",
+	}, []string{"status"})
+
+	SinkRequestDurationSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "flightctl_event_exporter_sink_request_duration_seconds",
+		Help:    "Time spent publishing batches to the sink in seconds",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	// Checkpoint metrics
+	CheckpointOperationsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "flightctl_event_exporter_checkpoint_operations_total",
+		Help: "Total number of checkpoint operations",
+	}, []string{"operation", "status"})
+
+	// Health metrics
+	UptimeSeconds = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "flightctl_event_exporter_uptime_seconds",
+		Help: "Time since the event exporter started in seconds",
+	})
+
+	LastSuccessfulProcessingTimestamp = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "flightctl_event_exporter_last_successful_processing_timestamp",
+		Help: "Unix timestamp of the last processing cycle that exported the events of all organizations",
+	})
+
+	ErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "flightctl_event_exporter_errors_total",
+		Help: "Total number of errors encountered",
+	}, []string{"component", "type"})
+)
@@ -0,0 +1,191 @@
+package event_exporter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+)
+
+// MetricsPort is the port of the metrics and health server of the event exporter.
+const MetricsPort = 8082
+
+type Server struct {
+	cfg       *config.Config
+	log       *logrus.Logger
+	startTime time.Time
+}
+
+// New returns a new instance of the event exporter server.
+func New(
+	cfg *config.Config,
+	log *logrus.Logger,
+) *Server {
+	return &Server{
+		cfg:       cfg,
+		log:       log,
+		startTime: time.Now(),
+	}
+}
+
+func (s *Server) Run(ctx context.Context, serviceHandler service.Service) error {
+	logger := s.log.WithFields(logrus.Fields{
+		"component": "event_exporter_server",
+	})
+
+	logger.Info("Starting event exporter server")
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sink, err := NewSink(ctx, s.cfg.EventExporter.Sink)
+	if err != nil {
+		return fmt.Errorf("creating event exporter sink: %w", err)
+	}
+	defer func() {
+		if err := sink.Close(); err != nil {
+			logger.WithError(err).Error("Failed to close event exporter sink")
+		}
+	}()
+
+	// Start metrics server
+	s.startMetricsServer(ctx)
+
+	// Start uptime tracking
+	go s.updateUptimeMetric(ctx)
+
+	eventExporter := NewEventExporter(s.log, serviceHandler, sink, s.cfg)
+
+	// Handle shutdown gracefully
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+	go func() {
+		sig := <-sigCh
+		logger.WithFields(logrus.Fields{
+			"signal": sig.String(),
+		}).Info("Received shutdown signal, initiating graceful shutdown")
+		cancel()
+	}()
+
+	backoff := time.Second
+	maxBackoff := time.Minute
+	retryCount := 0
+
+	for {
+		if ctx.Err() != nil {
+			logger.WithFields(logrus.Fields{
+				"context_error": ctx.Err(),
+				"retry_count":   retryCount,
+			}).Info("Context canceled, exiting event exporter")
+			return nil
+		}
+
+		err := eventExporter.Poll(ctx)
+		if errors.Is(err, context.Canceled) {
+			logger.Info("Event exporter received context cancellation")
+			return nil
+		}
+		if err != nil {
+			retryCount++
+			ErrorsTotal.WithLabelValues("server", "polling").Inc()
+			logger.WithFields(logrus.Fields{
+				"error":        err,
+				"retry_count":  retryCount,
+				"next_backoff": backoff.String(),
+			}).Error("Event exporter polling failed, will retry after backoff")
+
+			select {
+			case <-time.After(backoff):
+				backoff *= 2
+				if backoff > maxBackoff {
+					backoff = maxBackoff
+				}
+			case <-ctx.Done():
+				logger.WithField("context_error", ctx.Err()).
+					Info("Context cancelled during backoff, exiting")
+				return nil
+			}
+		} else {
+			retryCount = 0
+			backoff = time.Second
+		}
+	}
+}
+
+func (s *Server) startMetricsServer(ctx context.Context) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/health", s.healthHandler)
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", MetricsPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	logger := s.log.WithFields(logrus.Fields{
+		"component": "metrics_server",
+		"port":      MetricsPort,
+	})
+
+	go func() {
+		logger.Info("Starting Prometheus metrics and health server")
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.WithField("error", err).Error("Metrics server failed")
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		logger.Info("Shutting down metrics server")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.WithField("error", err).Error("Error shutting down metrics server")
+		}
+	}()
+}
+
+// healthHandler provides a simple health check endpoint
+func (s *Server) healthHandler(w http.ResponseWriter, r *http.Request) {
+	health := map[string]interface{}{
+		"status":         "ok",
+		"uptime_seconds": time.Since(s.startTime).Seconds(),
+		"component":      "flightctl-event-exporter",
+		"timestamp":      time.Now().Unix(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(health); err != nil {
+		s.log.WithField("error", err).Error("Failed to encode health response")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+}
+
+// updateUptimeMetric periodically updates the uptime metric
+func (s *Server) updateUptimeMetric(ctx context.Context) {
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			UptimeSeconds.Set(time.Since(s.startTime).Seconds())
+		}
+	}
+}
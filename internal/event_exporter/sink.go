@@ -0,0 +1,102 @@
+package event_exporter
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/notification"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// Record is an event in the format it is published in: a CloudEvent in structured mode.
+type Record struct {
+	// ID identifies the event across organizations
+	ID string
+	// Key is the partitioning key of the event, which keeps the events about an object in order
+	Key string
+	// Value is the CloudEvent
+	Value []byte
+}
+
+// Sink is where the event exporter publishes events to.
+type Sink interface {
+	// Publish publishes the records in order and returns once the sink durably holds all of them.
+	Publish(ctx context.Context, records []Record) error
+	// Published returns which of the given record IDs the sink already holds.  It is only asked about the records of
+	// the last batch, which are among the most recently published records.
+	Published(ctx context.Context, ids []string) (map[string]struct{}, error)
+	Close() error
+}
+
+// NewSink returns the sink that the configuration selects.
+func NewSink(ctx context.Context, cfg *config.EventExporterSinkConfig) (Sink, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("no sink configured")
+	}
+	switch cfg.Type {
+	case config.EventExporterSinkTypeKafka:
+		return NewKafkaSink(cfg.Kafka)
+	case config.EventExporterSinkTypeNATS:
+		return NewNATSSink(ctx, cfg.NATS)
+	case config.EventExporterSinkTypeFile:
+		return NewFileSink(cfg.File.Path)
+	default:
+		return nil, fmt.Errorf("unsupported sink type %q", cfg.Type)
+	}
+}
+
+// RecordID returns the ID of the record of an event.  Event names are only unique within an organization.
+func RecordID(orgID uuid.UUID, name string) string {
+	return fmt.Sprintf("%s/%s", orgID, name)
+}
+
+// NewRecord returns the record of an event of an organization.
+func NewRecord(orgID uuid.UUID, event domain.Event) (Record, error) {
+	value, err := json.Marshal(notification.NewCloudEvent(orgID, event))
+	if err != nil {
+		return Record{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+	return Record{
+		ID:    RecordID(orgID, lo.FromPtr(event.Metadata.Name)),
+		Key:   fmt.Sprintf("%s/%s/%s", orgID, event.InvolvedObject.Kind, event.InvolvedObject.Name),
+		Value: value,
+	}, nil
+}
+
+// recordIDOf returns the ID of a published record, or an empty string if it is not a CloudEvent of the exporter.
+func recordIDOf(value []byte) string {
+	var cloudEvent struct {
+		ID    string `json:"id"`
+		OrgId string `json:"flightctlorgid"`
+	}
+	if err := json.Unmarshal(value, &cloudEvent); err != nil || cloudEvent.ID == "" || cloudEvent.OrgId == "" {
+		return ""
+	}
+	return cloudEvent.OrgId + "/" + cloudEvent.ID
+}
+
+func tlsConfig(cfg *config.EventExporterTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipTlsVerify, //nolint:gosec
+	}
+	if cfg.CACert != "" {
+		pem, err := os.ReadFile(cfg.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", cfg.CACert)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
@@ -0,0 +1,93 @@
+package event_exporter
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/flightctl/flightctl/internal/config"
+)
+
+// maxFileRecordSize is the size of the largest record the file sink reads back
+const maxFileRecordSize = 16 * 1024 * 1024
+
+// FileSink appends records to a file, one CloudEvent per line.  It is meant for testing and for collecting events
+// with log shippers.
+type FileSink struct {
+	path string
+	out  io.Writer
+	file *os.File
+}
+
+// NewFileSink returns a sink that appends to the file at path, or writes to the standard output if path is "-".
+func NewFileSink(path string) (*FileSink, error) {
+	if path == config.EventExporterStdoutPath {
+		return &FileSink{path: path, out: os.Stdout}, nil
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	return &FileSink{path: path, out: file, file: file}, nil
+}
+
+func (s *FileSink) Publish(_ context.Context, records []Record) error {
+	var buf bytes.Buffer
+	for _, record := range records {
+		buf.Write(record.Value)
+		buf.WriteByte('\n')
+	}
+	if _, err := s.out.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to write to %s: %w", s.path, err)
+	}
+	if s.file != nil {
+		if err := s.file.Sync(); err != nil {
+			return fmt.Errorf("failed to sync %s: %w", s.path, err)
+		}
+	}
+	return nil
+}
+
+// Published reads the file back to find the given records.  Records written to the standard output cannot be read
+// back, so a batch that was interrupted by a restart may be written to it again.
+func (s *FileSink) Published(_ context.Context, ids []string) (map[string]struct{}, error) {
+	published := map[string]struct{}{}
+	if s.file == nil {
+		return published, nil
+	}
+
+	wanted := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		wanted[id] = struct{}{}
+	}
+
+	file, err := os.Open(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", s.path, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), maxFileRecordSize)
+	for scanner.Scan() {
+		if id := recordIDOf(scanner.Bytes()); id != "" {
+			if _, ok := wanted[id]; ok {
+				published[id] = struct{}{}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", s.path, err)
+	}
+	return published, nil
+}
+
+func (s *FileSink) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	return s.file.Close()
+}
@@ -0,0 +1,58 @@
+package event_exporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSink(t *testing.T) {
+	orgId := uuid.New()
+	record := func(name string) Record {
+		r, err := NewRecord(orgId, domain.Event{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr(name), CreationTimestamp: lo.ToPtr(time.Now())},
+			Reason:   domain.EventReasonDeviceConnected,
+		})
+		require.NoError(t, err)
+		return r
+	}
+
+	t.Run("When records were appended it should find them after a restart", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "events.jsonl")
+		sink, err := NewFileSink(path)
+		require.NoError(t, err)
+		require.NoError(t, sink.Publish(context.Background(), []Record{record("e1"), record("e2")}))
+		require.NoError(t, sink.Close())
+
+		sink, err = NewFileSink(path)
+		require.NoError(t, err)
+		defer sink.Close()
+		require.NoError(t, sink.Publish(context.Background(), []Record{record("e3")}))
+
+		published, err := sink.Published(context.Background(), []string{RecordID(orgId, "e2"), RecordID(orgId, "e4")})
+		require.NoError(t, err)
+		require.Equal(t, map[string]struct{}{RecordID(orgId, "e2"): {}}, published)
+
+		contents, err := os.ReadFile(path)
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+		require.Len(t, lines, 3)
+		require.Equal(t, RecordID(orgId, "e3"), recordIDOf([]byte(lines[2])))
+	})
+
+	t.Run("When writing to the standard output it should not find any records", func(t *testing.T) {
+		sink, err := NewFileSink("-")
+		require.NoError(t, err)
+		published, err := sink.Published(context.Background(), []string{RecordID(orgId, "e1")})
+		require.NoError(t, err)
+		require.Empty(t, published)
+	})
+}
@@ -0,0 +1,166 @@
+package event_exporter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/notification"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+)
+
+const defaultKafkaClientID = "flightctl-event-exporter"
+
+// KafkaSink produces records to a Kafka topic.  Records are keyed by the object an event is about, so that the
+// events about an object stay in order within their partition.
+type KafkaSink struct {
+	topic  string
+	opts   []kgo.Opt
+	client *kgo.Client
+}
+
+func NewKafkaSink(cfg *config.EventExporterKafkaSinkConfig) (*KafkaSink, error) {
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(cfg.Brokers...),
+		kgo.ClientID(defaultKafkaClientID),
+	}
+	if cfg.ClientID != "" {
+		opts = append(opts, kgo.ClientID(cfg.ClientID))
+	}
+	if cfg.TLS != nil {
+		tlsConfig, err := tlsConfig(cfg.TLS)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, kgo.DialTLSConfig(tlsConfig))
+	}
+	if cfg.SASL != nil {
+		mechanism, err := kafkaSASLMechanism(cfg.SASL)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, kgo.SASL(mechanism))
+	}
+
+	// The producer is idempotent and waits for all in-sync replicas by default
+	client, err := kgo.NewClient(append(opts, kgo.DefaultProduceTopic(cfg.Topic))...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kafka client: %w", err)
+	}
+	return &KafkaSink{
+		topic:  cfg.Topic,
+		opts:   opts,
+		client: client,
+	}, nil
+}
+
+func kafkaSASLMechanism(cfg *config.EventExporterSASLConfig) (sasl.Mechanism, error) {
+	switch cfg.Mechanism {
+	case "PLAIN":
+		return plain.Auth{User: cfg.Username, Pass: string(cfg.Password)}.AsMechanism(), nil
+	case "SCRAM-SHA-256":
+		return scram.Auth{User: cfg.Username, Pass: string(cfg.Password)}.AsSha256Mechanism(), nil
+	case "SCRAM-SHA-512":
+		return scram.Auth{User: cfg.Username, Pass: string(cfg.Password)}.AsSha512Mechanism(), nil
+	default:
+		return nil, fmt.Errorf("unsupported SASL mechanism %q", cfg.Mechanism)
+	}
+}
+
+func (s *KafkaSink) Publish(ctx context.Context, records []Record) error {
+	kafkaRecords := make([]*kgo.Record, 0, len(records))
+	for _, record := range records {
+		kafkaRecords = append(kafkaRecords, &kgo.Record{
+			Key:   []byte(record.Key),
+			Value: record.Value,
+			Headers: []kgo.RecordHeader{
+				{Key: "content-type", Value: []byte(notification.CloudEventsContentType)},
+			},
+		})
+	}
+	if err := s.client.ProduceSync(ctx, kafkaRecords...).FirstErr(); err != nil {
+		return fmt.Errorf("failed to produce to topic %s: %w", s.topic, err)
+	}
+	return nil
+}
+
+// Published reads back the last len(ids) records of every partition of the topic.  As the exporter is the only
+// producer of the topic, the records of its last batch are among them.
+func (s *KafkaSink) Published(ctx context.Context, ids []string) (map[string]struct{}, error) {
+	published := map[string]struct{}{}
+	wanted := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		wanted[id] = struct{}{}
+	}
+
+	admin := kadm.NewClient(s.client)
+	startOffsets, err := admin.ListStartOffsets(ctx, s.topic)
+	if err == nil {
+		err = startOffsets.Error()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list start offsets of topic %s: %w", s.topic, err)
+	}
+	endOffsets, err := admin.ListEndOffsets(ctx, s.topic)
+	if err == nil {
+		err = endOffsets.Error()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list end offsets of topic %s: %w", s.topic, err)
+	}
+
+	// The offset one past the last record of every partition that has records to read back
+	ends := map[int32]int64{}
+	offsets := map[int32]kgo.Offset{}
+	endOffsets.Each(func(end kadm.ListedOffset) {
+		from := end.Offset - int64(len(ids))
+		if start, ok := startOffsets.Lookup(s.topic, end.Partition); ok && start.Offset > from {
+			from = start.Offset
+		}
+		if from < end.Offset {
+			ends[end.Partition] = end.Offset
+			offsets[end.Partition] = kgo.NewOffset().At(from)
+		}
+	})
+	if len(offsets) == 0 {
+		return published, nil
+	}
+
+	consumer, err := kgo.NewClient(append(s.opts, kgo.ConsumePartitions(map[string]map[int32]kgo.Offset{s.topic: offsets}))...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kafka consumer: %w", err)
+	}
+	defer consumer.Close()
+
+	for len(ends) > 0 {
+		fetches := consumer.PollFetches(ctx)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		var fetchErr error
+		fetches.EachError(func(_ string, _ int32, err error) {
+			fetchErr = err
+		})
+		if fetchErr != nil {
+			return nil, fmt.Errorf("failed to read back topic %s: %w", s.topic, fetchErr)
+		}
+		fetches.EachRecord(func(record *kgo.Record) {
+			if _, ok := wanted[recordIDOf(record.Value)]; ok {
+				published[recordIDOf(record.Value)] = struct{}{}
+			}
+			if end, ok := ends[record.Partition]; ok && record.Offset+1 >= end {
+				delete(ends, record.Partition)
+			}
+		})
+	}
+	return published, nil
+}
+
+func (s *KafkaSink) Close() error {
+	s.client.Close()
+	return nil
+}
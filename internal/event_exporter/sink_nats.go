@@ -0,0 +1,119 @@
+package event_exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/notification"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// NATSSink publishes records to a subject of a NATS JetStream stream.  Records carry their ID as message ID, so that
+// JetStream also discards records that are published twice within the duplicate window of the stream.
+type NATSSink struct {
+	subject string
+	conn    *nats.Conn
+	js      jetstream.JetStream
+}
+
+func NewNATSSink(_ context.Context, cfg *config.EventExporterNATSSinkConfig) (*NATSSink, error) {
+	opts := []nats.Option{nats.Name("flightctl-event-exporter")}
+	if cfg.Username != "" {
+		opts = append(opts, nats.UserInfo(cfg.Username, string(cfg.Password)))
+	}
+	if cfg.TLS != nil {
+		tlsConfig, err := tlsConfig(cfg.TLS)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, nats.Secure(tlsConfig))
+	}
+
+	conn, err := nats.Connect(cfg.URL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+	return &NATSSink{
+		subject: cfg.Subject,
+		conn:    conn,
+		js:      js,
+	}, nil
+}
+
+func (s *NATSSink) Publish(ctx context.Context, records []Record) error {
+	// Records are published one at a time to keep them in order
+	for _, record := range records {
+		msg := &nats.Msg{
+			Subject: s.subject,
+			Data:    record.Value,
+			Header:  nats.Header{},
+		}
+		msg.Header.Set("Content-Type", notification.CloudEventsContentType)
+		if _, err := s.js.PublishMsg(ctx, msg, jetstream.WithMsgID(record.ID)); err != nil {
+			return fmt.Errorf("failed to publish to subject %s: %w", s.subject, err)
+		}
+	}
+	return nil
+}
+
+// Published reads back the last len(ids) messages of the stream that captures the subject.  As the exporter is the
+// only publisher to the stream, the records of its last batch are among them.
+func (s *NATSSink) Published(ctx context.Context, ids []string) (map[string]struct{}, error) {
+	published := map[string]struct{}{}
+	wanted := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		wanted[id] = struct{}{}
+	}
+
+	name, err := s.js.StreamNameBySubject(ctx, s.subject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the stream of subject %s: %w", s.subject, err)
+	}
+	stream, err := s.js.Stream(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream %s: %w", name, err)
+	}
+	info, err := stream.Info(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get info of stream %s: %w", name, err)
+	}
+
+	last := info.State.LastSeq
+	if last == 0 {
+		return published, nil
+	}
+	first := info.State.FirstSeq
+	if last >= uint64(len(ids)) && last-uint64(len(ids))+1 > first {
+		first = last - uint64(len(ids)) + 1
+	}
+	for seq := first; seq <= last; seq++ {
+		msg, err := stream.GetMsg(ctx, seq)
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get message %d of stream %s: %w", seq, name, err)
+		}
+		if msg.Subject != s.subject {
+			continue
+		}
+		if id := recordIDOf(msg.Data); id != "" {
+			if _, ok := wanted[id]; ok {
+				published[id] = struct{}{}
+			}
+		}
+	}
+	return published, nil
+}
+
+func (s *NATSSink) Close() error {
+	return s.conn.Drain()
+}
@@ -60,6 +60,10 @@ var (
 	ErrDuplicateOIDCProvider   = errors.New("an OIDC auth provider with the same issuer and clientId already exists")
 	ErrDuplicateOAuth2Provider = errors.New("an OAuth2 auth provider with the same userinfoUrl and clientId already exists")
 
+	// secret
+	ErrSecretEncryptionNotConfigured = errors.New("secret encryption key is not configured")
+	ErrSecretDecryption              = errors.New("failed to decrypt secret")
+
 	// database encoding
 	ErrUnsupportedUnicode = errors.New("unsupported Unicode escape sequence")
 )
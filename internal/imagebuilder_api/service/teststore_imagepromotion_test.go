@@ -311,6 +311,18 @@ func (s *DummyMainStore) VulnerabilityFinding() flightctlstore.VulnerabilityFind
 func (s *DummyMainStore) DependencyRef() flightctlstore.DependencyRef {
 	panic("DummyMainStore.DependencyRef() not implemented")
 }
+func (s *DummyMainStore) Secret() flightctlstore.Secret {
+	panic("DummyMainStore.Secret() not implemented")
+}
+func (s *DummyMainStore) EnrollmentPolicy() flightctlstore.EnrollmentPolicy {
+	panic("DummyMainStore.EnrollmentPolicy() not implemented")
+}
+func (s *DummyMainStore) DeviceRegistration() flightctlstore.DeviceRegistration {
+	panic("DummyMainStore.DeviceRegistration() not implemented")
+}
+func (s *DummyMainStore) NotificationSink() flightctlstore.NotificationSink {
+	panic("DummyMainStore.NotificationSink() not implemented")
+}
 func (s *DummyMainStore) SyncState() flightctlstore.SyncState {
 	panic("DummyMainStore.SyncState() not implemented")
 }
@@ -46,6 +46,10 @@ func (m *mockStore) Catalog() store.Catalog
 func (m *mockStore) VulnerabilityFinding() store.VulnerabilityFinding           { return nil }
 func (m *mockStore) SyncState() store.SyncState                                 { return nil }
 func (m *mockStore) DependencyRef() store.DependencyRef                         { return nil }
+func (m *mockStore) Secret() store.Secret                                       { return nil }
+func (m *mockStore) EnrollmentPolicy() store.EnrollmentPolicy                   { return nil }
+func (m *mockStore) DeviceRegistration() store.DeviceRegistration               { return nil }
+func (m *mockStore) NotificationSink() store.NotificationSink                   { return nil }
 func (m *mockStore) RunMigrations(context.Context) error                        { return nil }
 func (m *mockStore) CheckHealth(context.Context) error                          { return nil }
 func (m *mockStore) Close() error                                               { return nil }
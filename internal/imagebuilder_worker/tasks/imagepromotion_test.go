@@ -265,6 +265,10 @@ func (s *dummyCoreStore) Organization() mainstore.Organization                 {
 func (s *dummyCoreStore) AuthProvider() mainstore.AuthProvider                 { panic("not used") }
 func (s *dummyCoreStore) VulnerabilityFinding() mainstore.VulnerabilityFinding { panic("not used") }
 func (s *dummyCoreStore) DependencyRef() mainstore.DependencyRef               { panic("not used") }
+func (s *dummyCoreStore) Secret() mainstore.Secret                             { panic("not used") }
+func (s *dummyCoreStore) EnrollmentPolicy() mainstore.EnrollmentPolicy         { panic("not used") }
+func (s *dummyCoreStore) DeviceRegistration() mainstore.DeviceRegistration     { panic("not used") }
+func (s *dummyCoreStore) NotificationSink() mainstore.NotificationSink         { panic("not used") }
 func (s *dummyCoreStore) SyncState() mainstore.SyncState                       { panic("not used") }
 func (s *dummyCoreStore) RunMigrations(ctx context.Context) error              { return nil }
 func (s *dummyCoreStore) CheckHealth(ctx context.Context) error                { return nil }
@@ -80,6 +80,18 @@ func (m *MockStore) SyncState() store.SyncState {
 func (m *MockStore) DependencyRef() store.DependencyRef {
 	return nil
 }
+func (m *MockStore) Secret() store.Secret {
+	return nil
+}
+func (m *MockStore) EnrollmentPolicy() store.EnrollmentPolicy {
+	return nil
+}
+func (m *MockStore) DeviceRegistration() store.DeviceRegistration {
+	return nil
+}
+func (m *MockStore) NotificationSink() store.NotificationSink {
+	return nil
+}
 
 func (m *MockStore) RunMigrations(context.Context) error {
 	return nil
@@ -181,6 +181,18 @@ func (m *MockFleetStoreWrapper) SyncState() store.SyncState {
 func (m *MockFleetStoreWrapper) DependencyRef() store.DependencyRef {
 	return nil
 }
+func (m *MockFleetStoreWrapper) Secret() store.Secret {
+	return nil
+}
+func (m *MockFleetStoreWrapper) EnrollmentPolicy() store.EnrollmentPolicy {
+	return nil
+}
+func (m *MockFleetStoreWrapper) DeviceRegistration() store.DeviceRegistration {
+	return nil
+}
+func (m *MockFleetStoreWrapper) NotificationSink() store.NotificationSink {
+	return nil
+}
 
 func (m *MockFleetStoreWrapper) RunMigrations(context.Context) error {
 	return nil
@@ -40,6 +40,10 @@ func (m *MockRepositoryStore) Catalog() store.Catalog
 func (m *MockRepositoryStore) VulnerabilityFinding() store.VulnerabilityFinding           { return nil }
 func (m *MockRepositoryStore) SyncState() store.SyncState                                 { return nil }
 func (m *MockRepositoryStore) DependencyRef() store.DependencyRef                         { return nil }
+func (m *MockRepositoryStore) Secret() store.Secret                                       { return nil }
+func (m *MockRepositoryStore) EnrollmentPolicy() store.EnrollmentPolicy                   { return nil }
+func (m *MockRepositoryStore) DeviceRegistration() store.DeviceRegistration               { return nil }
+func (m *MockRepositoryStore) NotificationSink() store.NotificationSink                   { return nil }
 func (m *MockRepositoryStore) RunMigrations(context.Context) error                        { return nil }
 func (m *MockRepositoryStore) Close() error                                               { return nil }
 func (m *MockRepositoryStore) CheckHealth(context.Context) error                          { return nil }
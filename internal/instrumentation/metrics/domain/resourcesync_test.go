@@ -39,6 +39,10 @@ func (m *MockResourceSyncStore) Catalog() store.Catalog
 func (m *MockResourceSyncStore) VulnerabilityFinding() store.VulnerabilityFinding { return nil }
 func (m *MockResourceSyncStore) SyncState() store.SyncState                       { return nil }
 func (m *MockResourceSyncStore) DependencyRef() store.DependencyRef               { return nil }
+func (m *MockResourceSyncStore) Secret() store.Secret                             { return nil }
+func (m *MockResourceSyncStore) EnrollmentPolicy() store.EnrollmentPolicy         { return nil }
+func (m *MockResourceSyncStore) DeviceRegistration() store.DeviceRegistration     { return nil }
+func (m *MockResourceSyncStore) NotificationSink() store.NotificationSink         { return nil }
 func (m *MockResourceSyncStore) RunMigrations(context.Context) error              { return nil }
 func (m *MockResourceSyncStore) Close() error                                     { return nil }
 func (m *MockResourceSyncStore) CheckHealth(context.Context) error                { return nil }
@@ -0,0 +1,45 @@
+package notification
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/pkg/k8s/selector"
+	"github.com/samber/lo"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
+)
+
+// NeedsLabels returns whether matching events against the filter requires the labels of their involved objects.
+func NeedsLabels(filter *domain.NotificationSinkFilter) bool {
+	return filter != nil && filter.LabelSelector != nil
+}
+
+// Matches returns whether an event satisfies every criterion of the filter.  The labels are those of the event's
+// involved object; they are only consulted if the filter has a label selector.  A nil filter matches all events.
+func Matches(filter *domain.NotificationSinkFilter, event domain.Event, labels map[string]string) (bool, error) {
+	if filter == nil {
+		return true, nil
+	}
+	if filter.Reasons != nil && !lo.Contains(*filter.Reasons, event.Reason) {
+		return false, nil
+	}
+	if filter.Kinds != nil && !lo.ContainsBy(*filter.Kinds, func(kind string) bool {
+		return strings.EqualFold(kind, event.InvolvedObject.Kind)
+	}) {
+		return false, nil
+	}
+	if filter.LabelSelector == nil {
+		return true, nil
+	}
+	parts := lo.MapToSlice(lo.FromPtr(filter.LabelSelector.MatchLabels), func(k, v string) string { return k + "=" + v })
+	parts = append(parts, lo.Map(lo.FromPtr(filter.LabelSelector.MatchExpressions), func(e domain.MatchExpression, _ int) string { return e.String() })...)
+	if len(parts) == 0 {
+		return true, nil
+	}
+	s, err := selector.Parse(strings.Join(parts, ","))
+	if err != nil {
+		return false, fmt.Errorf("invalid label selector: %w", err)
+	}
+	return s.Matches(k8sLabels.Set(labels)), nil
+}
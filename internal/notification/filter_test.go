@@ -0,0 +1,95 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatches(t *testing.T) {
+	event := domain.Event{
+		Reason:         domain.EventReasonDeviceDisconnected,
+		InvolvedObject: domain.ObjectReference{Kind: domain.DeviceKind, Name: "dev1"},
+	}
+	labels := map[string]string{"site": "a", "env": "prod"}
+
+	tests := []struct {
+		name   string
+		filter *domain.NotificationSinkFilter
+		want   bool
+	}{
+		{
+			name: "no filter matches all events",
+			want: true,
+		},
+		{
+			name:   "empty filter matches all events",
+			filter: &domain.NotificationSinkFilter{},
+			want:   true,
+		},
+		{
+			name:   "matching reason",
+			filter: &domain.NotificationSinkFilter{Reasons: &[]domain.EventReason{domain.EventReasonDeviceConnected, domain.EventReasonDeviceDisconnected}},
+			want:   true,
+		},
+		{
+			name:   "other reason",
+			filter: &domain.NotificationSinkFilter{Reasons: &[]domain.EventReason{domain.EventReasonDeviceConnected}},
+			want:   false,
+		},
+		{
+			name:   "matching kind ignores case",
+			filter: &domain.NotificationSinkFilter{Kinds: &[]string{"device"}},
+			want:   true,
+		},
+		{
+			name:   "other kind",
+			filter: &domain.NotificationSinkFilter{Kinds: &[]string{domain.FleetKind}},
+			want:   false,
+		},
+		{
+			name:   "matching labels",
+			filter: &domain.NotificationSinkFilter{LabelSelector: &domain.LabelSelector{MatchLabels: &map[string]string{"site": "a"}}},
+			want:   true,
+		},
+		{
+			name: "matching label expression",
+			filter: &domain.NotificationSinkFilter{LabelSelector: &domain.LabelSelector{MatchExpressions: &[]domain.MatchExpression{
+				{Key: "env", Operator: domain.In, Values: &[]string{"prod", "stage"}},
+			}}},
+			want: true,
+		},
+		{
+			name:   "other labels",
+			filter: &domain.NotificationSinkFilter{LabelSelector: &domain.LabelSelector{MatchLabels: &map[string]string{"site": "b"}}},
+			want:   false,
+		},
+		{
+			name: "all criteria must match",
+			filter: &domain.NotificationSinkFilter{
+				Reasons:       &[]domain.EventReason{domain.EventReasonDeviceDisconnected},
+				Kinds:         &[]string{domain.DeviceKind},
+				LabelSelector: &domain.LabelSelector{MatchLabels: &map[string]string{"site": "b"}},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Matches(tt.filter, event, labels)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMatchesWithoutLabels(t *testing.T) {
+	event := domain.Event{InvolvedObject: domain.ObjectReference{Kind: domain.DeviceKind, Name: "dev1"}}
+	filter := &domain.NotificationSinkFilter{LabelSelector: &domain.LabelSelector{MatchLabels: &map[string]string{"site": "a"}}}
+
+	require.True(t, NeedsLabels(filter))
+	got, err := Matches(filter, event, nil)
+	require.NoError(t, err)
+	require.False(t, got)
+}
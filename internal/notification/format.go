@@ -0,0 +1,92 @@
+package notification
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	CloudEventsSpecVersion = "1.0"
+	// CloudEventsContentType is the content type of CloudEvents in structured mode
+	CloudEventsContentType = "application/cloudevents+json"
+	// CloudEventTypePrefix prefixes the event reason to form the type of a CloudEvent
+	CloudEventTypePrefix = "io.flightctl.event."
+)
+
+// WebhookPayload is the body posted to Webhook sinks.
+type WebhookPayload struct {
+	OrgId uuid.UUID    `json:"orgId"`
+	Event domain.Event `json:"event"`
+}
+
+// SlackPayload is the body posted to Slack sinks, in the format of Slack incoming webhooks.
+type SlackPayload struct {
+	Text string `json:"text"`
+}
+
+// CloudEvent is a Flight Control event as a CloudEvent in structured mode.
+type CloudEvent struct {
+	SpecVersion     string       `json:"specversion"`
+	ID              string       `json:"id"`
+	Source          string       `json:"source"`
+	Type            string       `json:"type"`
+	Subject         string       `json:"subject,omitempty"`
+	Time            *time.Time   `json:"time,omitempty"`
+	DataContentType string       `json:"datacontenttype"`
+	OrgId           string       `json:"flightctlorgid"`
+	Data            domain.Event `json:"data"`
+}
+
+// NewCloudEvent returns the CloudEvent for an event of an organization.  The ID of the CloudEvent is the name of the
+// event, so that consumers can deduplicate events that are delivered more than once.
+func NewCloudEvent(orgId uuid.UUID, event domain.Event) CloudEvent {
+	return CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              lo.FromPtr(event.Metadata.Name),
+		Source:          fmt.Sprintf("/organizations/%s", orgId),
+		Type:            CloudEventTypePrefix + string(event.Reason),
+		Subject:         fmt.Sprintf("%s/%s", event.InvolvedObject.Kind, event.InvolvedObject.Name),
+		Time:            event.Metadata.CreationTimestamp,
+		DataContentType: "application/json",
+		OrgId:           orgId.String(),
+		Data:            event,
+	}
+}
+
+// NewSlackPayload returns the Slack message for an event.
+func NewSlackPayload(event domain.Event) SlackPayload {
+	icon := ":information_source:"
+	if event.Type == domain.Warning {
+		icon = ":warning:"
+	}
+	return SlackPayload{
+		Text: fmt.Sprintf("%s *%s* %s `%s`\n%s", icon, event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name, event.Message),
+	}
+}
+
+// EmailSubject returns the subject of the mail about an event.
+func EmailSubject(event domain.Event) string {
+	return fmt.Sprintf("[Flight Control] %s %s: %s %s", event.Type, event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name)
+}
+
+// EmailBody returns the plain text body of the mail about an event.
+func EmailBody(orgId uuid.UUID, event domain.Event) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\n", event.Message)
+	fmt.Fprintf(&b, "Organization: %s\n", orgId)
+	fmt.Fprintf(&b, "Resource: %s %s\n", event.InvolvedObject.Kind, event.InvolvedObject.Name)
+	fmt.Fprintf(&b, "Reason: %s\n", event.Reason)
+	fmt.Fprintf(&b, "Type: %s\n", event.Type)
+	if event.Metadata.CreationTimestamp != nil {
+		fmt.Fprintf(&b, "Time: %s\n", event.Metadata.CreationTimestamp.UTC().Format(time.RFC3339))
+	}
+	if event.Actor != "" {
+		fmt.Fprintf(&b, "Actor: %s\n", event.Actor)
+	}
+	return b.String()
+}
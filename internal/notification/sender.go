@@ -0,0 +1,199 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	// DeliveryTimeout bounds the delivery of a single event to a sink
+	DeliveryTimeout = 10 * time.Second
+	// DefaultSMTPPort is the port of the SMTP server if an Email sink does not set one
+	DefaultSMTPPort = 587
+	// smtpsPort is the port on which SMTP servers expect TLS from the start
+	smtpsPort = 465
+)
+
+// SecretResolver returns the value of a key of a Secret in the organization of a sink.
+type SecretResolver func(ctx context.Context, orgId uuid.UUID, ref domain.SecretKeyReference) (string, error)
+
+// Sender delivers events to the targets of notification sinks.
+type Sender interface {
+	Send(ctx context.Context, orgId uuid.UUID, sink *domain.NotificationSink, event domain.Event) error
+}
+
+type sender struct {
+	httpClient    *http.Client
+	resolveSecret SecretResolver
+}
+
+func NewSender(resolveSecret SecretResolver) Sender {
+	return &sender{
+		httpClient:    &http.Client{Timeout: DeliveryTimeout},
+		resolveSecret: resolveSecret,
+	}
+}
+
+func (s *sender) Send(ctx context.Context, orgId uuid.UUID, sink *domain.NotificationSink, event domain.Event) error {
+	switch sink.Spec.Type {
+	case domain.NotificationSinkTypeWebhook:
+		return s.post(ctx, orgId, sink.Spec.Http, "application/json", WebhookPayload{OrgId: orgId, Event: event})
+	case domain.NotificationSinkTypeSlack:
+		return s.post(ctx, orgId, sink.Spec.Http, "application/json", NewSlackPayload(event))
+	case domain.NotificationSinkTypeCloudEvents:
+		return s.post(ctx, orgId, sink.Spec.Http, CloudEventsContentType, NewCloudEvent(orgId, event))
+	case domain.NotificationSinkTypeEmail:
+		return s.mail(ctx, orgId, sink.Spec.Email, event)
+	default:
+		return fmt.Errorf("unsupported notification sink type %q", sink.Spec.Type)
+	}
+}
+
+func (s *sender) post(ctx context.Context, orgId uuid.UUID, target *domain.NotificationSinkHttpTarget, contentType string, payload any) error {
+	if target == nil {
+		return fmt.Errorf("http target is not set")
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.Url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	for k, v := range lo.FromPtr(target.Headers) {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", contentType)
+	if target.Authorization != nil {
+		authorization, err := s.resolveSecret(ctx, orgId, *target.Authorization)
+		if err != nil {
+			return fmt.Errorf("failed to resolve authorization: %w", err)
+		}
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post event: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("endpoint responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *sender) mail(ctx context.Context, orgId uuid.UUID, target *domain.NotificationSinkEmailTarget, event domain.Event) error {
+	if target == nil {
+		return fmt.Errorf("email target is not set")
+	}
+	var auth smtp.Auth
+	if target.Username != nil {
+		var password string
+		if target.Password != nil {
+			var err error
+			if password, err = s.resolveSecret(ctx, orgId, *target.Password); err != nil {
+				return fmt.Errorf("failed to resolve password: %w", err)
+			}
+		}
+		auth = smtp.PlainAuth("", *target.Username, password, target.Host)
+	}
+
+	client, err := dialSMTP(ctx, target.Host, lo.FromPtrOr(target.Port, DefaultSMTPPort))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if auth != nil {
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("failed to authenticate to SMTP server: %w", err)
+		}
+	}
+	if err := client.Mail(target.From); err != nil {
+		return fmt.Errorf("failed to set sender: %w", err)
+	}
+	for _, to := range target.To {
+		if err := client.Rcpt(to); err != nil {
+			return fmt.Errorf("failed to add recipient %s: %w", to, err)
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start mail data: %w", err)
+	}
+	if _, err := w.Write(buildMail(target.From, target.To, orgId, event, time.Now())); err != nil {
+		return fmt.Errorf("failed to write mail: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+	return client.Quit()
+}
+
+// dialSMTP connects to an SMTP server, using TLS from the start on the SMTPS port and upgrading the connection with
+// STARTTLS otherwise if the server supports it.
+func dialSMTP(ctx context.Context, host string, port int) (*smtp.Client, error) {
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	tlsConfig := &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}
+
+	dialer := &net.Dialer{Timeout: DeliveryTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to SMTP server %s: %w", addr, err)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(DeliveryTimeout)
+	}
+	_ = conn.SetDeadline(deadline)
+
+	if port == smtpsPort {
+		conn = tls.Client(conn, tlsConfig)
+	}
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to start SMTP session with %s: %w", addr, err)
+	}
+	if port != smtpsPort {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(tlsConfig); err != nil {
+				client.Close()
+				return nil, fmt.Errorf("failed to start TLS with %s: %w", addr, err)
+			}
+		}
+	}
+	return client, nil
+}
+
+// buildMail returns the mail about an event, with headers and a plain text body.
+func buildMail(from string, to []string, orgId uuid.UUID, event domain.Event, now time.Time) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", EmailSubject(event)))
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(EmailBody(orgId, event), "\n", "\r\n"))
+	return b.Bytes()
+}
@@ -0,0 +1,146 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func newTestEndpoint(t *testing.T, status int) (*httptest.Server, chan receivedRequest) {
+	received := make(chan receivedRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received <- receivedRequest{header: r.Header.Clone(), body: body}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, received
+}
+
+func testEvent() domain.Event {
+	return domain.Event{
+		Metadata:       domain.ObjectMeta{Name: lo.ToPtr("0b6b1f4e-7a43-4a4e-9d8b-3d4c9e3f7a10"), CreationTimestamp: lo.ToPtr(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))},
+		Reason:         domain.EventReasonDeviceDisconnected,
+		Type:           domain.Warning,
+		Message:        "Device is disconnected.",
+		InvolvedObject: domain.ObjectReference{Kind: domain.DeviceKind, Name: "dev1"},
+	}
+}
+
+func httpSink(sinkType domain.NotificationSinkType, url string) *domain.NotificationSink {
+	return &domain.NotificationSink{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr("sink")},
+		Spec: domain.NotificationSinkSpec{
+			Type: sinkType,
+			Http: &domain.NotificationSinkHttpTarget{
+				Url:           url,
+				Headers:       &map[string]string{"X-Team": "edge"},
+				Authorization: &domain.SecretKeyReference{Name: "tokens", Key: "webhook"},
+			},
+		},
+	}
+}
+
+func resolveTestSecret(_ context.Context, _ uuid.UUID, ref domain.SecretKeyReference) (string, error) {
+	if ref.Name == "tokens" && ref.Key == "webhook" {
+		return "Bearer token", nil
+	}
+	return "", fmt.Errorf("secret %s has no key %s", ref.Name, ref.Key)
+}
+
+func TestSendWebhook(t *testing.T) {
+	server, received := newTestEndpoint(t, http.StatusOK)
+	orgId := uuid.New()
+
+	err := NewSender(resolveTestSecret).Send(context.Background(), orgId, httpSink(domain.NotificationSinkTypeWebhook, server.URL), testEvent())
+	require.NoError(t, err)
+
+	req := <-received
+	require.Equal(t, "application/json", req.header.Get("Content-Type"))
+	require.Equal(t, "Bearer token", req.header.Get("Authorization"))
+	require.Equal(t, "edge", req.header.Get("X-Team"))
+	var payload WebhookPayload
+	require.NoError(t, json.Unmarshal(req.body, &payload))
+	require.Equal(t, orgId, payload.OrgId)
+	require.Equal(t, domain.EventReasonDeviceDisconnected, payload.Event.Reason)
+}
+
+func TestSendSlack(t *testing.T) {
+	server, received := newTestEndpoint(t, http.StatusOK)
+
+	err := NewSender(resolveTestSecret).Send(context.Background(), uuid.New(), httpSink(domain.NotificationSinkTypeSlack, server.URL), testEvent())
+	require.NoError(t, err)
+
+	var payload SlackPayload
+	require.NoError(t, json.Unmarshal((<-received).body, &payload))
+	require.Contains(t, payload.Text, ":warning:")
+	require.Contains(t, payload.Text, "DeviceDisconnected")
+	require.Contains(t, payload.Text, "Device is disconnected.")
+}
+
+func TestSendCloudEvents(t *testing.T) {
+	server, received := newTestEndpoint(t, http.StatusAccepted)
+	orgId := uuid.New()
+
+	err := NewSender(resolveTestSecret).Send(context.Background(), orgId, httpSink(domain.NotificationSinkTypeCloudEvents, server.URL), testEvent())
+	require.NoError(t, err)
+
+	req := <-received
+	require.Equal(t, CloudEventsContentType, req.header.Get("Content-Type"))
+	var ce CloudEvent
+	require.NoError(t, json.Unmarshal(req.body, &ce))
+	require.Equal(t, CloudEventsSpecVersion, ce.SpecVersion)
+	require.Equal(t, "0b6b1f4e-7a43-4a4e-9d8b-3d4c9e3f7a10", ce.ID)
+	require.Equal(t, "io.flightctl.event.DeviceDisconnected", ce.Type)
+	require.Equal(t, "/organizations/"+orgId.String(), ce.Source)
+	require.Equal(t, "Device/dev1", ce.Subject)
+	require.Equal(t, "dev1", ce.Data.InvolvedObject.Name)
+}
+
+func TestSendFailures(t *testing.T) {
+	t.Run("error status", func(t *testing.T) {
+		server, _ := newTestEndpoint(t, http.StatusInternalServerError)
+		err := NewSender(resolveTestSecret).Send(context.Background(), uuid.New(), httpSink(domain.NotificationSinkTypeWebhook, server.URL), testEvent())
+		require.ErrorContains(t, err, "status 500")
+	})
+
+	t.Run("unresolvable authorization", func(t *testing.T) {
+		server, received := newTestEndpoint(t, http.StatusOK)
+		sink := httpSink(domain.NotificationSinkTypeWebhook, server.URL)
+		sink.Spec.Http.Authorization.Key = "missing"
+		err := NewSender(resolveTestSecret).Send(context.Background(), uuid.New(), sink, testEvent())
+		require.ErrorContains(t, err, "failed to resolve authorization")
+		require.Empty(t, received)
+	})
+}
+
+func TestBuildMail(t *testing.T) {
+	orgId := uuid.New()
+	mail := string(buildMail("fleet@example.com", []string{"ops@example.com", "oncall@example.com"}, orgId, testEvent(), time.Now()))
+
+	headers, body, found := strings.Cut(mail, "\r\n\r\n")
+	require.True(t, found)
+	require.Contains(t, headers, "From: fleet@example.com\r\n")
+	require.Contains(t, headers, "To: ops@example.com, oncall@example.com\r\n")
+	require.Contains(t, headers, "Subject: [Flight Control] Warning DeviceDisconnected: Device dev1\r\n")
+	require.Contains(t, body, "Device is disconnected.\r\n")
+	require.Contains(t, body, "Organization: "+orgId.String()+"\r\n")
+	require.Contains(t, body, "Time: 2026-01-02T03:04:05Z\r\n")
+}
@@ -72,6 +72,8 @@ func createTestExecutors() map[PeriodicTaskType]PeriodicTaskExecutor {
 		PeriodicTaskTypeDisruptionBudget:       &mockPeriodicTaskExecutor{},
 		PeriodicTaskTypeEventCleanup:           &mockPeriodicTaskExecutor{},
 		PeriodicTaskTypeDependencySyncGit:      &mockPeriodicTaskExecutor{},
+		PeriodicTaskTypeStaleRequestCleanup:    &mockPeriodicTaskExecutor{},
+		PeriodicTaskTypeNotificationDispatch:   &mockPeriodicTaskExecutor{},
 	}
 }
 
@@ -93,6 +95,8 @@ func TestConsumer_processTask_Success(t *testing.T) {
 		{"RolloutDeviceSelection", PeriodicTaskTypeRolloutDeviceSelection},
 		{"DisruptionBudget", PeriodicTaskTypeDisruptionBudget},
 		{"EventCleanup", PeriodicTaskTypeEventCleanup},
+		{"StaleRequestCleanup", PeriodicTaskTypeStaleRequestCleanup},
+		{"NotificationDispatch", PeriodicTaskTypeNotificationDispatch},
 	}
 
 	for _, tt := range tests {
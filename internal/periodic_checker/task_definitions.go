@@ -43,6 +43,8 @@ const (
 	PeriodicTaskTypeVulnerabilitySync      PeriodicTaskType = "vulnerability-sync"
 	PeriodicTaskTypeDependencySyncGit      PeriodicTaskType = "dependency-sync-git"
 	PeriodicTaskTypeDependencySyncHttp     PeriodicTaskType = "dependency-sync-http"
+	PeriodicTaskTypeStaleRequestCleanup    PeriodicTaskType = "stale-request-cleanup"
+	PeriodicTaskTypeNotificationDispatch   PeriodicTaskType = "notification-dispatch"
 )
 
 type PeriodicTaskMetadata struct {
@@ -61,6 +63,8 @@ var periodicTasks = map[PeriodicTaskType]PeriodicTaskMetadata{
 	PeriodicTaskTypeVulnerabilitySync:      {Interval: tasks.VulnerabilitySyncInterval, SystemWide: true},
 	PeriodicTaskTypeDependencySyncGit:      {Interval: config.DefaultDependencySyncTaskInterval, SystemWide: false},
 	PeriodicTaskTypeDependencySyncHttp:     {Interval: config.DefaultDependencySyncTaskInterval, SystemWide: false},
+	PeriodicTaskTypeStaleRequestCleanup:    {Interval: tasks.StaleRequestCleanupPollingInterval, SystemWide: false},
+	PeriodicTaskTypeNotificationDispatch:   {Interval: tasks.NotificationDispatchPollingInterval, SystemWide: false},
 }
 
 // MergeTasksWithConfig merges configured task intervals with defaults.
@@ -276,6 +280,37 @@ func (e *DependencySyncHttpExecutor) Execute(ctx context.Context, log logrus.Fie
 	depSync.Poll(taskCtx, orgId)
 }
 
+type StaleRequestCleanupExecutor struct {
+	log                          logrus.FieldLogger
+	serviceHandler               service.Service
+	enrollmentRequestTTL         util.Duration
+	certificateSigningRequestTTL util.Duration
+}
+
+func (e *StaleRequestCleanupExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeStaleRequestCleanup)
+	staleRequestCleanup := tasks.NewStaleRequestCleanup(e.log, e.serviceHandler, e.enrollmentRequestTTL, e.certificateSigningRequestTTL)
+	staleRequestCleanup.Poll(taskCtx, orgId)
+}
+
+type NotificationDispatchExecutor struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+	queuesProvider queues.Provider
+}
+
+func (e *NotificationDispatchExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeNotificationDispatch)
+	// The provider shares the producer of a queue within the process, so it is not closed here
+	publisher, err := e.queuesProvider.NewQueueProducer(taskCtx, consts.NotificationQueue)
+	if err != nil {
+		e.log.WithError(err).Error("Failed to create notification queue producer")
+		return
+	}
+	notificationDispatch := tasks.NewNotificationDispatch(e.log, e.serviceHandler, publisher)
+	notificationDispatch.Poll(taskCtx, orgId)
+}
+
 func InitializeTaskExecutors(log logrus.FieldLogger, serviceHandler service.Service, cfg *config.Config, queuesProvider queues.Provider, workerClient worker_client.WorkerClient, workerMetrics *worker.WorkerCollector, findingStore store.VulnerabilityFinding, vulnClient trustifyv2.VulnerabilityClient, depSyncMetrics *periodicmetrics.DependencySyncCollector) map[PeriodicTaskType]PeriodicTaskExecutor {
 	executors := map[PeriodicTaskType]PeriodicTaskExecutor{
 		PeriodicTaskTypeRepositoryTester: &RepositoryTesterExecutor{
@@ -336,5 +371,18 @@ func InitializeTaskExecutors(log logrus.FieldLogger, serviceHandler service.Serv
 		metrics:        depSyncMetrics,
 	}
 
+	executors[PeriodicTaskTypeStaleRequestCleanup] = &StaleRequestCleanupExecutor{
+		log:                          log.WithField("pkg", "stale-request-cleanup"),
+		serviceHandler:               serviceHandler,
+		enrollmentRequestTTL:         cfg.Service.EnrollmentRequestTTL,
+		certificateSigningRequestTTL: cfg.Service.CSRTTL,
+	}
+
+	executors[PeriodicTaskTypeNotificationDispatch] = &NotificationDispatchExecutor{
+		log:            log.WithField("pkg", "notification-dispatch"),
+		serviceHandler: serviceHandler,
+		queuesProvider: queuesProvider,
+	}
+
 	return executors
 }
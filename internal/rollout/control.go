@@ -0,0 +1,52 @@
+package rollout
+
+import (
+	"fmt"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+// IsPaused returns true if a user paused the rollout of the fleet
+func IsPaused(fleet *domain.Fleet) bool {
+	_, paused := util.GetFromMap(lo.FromPtr(fleet.Metadata.Annotations), domain.FleetAnnotationRolloutPaused)
+	return paused
+}
+
+// IsAborted returns true if a user aborted the rollout of the fleet
+func IsAborted(fleet *domain.Fleet) bool {
+	_, aborted := util.GetFromMap(lo.FromPtr(fleet.Metadata.Annotations), domain.FleetAnnotationRolloutAborted)
+	return aborted
+}
+
+// PausedCondition returns the RolloutInProgress condition of a paused rollout
+func PausedCondition() domain.Condition {
+	return domain.Condition{
+		Type:    domain.ConditionTypeFleetRolloutInProgress,
+		Status:  domain.ConditionStatusFalse,
+		Reason:  domain.RolloutPausedReason,
+		Message: "Rollout is paused",
+	}
+}
+
+// ResumedCondition returns the RolloutInProgress condition of a rollout that was just resumed.  The device
+// selection reconciler replaces it once it proceeds with the rollout.
+func ResumedCondition() domain.Condition {
+	return domain.Condition{
+		Type:    domain.ConditionTypeFleetRolloutInProgress,
+		Status:  domain.ConditionStatusTrue,
+		Reason:  domain.RolloutActiveReason,
+		Message: "Rollout was resumed",
+	}
+}
+
+// AbortedCondition returns the RolloutInProgress condition of an aborted rollout
+func AbortedCondition(templateVersion string) domain.Condition {
+	return domain.Condition{
+		Type:    domain.ConditionTypeFleetRolloutInProgress,
+		Status:  domain.ConditionStatusFalse,
+		Reason:  domain.RolloutAbortedReason,
+		Message: fmt.Sprintf("Rollout of template version %s was aborted", templateVersion),
+	}
+}
@@ -0,0 +1,39 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRolloutControlAnnotations(t *testing.T) {
+	fleet := &domain.Fleet{}
+	require.False(t, IsPaused(fleet))
+	require.False(t, IsAborted(fleet))
+
+	fleet.Metadata.Annotations = &map[string]string{domain.FleetAnnotationRolloutPaused: "true"}
+	require.True(t, IsPaused(fleet))
+	require.False(t, IsAborted(fleet))
+
+	fleet.Metadata.Annotations = &map[string]string{domain.FleetAnnotationRolloutAborted: "1"}
+	require.False(t, IsPaused(fleet))
+	require.True(t, IsAborted(fleet))
+}
+
+func TestAbortedRolloutProgressStage(t *testing.T) {
+	fleet := &domain.Fleet{Spec: domain.FleetSpec{RolloutPolicy: &domain.RolloutPolicy{DeviceSelection: &domain.RolloutDeviceSelection{}}}}
+	require.NoError(t, fleet.Spec.RolloutPolicy.DeviceSelection.FromBatchSequence(domain.BatchSequence{
+		Strategy: domain.RolloutStrategyBatchSequence,
+		Sequence: &[]domain.Batch{{}},
+	}))
+	fleet.Metadata.Annotations = &map[string]string{domain.FleetAnnotationBatchNumber: "0"}
+	stage, err := ProgressStage(fleet)
+	require.NoError(t, err)
+	require.Equal(t, ConfiguredBatch, stage)
+
+	(*fleet.Metadata.Annotations)[domain.FleetAnnotationRolloutAborted] = "v2"
+	stage, err = ProgressStage(fleet)
+	require.NoError(t, err)
+	require.Equal(t, Aborted, stage)
+}
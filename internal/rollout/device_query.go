@@ -0,0 +1,104 @@
+package rollout
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+// DeviceQuery builds the list parameters of the device queries that the rollout device selection and its preview
+// are made of
+type DeviceQuery struct {
+	fieldSelectorList      []string
+	labelSelectorList      []string
+	annotationSelectorList []string
+}
+
+func NewDeviceQuery() *DeviceQuery {
+	return &DeviceQuery{}
+}
+
+func (q *DeviceQuery) ListParams() (domain.ListDevicesParams, *selector.AnnotationSelector) {
+	var ret domain.ListDevicesParams
+	var annotationSelector *selector.AnnotationSelector
+
+	if len(q.fieldSelectorList) > 0 {
+		ret.FieldSelector = lo.ToPtr(strings.Join(q.fieldSelectorList, ","))
+	}
+	if len(q.labelSelectorList) > 0 {
+		ret.LabelSelector = lo.ToPtr(strings.Join(q.labelSelectorList, ","))
+	}
+	if len(q.annotationSelectorList) > 0 {
+		annotationSelector = selector.NewAnnotationSelectorOrDie(strings.Join(q.annotationSelectorList, ","))
+	}
+	return ret, annotationSelector
+}
+
+func (q *DeviceQuery) WithSelectedForRollout() *DeviceQuery {
+	q.annotationSelectorList = append(q.annotationSelectorList, domain.MatchExpression{
+		Key:      domain.DeviceAnnotationSelectedForRollout,
+		Operator: domain.Exists,
+	}.String())
+	return q
+}
+
+func (q *DeviceQuery) WithOwner(fleetName string) *DeviceQuery {
+	q.fieldSelectorList = append(q.fieldSelectorList, "metadata.owner"+"="+util.ResourceOwner(domain.FleetKind, fleetName))
+	return q
+}
+
+func (q *DeviceQuery) WithLabelSelector(l *domain.LabelSelector) *DeviceQuery {
+	if l != nil {
+		q.labelSelectorList = append(q.labelSelectorList, lo.MapToSlice(lo.FromPtr(l.MatchLabels), func(k, v string) string { return k + "=" + v })...)
+		q.labelSelectorList = append(q.labelSelectorList, lo.Map(lo.FromPtr(l.MatchExpressions), func(e domain.MatchExpression, _ int) string { return e.String() })...)
+	}
+	return q
+}
+
+func (q *DeviceQuery) WithoutRolledOut(templateVersionName string) *DeviceQuery {
+	q.annotationSelectorList = append(q.annotationSelectorList, domain.MatchExpression{
+		Key:      domain.DeviceAnnotationTemplateVersion,
+		Operator: domain.NotIn,
+		Values:   lo.ToPtr([]string{templateVersionName}),
+	}.String())
+	return q
+}
+
+func (q *DeviceQuery) WithRolledOut(templateVersionName string) *DeviceQuery {
+	q.annotationSelectorList = append(q.annotationSelectorList, domain.MatchExpression{
+		Key:      domain.DeviceAnnotationTemplateVersion,
+		Operator: domain.In,
+		Values:   lo.ToPtr([]string{templateVersionName}),
+	}.String())
+	return q
+}
+
+func (q *DeviceQuery) WithoutDisconnected() *DeviceQuery {
+	q.fieldSelectorList = append(q.fieldSelectorList, "status.summary.status!=Unknown")
+	return q
+}
+
+func (q *DeviceQuery) WithNotOnline() *DeviceQuery {
+	q.fieldSelectorList = append(q.fieldSelectorList, "status.summary.status!="+string(domain.DeviceSummaryStatusOnline))
+	return q
+}
+
+// Devices that run no applications are considered as having healthy applications
+func (q *DeviceQuery) WithUnhealthyApplications() *DeviceQuery {
+	q.fieldSelectorList = append(q.fieldSelectorList, fmt.Sprintf("status.applicationsSummary.status notin (%s,%s)",
+		domain.ApplicationsSummaryStatusHealthy, domain.ApplicationsSummaryStatusNoApplications))
+	return q
+}
+
+// BatchQuery returns the query of the devices within the scope that match the selector of a batch.  The scope of a
+// rollout is the devices owned by the fleet.
+func BatchQuery(scope *DeviceQuery, batch *domain.Batch) *DeviceQuery {
+	if batch == nil {
+		return scope
+	}
+	return scope.WithLabelSelector(batch.Selector)
+}
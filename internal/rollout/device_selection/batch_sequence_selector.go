@@ -4,96 +4,66 @@ import (
 	"context"
 	"crypto/md5" // #nosec G401,G501
 	"encoding/json"
-	"errors"
 	"fmt"
-	"math"
 	"net/http"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/rollout"
 	"github.com/flightctl/flightctl/internal/service"
-	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
-type querySelectorParts struct {
-	fieldSelectorList      []string
-	labelSelectorList      []string
-	annotationSelectorList []string
-}
-
-func newQuerySelectorParts() *querySelectorParts {
-	return &querySelectorParts{}
-}
-
-func (q *querySelectorParts) listParams() (domain.ListDevicesParams, *selector.AnnotationSelector) {
-	var ret domain.ListDevicesParams
-	var annotationSelector *selector.AnnotationSelector
-
-	if len(q.fieldSelectorList) > 0 {
-		ret.FieldSelector = lo.ToPtr(strings.Join(q.fieldSelectorList, ","))
-	}
-	if len(q.labelSelectorList) > 0 {
-		ret.LabelSelector = lo.ToPtr(strings.Join(q.labelSelectorList, ","))
-	}
-	if len(q.annotationSelectorList) > 0 {
-		annotationSelector = selector.NewAnnotationSelectorOrDie(strings.Join(q.annotationSelectorList, ","))
+func newBatchSequenceSelector(sequence domain.BatchSequence, updateTimeout time.Duration, serviceHandler service.Service, orgId uuid.UUID, fleet *domain.Fleet, templateVersionName string, log logrus.FieldLogger) RolloutDeviceSelector {
+	return &batchSequenceSelector{
+		BatchSequence:       sequence,
+		serviceHandler:      serviceHandler,
+		orgId:               orgId,
+		fleetName:           lo.FromPtr(fleet.Metadata.Name),
+		fleet:               fleet,
+		templateVersionName: templateVersionName,
+		updateTimeout:       updateTimeout,
+		log:                 log,
 	}
-	return ret, annotationSelector
-}
-
-func (q *querySelectorParts) withSelectedForRollout() *querySelectorParts {
-	q.annotationSelectorList = append(q.annotationSelectorList, domain.MatchExpression{
-		Key:      domain.DeviceAnnotationSelectedForRollout,
-		Operator: domain.Exists,
-	}.String())
-	return q
 }
 
-func (q *querySelectorParts) withOwner(fleetName string) *querySelectorParts {
-	q.fieldSelectorList = append(q.fieldSelectorList, "metadata.owner"+"="+util.ResourceOwner(domain.FleetKind, fleetName))
-	return q
-}
-
-func (q *querySelectorParts) withLabelSelector(l *domain.LabelSelector) *querySelectorParts {
-	if l != nil {
-		q.labelSelectorList = append(q.labelSelectorList, lo.MapToSlice(lo.FromPtr(l.MatchLabels), func(k, v string) string { return k + "=" + v })...)
-		q.labelSelectorList = append(q.labelSelectorList, lo.Map(lo.FromPtr(l.MatchExpressions), func(e domain.MatchExpression, _ int) string { return e.String() })...)
+// A canary rollout is a batch sequence of a single batch of the canary devices.  The canary batch is
+// complete only after its devices have soaked for the soak duration.
+func newCanarySelector(canary domain.Canary, updateTimeout time.Duration, serviceHandler service.Service, orgId uuid.UUID, fleet *domain.Fleet, templateVersionName string, log logrus.FieldLogger) (RolloutDeviceSelector, error) {
+	soakDuration, err := time.ParseDuration(canary.SoakDuration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse soak duration %s: %w", canary.SoakDuration, err)
 	}
-	return q
-}
-
-func (q *querySelectorParts) withoutRolledOut(templateVersionName string) *querySelectorParts {
-	q.annotationSelectorList = append(q.annotationSelectorList, domain.MatchExpression{
-		Key:      domain.DeviceAnnotationTemplateVersion,
-		Operator: domain.NotIn,
-		Values:   lo.ToPtr([]string{templateVersionName}),
-	}.String())
-	return q
-}
-
-func (q *querySelectorParts) withRolledOut(templateVersionName string) *querySelectorParts {
-	q.annotationSelectorList = append(q.annotationSelectorList, domain.MatchExpression{
-		Key:      domain.DeviceAnnotationTemplateVersion,
-		Operator: domain.In,
-		Values:   lo.ToPtr([]string{templateVersionName}),
-	}.String())
-	return q
-}
-
-func (q *querySelectorParts) withoutDisconnected() *querySelectorParts {
-	q.fieldSelectorList = append(q.fieldSelectorList, "status.summary.status!=Unknown")
-	return q
+	return &batchSequenceSelector{
+		BatchSequence:       rollout.CanaryBatchSequence(canary),
+		canary:              &canary,
+		soakDuration:        soakDuration,
+		serviceHandler:      serviceHandler,
+		orgId:               orgId,
+		fleetName:           lo.FromPtr(fleet.Metadata.Name),
+		fleet:               fleet,
+		templateVersionName: templateVersionName,
+		updateTimeout:       updateTimeout,
+		log:                 log,
+	}, nil
 }
 
-func newBatchSequenceSelector(sequence domain.BatchSequence, updateTimeout time.Duration, serviceHandler service.Service, orgId uuid.UUID, fleet *domain.Fleet, templateVersionName string, log logrus.FieldLogger) RolloutDeviceSelector {
+// A progressive rollout is a batch sequence of a batch per step, each limited to the share of the step.  A step
+// batch is complete only after the step interval has passed since it started.
+func newProgressiveSelector(progressive domain.Progressive, updateTimeout time.Duration, serviceHandler service.Service, orgId uuid.UUID, fleet *domain.Fleet, templateVersionName string, log logrus.FieldLogger) (RolloutDeviceSelector, error) {
+	stepInterval, err := time.ParseDuration(progressive.StepInterval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse step interval %s: %w", progressive.StepInterval, err)
+	}
 	return &batchSequenceSelector{
-		BatchSequence:       sequence,
+		BatchSequence:       rollout.ProgressiveBatchSequence(progressive),
+		progressive:         &progressive,
+		stepInterval:        stepInterval,
 		serviceHandler:      serviceHandler,
 		orgId:               orgId,
 		fleetName:           lo.FromPtr(fleet.Metadata.Name),
@@ -101,11 +71,15 @@ func newBatchSequenceSelector(sequence domain.BatchSequence, updateTimeout time.
 		templateVersionName: templateVersionName,
 		updateTimeout:       updateTimeout,
 		log:                 log,
-	}
+	}, nil
 }
 
 type batchSequenceSelector struct {
 	domain.BatchSequence
+	canary              *domain.Canary
+	soakDuration        time.Duration
+	progressive         *domain.Progressive
+	stepInterval        time.Duration
 	serviceHandler      service.Service
 	orgId               uuid.UUID
 	fleet               *domain.Fleet
@@ -115,6 +89,14 @@ type batchSequenceSelector struct {
 	log                 logrus.FieldLogger
 }
 
+func (b *batchSequenceSelector) isCanaryBatch(currentBatch int) bool {
+	return b.canary != nil && currentBatch == 0
+}
+
+func (b *batchSequenceSelector) isProgressiveStep(currentBatch int) bool {
+	return b.progressive != nil && currentBatch >= 0 && currentBatch < len(lo.FromPtr(b.Sequence))
+}
+
 func (b *batchSequenceSelector) IsRolloutNew() bool {
 	dtv, exists := b.fleet.GetAnnotation(domain.FleetAnnotationDeployingTemplateVersion)
 	if !exists {
@@ -131,7 +113,14 @@ func (b *batchSequenceSelector) getPreviousBatchSequenceDigest() (string, bool)
 }
 
 func (b *batchSequenceSelector) batchSequenceDigest() (string, error) {
-	marshalled, err := json.Marshal(&b.BatchSequence)
+	var definition any = &b.BatchSequence
+	switch {
+	case b.canary != nil:
+		definition = b.canary
+	case b.progressive != nil:
+		definition = b.progressive
+	}
+	marshalled, err := json.Marshal(definition)
 	if err != nil {
 		return "", err
 	}
@@ -156,11 +145,17 @@ func (b *batchSequenceSelector) OnNewRollout(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	previousTemplateVersionName, _ := b.fleet.GetAnnotation(domain.FleetAnnotationDeployingTemplateVersion)
 	annotations := map[string]string{
 		domain.FleetAnnotationDeployingTemplateVersion:    b.templateVersionName,
 		domain.FleetAnnotationDeviceSelectionConfigDigest: batchSequenceDigest,
 	}
-	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, nil))
+	if err = service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, []string{
+		domain.FleetAnnotationRollbackTemplateVersion, domain.FleetAnnotationRolloutPaused, domain.FleetAnnotationRolloutAborted})); err != nil {
+		return err
+	}
+	newRolloutRecorder(b.orgId, b.fleetName, b.templateVersionName, b.serviceHandler, b.log).started(ctx, previousTemplateVersionName)
+	return nil
 }
 
 func (b *batchSequenceSelector) getCurrentBatch(ctx context.Context) (int, error) {
@@ -233,6 +228,11 @@ func (b *batchSequenceSelector) Advance(ctx context.Context) error {
 	if err = b.setCurrentBatch(ctx, nextBatch); err != nil {
 		return fmt.Errorf("failed to set current batch: %w", err)
 	}
+	if b.isProgressiveStep(nextBatch) {
+		if err = b.setStepStartedAt(ctx); err != nil {
+			return fmt.Errorf("failed to set step start time: %w", err)
+		}
+	}
 	// Mark the devices that participate in the batch
 	switch {
 	case nextBatch < len(lo.FromPtr(b.Sequence)):
@@ -248,6 +248,13 @@ func (b *batchSequenceSelector) Advance(ctx context.Context) error {
 	return nil
 }
 
+func (b *batchSequenceSelector) setStepStartedAt(ctx context.Context) error {
+	annotations := map[string]string{
+		domain.FleetAnnotationProgressiveStepStartedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, nil))
+}
+
 func (b *batchSequenceSelector) clearApproval(ctx context.Context) error {
 	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, make(map[string]string), []string{domain.FleetAnnotationRolloutApproved}))
 }
@@ -260,7 +267,8 @@ func (b *batchSequenceSelector) resetApprovalAndCompletionReport(ctx context.Con
 		annotations[domain.FleetAnnotationRolloutApprovalMethod] = "automatic"
 	}
 	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, []string{
-		domain.FleetAnnotationRolloutApproved, domain.FleetAnnotationLastBatchCompletionReport}))
+		domain.FleetAnnotationRolloutApproved, domain.FleetAnnotationLastBatchCompletionReport, domain.FleetAnnotationCanaryHealthySince,
+		domain.FleetAnnotationCanarySoakStartedAt, domain.FleetAnnotationProgressiveStepStartedAt}))
 }
 
 func (b *batchSequenceSelector) batchName(currentBatch int) string {
@@ -268,6 +276,10 @@ func (b *batchSequenceSelector) batchName(currentBatch int) string {
 	switch {
 	case currentBatch == -1:
 		return domain.PreliminaryBatchName
+	case b.isCanaryBatch(currentBatch):
+		return domain.CanaryBatchName
+	case b.isProgressiveStep(currentBatch):
+		return rollout.ProgressiveStepName(currentBatch, *b.progressive)
 	case currentBatch >= 0 && currentBatch < len(lo.FromPtr(b.Sequence)):
 		return fmt.Sprintf("batch %d", printableBatchNum)
 	case currentBatch == len(lo.FromPtr(b.Sequence)):
@@ -290,8 +302,18 @@ func (b *batchSequenceSelector) currentSelection(ctx context.Context, currentBat
 		batch = lo.ToPtr(lo.FromPtr(b.Sequence)[currentBatch])
 	}
 	batchName := b.batchName(currentBatch)
+	var soakDuration time.Duration
+	if b.isCanaryBatch(currentBatch) {
+		soakDuration = b.soakDuration
+	}
+	var stepInterval time.Duration
+	if b.isProgressiveStep(currentBatch) {
+		stepInterval = b.stepInterval
+	}
 	return &batchSelection{
 		batch:               batch,
+		soakDuration:        soakDuration,
+		stepInterval:        stepInterval,
 		batchNum:            currentBatch,
 		batchName:           batchName,
 		serviceHandler:      b.serviceHandler,
@@ -302,6 +324,7 @@ func (b *batchSequenceSelector) currentSelection(ctx context.Context, currentBat
 		updateTimeout:       b.updateTimeout,
 		log:                 b.log,
 		conditionEmitter:    newConditionEmitter(b.orgId, b.fleetName, batchName, b.serviceHandler),
+		rolloutRecorder:     newRolloutRecorder(b.orgId, b.fleetName, b.templateVersionName, b.serviceHandler, b.log),
 	}, nil
 }
 
@@ -329,7 +352,11 @@ func (b *batchSequenceSelector) Reset(ctx context.Context) error {
 }
 
 type batchSelection struct {
-	batch               *domain.Batch
+	batch        *domain.Batch
+	soakDuration time.Duration
+	// soakFailed is set by IsComplete if the devices of the canary batch did not soak before the soak deadline
+	soakFailed          bool
+	stepInterval        time.Duration
 	batchNum            int
 	batchName           string
 	serviceHandler      service.Service
@@ -339,6 +366,7 @@ type batchSelection struct {
 	fleet               *domain.Fleet
 	updateTimeout       time.Duration
 	conditionEmitter    *conditionEmitter
+	rolloutRecorder     *rolloutRecorder
 	log                 logrus.FieldLogger
 }
 
@@ -354,12 +382,12 @@ func (b *batchSelection) IsRolledOut(ctx context.Context) (bool, error) {
 	// A rolled out device is one that its template version is the same as the expected template version
 	// A rolled out batch is a batch that for every of its devices there is no device that its template
 	// version is not equal to the expected template version
-	newQuerySelectorParts().withOwner(b.fleetName).withSelectedForRollout().withoutRolledOut(b.templateVersionName).listParams()
-	listParams, annotationSelector := newQuerySelectorParts().
-		withOwner(b.fleetName).
-		withSelectedForRollout().
-		withoutRolledOut(b.templateVersionName).
-		listParams()
+	rollout.NewDeviceQuery().WithOwner(b.fleetName).WithSelectedForRollout().WithoutRolledOut(b.templateVersionName).ListParams()
+	listParams, annotationSelector := rollout.NewDeviceQuery().
+		WithOwner(b.fleetName).
+		WithSelectedForRollout().
+		WithoutRolledOut(b.templateVersionName).
+		ListParams()
 	count, status := b.serviceHandler.CountDevices(ctx, b.orgId, listParams, annotationSelector)
 	if status.Code != http.StatusOK {
 		return false, service.ApiStatusToErr(status)
@@ -399,41 +427,109 @@ func (b *batchSelection) getLastCompletionReport() (domain.RolloutBatchCompletio
 	return report, true, nil
 }
 
-func (b *batchSelection) getLastSuccessPercentage() (int, bool, error) {
-	report, exists, err := b.getLastCompletionReport()
-	if err != nil || !exists {
-		return 0, exists, err
-	}
-	return int(report.SuccessPercentage), true, nil
-}
-
 func (b *batchSelection) isApprovalMethodAutomatic() bool {
 	approvalMethod, _ := b.fleet.GetAnnotation(domain.FleetAnnotationRolloutApprovalMethod)
 	return approvalMethod == "automatic"
 }
 
-// A batch may be approved atotmatically only if its approval method is "automatic" and the
-// success percentage of the previous batch is greater or equal to the success threshold
+// requiresApproval returns true if the batch definition requires a user to approve the batch before it is rolled out
+func (b *batchSelection) requiresApproval() bool {
+	return b.batch != nil && lo.FromPtr(b.batch.RequireApproval)
+}
+
+// isLastBatchSuccessful returns true if the success percentage of the previous batch is greater or equal to the
+// success threshold and its devices soaked if it was a canary batch, or if there is no previous batch
+func (b *batchSelection) isLastBatchSuccessful() (bool, error) {
+	successThreshold, err := b.getSuccessThreshold()
+	if err != nil {
+		return false, err
+	}
+	report, exists, err := b.getLastCompletionReport()
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return true, nil
+	}
+
+	return !report.SoakFailed && int(report.SuccessPercentage) >= successThreshold, nil
+}
+
+// A batch may be approved atotmatically only if its approval method is "automatic", the batch doesn't require
+// approval by a user and the success percentage of the previous batch is greater or equal to the success threshold
 func (b *batchSelection) MayApproveAutomatically() (bool, error) {
 	if b.batchNum == -1 {
 		return true, nil
 	}
-	if !b.isApprovalMethodAutomatic() {
+	if !b.isApprovalMethodAutomatic() || b.requiresApproval() {
 		return false, nil
 	}
-	successThreshold, err := b.getSuccessThreshold()
+	return b.isLastBatchSuccessful()
+}
+
+func (b *batchSelection) isRollbackOnFailure() bool {
+	return lo.FromPtr(b.fleet.Spec.RolloutPolicy.OnFailure) == domain.RolloutFailurePolicyRollback
+}
+
+// A batch should be rolled back if the rollout policy requires rollback on failure and the
+// previous batch was not successful
+func (b *batchSelection) ShouldRollback() (bool, error) {
+	if b.batchNum == -1 || !b.isRollbackOnFailure() {
+		return false, nil
+	}
+	successful, err := b.isLastBatchSuccessful()
 	if err != nil {
 		return false, err
 	}
-	lastSuccessPercentage, exists, err := b.getLastSuccessPercentage()
+	return !successful, nil
+}
+
+// Rollback halts the rollout and returns the devices that were already rolled out to the last
+// template version whose rollout completed, or else to the one that preceded the one being rolled
+// out.  The devices themselves are updated by the fleet-rollback task, which is triggered by the
+// FleetRolloutRolledBack event.
+func (b *batchSelection) Rollback(ctx context.Context) error {
+	report, exists, err := b.getLastCompletionReport()
 	if err != nil {
-		return false, err
+		return fmt.Errorf("failed to get last completion report: %w", err)
 	}
 	if !exists {
-		return true, nil
+		return fmt.Errorf("last completion report doesn't exist")
+	}
+	successThreshold, err := b.getSuccessThreshold()
+	if err != nil {
+		return fmt.Errorf("failed to get success threshold: %w", err)
 	}
+	rollbackTemplateVersion, exists, err := rollout.RollbackTemplateVersion(ctx, b.serviceHandler, b.orgId, b.fleetName, b.templateVersionName)
+	if err != nil {
+		return fmt.Errorf("failed to find the template version to roll back to: %w", err)
+	}
+	if !exists {
+		b.log.Warnf("%v/%s: No template version precedes %s. Suspending rollout instead of rolling back", b.orgId, b.fleetName, b.templateVersionName)
+		return b.conditionEmitter.suspended(ctx, successThreshold, report)
+	}
+	b.log.Infof("%v/%s:In Rollback. Rolling back template version %s to %s", b.orgId, b.fleetName, b.templateVersionName, rollbackTemplateVersion)
 
-	return lastSuccessPercentage >= successThreshold, nil
+	// The devices of the next batch must not be rolled out
+	if err = b.unmark(ctx); err != nil {
+		return err
+	}
+	if err = b.conditionEmitter.rolledBack(ctx, rollbackTemplateVersion, successThreshold, report); err != nil {
+		return err
+	}
+	if event := common.GetFleetRolloutRolledBackEvent(ctx, b.fleetName, b.templateVersionName, rollbackTemplateVersion, report.BatchName); event != nil {
+		b.serviceHandler.CreateEvent(ctx, b.orgId, event)
+	}
+
+	// Halt the rollout until a new template version or rollout definition is applied
+	annotations := map[string]string{
+		domain.FleetAnnotationRollbackTemplateVersion: rollbackTemplateVersion,
+	}
+	if err = service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, nil)); err != nil {
+		return err
+	}
+	b.rolloutRecorder.ended(ctx, b.templateVersionName, domain.RolloutOutcomeRolledBack)
+	return nil
 }
 
 func (b *batchSelection) Approve(ctx context.Context) error {
@@ -446,12 +542,49 @@ func (b *batchSelection) Approve(ctx context.Context) error {
 
 // A group of device is considered as completed successfully if the rendered template version is the same as the
 // template version of the fleet and same-rendered-version is true
+// If the fleet gates its rollout on application health probes, the applications of the device must also be neither
+// errored nor degraded
 func (b *batchSelection) isUpdateCompletedSuccessfully(c domain.DeviceCompletionCount) bool {
-	return c.SameTemplateVersion && c.SameRenderedVersion
+	if !c.SameTemplateVersion || !c.SameRenderedVersion {
+		return false
+	}
+	return !b.gatesOnApplicationHealth() ||
+		c.ApplicationsStatus != domain.ApplicationsSummaryStatusError && c.ApplicationsStatus != domain.ApplicationsSummaryStatusDegraded
 }
 
+// A device has failed its update if the update itself failed, or if the fleet gates its rollout on application
+// health probes and the applications of the device are errored after the update
 func (b *batchSelection) isFailed(c domain.DeviceCompletionCount) bool {
-	return c.SameTemplateVersion && c.UpdatingReason == domain.UpdateStateError
+	if !c.SameTemplateVersion {
+		return false
+	}
+	return c.UpdatingReason == domain.UpdateStateError ||
+		b.gatesOnApplicationHealth() && c.SameRenderedVersion && c.ApplicationsStatus == domain.ApplicationsSummaryStatusError
+}
+
+// gatesOnApplicationHealth returns true if the fleet template declares a liveness or readiness probe on any of its
+// applications. Only then does the application status of the devices count towards the success of a batch, so that
+// fleets that do not use health probes are rolled out as before.
+func (b *batchSelection) gatesOnApplicationHealth() bool {
+	if b.fleet == nil {
+		return false
+	}
+	return lo.ContainsBy(lo.FromPtr(b.fleet.Spec.Template.Spec.Applications), func(app domain.ApplicationProviderSpec) bool {
+		appType, err := app.GetAppType()
+		if err != nil {
+			return false
+		}
+		switch appType {
+		case domain.AppTypeContainer:
+			container, err := app.AsContainerApplication()
+			return err == nil && (container.LivenessProbe != nil || container.ReadinessProbe != nil)
+		case domain.AppTypeQuadlet:
+			quadlet, err := app.AsQuadletApplication()
+			return err == nil && (quadlet.LivenessProbe != nil || quadlet.ReadinessProbe != nil)
+		default:
+			return false
+		}
+	})
 }
 
 func (b *batchSelection) isTimedOut(c domain.DeviceCompletionCount) bool {
@@ -470,11 +603,120 @@ func (b *batchSelection) IsComplete(ctx context.Context) (bool, error) {
 		return c.Count
 	}))
 
-	// A device is counted as completed if it has completed successfully or, it is in error state or its update is timed out
+	// A device is counted as completed if it has completed successfully or, it has failed or its update is timed out
 	complete := lo.Sum(lo.Map(counts, func(c domain.DeviceCompletionCount, _ int) int64 {
-		return lo.Ternary(b.isUpdateCompletedSuccessfully(c) || c.SameTemplateVersion && (c.UpdatingReason == domain.UpdateStateError || c.UpdateTimedOut), c.Count, 0)
+		return lo.Ternary(b.isUpdateCompletedSuccessfully(c) || b.isFailed(c) || b.isTimedOut(c), c.Count, 0)
 	}))
-	return total == complete, nil
+	switch {
+	case total != complete:
+		return false, nil
+	case b.soakDuration != 0:
+		return b.isSoaked(ctx)
+	case b.stepInterval != 0:
+		return b.isStepIntervalOver(ctx)
+	default:
+		return true, nil
+	}
+}
+
+// isStepIntervalOver checks if the step interval has passed since the step of a progressive rollout started
+func (b *batchSelection) isStepIntervalOver(ctx context.Context) (bool, error) {
+	startedAtStr, exists := b.fleet.GetAnnotation(domain.FleetAnnotationProgressiveStepStartedAt)
+	if !exists {
+		return true, nil
+	}
+	startedAt, err := time.Parse(time.RFC3339, startedAtStr)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse %s annotation: %w", domain.FleetAnnotationProgressiveStepStartedAt, err)
+	}
+	nextStepAt := startedAt.Add(b.stepInterval)
+	if time.Now().Before(nextStepAt) {
+		return false, b.conditionEmitter.holdingStep(ctx, nextStepAt)
+	}
+	return true, nil
+}
+
+// areDevicesHealthy checks that all the devices of the batch are online and their applications are healthy
+func (b *batchSelection) areDevicesHealthy(ctx context.Context) (bool, error) {
+	for _, parts := range []*rollout.DeviceQuery{
+		rollout.NewDeviceQuery().WithOwner(b.fleetName).WithSelectedForRollout().WithNotOnline(),
+		rollout.NewDeviceQuery().WithOwner(b.fleetName).WithSelectedForRollout().WithUnhealthyApplications(),
+	} {
+		listParams, annotationSelector := parts.ListParams()
+		count, status := b.serviceHandler.CountDevices(ctx, b.orgId, listParams, annotationSelector)
+		if status.Code != http.StatusOK {
+			return false, service.ApiStatusToErr(status)
+		}
+		if count > 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// isSoaked checks if the devices of the batch have stayed healthy for the soak duration.  If they have not soaked by
+// the soak deadline, the soak fails and the batch is complete, so that the rollout is suspended or rolled back.
+func (b *batchSelection) isSoaked(ctx context.Context) (bool, error) {
+	pastDeadline, err := b.isPastSoakDeadline(ctx)
+	if err != nil {
+		return false, err
+	}
+	soaked, err := b.checkSoak(ctx)
+	if err != nil || soaked || !pastDeadline {
+		return soaked, err
+	}
+	b.log.Warnf("%v/%s: Devices of %s did not stay healthy for %s within %s. Failing the batch", b.orgId, b.fleetName, b.batchName, b.soakDuration, b.soakDuration*SoakDeadlineFactor)
+	b.soakFailed = true
+	return true, nil
+}
+
+// isPastSoakDeadline checks if the soak deadline of the batch has passed.  The time the soak started is kept in a
+// fleet annotation that is set when the batch is first checked for soaking.
+func (b *batchSelection) isPastSoakDeadline(ctx context.Context) (bool, error) {
+	startedAtStr, exists := b.fleet.GetAnnotation(domain.FleetAnnotationCanarySoakStartedAt)
+	if !exists {
+		annotations := map[string]string{
+			domain.FleetAnnotationCanarySoakStartedAt: time.Now().UTC().Format(time.RFC3339),
+		}
+		return false, service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, nil))
+	}
+	startedAt, err := time.Parse(time.RFC3339, startedAtStr)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse %s annotation: %w", domain.FleetAnnotationCanarySoakStartedAt, err)
+	}
+	return time.Since(startedAt) >= b.soakDuration*SoakDeadlineFactor, nil
+}
+
+// checkSoak checks if the devices of the batch have stayed healthy for the soak duration.  The time since which the
+// devices are healthy is kept in a fleet annotation, and it is cleared whenever one of the devices is unhealthy.
+func (b *batchSelection) checkSoak(ctx context.Context) (bool, error) {
+	healthy, err := b.areDevicesHealthy(ctx)
+	if err != nil {
+		return false, err
+	}
+	healthySinceStr, exists := b.fleet.GetAnnotation(domain.FleetAnnotationCanaryHealthySince)
+	if !healthy {
+		if exists {
+			b.log.Infof("%v/%s: Devices of %s are no longer healthy. Restarting soak", b.orgId, b.fleetName, b.batchName)
+			return false, service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, nil, []string{domain.FleetAnnotationCanaryHealthySince}))
+		}
+		return false, nil
+	}
+	if !exists {
+		b.log.Infof("%v/%s: Devices of %s are healthy. Soaking for %s", b.orgId, b.fleetName, b.batchName, b.soakDuration)
+		annotations := map[string]string{
+			domain.FleetAnnotationCanaryHealthySince: time.Now().UTC().Format(time.RFC3339),
+		}
+		if err = service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, nil)); err != nil {
+			return false, err
+		}
+		return false, b.conditionEmitter.soaking(ctx, b.soakDuration)
+	}
+	healthySince, err := time.Parse(time.RFC3339, healthySinceStr)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse %s annotation: %w", domain.FleetAnnotationCanaryHealthySince, err)
+	}
+	return time.Since(healthySince) >= b.soakDuration, nil
 }
 
 func (b *batchSelection) completionReport(counts []domain.DeviceCompletionCount) domain.RolloutBatchCompletionReport {
@@ -492,6 +734,12 @@ func (b *batchSelection) completionReport(counts []domain.DeviceCompletionCount)
 			ret.TimedOut += c.Count
 		}
 	}
+	// A batch whose devices did not soak failed as a whole
+	if b.soakFailed {
+		ret.SoakFailed = true
+		ret.Failed += ret.Successful
+		ret.Successful = 0
+	}
 	ret.SuccessPercentage = 100
 	if ret.Total != 0 {
 		ret.SuccessPercentage = ret.Successful * 100 / ret.Total
@@ -515,22 +763,30 @@ func (b *batchSelection) SetCompletionReport(ctx context.Context) error {
 	}
 	outStr := string(out)
 	b.log.Infof("%v/%s:In SetCompletionReport: %s", b.orgId, b.fleetName, outStr)
+	lastReport, exists, err := b.getLastCompletionReport()
+	if err != nil {
+		return fmt.Errorf("failed to get last completion report: %w", err)
+	}
 	annotations := map[string]string{
 		domain.FleetAnnotationLastBatchCompletionReport: outStr,
 	}
-	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, nil))
+	if err = service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, nil)); err != nil {
+		return err
+	}
+	if !exists || lastReport != report {
+		b.rolloutRecorder.batchCompleted(ctx, report)
+	}
+	return nil
 }
 
 func (b *batchSelection) batchCounts(ctx context.Context) (int, int, error) {
-	parts := newQuerySelectorParts().
-		withOwner(b.fleetName).
-		withLabelSelector(b.batch.Selector)
-	listParams, annotationSelector := parts.listParams()
+	parts := rollout.BatchQuery(rollout.NewDeviceQuery().WithOwner(b.fleetName), b.batch)
+	listParams, annotationSelector := parts.ListParams()
 	total, status := b.serviceHandler.CountDevices(ctx, b.orgId, listParams, annotationSelector)
 	if status.Code != http.StatusOK {
 		return 0, 0, service.ApiStatusToErr(status)
 	}
-	listParams, annotationSelector = parts.withRolledOut(b.templateVersionName).listParams()
+	listParams, annotationSelector = parts.WithRolledOut(b.templateVersionName).ListParams()
 	rolledOut, status := b.serviceHandler.CountDevices(ctx, b.orgId, listParams, annotationSelector)
 	if status.Code != http.StatusOK {
 		return 0, 0, service.ApiStatusToErr(status)
@@ -539,32 +795,10 @@ func (b *batchSelection) batchCounts(ctx context.Context) (int, int, error) {
 }
 
 func (b *batchSelection) calculateLimit(ctx context.Context) (*int, error) {
-	if b.batch == nil || b.batch.Limit == nil {
-		return nil, nil
-	}
-	unifiedBatchLimit := *b.batch.Limit
-	intBatchLimit, intErr := unifiedBatchLimit.AsBatchLimit1()
-	if intErr == nil {
-		return &intBatchLimit, nil
-	}
-	percentageStr, pErr := unifiedBatchLimit.AsPercentage()
-	if pErr != nil {
-		return nil, errors.Join(intErr, pErr)
-	}
-	percentage, err := util.PercentageAsInt(percentageStr)
-	if err != nil {
-		return nil, err
-	}
-	if percentage == 100 {
-		// Ignore limit
+	if b.batch == nil {
 		return nil, nil
 	}
-	rolledOut, total, err := b.batchCounts(ctx)
-	if err != nil {
-		return nil, err
-	}
-	res := int(math.Round(float64(total)*float64(percentage)/100.0)) - rolledOut
-	return &res, nil
+	return rollout.BatchLimit(b.batch.Limit, func() (int, int, error) { return b.batchCounts(ctx) })
 }
 
 func (b *batchSelection) unmark(ctx context.Context) error {
@@ -583,28 +817,26 @@ func (b *batchSelection) markBatchSelection(ctx context.Context) error {
 		// Limit already reached.  Do not mark any device for rollout
 		return nil
 	}
-	listParams, annotationSelector := newQuerySelectorParts().
-		withOwner(b.fleetName).
-		withLabelSelector(b.batch.Selector).
-		withoutRolledOut(b.templateVersionName).
-		withoutDisconnected().
-		listParams()
+	listParams, annotationSelector := rollout.BatchQuery(rollout.NewDeviceQuery().WithOwner(b.fleetName), b.batch).
+		WithoutRolledOut(b.templateVersionName).
+		WithoutDisconnected().
+		ListParams()
 	return service.ApiStatusToErr(b.serviceHandler.MarkDevicesRolloutSelection(ctx, b.orgId, listParams, annotationSelector, limit))
 }
 
 func (b *batchSelection) markRemainingDevices(ctx context.Context) error {
-	listParams, annotationSelector := newQuerySelectorParts().
-		withOwner(b.fleetName).
-		withoutRolledOut(b.templateVersionName).
-		listParams()
+	listParams, annotationSelector := rollout.NewDeviceQuery().
+		WithOwner(b.fleetName).
+		WithoutRolledOut(b.templateVersionName).
+		ListParams()
 	return service.ApiStatusToErr(b.serviceHandler.MarkDevicesRolloutSelection(ctx, b.orgId, listParams, annotationSelector, nil))
 }
 
 func (b *batchSelection) Devices(ctx context.Context) (*domain.DeviceList, error) {
-	listParams, annotationSelector := newQuerySelectorParts().
-		withOwner(b.fleetName).
-		withSelectedForRollout().
-		listParams()
+	listParams, annotationSelector := rollout.NewDeviceQuery().
+		WithOwner(b.fleetName).
+		WithSelectedForRollout().
+		ListParams()
 	result, status := b.serviceHandler.ListDevices(ctx, b.orgId, listParams, annotationSelector)
 	return result, service.ApiStatusToErr(status)
 }
@@ -613,8 +845,19 @@ func (b *batchSelection) OnRollout(ctx context.Context) error {
 	return b.conditionEmitter.active(ctx)
 }
 
+func (b *batchSelection) OnOutsideMaintenanceWindow(ctx context.Context, nextWindowStart time.Time) error {
+	return b.conditionEmitter.outsideMaintenanceWindow(ctx, nextWindowStart)
+}
+
 func (b *batchSelection) OnSuspended(ctx context.Context) error {
 	if b.isApprovalMethodAutomatic() {
+		successful, err := b.isLastBatchSuccessful()
+		if err != nil {
+			return fmt.Errorf("failed to check last batch success: %w", err)
+		}
+		if successful && b.requiresApproval() {
+			return b.conditionEmitter.awaitingApproval(ctx)
+		}
 		report, exists, err := b.getLastCompletionReport()
 		if err != nil {
 			return fmt.Errorf("failed to get last completion report: %w", err)
@@ -633,5 +876,12 @@ func (b *batchSelection) OnSuspended(ctx context.Context) error {
 }
 
 func (b *batchSelection) OnFinish(ctx context.Context) error {
+	var condition *domain.Condition
+	if b.fleet.Status != nil {
+		condition = domain.FindStatusCondition(b.fleet.Status.Conditions, domain.ConditionTypeFleetRolloutInProgress)
+	}
+	if condition == nil || condition.Reason != domain.RolloutInactiveReason {
+		b.rolloutRecorder.ended(ctx, b.templateVersionName, domain.RolloutOutcomeCompleted)
+	}
 	return b.conditionEmitter.inactive(ctx)
 }
@@ -3,6 +3,7 @@ package device_selection
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/service"
@@ -54,12 +55,62 @@ func (c *conditionEmitter) active(ctx context.Context) error {
 	))
 }
 
+func (c *conditionEmitter) soaking(ctx context.Context, soakDuration time.Duration) error {
+	return c.save(ctx, c.create(
+		domain.ConditionStatusTrue,
+		domain.RolloutActiveReason,
+		fmt.Sprintf("Soaking %s: waiting for its devices to stay online and healthy for %s", c.batchName, soakDuration),
+	))
+}
+
+func (c *conditionEmitter) holdingStep(ctx context.Context, nextStepAt time.Time) error {
+	return c.save(ctx, c.create(
+		domain.ConditionStatusTrue,
+		domain.RolloutActiveReason,
+		fmt.Sprintf("Holding %s until %s before proceeding to the next step", c.batchName, nextStepAt.Format(time.RFC3339)),
+	))
+}
+
 func (c *conditionEmitter) suspended(ctx context.Context, threshold int, completionReport domain.RolloutBatchCompletionReport) error {
 	return c.save(ctx, c.create(
 		domain.ConditionStatusFalse,
 		domain.RolloutSuspendedReason,
-		fmt.Sprintf("%s failed: %d%% of batch devices were updated successfully, while success threshold was set to %d%%; Breakdown: total=%d successful=%d failed=%d timed out=%d",
-			completionReport.BatchName, completionReport.SuccessPercentage, threshold, completionReport.Total, completionReport.Successful, completionReport.Failed, completionReport.TimedOut),
+		fmt.Sprintf("%s; Breakdown: total=%d successful=%d failed=%d timed out=%d",
+			batchFailure(threshold, completionReport), completionReport.Total, completionReport.Successful, completionReport.Failed, completionReport.TimedOut),
+	))
+}
+
+func (c *conditionEmitter) rolledBack(ctx context.Context, previousTemplateVersion string, threshold int, completionReport domain.RolloutBatchCompletionReport) error {
+	return c.save(ctx, c.create(
+		domain.ConditionStatusFalse,
+		domain.RolloutRolledBackReason,
+		fmt.Sprintf("%s; Rolled back to template version %s; Breakdown: total=%d successful=%d failed=%d timed out=%d",
+			batchFailure(threshold, completionReport), previousTemplateVersion, completionReport.Total, completionReport.Successful, completionReport.Failed, completionReport.TimedOut),
+	))
+}
+
+// batchFailure describes why a batch failed
+func batchFailure(threshold int, completionReport domain.RolloutBatchCompletionReport) string {
+	if completionReport.SoakFailed {
+		return fmt.Sprintf("%s failed: its devices did not stay online and healthy for the soak duration", completionReport.BatchName)
+	}
+	return fmt.Sprintf("%s failed: %d%% of batch devices were updated successfully, while success threshold was set to %d%%",
+		completionReport.BatchName, completionReport.SuccessPercentage, threshold)
+}
+
+func (c *conditionEmitter) outsideMaintenanceWindow(ctx context.Context, nextWindowStart time.Time) error {
+	return c.save(ctx, c.create(
+		domain.ConditionStatusFalse,
+		domain.RolloutOutsideMaintenanceWindowReason,
+		fmt.Sprintf("Waiting for the maintenance window that opens at %s to roll out %s", nextWindowStart.Format(time.RFC3339), c.batchName),
+	))
+}
+
+func (c *conditionEmitter) awaitingApproval(ctx context.Context) error {
+	return c.save(ctx, c.create(
+		domain.ConditionStatusFalse,
+		domain.RolloutAwaitingApprovalReason,
+		fmt.Sprintf("Waiting for %s to be approved", c.batchName),
 	))
 }
 
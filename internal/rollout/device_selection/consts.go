@@ -6,4 +6,8 @@ const (
 	RolloutDeviceSelectionInterval = 30 * time.Second
 	DefaultSuccessThreshold        = 90
 	DefaultUpdateTimeout           = 24 * time.Hour
+
+	// The devices of a canary batch must have stayed healthy for the soak duration within this many soak durations
+	// after the soak started, or else the batch fails
+	SoakDeadlineFactor = 2
 )
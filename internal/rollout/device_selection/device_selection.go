@@ -30,9 +30,12 @@ type Selection interface {
 	IsApproved() bool
 	IsRolledOut(ctx context.Context) (bool, error)
 	MayApproveAutomatically() (bool, error)
+	ShouldRollback() (bool, error)
+	Rollback(ctx context.Context) error
 	IsComplete(ctx context.Context) (bool, error)
 	SetCompletionReport(ctx context.Context) error
 	OnRollout(ctx context.Context) error
+	OnOutsideMaintenanceWindow(ctx context.Context, nextWindowStart time.Time) error
 	OnSuspended(ctx context.Context) error
 	OnFinish(ctx context.Context) error
 }
@@ -65,6 +68,10 @@ func NewRolloutDeviceSelector(deviceSelection *domain.RolloutDeviceSelection, de
 	switch v := selectorInterface.(type) {
 	case domain.BatchSequence:
 		return newBatchSequenceSelector(v, updateTimeout, serviceHandler, orgId, fleet, templateVersionName, log), nil
+	case domain.Canary:
+		return newCanarySelector(v, updateTimeout, serviceHandler, orgId, fleet, templateVersionName, log)
+	case domain.Progressive:
+		return newProgressiveSelector(v, updateTimeout, serviceHandler, orgId, fleet, templateVersionName, log)
 	default:
 		return nil, fmt.Errorf("unexpected selector %T", selectorInterface)
 	}
@@ -79,6 +86,12 @@ func cleanupRollout(ctx context.Context, orgId uuid.UUID, fleet *domain.Fleet, s
 		domain.FleetAnnotationRolloutApprovalMethod,
 		domain.FleetAnnotationDeployingTemplateVersion,
 		domain.FleetAnnotationDeviceSelectionConfigDigest,
+		domain.FleetAnnotationRollbackTemplateVersion,
+		domain.FleetAnnotationCanaryHealthySince,
+		domain.FleetAnnotationCanarySoakStartedAt,
+		domain.FleetAnnotationProgressiveStepStartedAt,
+		domain.FleetAnnotationRolloutPaused,
+		domain.FleetAnnotationRolloutAborted,
 	}
 	if lo.NoneBy(annotationsToDelete, func(ann string) bool {
 		return lo.HasKey(lo.CoalesceMapOrEmpty(lo.FromPtr(fleet.Metadata.Annotations)), ann)
@@ -3,8 +3,10 @@ package device_selection
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/rollout"
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/util"
@@ -74,6 +76,11 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 		}
 		if rolloutWasActive {
 
+			// The batched rollout in progress is replaced by the rollout of the entire fleet
+			if deployingTemplateVersion, exists := annotations[domain.FleetAnnotationDeployingTemplateVersion]; exists {
+				newRolloutRecorder(orgId, fleetName, deployingTemplateVersion, r.serviceHandler, r.log).ended(ctx, deployingTemplateVersion, domain.RolloutOutcomeSuperseded)
+			}
+
 			// Send the entire fleet for rollout
 			r.emitFleetRolloutStartedEventDueToPolicyRemoval(ctx, orgId, fleet, annotations)
 		}
@@ -104,6 +111,27 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 			r.log.WithError(err).Errorf("%v/%s: Reset", orgId, fleetName)
 			return
 		}
+	} else if rollbackTemplateVersion, rolledBack := annotations[domain.FleetAnnotationRollbackTemplateVersion]; rolledBack {
+		// The rollout was rolled back. It stays halted until a new template version or rollout definition is applied
+		r.log.Debugf("%v/%s: Rollout of template version %s was rolled back to %s", orgId, fleetName, templateVersionName, rollbackTemplateVersion)
+		return
+	} else if rollout.IsAborted(&fleet) {
+		// The rollout was aborted by a user. It stays halted until a new template version or rollout definition is applied
+		r.log.Debugf("%v/%s: Rollout of template version %s was aborted", orgId, fleetName, templateVersionName)
+		return
+	} else if rollout.IsPaused(&fleet) {
+		// No batch is approved, dispatched or advanced while the rollout is paused
+		r.log.Debugf("%v/%s: Rollout of template version %s is paused", orgId, fleetName, templateVersionName)
+		var condition *domain.Condition
+		if fleet.Status != nil {
+			condition = domain.FindStatusCondition(fleet.Status.Conditions, domain.ConditionTypeFleetRolloutInProgress)
+		}
+		if condition == nil || condition.Reason != domain.RolloutPausedReason {
+			if status := r.serviceHandler.UpdateFleetConditions(ctx, orgId, fleetName, []domain.Condition{rollout.PausedCondition()}); status.Code != http.StatusOK {
+				r.log.WithError(service.ApiStatusToErr(status)).Errorf("%v/%s: UpdateFleetConditions", orgId, fleetName)
+			}
+		}
+		return
 	}
 
 	for {
@@ -116,6 +144,19 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 
 		if !selection.IsApproved() {
 
+			// A batch that missed its success threshold is rolled back if the rollout policy requires it
+			shouldRollback, err := selection.ShouldRollback()
+			if err != nil {
+				r.log.WithError(err).Errorf("%v/%s: ShouldRollback", orgId, fleetName)
+				break
+			}
+			if shouldRollback {
+				if err = selection.Rollback(ctx); err != nil {
+					r.log.WithError(err).Errorf("%v/%s: Rollback", orgId, fleetName)
+				}
+				break
+			}
+
 			// A batch may be approved either by a user or automatically
 			mayApprove, err := selection.MayApproveAutomatically()
 			if err != nil {
@@ -142,6 +183,19 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 			break
 		}
 		if !isRolledOut {
+
+			// Batches are dispatched only within the maintenance windows of the fleet
+			inWindow, nextWindow, err := rollout.InMaintenanceWindow(&fleet, time.Now())
+			if err != nil {
+				r.log.WithError(err).Errorf("%v/%s: InMaintenanceWindow", orgId, fleetName)
+				break
+			}
+			if !inWindow {
+				if err = selection.OnOutsideMaintenanceWindow(ctx, nextWindow.Start); err != nil {
+					r.log.WithError(err).Errorf("%v/%s: OnOutsideMaintenanceWindow", orgId, fleetName)
+				}
+				break
+			}
 			if err = selection.OnRollout(ctx); err != nil {
 				r.log.WithError(err).Errorf("%v/%s: OnRollout", orgId, fleetName)
 			}
@@ -0,0 +1,91 @@
+package device_selection
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/rollout"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// rolloutRecorder keeps the rollout record in the status of the template version being rolled out.  The record
+// doesn't affect the rollout itself, so failures to keep it are only logged.
+type rolloutRecorder struct {
+	orgId               uuid.UUID
+	fleetName           string
+	templateVersionName string
+	serviceHandler      service.Service
+	log                 logrus.FieldLogger
+}
+
+func newRolloutRecorder(orgId uuid.UUID, fleetName, templateVersionName string, serviceHandler service.Service, log logrus.FieldLogger) *rolloutRecorder {
+	return &rolloutRecorder{
+		orgId:               orgId,
+		fleetName:           fleetName,
+		templateVersionName: templateVersionName,
+		serviceHandler:      serviceHandler,
+		log:                 log,
+	}
+}
+
+func (r *rolloutRecorder) getRecord(ctx context.Context, templateVersionName string) (*domain.TemplateVersionRolloutRecord, bool) {
+	tv, status := r.serviceHandler.GetTemplateVersion(ctx, r.orgId, r.fleetName, templateVersionName)
+	if status.Code != http.StatusOK {
+		r.log.WithError(service.ApiStatusToErr(status)).Warnf("%v/%s: failed to get template version %s for its rollout record", r.orgId, r.fleetName, templateVersionName)
+		return nil, false
+	}
+	if tv.Status == nil {
+		return nil, true
+	}
+	return tv.Status.Rollout, true
+}
+
+func (r *rolloutRecorder) save(ctx context.Context, templateVersionName string, record domain.TemplateVersionRolloutRecord) {
+	if status := r.serviceHandler.UpdateTemplateVersionRolloutRecord(ctx, r.orgId, r.fleetName, templateVersionName, record); status.Code != http.StatusOK {
+		r.log.WithError(service.ApiStatusToErr(status)).Warnf("%v/%s: failed to save the rollout record of template version %s", r.orgId, r.fleetName, templateVersionName)
+	}
+}
+
+// update applies the update to the rollout record of the given template version, if it has one
+func (r *rolloutRecorder) update(ctx context.Context, templateVersionName string, update func(*domain.TemplateVersionRolloutRecord) bool) {
+	record, ok := r.getRecord(ctx, templateVersionName)
+	if !ok || record == nil {
+		return
+	}
+	if update(record) {
+		r.save(ctx, templateVersionName, *record)
+	}
+}
+
+// started records the start of the rollout of the template version.  The rollout of a template version that
+// restarts because the rollout definition was updated keeps its record.  If the rollout replaces the rollout of
+// another template version, the other rollout is recorded as superseded.
+func (r *rolloutRecorder) started(ctx context.Context, previousTemplateVersionName string) {
+	if previousTemplateVersionName != "" && previousTemplateVersionName != r.templateVersionName {
+		r.ended(ctx, previousTemplateVersionName, domain.RolloutOutcomeSuperseded)
+	}
+	record, ok := r.getRecord(ctx, r.templateVersionName)
+	if !ok || record != nil && record.Outcome == domain.RolloutOutcomeInProgress {
+		return
+	}
+	r.save(ctx, r.templateVersionName, rollout.NewRolloutRecord(time.Now()))
+}
+
+// batchCompleted records the completion report of a batch of the rollout
+func (r *rolloutRecorder) batchCompleted(ctx context.Context, report domain.RolloutBatchCompletionReport) {
+	r.update(ctx, r.templateVersionName, func(record *domain.TemplateVersionRolloutRecord) bool {
+		rollout.AddBatchRecord(record, report, time.Now())
+		return true
+	})
+}
+
+// ended records the outcome of the rollout of the given template version
+func (r *rolloutRecorder) ended(ctx context.Context, templateVersionName string, outcome domain.RolloutOutcome) {
+	r.update(ctx, templateVersionName, func(record *domain.TemplateVersionRolloutRecord) bool {
+		return rollout.EndRolloutRecord(record, outcome, time.Now())
+	})
+}
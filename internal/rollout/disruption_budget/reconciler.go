@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/rollout"
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/store/selector"
@@ -179,9 +180,8 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 		}
 		return nil
 	}
-	maxUnavailable := fleet.Spec.RolloutPolicy.DisruptionBudget.MaxUnavailable
-	minAvailable := fleet.Spec.RolloutPolicy.DisruptionBudget.MinAvailable
-	if maxUnavailable == nil && minAvailable == nil {
+	budget := *fleet.Spec.RolloutPolicy.DisruptionBudget
+	if budget.MaxUnavailable == nil && budget.MinAvailable == nil {
 		return fmt.Errorf("both maxUnavailable and minAvailable for fleet %s are nil", lo.FromPtr(fleet.Metadata.Name))
 	}
 	counts, err := r.getFleetCounts(ctx, orgId, fleet)
@@ -189,15 +189,7 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 		return fmt.Errorf("getFleetCounts: %w", err)
 	}
 	for _, count := range counts {
-		unavailable := int(count.busyConnectedCount)
-		available := int(count.connectedCount - count.busyConnectedCount)
-		numToRender := math.MaxInt
-		if maxUnavailable != nil {
-			numToRender = util.Min(numToRender, lo.FromPtr(maxUnavailable)-unavailable)
-		}
-		if minAvailable != nil {
-			numToRender = util.Min(numToRender, available-util.Min(lo.FromPtr(minAvailable), int(count.totalCount-1)))
-		}
+		numToRender := rollout.DisruptionBudgetAllowance(budget, int(count.totalCount), int(count.connectedCount), int(count.busyConnectedCount))
 		if numToRender > 0 {
 			if err = r.reconcileSelectionDevices(ctx, orgId, fleet, count.key, numToRender); err != nil {
 				return fmt.Errorf("reconcileSelectionDevices: %w", err)
@@ -223,6 +215,10 @@ func (r *reconciler) Reconcile(ctx context.Context, orgID uuid.UUID) {
 		if !exists {
 			continue
 		}
+		if rollout.IsPaused(fleet) {
+			// Devices of a paused rollout are rendered once it is resumed
+			continue
+		}
 		if err := r.reconcileFleet(ctx, orgID, fleet); err != nil {
 			r.log.WithError(err).Errorf("reconcile fleet %v/%s", orgID, lo.FromPtr(fleet.Metadata.Name))
 		}
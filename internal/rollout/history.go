@@ -0,0 +1,49 @@
+package rollout
+
+import (
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/samber/lo"
+)
+
+// NewRolloutRecord returns the record of a rollout of a template version that starts at the given time
+func NewRolloutRecord(now time.Time) domain.TemplateVersionRolloutRecord {
+	return domain.TemplateVersionRolloutRecord{
+		StartedAt: now.UTC(),
+		Outcome:   domain.RolloutOutcomeInProgress,
+		Batches:   []domain.RolloutBatchRecord{},
+	}
+}
+
+// AddBatchRecord adds the completion report of a batch to the record of a rollout.  A report of a batch that
+// was already recorded replaces the earlier one.
+func AddBatchRecord(record *domain.TemplateVersionRolloutRecord, report domain.RolloutBatchCompletionReport, now time.Time) {
+	batch := domain.RolloutBatchRecord{
+		Name:              report.BatchName,
+		CompletedAt:       now.UTC(),
+		Total:             report.Total,
+		Successful:        report.Successful,
+		Failed:            report.Failed,
+		TimedOut:          report.TimedOut,
+		SuccessPercentage: report.SuccessPercentage,
+	}
+	for i := range record.Batches {
+		if record.Batches[i].Name == batch.Name {
+			record.Batches[i] = batch
+			return
+		}
+	}
+	record.Batches = append(record.Batches, batch)
+}
+
+// EndRolloutRecord sets the outcome of a rollout that is still in progress, and returns false if the rollout had
+// already ended
+func EndRolloutRecord(record *domain.TemplateVersionRolloutRecord, outcome domain.RolloutOutcome, now time.Time) bool {
+	if record.Outcome != domain.RolloutOutcomeInProgress {
+		return false
+	}
+	record.Outcome = outcome
+	record.CompletedAt = lo.ToPtr(now.UTC())
+	return true
+}
@@ -0,0 +1,27 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRolloutRecord(t *testing.T) {
+	start := time.Date(2026, 10, 17, 2, 0, 0, 0, time.UTC)
+	record := NewRolloutRecord(start)
+	require.Equal(t, domain.RolloutOutcomeInProgress, record.Outcome)
+
+	AddBatchRecord(&record, domain.RolloutBatchCompletionReport{BatchName: "batch 1", Total: 4, Successful: 3, Failed: 1, SuccessPercentage: 75}, start.Add(time.Hour))
+	AddBatchRecord(&record, domain.RolloutBatchCompletionReport{BatchName: "batch 1", Total: 4, Successful: 4, SuccessPercentage: 100}, start.Add(2*time.Hour))
+	AddBatchRecord(&record, domain.RolloutBatchCompletionReport{BatchName: "batch 2", Total: 2, Successful: 2, SuccessPercentage: 100}, start.Add(3*time.Hour))
+	require.Len(t, record.Batches, 2)
+	require.Equal(t, int64(100), record.Batches[0].SuccessPercentage)
+
+	require.True(t, EndRolloutRecord(&record, domain.RolloutOutcomeCompleted, start.Add(4*time.Hour)))
+	require.False(t, EndRolloutRecord(&record, domain.RolloutOutcomeSuperseded, start.Add(5*time.Hour)))
+	require.Equal(t, domain.RolloutOutcomeCompleted, record.Outcome)
+	require.True(t, start.Add(4*time.Hour).Equal(lo.FromPtr(record.CompletedAt)))
+}
@@ -0,0 +1,98 @@
+package rollout
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/robfig/cron/v3"
+	"github.com/samber/lo"
+)
+
+// MaintenanceWindow is a single occurrence of a rollout maintenance window
+type MaintenanceWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// IsOpen returns true if the window is open at the given time
+func (m MaintenanceWindow) IsOpen(now time.Time) bool {
+	return !now.Before(m.Start) && !now.After(m.End)
+}
+
+func parseMaintenanceWindow(window domain.UpdateSchedule) (cron.Schedule, *time.Location, time.Duration, error) {
+	location := time.Local
+	if window.TimeZone != nil {
+		loc, err := time.LoadLocation(lo.FromPtr(window.TimeZone))
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("invalid time zone: %w", err)
+		}
+		location = loc
+	}
+	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, err := parser.Parse(window.At)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("invalid cron expression: %w", err)
+	}
+	duration, err := time.ParseDuration(window.StartGraceDuration)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("invalid start grace duration: %w", err)
+	}
+	return schedule, location, duration, nil
+}
+
+// currentOrNext returns the occurrence of the window that is open at the given time, or the one that opens next
+func currentOrNext(window domain.UpdateSchedule, now time.Time) (MaintenanceWindow, error) {
+	schedule, location, duration, err := parseMaintenanceWindow(window)
+	if err != nil {
+		return MaintenanceWindow{}, err
+	}
+	now = now.In(location)
+
+	// The latest occurrence that may still be open started no earlier than one duration ago
+	start := schedule.Next(now.Add(-duration).Add(-time.Nanosecond))
+	if start.After(now) {
+		start = schedule.Next(now)
+	}
+	return MaintenanceWindow{Start: start, End: start.Add(duration)}, nil
+}
+
+// CurrentOrNextMaintenanceWindow returns the maintenance window that is open at the given time, or the one that
+// opens next if none is open.  If several windows are open, the one that stays open the longest is returned.
+// Nil is returned if there are no maintenance windows.
+func CurrentOrNextMaintenanceWindow(windows []domain.UpdateSchedule, now time.Time) (*MaintenanceWindow, error) {
+	var ret *MaintenanceWindow
+	for i := range windows {
+		window, err := currentOrNext(windows[i], now)
+		if err != nil {
+			return nil, fmt.Errorf("maintenance window %d: %w", i, err)
+		}
+		switch {
+		case ret == nil:
+			ret = &window
+		case window.IsOpen(now):
+			if !ret.IsOpen(now) || window.End.After(ret.End) {
+				ret = &window
+			}
+		case !ret.IsOpen(now) && window.Start.Before(ret.Start):
+			ret = &window
+		}
+	}
+	return ret, nil
+}
+
+// InMaintenanceWindow returns true if new rollout batches of the fleet may be dispatched at the given time.  If
+// they may not, the maintenance window that opens next is returned as well.
+func InMaintenanceWindow(fleet *domain.Fleet, now time.Time) (bool, *MaintenanceWindow, error) {
+	if fleet.Spec.RolloutPolicy == nil {
+		return true, nil, nil
+	}
+	window, err := CurrentOrNextMaintenanceWindow(lo.FromPtr(fleet.Spec.RolloutPolicy.MaintenanceWindows), now)
+	if err != nil {
+		return false, nil, err
+	}
+	if window == nil || window.IsOpen(now) {
+		return true, nil, nil
+	}
+	return false, window, nil
+}
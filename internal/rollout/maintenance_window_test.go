@@ -0,0 +1,70 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrentOrNextMaintenanceWindow(t *testing.T) {
+	nightly := domain.UpdateSchedule{At: "0 2 * * *", StartGraceDuration: "2h", TimeZone: lo.ToPtr("UTC")}
+	weekend := domain.UpdateSchedule{At: "0 0 * * 6", StartGraceDuration: "48h", TimeZone: lo.ToPtr("UTC")}
+	date := func(day, hour, minute int) time.Time {
+		// 2026-10-17 is a Saturday
+		return time.Date(2026, 10, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name      string
+		windows   []domain.UpdateSchedule
+		now       time.Time
+		wantNil   bool
+		wantStart time.Time
+		wantOpen  bool
+	}{
+		{name: "no windows", now: date(15, 12, 0), wantNil: true},
+		{name: "before window", windows: []domain.UpdateSchedule{nightly}, now: date(15, 1, 0), wantStart: date(15, 2, 0)},
+		{name: "window opens", windows: []domain.UpdateSchedule{nightly}, now: date(15, 2, 0), wantStart: date(15, 2, 0), wantOpen: true},
+		{name: "inside window", windows: []domain.UpdateSchedule{nightly}, now: date(15, 3, 30), wantStart: date(15, 2, 0), wantOpen: true},
+		{name: "window closes", windows: []domain.UpdateSchedule{nightly}, now: date(15, 4, 0), wantStart: date(15, 2, 0), wantOpen: true},
+		{name: "after window", windows: []domain.UpdateSchedule{nightly}, now: date(15, 4, 1), wantStart: date(16, 2, 0)},
+		{name: "earliest next window", windows: []domain.UpdateSchedule{weekend, nightly}, now: date(15, 12, 0), wantStart: date(16, 2, 0)},
+		{name: "open window preferred", windows: []domain.UpdateSchedule{nightly, weekend}, now: date(18, 12, 0), wantStart: date(17, 0, 0), wantOpen: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window, err := CurrentOrNextMaintenanceWindow(tt.windows, tt.now)
+			require.NoError(t, err)
+			if tt.wantNil {
+				require.Nil(t, window)
+				return
+			}
+			require.NotNil(t, window)
+			require.True(t, tt.wantStart.Equal(window.Start), "expected start %s, got %s", tt.wantStart, window.Start)
+			require.Equal(t, tt.wantOpen, window.IsOpen(tt.now))
+		})
+	}
+}
+
+func TestInMaintenanceWindow(t *testing.T) {
+	fleet := &domain.Fleet{Spec: domain.FleetSpec{RolloutPolicy: &domain.RolloutPolicy{
+		MaintenanceWindows: &[]domain.UpdateSchedule{{At: "0 2 * * *", StartGraceDuration: "1h", TimeZone: lo.ToPtr("UTC")}},
+	}}}
+
+	inWindow, next, err := InMaintenanceWindow(fleet, time.Date(2026, 10, 17, 2, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.True(t, inWindow)
+	require.Nil(t, next)
+
+	inWindow, next, err = InMaintenanceWindow(fleet, time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.False(t, inWindow)
+	require.True(t, time.Date(2026, 10, 18, 2, 0, 0, 0, time.UTC).Equal(next.Start))
+
+	inWindow, _, err = InMaintenanceWindow(&domain.Fleet{}, time.Now())
+	require.NoError(t, err)
+	require.True(t, inWindow)
+}
@@ -0,0 +1,305 @@
+package rollout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// The name of the single batch of a preview of a fleet that has no device selection
+const AllDevicesBatchName = "all devices"
+
+// ErrListDevices is returned by PreviewRollout if the devices of a query could not be listed
+var ErrListDevices = errors.New("failed to list devices")
+
+// BatchLimit returns the number of devices that may still be selected into a batch with the given limit.  The counts
+// function returns the number of devices matching the batch selector that were already rolled out, and the total
+// number of devices matching the batch selector.  It is only called for percentage limits.  Nil is returned if the
+// batch is not limited.
+func BatchLimit(limit *domain.Batch_Limit, counts func() (int, int, error)) (*int, error) {
+	if limit == nil {
+		return nil, nil
+	}
+	intBatchLimit, intErr := limit.AsBatchLimit1()
+	if intErr == nil {
+		return &intBatchLimit, nil
+	}
+	percentageStr, pErr := limit.AsPercentage()
+	if pErr != nil {
+		return nil, errors.Join(intErr, pErr)
+	}
+	percentage, err := util.PercentageAsInt(percentageStr)
+	if err != nil {
+		return nil, err
+	}
+	if percentage == 100 {
+		// Ignore limit
+		return nil, nil
+	}
+	rolledOut, total, err := counts()
+	if err != nil {
+		return nil, err
+	}
+	res := int(math.Round(float64(total)*float64(percentage)/100.0)) - rolledOut
+	return &res, nil
+}
+
+// DisruptionBudgetAllowance returns the number of additional devices of a disruption budget group that may be
+// updated at the same time, given the number of devices in the group, how many of them are connected, and how many
+// of the connected devices are busy updating.  The result may be zero or negative.
+func DisruptionBudgetAllowance(budget domain.DisruptionBudget, total, connected, busyConnected int) int {
+	unavailable := busyConnected
+	available := connected - busyConnected
+	numToRender := math.MaxInt
+	if budget.MaxUnavailable != nil {
+		numToRender = util.Min(numToRender, lo.FromPtr(budget.MaxUnavailable)-unavailable)
+	}
+	if budget.MinAvailable != nil {
+		numToRender = util.Min(numToRender, available-util.Min(lo.FromPtr(budget.MinAvailable), total-1))
+	}
+	return numToRender
+}
+
+func isConnected(device *domain.Device) bool {
+	return device.Status != nil && device.Status.Summary.Status != domain.DeviceSummaryStatusUnknown
+}
+
+func isBusyConnected(device *domain.Device) bool {
+	renderedVersion, exists := util.GetFromMap(lo.FromPtr(device.Metadata.Annotations), domain.DeviceAnnotationRenderedVersion)
+	return isConnected(device) && exists && device.Status.Config.RenderedVersion != renderedVersion
+}
+
+// previewBatchSequence returns the batch sequence of the spec and the names of its configured batches
+func previewBatchSequence(spec domain.FleetSpec) (*domain.BatchSequence, func(int) string, error) {
+	if spec.RolloutPolicy == nil || spec.RolloutPolicy.DeviceSelection == nil {
+		return nil, nil, nil
+	}
+	intf, err := spec.RolloutPolicy.DeviceSelection.ValueByDiscriminator()
+	if err != nil {
+		return nil, nil, fmt.Errorf("value by discriminator: %w", err)
+	}
+	switch value := intf.(type) {
+	case domain.BatchSequence:
+		return &value, func(i int) string { return fmt.Sprintf("batch %d", i+1) }, nil
+	case domain.Canary:
+		return lo.ToPtr(CanaryBatchSequence(value)), func(int) string { return domain.CanaryBatchName }, nil
+	case domain.Progressive:
+		return lo.ToPtr(ProgressiveBatchSequence(value)), func(i int) string { return ProgressiveStepName(i, value) }, nil
+	default:
+		return nil, nil, fmt.Errorf("unexpected type for device selection %T", intf)
+	}
+}
+
+type previewGroup struct {
+	labels        map[string]string
+	total         int
+	connected     int
+	busyConnected int
+}
+
+func groupKey(groupBy []string, labels map[string]string) string {
+	return strings.Join(lo.Map(groupBy, func(k string, _ int) string {
+		v, exists := labels[k]
+		return fmt.Sprintf("%t:%s", exists, v)
+	}), "\x00")
+}
+
+// DeviceLister lists the devices of an organization that match the list parameters
+type DeviceLister interface {
+	ListDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (*domain.DeviceList, domain.Status)
+}
+
+// listPreviewDevices returns the devices that a query matches, ordered by name
+func listPreviewDevices(ctx context.Context, lister DeviceLister, orgId uuid.UUID, query *DeviceQuery) ([]*domain.Device, error) {
+	params, annotationSelector := query.ListParams()
+	var ret []*domain.Device
+	for {
+		deviceList, status := lister.ListDevices(ctx, orgId, params, annotationSelector)
+		switch status.Code {
+		case http.StatusOK:
+		case http.StatusBadRequest:
+			// The selectors of the spec are invalid
+			return nil, errors.New(status.Message)
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrListDevices, status.Message)
+		}
+		for i := range deviceList.Items {
+			ret = append(ret, &deviceList.Items[i])
+		}
+		if deviceList.Metadata.Continue == nil {
+			break
+		}
+		params.Continue = deviceList.Metadata.Continue
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return lo.FromPtr(ret[i].Metadata.Name) < lo.FromPtr(ret[j].Metadata.Name)
+	})
+	return ret, nil
+}
+
+// PreviewRollout returns how a rollout of the given fleet spec would select the devices into batches and how the
+// disruption budget of the spec would throttle them.  It runs the queries of the rollout device selection without
+// marking any device.  Since the devices that the spec selects are not necessarily owned by the fleet yet, the
+// queries are scoped to the devices that match the selector of the spec and are either owned by the fleet or not
+// owned at all, rather than to the devices owned by the fleet.  The preview assumes that none of the devices was
+// rolled out yet, as would be the case for a new template version.
+func PreviewRollout(ctx context.Context, lister DeviceLister, orgId uuid.UUID, fleetName string, spec domain.FleetSpec) (*domain.RolloutPreview, error) {
+	fleetOwner := util.ResourceOwner(domain.FleetKind, fleetName)
+	ret := &domain.RolloutPreview{
+		Batches:           []domain.RolloutPreviewBatch{},
+		UnselectedDevices: []domain.RolloutPreviewUnselectedDevice{},
+	}
+
+	var scope func() *DeviceQuery
+	population := make(map[string]*domain.Device)
+	if matchLabels := lo.FromPtr(lo.FromPtr(spec.Selector).MatchLabels); len(matchLabels) > 0 {
+		scope = func() *DeviceQuery {
+			return NewDeviceQuery().WithLabelSelector(&domain.LabelSelector{MatchLabels: &matchLabels})
+		}
+		matching, err := listPreviewDevices(ctx, lister, orgId, scope())
+		if err != nil {
+			return nil, err
+		}
+		for _, device := range matching {
+			owner := lo.FromPtr(device.Metadata.Owner)
+			if owner != "" && owner != fleetOwner {
+				ret.UnselectedDevices = append(ret.UnselectedDevices, domain.RolloutPreviewUnselectedDevice{
+					Name:   lo.FromPtr(device.Metadata.Name),
+					Reason: fmt.Sprintf("owned by %s", owner),
+				})
+				continue
+			}
+			population[lo.FromPtr(device.Metadata.Name)] = device
+		}
+	}
+	owned, err := listPreviewDevices(ctx, lister, orgId, NewDeviceQuery().WithOwner(fleetName))
+	if err != nil {
+		return nil, err
+	}
+	for _, device := range owned {
+		if _, selected := population[lo.FromPtr(device.Metadata.Name)]; !selected {
+			ret.UnselectedDevices = append(ret.UnselectedDevices, domain.RolloutPreviewUnselectedDevice{
+				Name:   lo.FromPtr(device.Metadata.Name),
+				Reason: "not selected by the fleet selector",
+			})
+		}
+	}
+	sort.Slice(ret.UnselectedDevices, func(i, j int) bool {
+		return ret.UnselectedDevices[i].Name < ret.UnselectedDevices[j].Name
+	})
+
+	// listPopulation returns the devices of the population that a query within the scope matches
+	listPopulation := func(query func() *DeviceQuery) ([]*domain.Device, error) {
+		if scope == nil {
+			return nil, nil
+		}
+		devices, err := listPreviewDevices(ctx, lister, orgId, query())
+		if err != nil {
+			return nil, err
+		}
+		return lo.Filter(devices, func(d *domain.Device, _ int) bool { return population[lo.FromPtr(d.Metadata.Name)] != nil }), nil
+	}
+	allDevices, err := listPopulation(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, batchName, err := previewBatchSequence(spec)
+	if err != nil {
+		return nil, err
+	}
+	var batchDevices [][]*domain.Device
+	if sequence == nil {
+		ret.Batches = append(ret.Batches, domain.RolloutPreviewBatch{Name: AllDevicesBatchName})
+		batchDevices = append(batchDevices, allDevices)
+	} else {
+		selected := make(map[string]bool)
+		for i := range lo.FromPtr(sequence.Sequence) {
+			batch := &lo.FromPtr(sequence.Sequence)[i]
+
+			// The same queries as the ones that limit and mark the devices of the batch
+			matching, err := listPopulation(func() *DeviceQuery { return BatchQuery(scope(), batch) })
+			if err != nil {
+				return nil, fmt.Errorf("batch %d: %w", i+1, err)
+			}
+			connected, err := listPopulation(func() *DeviceQuery { return BatchQuery(scope(), batch).WithoutDisconnected() })
+			if err != nil {
+				return nil, fmt.Errorf("batch %d: %w", i+1, err)
+			}
+			limit, err := BatchLimit(batch.Limit, func() (int, int, error) {
+				return lo.CountBy(matching, func(d *domain.Device) bool { return selected[lo.FromPtr(d.Metadata.Name)] }), len(matching), nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("batch %d: %w", i+1, err)
+			}
+			candidates := lo.Filter(connected, func(d *domain.Device, _ int) bool { return !selected[lo.FromPtr(d.Metadata.Name)] })
+			if limit != nil {
+				candidates = candidates[:util.Min(len(candidates), util.Max(*limit, 0))]
+			}
+			for _, device := range candidates {
+				selected[lo.FromPtr(device.Metadata.Name)] = true
+			}
+			ret.Batches = append(ret.Batches, domain.RolloutPreviewBatch{Name: batchName(i)})
+			batchDevices = append(batchDevices, candidates)
+		}
+		ret.Batches = append(ret.Batches, domain.RolloutPreviewBatch{Name: domain.FinalImplicitBatchName})
+		batchDevices = append(batchDevices, lo.Filter(allDevices, func(d *domain.Device, _ int) bool {
+			return !selected[lo.FromPtr(d.Metadata.Name)]
+		}))
+	}
+	for i := range ret.Batches {
+		ret.Batches[i].Devices = lo.Map(batchDevices[i], func(d *domain.Device, _ int) string { return lo.FromPtr(d.Metadata.Name) })
+	}
+
+	if spec.RolloutPolicy == nil || spec.RolloutPolicy.DisruptionBudget == nil {
+		return ret, nil
+	}
+	budget := *spec.RolloutPolicy.DisruptionBudget
+	groupBy := lo.FromPtr(budget.GroupBy)
+	groups := make(map[string]*previewGroup)
+	for _, device := range allDevices {
+		labels := lo.FromPtr(device.Metadata.Labels)
+		key := groupKey(groupBy, labels)
+		group, exists := groups[key]
+		if !exists {
+			group = &previewGroup{labels: lo.PickByKeys(labels, groupBy)}
+			groups[key] = group
+		}
+		group.total++
+		if isConnected(device) {
+			group.connected++
+		}
+		if isBusyConnected(device) {
+			group.busyConnected++
+		}
+	}
+	for i := range ret.Batches {
+		counts := lo.CountValuesBy(batchDevices[i], func(d *domain.Device) string {
+			return groupKey(groupBy, lo.FromPtr(d.Metadata.Labels))
+		})
+		keys := lo.Keys(counts)
+		sort.Strings(keys)
+		budgetGroups := []domain.RolloutPreviewDisruptionBudgetGroup{}
+		for _, key := range keys {
+			group := groups[key]
+			budgetGroups = append(budgetGroups, domain.RolloutPreviewDisruptionBudgetGroup{
+				Labels:        group.labels,
+				Devices:       counts[key],
+				Total:         group.total,
+				MaxConcurrent: util.Max(DisruptionBudgetAllowance(budget, group.total, group.connected, group.busyConnected), 0),
+			})
+		}
+		ret.Batches[i].DisruptionBudgetGroups = &budgetGroups
+	}
+	return ret, nil
+}
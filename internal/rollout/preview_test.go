@@ -0,0 +1,229 @@
+package rollout
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util"
+	k8sselector "github.com/flightctl/flightctl/pkg/k8s/selector"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
+)
+
+// previewDevices lists its devices by evaluating the selectors of the rollout device queries
+type previewDevices []domain.Device
+
+func (p previewDevices) ListDevices(_ context.Context, _ uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (*domain.DeviceList, domain.Status) {
+	if annotationSelector != nil {
+		return nil, domain.StatusBadRequest("unexpected annotation selector")
+	}
+	labelSelector := k8sselector.Everything()
+	if params.LabelSelector != nil {
+		var err error
+		if labelSelector, err = k8sselector.Parse(*params.LabelSelector); err != nil {
+			return nil, domain.StatusBadRequest(err.Error())
+		}
+	}
+	ret := &domain.DeviceList{}
+	for _, device := range p {
+		if !labelSelector.Matches(k8sLabels.Set(lo.FromPtr(device.Metadata.Labels))) {
+			continue
+		}
+		matches := true
+		for _, field := range strings.Split(lo.FromPtr(params.FieldSelector), ",") {
+			switch {
+			case field == "":
+			case strings.HasPrefix(field, "metadata.owner="):
+				matches = matches && lo.FromPtr(device.Metadata.Owner) == strings.TrimPrefix(field, "metadata.owner=")
+			case field == "status.summary.status!=Unknown":
+				matches = matches && device.Status.Summary.Status != domain.DeviceSummaryStatusUnknown
+			default:
+				return nil, domain.StatusBadRequest("unexpected field selector " + field)
+			}
+		}
+		if matches {
+			ret.Items = append(ret.Items, device)
+		}
+	}
+	return ret, domain.StatusOK()
+}
+
+func previewDevice(name string, labels map[string]string, owner string, status domain.DeviceSummaryStatusType) domain.Device {
+	device := domain.Device{
+		Metadata: domain.ObjectMeta{
+			Name:   lo.ToPtr(name),
+			Labels: &labels,
+		},
+		Status: &domain.DeviceStatus{
+			Summary: domain.DeviceSummaryStatus{Status: status},
+		},
+	}
+	if owner != "" {
+		device.Metadata.Owner = util.SetResourceOwner(domain.FleetKind, owner)
+	}
+	return device
+}
+
+func batchLimit(t *testing.T, limit any) *domain.Batch_Limit {
+	ret := &domain.Batch_Limit{}
+	switch v := limit.(type) {
+	case int:
+		require.NoError(t, ret.FromBatchLimit1(v))
+	case string:
+		require.NoError(t, ret.FromPercentage(v))
+	}
+	return ret
+}
+
+func TestBatchLimit(t *testing.T) {
+	counts := func() (int, int, error) { return 2, 10, nil }
+	tests := []struct {
+		name     string
+		limit    any
+		expected *int
+	}{
+		{name: "no limit", expected: nil},
+		{name: "absolute", limit: 3, expected: lo.ToPtr(3)},
+		{name: "percentage", limit: "50%", expected: lo.ToPtr(3)},
+		{name: "all", limit: "100%", expected: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var limit *domain.Batch_Limit
+			if tt.limit != nil {
+				limit = batchLimit(t, tt.limit)
+			}
+			result, err := BatchLimit(limit, counts)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestDisruptionBudgetAllowance(t *testing.T) {
+	tests := []struct {
+		name                            string
+		budget                          domain.DisruptionBudget
+		total, connected, busyConnected int
+		expected                        int
+	}{
+		{name: "max unavailable", budget: domain.DisruptionBudget{MaxUnavailable: lo.ToPtr(2)}, total: 10, connected: 10, busyConnected: 1, expected: 1},
+		{name: "min available", budget: domain.DisruptionBudget{MinAvailable: lo.ToPtr(7)}, total: 10, connected: 9, busyConnected: 0, expected: 2},
+		{name: "both", budget: domain.DisruptionBudget{MaxUnavailable: lo.ToPtr(5), MinAvailable: lo.ToPtr(7)}, total: 10, connected: 10, busyConnected: 0, expected: 3},
+		{name: "exhausted", budget: domain.DisruptionBudget{MaxUnavailable: lo.ToPtr(1)}, total: 10, connected: 10, busyConnected: 3, expected: -2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DisruptionBudgetAllowance(tt.budget, tt.total, tt.connected, tt.busyConnected))
+		})
+	}
+}
+
+func TestPreviewRollout(t *testing.T) {
+	devices := previewDevices{
+		previewDevice("d5", map[string]string{"fleet": "f1", "site": "b"}, "", domain.DeviceSummaryStatusOnline),
+		previewDevice("d1", map[string]string{"fleet": "f1", "site": "a", "canary": "true"}, "f1", domain.DeviceSummaryStatusOnline),
+		previewDevice("d2", map[string]string{"fleet": "f1", "site": "a"}, "f1", domain.DeviceSummaryStatusOnline),
+		previewDevice("d3", map[string]string{"fleet": "f1", "site": "a"}, "f1", domain.DeviceSummaryStatusUnknown),
+		previewDevice("d4", map[string]string{"fleet": "f1", "site": "b"}, "f1", domain.DeviceSummaryStatusOnline),
+		previewDevice("d6", map[string]string{"fleet": "f1", "site": "b"}, "other", domain.DeviceSummaryStatusOnline),
+		previewDevice("d7", map[string]string{"fleet": "old"}, "f1", domain.DeviceSummaryStatusOnline),
+	}
+	selector := &domain.LabelSelector{MatchLabels: &map[string]string{"fleet": "f1"}}
+	expectedUnselected := []domain.RolloutPreviewUnselectedDevice{
+		{Name: "d6", Reason: "owned by Fleet/other"},
+		{Name: "d7", Reason: "not selected by the fleet selector"},
+	}
+
+	t.Run("no device selection", func(t *testing.T) {
+		preview, err := PreviewRollout(context.Background(), devices, uuid.New(), "f1", domain.FleetSpec{Selector: selector})
+		require.NoError(t, err)
+		require.Equal(t, []domain.RolloutPreviewBatch{
+			{Name: AllDevicesBatchName, Devices: []string{"d1", "d2", "d3", "d4", "d5"}},
+		}, preview.Batches)
+		require.Equal(t, expectedUnselected, preview.UnselectedDevices)
+	})
+
+	t.Run("batch sequence", func(t *testing.T) {
+		deviceSelection := &domain.RolloutDeviceSelection{}
+		require.NoError(t, deviceSelection.FromBatchSequence(domain.BatchSequence{
+			Sequence: &[]domain.Batch{
+				{Selector: &domain.LabelSelector{MatchLabels: &map[string]string{"site": "a"}}},
+				{Limit: batchLimit(t, "60%")},
+				{Selector: &domain.LabelSelector{MatchExpressions: &[]domain.MatchExpression{
+					{Key: "site", Operator: domain.In, Values: &[]string{"b"}},
+				}}},
+			},
+		}))
+		spec := domain.FleetSpec{
+			Selector: selector,
+			RolloutPolicy: &domain.RolloutPolicy{
+				DeviceSelection:  deviceSelection,
+				DisruptionBudget: &domain.DisruptionBudget{GroupBy: &[]string{"site"}, MaxUnavailable: lo.ToPtr(1)},
+			},
+		}
+		preview, err := PreviewRollout(context.Background(), devices, uuid.New(), "f1", spec)
+		require.NoError(t, err)
+		require.Equal(t, []string{"batch 1", "batch 2", "batch 3", domain.FinalImplicitBatchName},
+			lo.Map(preview.Batches, func(b domain.RolloutPreviewBatch, _ int) string { return b.Name }))
+
+		// The disconnected d3 is skipped by the first batch and left to the final implicit batch
+		require.Equal(t, []string{"d1", "d2"}, preview.Batches[0].Devices)
+		require.Equal(t, []string{"d4"}, preview.Batches[1].Devices)
+		require.Equal(t, []string{"d5"}, preview.Batches[2].Devices)
+		require.Equal(t, []string{"d3"}, preview.Batches[3].Devices)
+		require.Equal(t, expectedUnselected, preview.UnselectedDevices)
+
+		require.Equal(t, []domain.RolloutPreviewDisruptionBudgetGroup{
+			{Labels: map[string]string{"site": "a"}, Devices: 2, Total: 3, MaxConcurrent: 1},
+		}, *preview.Batches[0].DisruptionBudgetGroups)
+		require.Equal(t, []domain.RolloutPreviewDisruptionBudgetGroup{
+			{Labels: map[string]string{"site": "b"}, Devices: 1, Total: 2, MaxConcurrent: 1},
+		}, *preview.Batches[2].DisruptionBudgetGroups)
+	})
+
+	t.Run("canary", func(t *testing.T) {
+		deviceSelection := &domain.RolloutDeviceSelection{}
+		require.NoError(t, deviceSelection.FromCanary(domain.Canary{
+			Selector:     domain.LabelSelector{MatchLabels: &map[string]string{"canary": "true"}},
+			SoakDuration: "1h",
+		}))
+		spec := domain.FleetSpec{
+			Selector:      selector,
+			RolloutPolicy: &domain.RolloutPolicy{DeviceSelection: deviceSelection},
+		}
+		preview, err := PreviewRollout(context.Background(), devices, uuid.New(), "f1", spec)
+		require.NoError(t, err)
+		require.Equal(t, []domain.RolloutPreviewBatch{
+			{Name: domain.CanaryBatchName, Devices: []string{"d1"}},
+			{Name: domain.FinalImplicitBatchName, Devices: []string{"d2", "d3", "d4", "d5"}},
+		}, preview.Batches)
+	})
+
+	t.Run("progressive", func(t *testing.T) {
+		deviceSelection := &domain.RolloutDeviceSelection{}
+		require.NoError(t, deviceSelection.FromProgressive(domain.Progressive{
+			Steps:        []domain.Percentage{"20%", "60%", "100%"},
+			StepInterval: "4h",
+		}))
+		spec := domain.FleetSpec{
+			Selector:      selector,
+			RolloutPolicy: &domain.RolloutPolicy{DeviceSelection: deviceSelection},
+		}
+		preview, err := PreviewRollout(context.Background(), devices, uuid.New(), "f1", spec)
+		require.NoError(t, err)
+
+		// Each step only selects the devices missing to reach its share of the fleet's 5 devices
+		require.Equal(t, []domain.RolloutPreviewBatch{
+			{Name: "step 1 (20%)", Devices: []string{"d1"}},
+			{Name: "step 2 (60%)", Devices: []string{"d2", "d4"}},
+			{Name: "step 3 (100%)", Devices: []string{"d5"}},
+			{Name: domain.FinalImplicitBatchName, Devices: []string{"d3"}},
+		}, preview.Batches)
+	})
+}
@@ -0,0 +1,72 @@
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// TemplateVersionLister lists the template versions of a fleet
+type TemplateVersionLister interface {
+	ListTemplateVersions(ctx context.Context, orgId uuid.UUID, fleet string, params domain.ListTemplateVersionsParams) (*domain.TemplateVersionList, domain.Status)
+}
+
+// PreviousTemplateVersion returns the name of the template version of the fleet that was created just before the
+// given one.  Template versions are listed from newest to oldest.
+func PreviousTemplateVersion(ctx context.Context, lister TemplateVersionLister, orgId uuid.UUID, fleetName string, templateVersionName string) (string, bool, error) {
+	params := domain.ListTemplateVersionsParams{Limit: lo.ToPtr(int32(100))}
+	found := false
+	for {
+		templateVersions, status := lister.ListTemplateVersions(ctx, orgId, fleetName, params)
+		if status.Code != http.StatusOK {
+			return "", false, fmt.Errorf("failed to list template versions of fleet %s: %s", fleetName, status.Message)
+		}
+		for _, tv := range templateVersions.Items {
+			name := lo.FromPtr(tv.Metadata.Name)
+			if found {
+				return name, true, nil
+			}
+			found = name == templateVersionName
+		}
+		if templateVersions.Metadata.Continue == nil {
+			return "", false, nil
+		}
+		params.Continue = templateVersions.Metadata.Continue
+	}
+}
+
+// RollbackTemplateVersion returns the name of the template version that a failed rollout of the given template
+// version is rolled back to: the newest older template version whose rollout completed.  If no older rollout
+// completed, it is the template version that was created just before the given one, which the devices ran before.
+func RollbackTemplateVersion(ctx context.Context, lister TemplateVersionLister, orgId uuid.UUID, fleetName string, templateVersionName string) (string, bool, error) {
+	params := domain.ListTemplateVersionsParams{Limit: lo.ToPtr(int32(100))}
+	found := false
+	previous := ""
+	for {
+		templateVersions, status := lister.ListTemplateVersions(ctx, orgId, fleetName, params)
+		if status.Code != http.StatusOK {
+			return "", false, fmt.Errorf("failed to list template versions of fleet %s: %s", fleetName, status.Message)
+		}
+		for _, tv := range templateVersions.Items {
+			name := lo.FromPtr(tv.Metadata.Name)
+			if !found {
+				found = name == templateVersionName
+				continue
+			}
+			if previous == "" {
+				previous = name
+			}
+			if tv.Status != nil && tv.Status.Rollout != nil && tv.Status.Rollout.Outcome == domain.RolloutOutcomeCompleted {
+				return name, true, nil
+			}
+		}
+		if templateVersions.Metadata.Continue == nil {
+			return previous, previous != "", nil
+		}
+		params.Continue = templateVersions.Metadata.Continue
+	}
+}
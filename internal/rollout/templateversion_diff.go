@@ -0,0 +1,152 @@
+package rollout
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/samber/lo"
+)
+
+// The status fields of a template version that are not part of the device specification it renders
+var templateVersionStatusFields = []string{"updatedAt", "conditions", "rollout"}
+
+// DiffTemplateVersions returns the changes of the device specification of the from template version that lead to
+// the device specification of the to template version
+func DiffTemplateVersions(from, to *domain.TemplateVersion) (*domain.TemplateVersionDiff, error) {
+	fromSpec, err := deviceSpecValue(from)
+	if err != nil {
+		return nil, fmt.Errorf("template version %s: %w", lo.FromPtr(from.Metadata.Name), err)
+	}
+	toSpec, err := deviceSpecValue(to)
+	if err != nil {
+		return nil, fmt.Errorf("template version %s: %w", lo.FromPtr(to.Metadata.Name), err)
+	}
+	ret := &domain.TemplateVersionDiff{
+		Fleet:   from.Spec.Fleet,
+		From:    lo.FromPtr(from.Metadata.Name),
+		To:      lo.FromPtr(to.Metadata.Name),
+		Changes: []domain.TemplateVersionChange{},
+	}
+	diffValues("", fromSpec, toSpec, &ret.Changes)
+	return ret, nil
+}
+
+// deviceSpecValue returns the device specification rendered by the template version as a generic JSON value
+func deviceSpecValue(tv *domain.TemplateVersion) (map[string]any, error) {
+	ret := map[string]any{}
+	if tv.Status == nil {
+		return ret, nil
+	}
+	marshalled, err := json.Marshal(tv.Status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal status: %w", err)
+	}
+	if err = json.Unmarshal(marshalled, &ret); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal status: %w", err)
+	}
+	for _, field := range templateVersionStatusFields {
+		delete(ret, field)
+	}
+	return ret, nil
+}
+
+func diffValues(path string, from, to any, changes *[]domain.TemplateVersionChange) {
+	fromMap, fromIsMap := from.(map[string]any)
+	toMap, toIsMap := to.(map[string]any)
+	if fromIsMap && toIsMap {
+		keys := lo.Union(lo.Keys(fromMap), lo.Keys(toMap))
+		sort.Strings(keys)
+		for _, key := range keys {
+			diffChild(fieldPath(path, key), fromMap, toMap, key, changes)
+		}
+		return
+	}
+
+	fromList, fromIsList := from.([]any)
+	toList, toIsList := to.([]any)
+	if fromIsList && toIsList {
+		diffLists(path, fromList, toList, changes)
+		return
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, domain.TemplateVersionChange{
+			Path: path,
+			Type: domain.TemplateVersionChangeModified,
+			From: from,
+			To:   to,
+		})
+	}
+}
+
+func diffChild[K comparable](path string, from, to map[K]any, key K, changes *[]domain.TemplateVersionChange) {
+	fromValue, inFrom := from[key]
+	toValue, inTo := to[key]
+	switch {
+	case inFrom && inTo:
+		diffValues(path, fromValue, toValue, changes)
+	case inFrom:
+		*changes = append(*changes, domain.TemplateVersionChange{Path: path, Type: domain.TemplateVersionChangeRemoved, From: fromValue})
+	default:
+		*changes = append(*changes, domain.TemplateVersionChange{Path: path, Type: domain.TemplateVersionChangeAdded, To: toValue})
+	}
+}
+
+// diffLists compares the elements of lists of named items, such as applications, by their name, so that
+// reordering or inserting an item only reports the items that actually changed.  Other lists are compared
+// element by element.
+func diffLists(path string, from, to []any, changes *[]domain.TemplateVersionChange) {
+	fromNamed, fromOk := namedElements(from)
+	toNamed, toOk := namedElements(to)
+	if !fromOk || !toOk {
+		fromIndexed := lo.SliceToMap(lo.Range(len(from)), func(i int) (int, any) { return i, from[i] })
+		toIndexed := lo.SliceToMap(lo.Range(len(to)), func(i int) (int, any) { return i, to[i] })
+		for i := 0; i < len(from) || i < len(to); i++ {
+			diffChild(fmt.Sprintf("%s[%d]", path, i), fromIndexed, toIndexed, i, changes)
+		}
+		return
+	}
+	names := lo.Uniq(append(elementNames(from), elementNames(to)...))
+	for _, name := range names {
+		diffChild(fmt.Sprintf("%s[name=%s]", path, name), fromNamed, toNamed, name, changes)
+	}
+}
+
+// namedElements returns the elements of the list by their name if every element is an object with a unique name
+func namedElements(list []any) (map[string]any, bool) {
+	ret := make(map[string]any, len(list))
+	for _, element := range list {
+		name, ok := elementName(element)
+		if !ok || lo.HasKey(ret, name) {
+			return nil, false
+		}
+		ret[name] = element
+	}
+	return ret, true
+}
+
+func elementNames(list []any) []string {
+	return lo.Map(list, func(element any, _ int) string {
+		name, _ := elementName(element)
+		return name
+	})
+}
+
+func elementName(element any) (string, bool) {
+	object, ok := element.(map[string]any)
+	if !ok {
+		return "", false
+	}
+	name, ok := object["name"].(string)
+	return name, ok && name != ""
+}
+
+func fieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
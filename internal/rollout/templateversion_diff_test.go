@@ -0,0 +1,74 @@
+package rollout
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func diffTemplateVersion(t *testing.T, name string, status string) *domain.TemplateVersion {
+	tv := &domain.TemplateVersion{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec:     domain.TemplateVersionSpec{Fleet: "fleet"},
+	}
+	if status != "" {
+		tv.Status = &domain.TemplateVersionStatus{}
+		require.NoError(t, json.Unmarshal([]byte(status), tv.Status))
+	}
+	return tv
+}
+
+func TestDiffTemplateVersions(t *testing.T) {
+	from := diffTemplateVersion(t, "1", `{
+		"conditions": [{"type": "Valid", "status": "True"}],
+		"os": {"image": "quay.io/os:1"},
+		"applications": [
+			{"name": "web", "appType": "compose", "image": "quay.io/web:1"},
+			{"name": "db", "appType": "compose", "image": "quay.io/db:1"}
+		],
+		"resources": [{"monitorType": "CPU", "samplingInterval": "30s"}]
+	}`)
+	to := diffTemplateVersion(t, "2", `{
+		"conditions": [{"type": "Valid", "status": "False"}],
+		"os": {"image": "quay.io/os:2"},
+		"applications": [
+			{"name": "cache", "appType": "compose", "image": "quay.io/cache:1"},
+			{"name": "web", "appType": "compose", "image": "quay.io/web:2"}
+		],
+		"resources": [{"monitorType": "CPU", "samplingInterval": "1m"}, {"monitorType": "Memory", "samplingInterval": "1m"}],
+		"updatePolicy": {"downloadSchedule": {"at": "* * * * *"}}
+	}`)
+
+	diff, err := DiffTemplateVersions(from, to)
+	require.NoError(t, err)
+	require.Equal(t, "fleet", diff.Fleet)
+	require.Equal(t, "1", diff.From)
+	require.Equal(t, "2", diff.To)
+	require.Equal(t, []string{
+		"applications[name=web].image",
+		"applications[name=db]",
+		"applications[name=cache]",
+		"os.image",
+		"resources[0].samplingInterval",
+		"resources[1]",
+		"updatePolicy",
+	}, lo.Map(diff.Changes, func(c domain.TemplateVersionChange, _ int) string { return c.Path }))
+	require.Equal(t, []domain.TemplateVersionChangeType{
+		domain.TemplateVersionChangeModified,
+		domain.TemplateVersionChangeRemoved,
+		domain.TemplateVersionChangeAdded,
+		domain.TemplateVersionChangeModified,
+		domain.TemplateVersionChangeModified,
+		domain.TemplateVersionChangeAdded,
+		domain.TemplateVersionChangeAdded,
+	}, lo.Map(diff.Changes, func(c domain.TemplateVersionChange, _ int) domain.TemplateVersionChangeType { return c.Type }))
+	require.Equal(t, "quay.io/os:1", diff.Changes[3].From)
+	require.Equal(t, "quay.io/os:2", diff.Changes[3].To)
+
+	same, err := DiffTemplateVersions(from, from)
+	require.NoError(t, err)
+	require.Empty(t, same.Changes)
+}
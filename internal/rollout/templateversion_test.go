@@ -0,0 +1,80 @@
+package rollout
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+// pagedTemplateVersions serves the template versions, newest first, one per page
+type pagedTemplateVersions struct {
+	names    []string
+	outcomes map[string]domain.RolloutOutcome
+}
+
+func (p pagedTemplateVersions) ListTemplateVersions(_ context.Context, _ uuid.UUID, _ string, params domain.ListTemplateVersionsParams) (*domain.TemplateVersionList, domain.Status) {
+	i := 0
+	if params.Continue != nil {
+		i = len(*params.Continue)
+	}
+	list := &domain.TemplateVersionList{}
+	if i < len(p.names) {
+		tv := domain.TemplateVersion{Metadata: domain.ObjectMeta{Name: lo.ToPtr(p.names[i])}}
+		if outcome, ok := p.outcomes[p.names[i]]; ok {
+			tv.Status = &domain.TemplateVersionStatus{Rollout: &domain.TemplateVersionRolloutRecord{Outcome: outcome}}
+		}
+		list.Items = append(list.Items, tv)
+	}
+	if i+1 < len(p.names) {
+		list.Metadata.Continue = lo.ToPtr(string(make([]byte, i+1)))
+	}
+	return list, domain.Status{Code: http.StatusOK}
+}
+
+func TestPreviousTemplateVersion(t *testing.T) {
+	ctx := context.Background()
+	lister := pagedTemplateVersions{names: []string{"tv3", "tv2", "tv1"}}
+
+	name, exists, err := PreviousTemplateVersion(ctx, lister, uuid.New(), "fleet", "tv3")
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, "tv2", name)
+
+	_, exists, err = PreviousTemplateVersion(ctx, lister, uuid.New(), "fleet", "tv1")
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestRollbackTemplateVersion(t *testing.T) {
+	ctx := context.Background()
+	lister := pagedTemplateVersions{
+		names: []string{"tv5", "tv4", "tv3", "tv2", "tv1"},
+		outcomes: map[string]domain.RolloutOutcome{
+			"tv5": domain.RolloutOutcomeRolledBack,
+			"tv4": domain.RolloutOutcomeSuperseded,
+			"tv3": domain.RolloutOutcomeRolledBack,
+			"tv2": domain.RolloutOutcomeCompleted,
+		},
+	}
+
+	// The newest older template version whose rollout completed
+	name, exists, err := RollbackTemplateVersion(ctx, lister, uuid.New(), "fleet", "tv5")
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, "tv2", name)
+
+	// Without a completed rollout, the template version the devices ran before
+	name, exists, err = RollbackTemplateVersion(ctx, lister, uuid.New(), "fleet", "tv2")
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, "tv1", name)
+
+	_, exists, err = RollbackTemplateVersion(ctx, lister, uuid.New(), "fleet", "tv1")
+	require.NoError(t, err)
+	require.False(t, exists)
+}
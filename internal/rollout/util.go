@@ -15,6 +15,8 @@ const (
 	Inactive Stage = iota
 	ConfiguredBatch
 	FinalImplicitBatch
+	RolledBack
+	Aborted
 )
 
 func (s Stage) String() string {
@@ -25,12 +27,23 @@ func (s Stage) String() string {
 		return "Configured batch rollout"
 	case FinalImplicitBatch:
 		return "Final implicit batch rollout"
+	case RolledBack:
+		return "Rollout rolled back"
+	case Aborted:
+		return "Rollout aborted"
 	default:
 		return fmt.Sprintf("unexpected stage %d", s)
 	}
 }
 
 func batchSequenceProgressStage(fleet *domain.Fleet, sequence domain.BatchSequence) (Stage, error) {
+	if _, rolledBack := util.GetFromMap(lo.FromPtr(fleet.Metadata.Annotations), domain.FleetAnnotationRollbackTemplateVersion); rolledBack {
+		return RolledBack, nil
+	}
+	if IsAborted(fleet) {
+		// An aborted rollout selects no further batches
+		return Aborted, nil
+	}
 	batchNumberStr, exists := util.GetFromMap(lo.FromPtr(fleet.Metadata.Annotations), domain.FleetAnnotationBatchNumber)
 	if !exists {
 		return Inactive, nil
@@ -49,6 +62,43 @@ func batchSequenceProgressStage(fleet *domain.Fleet, sequence domain.BatchSequen
 	}
 }
 
+// CanaryBatchSequence returns the batch sequence that a canary rollout is performed with: a single batch
+// of the canary devices, followed by the final implicit batch
+func CanaryBatchSequence(canary domain.Canary) domain.BatchSequence {
+	return domain.BatchSequence{
+		Strategy: domain.RolloutStrategyBatchSequence,
+		Sequence: &[]domain.Batch{
+			{
+				Selector:         lo.ToPtr(canary.Selector),
+				SuccessThreshold: canary.SuccessThreshold,
+			},
+		},
+	}
+}
+
+// ProgressiveBatchSequence returns the batch sequence that a progressive rollout is performed with: a batch per
+// step, limited to the share of the fleet's devices that the step raises the rollout to, followed by the final
+// implicit batch.  Since a percentage limit accounts for the devices that were already rolled out, each batch only
+// selects the devices that are missing to reach the share of its step.
+func ProgressiveBatchSequence(progressive domain.Progressive) domain.BatchSequence {
+	return domain.BatchSequence{
+		Strategy: domain.RolloutStrategyBatchSequence,
+		Sequence: lo.ToPtr(lo.Map(progressive.Steps, func(step domain.Percentage, _ int) domain.Batch {
+			limit := &domain.Batch_Limit{}
+			_ = limit.FromPercentage(step)
+			return domain.Batch{
+				Limit:            limit,
+				SuccessThreshold: progressive.SuccessThreshold,
+			}
+		})),
+	}
+}
+
+// ProgressiveStepName returns the name of the batch of the given 0-based step of a progressive rollout
+func ProgressiveStepName(step int, progressive domain.Progressive) string {
+	return fmt.Sprintf("step %d (%s)", step+1, progressive.Steps[step])
+}
+
 func ProgressStage(fleet *domain.Fleet) (Stage, error) {
 	if fleet.Spec.RolloutPolicy == nil || fleet.Spec.RolloutPolicy.DeviceSelection == nil {
 		return Inactive, nil
@@ -60,6 +110,10 @@ func ProgressStage(fleet *domain.Fleet) (Stage, error) {
 	switch value := intf.(type) {
 	case domain.BatchSequence:
 		return batchSequenceProgressStage(fleet, value)
+	case domain.Canary:
+		return batchSequenceProgressStage(fleet, CanaryBatchSequence(value))
+	case domain.Progressive:
+		return batchSequenceProgressStage(fleet, ProgressiveBatchSequence(value))
 	default:
 		return Inactive, fmt.Errorf("unexpected type for device selection %T", intf)
 	}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/flightctl/flightctl/internal/contextutil"
 	"github.com/flightctl/flightctl/internal/crypto/signer"
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/flightctl/flightctl/internal/tpm"
 	"github.com/flightctl/flightctl/internal/util"
@@ -394,6 +396,38 @@ func (h *ServiceHandler) UpdateCertificateSigningRequestApproval(ctx context.Con
 	return result, domain.StatusOK()
 }
 
+// ExpireCertificateSigningRequest denies a pending certificate signing request that was not approved within the given TTL.
+func (h *ServiceHandler) ExpireCertificateSigningRequest(ctx context.Context, orgId uuid.UUID, name string, ttl time.Duration) domain.Status {
+	csr, err := h.store.CertificateSigningRequest().Get(ctx, orgId, name)
+	if err != nil {
+		return StoreErrorToApiStatus(err, false, domain.CertificateSigningRequestKind, &name)
+	}
+	if csr.Status == nil {
+		csr.Status = &domain.CertificateSigningRequestStatus{Conditions: []domain.Condition{}}
+	}
+	for _, conditionType := range []domain.ConditionType{
+		domain.ConditionTypeCertificateSigningRequestApproved,
+		domain.ConditionTypeCertificateSigningRequestDenied,
+		domain.ConditionTypeCertificateSigningRequestFailed,
+	} {
+		if domain.IsStatusConditionTrue(csr.Status.Conditions, conditionType) {
+			return domain.StatusConflict(fmt.Sprintf("The request is already %s", strings.ToLower(string(conditionType))))
+		}
+	}
+
+	domain.SetStatusCondition(&csr.Status.Conditions, domain.Condition{
+		Type:    domain.ConditionTypeCertificateSigningRequestDenied,
+		Status:  domain.ConditionStatusTrue,
+		Reason:  domain.RequestExpiredReason,
+		Message: fmt.Sprintf("Not approved within %s", ttl),
+	})
+	if _, err := h.store.CertificateSigningRequest().UpdateStatus(ctx, orgId, csr); err != nil {
+		return StoreErrorToApiStatus(err, false, domain.CertificateSigningRequestKind, &name)
+	}
+	h.CreateEvent(ctx, orgId, common.GetCertificateSigningRequestExpiredEvent(ctx, name, ttl))
+	return domain.StatusOK()
+}
+
 func newSignRequestFromCertificateSigningRequest(csr *domain.CertificateSigningRequest) (signer.SignRequest, bool, error) {
 	var opts []signer.SignRequestOption
 	csrData, isTPM, err := tpm.NormalizeEnrollmentCSR(string(csr.Spec.Request))
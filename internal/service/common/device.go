@@ -31,6 +31,18 @@ const (
 	DiskIsCritical                    = "Disk utilization has reached a critical level."
 	DiskIsWarning                     = "Disk utilization has reached a warning level."
 	DiskIsNormal                      = "Disk utilization has returned to normal."
+	NetworkIsCritical                 = "Network interface has reached a critical level."
+	NetworkIsWarning                  = "Network interface has reached a warning level."
+	NetworkIsNormal                   = "Network interface has returned to normal."
+	TemperatureIsCritical             = "Temperature has reached a critical level."
+	TemperatureIsWarning              = "Temperature has reached a warning level."
+	TemperatureIsNormal               = "Temperature has returned to normal."
+	ProcessIsCritical                 = "Monitored process has reached a critical level."
+	ProcessIsWarning                  = "Monitored process has reached a warning level."
+	ProcessIsNormal                   = "Monitored process has returned to normal."
+	CustomIsCritical                  = "Custom monitor has reached a critical level."
+	CustomIsWarning                   = "Custom monitor has reached a warning level."
+	CustomIsNormal                    = "Custom monitor has returned to normal."
 )
 
 type DeviceSuccessEvent func(ctx context.Context, created bool, resourceKind domain.ResourceKind, resourceName string, updateDetails *domain.ResourceUpdatedDetailsUpdatedFields, log logrus.FieldLogger) *domain.Event
@@ -64,6 +76,30 @@ var (
 		domain.DeviceResourceStatusWarning:  ResourceUpdate{Reason: domain.EventReasonDeviceDiskWarning, Details: DiskIsWarning},
 		domain.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: domain.EventReasonDeviceDiskNormal, Details: DiskIsNormal},
 	}
+
+	networkStatus = statusType{
+		domain.DeviceResourceStatusCritical: ResourceUpdate{Reason: domain.EventReasonDeviceNetworkCritical, Details: NetworkIsCritical},
+		domain.DeviceResourceStatusWarning:  ResourceUpdate{Reason: domain.EventReasonDeviceNetworkWarning, Details: NetworkIsWarning},
+		domain.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: domain.EventReasonDeviceNetworkNormal, Details: NetworkIsNormal},
+	}
+
+	temperatureStatus = statusType{
+		domain.DeviceResourceStatusCritical: ResourceUpdate{Reason: domain.EventReasonDeviceTemperatureCritical, Details: TemperatureIsCritical},
+		domain.DeviceResourceStatusWarning:  ResourceUpdate{Reason: domain.EventReasonDeviceTemperatureWarning, Details: TemperatureIsWarning},
+		domain.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: domain.EventReasonDeviceTemperatureNormal, Details: TemperatureIsNormal},
+	}
+
+	processStatus = statusType{
+		domain.DeviceResourceStatusCritical: ResourceUpdate{Reason: domain.EventReasonDeviceProcessCritical, Details: ProcessIsCritical},
+		domain.DeviceResourceStatusWarning:  ResourceUpdate{Reason: domain.EventReasonDeviceProcessWarning, Details: ProcessIsWarning},
+		domain.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: domain.EventReasonDeviceProcessNormal, Details: ProcessIsNormal},
+	}
+
+	customStatus = statusType{
+		domain.DeviceResourceStatusCritical: ResourceUpdate{Reason: domain.EventReasonDeviceCustomCritical, Details: CustomIsCritical},
+		domain.DeviceResourceStatusWarning:  ResourceUpdate{Reason: domain.EventReasonDeviceCustomWarning, Details: CustomIsWarning},
+		domain.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: domain.EventReasonDeviceCustomNormal, Details: CustomIsNormal},
+	}
 )
 
 func UpdateServiceSideStatus(ctx context.Context, orgId uuid.UUID, device *domain.Device, st store.Store, log logrus.FieldLogger) bool {
@@ -109,6 +145,16 @@ func resourcesDisk(disk domain.DeviceResourceStatusType, resourceErrors *[]strin
 	}
 }
 
+// resourcesOptional adds the state of a resource that is only reported while the device monitors it
+func resourcesOptional(status *domain.DeviceResourceStatusType, critical, warning string, resourceErrors *[]string, resourceDegradations *[]string) {
+	switch lo.FromPtr(status) {
+	case domain.DeviceResourceStatusCritical:
+		*resourceErrors = append(*resourceErrors, critical)
+	case domain.DeviceResourceStatusWarning:
+		*resourceDegradations = append(*resourceDegradations, warning)
+	}
+}
+
 func updateServerSideDeviceStatus(device *domain.Device) bool {
 	lastDeviceStatus := device.Status.Summary.Status
 
@@ -146,6 +192,10 @@ func updateServerSideDeviceStatus(device *domain.Device) bool {
 	resourcesCpu(device.Status.Resources.Cpu, &resourceErrors, &resourceDegradations)
 	resourcesMemory(device.Status.Resources.Memory, &resourceErrors, &resourceDegradations)
 	resourcesDisk(device.Status.Resources.Disk, &resourceErrors, &resourceDegradations)
+	resourcesOptional(device.Status.Resources.Network, NetworkIsCritical, NetworkIsWarning, &resourceErrors, &resourceDegradations)
+	resourcesOptional(device.Status.Resources.Temperature, TemperatureIsCritical, TemperatureIsWarning, &resourceErrors, &resourceDegradations)
+	resourcesOptional(device.Status.Resources.Process, ProcessIsCritical, ProcessIsWarning, &resourceErrors, &resourceDegradations)
+	resourcesOptional(device.Status.Resources.Custom, CustomIsCritical, CustomIsWarning, &resourceErrors, &resourceDegradations)
 
 	switch {
 	case len(resourceErrors) > 0:
@@ -423,6 +473,10 @@ func ComputeDeviceStatusChanges(ctx context.Context, oldDevice, newDevice *domai
 		{cpuStatus, func(d *domain.Device) domain.DeviceResourceStatusType { return d.Status.Resources.Cpu }},
 		{memoryStatus, func(d *domain.Device) domain.DeviceResourceStatusType { return d.Status.Resources.Memory }},
 		{diskStatus, func(d *domain.Device) domain.DeviceResourceStatusType { return d.Status.Resources.Disk }},
+		{networkStatus, optionalResourceStatus(func(d *domain.Device) *domain.DeviceResourceStatusType { return d.Status.Resources.Network })},
+		{temperatureStatus, optionalResourceStatus(func(d *domain.Device) *domain.DeviceResourceStatusType { return d.Status.Resources.Temperature })},
+		{processStatus, optionalResourceStatus(func(d *domain.Device) *domain.DeviceResourceStatusType { return d.Status.Resources.Process })},
+		{customStatus, optionalResourceStatus(func(d *domain.Device) *domain.DeviceResourceStatusType { return d.Status.Resources.Custom })},
 	}
 	for _, check := range resourceChecks {
 		checkResourceStatus(oldDevice, newDevice, check.statusMap, check.getter, &resourceUpdates)
@@ -439,6 +493,14 @@ func hasStatusChanged[T comparable](oldDevice *domain.Device, newDevice *domain.
 	return defaultValue != newStatus
 }
 
+// optionalResourceStatus reports a resource that the device does not monitor as Unknown, so that no event is
+// emitted until the device starts monitoring it
+func optionalResourceStatus(getter func(*domain.Device) *domain.DeviceResourceStatusType) func(*domain.Device) domain.DeviceResourceStatusType {
+	return func(d *domain.Device) domain.DeviceResourceStatusType {
+		return lo.FromPtrOr(getter(d), domain.DeviceResourceStatusUnknown)
+	}
+}
+
 // Generate events for all transitions except Unknown -> Healthy (normal startup)
 func checkResourceStatus(oldDevice, newDevice *domain.Device, statusMap statusType, getter func(*domain.Device) domain.DeviceResourceStatusType, resourceUpdates *ResourceUpdates) {
 	oldStatus := domain.DeviceResourceStatusUnknown
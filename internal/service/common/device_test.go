@@ -469,3 +469,50 @@ func TestUpdateServerSideDeviceUpdatedStatus_OsImageMismatch(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeDeviceStatusChanges_OptionalResources(t *testing.T) {
+	ctx := context.Background()
+	orgId := uuid.New()
+
+	deviceWithResources := func(temperature *domain.DeviceResourceStatusType) *domain.Device {
+		return &domain.Device{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("test-device")},
+			Status: &domain.DeviceStatus{
+				LastSeen: lo.ToPtr(time.Now()),
+				Resources: domain.DeviceResourceStatus{
+					Cpu:         domain.DeviceResourceStatusHealthy,
+					Memory:      domain.DeviceResourceStatusHealthy,
+					Disk:        domain.DeviceResourceStatusHealthy,
+					Temperature: temperature,
+				},
+			},
+		}
+	}
+	resourceReasons := func(updates ResourceUpdates) []domain.EventReason {
+		return lo.FilterMap(updates, func(u ResourceUpdate, _ int) (domain.EventReason, bool) {
+			return u.Reason, u.Reason == domain.EventReasonDeviceTemperatureCritical || u.Reason == domain.EventReasonDeviceTemperatureNormal
+		})
+	}
+
+	unmonitored := deviceWithResources(nil)
+	healthy := deviceWithResources(lo.ToPtr(domain.DeviceResourceStatusHealthy))
+	critical := deviceWithResources(lo.ToPtr(domain.DeviceResourceStatusCritical))
+
+	// starting to monitor a healthy resource emits no event
+	assert.Empty(t, resourceReasons(ComputeDeviceStatusChanges(ctx, unmonitored, healthy, orgId, nil)))
+	assert.Equal(t, []domain.EventReason{domain.EventReasonDeviceTemperatureCritical},
+		resourceReasons(ComputeDeviceStatusChanges(ctx, healthy, critical, orgId, nil)))
+	// no longer monitoring a critical resource resolves it
+	assert.Equal(t, []domain.EventReason{domain.EventReasonDeviceTemperatureNormal},
+		resourceReasons(ComputeDeviceStatusChanges(ctx, critical, unmonitored, orgId, nil)))
+
+	assert.True(t, updateServerSideDeviceStatus(critical))
+	assert.Equal(t, domain.DeviceSummaryStatusError, critical.Status.Summary.Status)
+	assert.Equal(t, TemperatureIsCritical, lo.FromPtr(critical.Status.Summary.Info))
+
+	process := deviceWithResources(nil)
+	process.Status.Resources.Process = lo.ToPtr(domain.DeviceResourceStatusWarning)
+	updateServerSideDeviceStatus(process)
+	assert.Equal(t, domain.DeviceSummaryStatusDegraded, process.Status.Summary.Status)
+	assert.Equal(t, ProcessIsWarning, lo.FromPtr(process.Status.Summary.Info))
+}
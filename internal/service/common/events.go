@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/samber/lo"
@@ -43,6 +44,11 @@ func formatResourceActionMessage(resourceKind domain.ResourceKind, action string
 	return s
 }
 
+// formatResourceExpiredMessage creates a standardized message for requests denied because they were not approved in time
+func formatResourceExpiredMessage(resourceKind domain.ResourceKind, ttl time.Duration) string {
+	return fmt.Sprintf("%s was denied because it was not approved within %s.", resourceKind, ttl)
+}
+
 // formatResourceActionFailedTemplate creates a template for failed resource actions
 func formatResourceActionFailedTemplate(resourceKind domain.ResourceKind, action string) string {
 	return fmt.Sprintf("%s %s failed: %%s.", resourceKind, action)
@@ -190,6 +196,28 @@ func GetEnrollmentRequestApprovalFailedEvent(ctx context.Context, resourceName s
 	})
 }
 
+// GetEnrollmentRequestExpiredEvent creates an event for an enrollment request denied because it was not approved in time
+func GetEnrollmentRequestExpiredEvent(ctx context.Context, resourceName string, ttl time.Duration) *domain.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.EnrollmentRequestKind,
+		resourceName: resourceName,
+		reason:       domain.EventReasonEnrollmentRequestExpired,
+		message:      formatResourceExpiredMessage(domain.EnrollmentRequestKind, ttl),
+		details:      nil,
+	})
+}
+
+// GetCertificateSigningRequestExpiredEvent creates an event for a certificate signing request denied because it was not approved in time
+func GetCertificateSigningRequestExpiredEvent(ctx context.Context, resourceName string, ttl time.Duration) *domain.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.CertificateSigningRequestKind,
+		resourceName: resourceName,
+		reason:       domain.EventReasonCertificateSigningRequestExpired,
+		message:      formatResourceExpiredMessage(domain.CertificateSigningRequestKind, ttl),
+		details:      nil,
+	})
+}
+
 // GetDeviceDecommissionedSuccessEvent creates an event for successful device decommission
 func GetDeviceDecommissionedSuccessEvent(ctx context.Context, _ bool, _ domain.ResourceKind, resourceName string, update *domain.ResourceUpdatedDetails, log logrus.FieldLogger) *domain.Event {
 	return getBaseEvent(ctx, resourceEvent{
@@ -551,6 +579,88 @@ func GetFleetRolloutFailedEvent(ctx context.Context, name string, deployingTempl
 	})
 }
 
+// GetFleetRolloutRolledBackEvent creates an event for a fleet rollout that was rolled back
+func GetFleetRolloutRolledBackEvent(ctx context.Context, name string, templateVersion string, previousTemplateVersion string, batch string) *domain.Event {
+	details := domain.FleetRolloutRolledBackDetails{
+		DetailType:              domain.FleetRolloutRolledBack,
+		TemplateVersion:         templateVersion,
+		PreviousTemplateVersion: previousTemplateVersion,
+		Batch:                   batch,
+	}
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromFleetRolloutRolledBackDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.FleetKind,
+		resourceName: name,
+		reason:       domain.EventReasonFleetRolloutRolledBack,
+		message:      fmt.Sprintf("Fleet rollout of template version %s was rolled back to template version %s after %s missed its success threshold.", templateVersion, previousTemplateVersion, batch),
+		details:      &eventDetails,
+	})
+}
+
+// GetFleetRolloutPausedEvent creates an event for a fleet rollout that was paused by a user
+func GetFleetRolloutPausedEvent(ctx context.Context, name string, templateVersion string) *domain.Event {
+	details := domain.FleetRolloutPausedDetails{
+		DetailType:      domain.FleetRolloutPaused,
+		TemplateVersion: templateVersion,
+	}
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromFleetRolloutPausedDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.FleetKind,
+		resourceName: name,
+		reason:       domain.EventReasonFleetRolloutPaused,
+		message:      fmt.Sprintf("Fleet rollout of template version %s was paused.", templateVersion),
+		details:      &eventDetails,
+	})
+}
+
+// GetFleetRolloutResumedEvent creates an event for a fleet rollout that was resumed by a user
+func GetFleetRolloutResumedEvent(ctx context.Context, name string, templateVersion string) *domain.Event {
+	details := domain.FleetRolloutResumedDetails{
+		DetailType:      domain.FleetRolloutResumed,
+		TemplateVersion: templateVersion,
+	}
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromFleetRolloutResumedDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.FleetKind,
+		resourceName: name,
+		reason:       domain.EventReasonFleetRolloutResumed,
+		message:      fmt.Sprintf("Fleet rollout of template version %s was resumed.", templateVersion),
+		details:      &eventDetails,
+	})
+}
+
+// GetFleetRolloutAbortedEvent creates an event for a fleet rollout that was aborted by a user
+func GetFleetRolloutAbortedEvent(ctx context.Context, name string, templateVersion string) *domain.Event {
+	details := domain.FleetRolloutAbortedDetails{
+		DetailType:      domain.FleetRolloutAborted,
+		TemplateVersion: templateVersion,
+	}
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromFleetRolloutAbortedDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.FleetKind,
+		resourceName: name,
+		reason:       domain.EventReasonFleetRolloutAborted,
+		message:      fmt.Sprintf("Fleet rollout of template version %s was aborted.", templateVersion),
+		details:      &eventDetails,
+	})
+}
+
 // GetRepositoryAccessibleEvent creates an event for repository accessibility
 func GetRepositoryAccessibleEvent(ctx context.Context, name string) *domain.Event {
 	return getBaseEvent(ctx, resourceEvent{
@@ -633,6 +743,17 @@ func GetReferencedRepositoryUpdatedEvent(ctx context.Context, kind domain.Resour
 	})
 }
 
+// GetNotificationDeliveryFailedEvent creates an event for an event that a notification sink failed to deliver
+func GetNotificationDeliveryFailedEvent(ctx context.Context, sinkName string, event domain.Event, errorMessage string) *domain.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.NotificationSinkKind,
+		resourceName: sinkName,
+		reason:       domain.EventReasonNotificationDeliveryFailed,
+		message:      fmt.Sprintf("Failed to deliver %s event of %s %s: %s.", event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name, errorMessage),
+		details:      nil,
+	})
+}
+
 // GetSystemRestoredEvent creates an event for system restoration completion
 // Associates the event with a system-level resource using the System kind
 func GetSystemRestoredEvent(ctx context.Context, devicesUpdated int64) *domain.Event {
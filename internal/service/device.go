@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
@@ -281,13 +283,67 @@ func (h *ServiceHandler) ReplaceDeviceStatus(ctx context.Context, orgId uuid.UUI
 	*deviceToStore = *originalDevice
 
 	common.KeepDBDeviceStatus(&incomingDevice, deviceToStore)
+	keepSecretEncryptionKey(ctx, &incomingDevice, originalDevice)
 	deviceToStore.Status = incomingDevice.Status
 	_ = common.UpdateServiceSideStatus(ctx, orgId, deviceToStore, h.store, h.log)
 
 	result, err := h.store.Device().UpdateStatus(ctx, orgId, deviceToStore, h.callbackDeviceUpdated)
+	if err == nil {
+		h.notifySecretEncryptionKeyChanged(ctx, orgId, originalDevice, result)
+	}
 	return result, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 }
 
+// keepSecretEncryptionKey keeps the stored key for the encryption of Secret resources unless the status update is
+// made by the device's agent over its mTLS-authenticated connection. Secrets are encrypted to this key, so users that
+// may write the device status must not be able to replace it with a key of their own.
+func keepSecretEncryptionKey(ctx context.Context, device, storedDevice *domain.Device) {
+	if _, isAgent := ctx.Value(consts.AgentCtxKey).(string); isAgent || device.Status == nil {
+		return
+	}
+	var storedKey string
+	var found bool
+	if storedDevice != nil && storedDevice.Status != nil {
+		storedKey, found = storedDevice.Status.SystemInfo.Get(domain.DeviceSystemInfoSecretEncryptionKey)
+	}
+	if found {
+		device.Status.SystemInfo.Set(domain.DeviceSystemInfoSecretEncryptionKey, storedKey)
+	} else {
+		delete(device.Status.SystemInfo.AdditionalProperties, domain.DeviceSystemInfoSecretEncryptionKey)
+	}
+}
+
+// notifySecretEncryptionKeyChanged renders a device that receives Secret resources again when it reports a
+// new key for their encryption, as the secrets in its rendered spec can only be decrypted with the old key.
+func (h *ServiceHandler) notifySecretEncryptionKeyChanged(ctx context.Context, orgId uuid.UUID, oldDevice, newDevice *domain.Device) {
+	// the status update does not change the spec, so the spec of the old device is the current one
+	if newDevice == nil || newDevice.Status == nil || !deviceHasSecretConfig(oldDevice) {
+		return
+	}
+	newKey, _ := newDevice.Status.SystemInfo.Get(domain.DeviceSystemInfoSecretEncryptionKey)
+	var oldKey string
+	if oldDevice.Status != nil {
+		oldKey, _ = oldDevice.Status.SystemInfo.Get(domain.DeviceSystemInfoSecretEncryptionKey)
+	}
+	if newKey == "" || newKey == oldKey {
+		return
+	}
+
+	fingerprint := sha256.Sum256([]byte(newKey))
+	h.CreateEvent(ctx, orgId, common.GetDependencyChangeDetectedEvent(ctx, domain.DeviceKind, lo.FromPtr(newDevice.Metadata.Name),
+		domain.DeviceSystemInfoSecretEncryptionKey, hex.EncodeToString(fingerprint[:])))
+}
+
+func deviceHasSecretConfig(device *domain.Device) bool {
+	if device == nil || device.Spec == nil || device.Spec.Config == nil {
+		return false
+	}
+	return lo.ContainsBy(*device.Spec.Config, func(item domain.ConfigProviderSpec) bool {
+		configType, err := item.Type()
+		return err == nil && configType == domain.SecretProviderType
+	})
+}
+
 func (h *ServiceHandler) PatchDeviceStatus(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.Device, domain.Status) {
 	currentObj, err := h.store.Device().Get(ctx, orgId, name)
 	if err != nil {
@@ -319,6 +375,7 @@ func (h *ServiceHandler) PatchDeviceStatus(ctx context.Context, orgId uuid.UUID,
 	if !reflect.DeepEqual(currentObj.Spec, newObj.Spec) {
 		return nil, domain.StatusBadRequest("spec is immutable")
 	}
+	keepSecretEncryptionKey(ctx, newObj, currentObj)
 
 	NilOutManagedObjectMetaProperties(&newObj.Metadata)
 	newObj.Metadata.ResourceVersion = nil
@@ -414,6 +471,7 @@ func (h *ServiceHandler) PatchDevice(ctx context.Context, orgId uuid.UUID, name
 	if newObj.Spec != nil && newObj.Spec.Decommissioning != nil {
 		return nil, domain.StatusBadRequest("spec.decommissioning cannot be changed via patch request")
 	}
+	keepSecretEncryptionKey(ctx, newObj, currentObj)
 
 	NilOutManagedObjectMetaProperties(&newObj.Metadata)
 	newObj.Metadata.ResourceVersion = nil
@@ -450,6 +508,9 @@ func (h *ServiceHandler) DecommissionDevice(ctx context.Context, orgId uuid.UUID
 
 func (h *ServiceHandler) UpdateDeviceAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) domain.Status {
 	err := h.store.Device().UpdateAnnotations(ctx, orgId, name, annotations, deleteKeys)
+	if err == nil {
+		notifyWatchers(ctx, h.log, orgId, domain.DeviceKind, name, domain.WatchEventTypeModified)
+	}
 	return StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 }
 
@@ -479,6 +540,7 @@ func (h *ServiceHandler) UpdateRenderedDevice(ctx context.Context, orgId uuid.UU
 func (h *ServiceHandler) SetDeviceServiceConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []domain.Condition) domain.Status {
 	callback := func(ctx context.Context, orgId uuid.UUID, device *domain.Device, oldConditions, newConditions []domain.Condition) {
 		h.diffAndEmitConditionEvents(ctx, orgId, device, oldConditions, newConditions)
+		notifyWatchers(ctx, h.log, orgId, domain.DeviceKind, name, domain.WatchEventTypeModified)
 	}
 
 	err := h.store.Device().SetServiceConditions(ctx, orgId, name, conditions, callback)
@@ -635,16 +697,25 @@ func (h *ServiceHandler) ResumeDevices(ctx context.Context, orgId uuid.UUID, req
 // callbackDeviceUpdated is the device-specific callback that handles device events
 func (h *ServiceHandler) callbackDeviceUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.eventHandler.HandleDeviceUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+	if err == nil {
+		notifyWatchers(ctx, h.log, orgId, domain.DeviceKind, name, lo.Ternary(created, domain.WatchEventTypeAdded, domain.WatchEventTypeModified))
+	}
 }
 
 // callbackDeviceDecommission is the device-specific callback that handles device decommission events
 func (h *ServiceHandler) callbackDeviceDecommission(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.eventHandler.HandleDeviceDecommissionEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+	if err == nil {
+		notifyWatchers(ctx, h.log, orgId, domain.DeviceKind, name, domain.WatchEventTypeModified)
+	}
 }
 
 // callbackDeviceDeleted is the device-specific callback that handles device deletion events
 func (h *ServiceHandler) callbackDeviceDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+	if err == nil {
+		notifyWatchers(ctx, h.log, orgId, domain.DeviceKind, name, domain.WatchEventTypeDeleted)
+	}
 }
 
 // processAwaitingReconnectIfNeeded processes the awaiting reconnect annotation only if the KV store contains the awaiting reconnection key.
@@ -383,3 +383,64 @@ func TestDeviceDisconnected(t *testing.T) {
 	require.Equal(true, changed)
 	require.Equal(device.Status.Summary.Status, domain.DeviceSummaryStatusUnknown)
 }
+
+func TestReplaceDeviceStatusSecretEncryptionKey(t *testing.T) {
+	require := require.New(t)
+
+	ts := &TestStore{}
+	wc := &DummyWorkerClient{}
+	serviceHandler := &ServiceHandler{
+		eventHandler: NewEventHandler(ts, wc, logrus.New()),
+		store:        ts,
+		workerClient: wc,
+		log:          logrus.New(),
+	}
+	ctx := context.WithValue(context.Background(), consts.InternalRequestCtxKey, true)
+	testOrgId := uuid.New()
+
+	secretProvider := domain.SecretProviderSpec{Name: "creds"}
+	secretProvider.Secret.Name = "db-creds"
+	secretProvider.Secret.MountPath = "/etc/db"
+	configItem := domain.ConfigProviderSpec{}
+	require.NoError(configItem.FromSecretProviderSpec(secretProvider))
+
+	device := prepareDevice(testOrgId, "foo")
+	device.Spec.Config = &[]domain.ConfigProviderSpec{configItem}
+	_, err := ts.Device().Create(ctx, testOrgId, device, nil)
+	require.NoError(err)
+
+	agentCtx := context.WithValue(ctx, consts.AgentCtxKey, "true")
+	reportKeyWithContext := func(ctx context.Context, key string) *domain.Device {
+		incoming := *device
+		status := domain.NewDeviceStatus()
+		status.SystemInfo.AdditionalProperties = map[string]string{domain.DeviceSystemInfoSecretEncryptionKey: key}
+		incoming.Status = &status
+		result, retStatus := serviceHandler.ReplaceDeviceStatus(ctx, testOrgId, "foo", incoming)
+		require.Equal(statusSuccessCode, retStatus.Code)
+		return result
+	}
+	reportKey := func(key string) {
+		reportKeyWithContext(agentCtx, key)
+	}
+	dependencyChangeEvents := func() int {
+		return len(lo.Filter(*ts.events.events, func(e domain.Event, _ int) bool {
+			return e.Reason == domain.EventReasonDependencyChangeDetected && e.InvolvedObject.Name == "foo"
+		}))
+	}
+
+	reportKey("key1")
+	require.Equal(1, dependencyChangeEvents())
+
+	// reporting the same key again does not render the device again
+	reportKey("key1")
+	require.Equal(1, dependencyChangeEvents())
+
+	reportKey("key2")
+	require.Equal(2, dependencyChangeEvents())
+
+	// status updates that are not made by the agent cannot replace the key
+	result := reportKeyWithContext(ctx, "attacker-key")
+	key, _ := result.Status.SystemInfo.Get(domain.DeviceSystemInfoSecretEncryptionKey)
+	require.Equal("key2", key)
+	require.Equal(2, dependencyChangeEvents())
+}
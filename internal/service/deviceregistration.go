@@ -0,0 +1,142 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func (h *ServiceHandler) CreateDeviceRegistration(ctx context.Context, orgId uuid.UUID, registration domain.DeviceRegistration) (*domain.DeviceRegistration, domain.Status) {
+	// don't set fields that are managed by the service
+	registration.Status = nil
+	NilOutManagedObjectMetaProperties(&registration.Metadata)
+
+	if errs := registration.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, err := h.store.DeviceRegistration().Create(ctx, orgId, &registration, h.callbackDeviceRegistrationUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.DeviceRegistrationKind, registration.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListDeviceRegistrations(ctx context.Context, orgId uuid.UUID, params domain.ListDeviceRegistrationsParams) (*domain.DeviceRegistrationList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.DeviceRegistration().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetDeviceRegistration(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceRegistration, domain.Status) {
+	result, err := h.store.DeviceRegistration().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.DeviceRegistrationKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceDeviceRegistration(ctx context.Context, orgId uuid.UUID, name string, registration domain.DeviceRegistration) (*domain.DeviceRegistration, domain.Status) {
+	// don't overwrite fields that are managed by the service for external requests
+	if !IsInternalRequest(ctx) {
+		registration.Status = nil
+		NilOutManagedObjectMetaProperties(&registration.Metadata)
+	}
+
+	if errs := registration.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *registration.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, created, err := h.store.DeviceRegistration().CreateOrUpdate(ctx, orgId, &registration, h.callbackDeviceRegistrationUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.DeviceRegistrationKind, &name)
+}
+
+func (h *ServiceHandler) DeleteDeviceRegistration(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	err := h.store.DeviceRegistration().Delete(ctx, orgId, name, h.callbackDeviceRegistrationDeleted)
+	return StoreErrorToApiStatus(err, false, domain.DeviceRegistrationKind, &name)
+}
+
+// callbackDeviceRegistrationUpdated is the device registration-specific callback that handles device registration update events
+func (h *ServiceHandler) callbackDeviceRegistrationUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleDeviceRegistrationUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackDeviceRegistrationDeleted is the device registration-specific callback that handles device registration deletion events
+func (h *ServiceHandler) callbackDeviceRegistrationDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// deviceRegistrationMatches returns whether the enrollment request comes from the pre-registered device.
+// A registration that lists a TPM endorsement key certificate matches requests whose TCG CSR carries the same
+// certificate; if requireTPMVerified is set, the device must also have proven that it holds the key.
+// A registration that lists system info matches requests reporting the same value for every listed key.
+// A registration that was already used to enroll a different request does not match.
+func deviceRegistrationMatches(registration *domain.DeviceRegistration, er *domain.EnrollmentRequest, requireTPMVerified bool) bool {
+	if registration.Status != nil && registration.Status.EnrollmentRequest != nil && *registration.Status.EnrollmentRequest != lo.FromPtr(er.Metadata.Name) {
+		return false
+	}
+
+	if registration.Spec.TpmEkCertificate == nil && len(lo.FromPtr(registration.Spec.SystemInfo)) == 0 {
+		return false
+	}
+
+	if registration.Spec.TpmEkCertificate != nil {
+		ekCert, err := registration.Spec.TPMEKCertificateDER()
+		if err != nil || ekCert == nil {
+			return false
+		}
+		requestEKCert, ok := enrollmentRequestEKCertificate(er)
+		if !ok || !bytes.Equal(ekCert, requestEKCert) {
+			return false
+		}
+		if requireTPMVerified && (er.Status == nil || !domain.IsStatusConditionTrue(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestTPMVerified)) {
+			return false
+		}
+	}
+
+	if registration.Spec.SystemInfo != nil {
+		var systemInfo map[string]string
+		if er.Spec.DeviceStatus != nil {
+			systemInfo = er.Spec.DeviceStatus.SystemInfo.AdditionalProperties
+		}
+		for k, v := range *registration.Spec.SystemInfo {
+			if value, ok := systemInfo[k]; !ok || value != v {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// enrollmentRequestEKCertificate returns the DER encoding of the TPM endorsement key certificate
+// the enrollment request's TCG CSR carries.
+func enrollmentRequestEKCertificate(er *domain.EnrollmentRequest) ([]byte, bool) {
+	csrBytes, isTPM := tpm.ParseTCGCSRBytes(er.Spec.Csr)
+	if !isTPM {
+		return nil, false
+	}
+	parsed, err := tpm.ParseTCGCSR(csrBytes)
+	if err != nil || parsed.CSRContents == nil || parsed.CSRContents.Payload == nil || len(parsed.CSRContents.Payload.EkCert) == 0 {
+		return nil, false
+	}
+	return parsed.CSRContents.Payload.EkCert, true
+}
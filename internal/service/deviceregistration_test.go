@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+// newTestEKCertificate returns the DER and PEM encodings of a self-signed certificate standing in for a TPM EK certificate.
+func newTestEKCertificate(t *testing.T) ([]byte, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "ek"}, NotAfter: time.Now().Add(time.Hour)}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return der, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestDeviceRegistrationMatches(t *testing.T) {
+	ekCert, ekCertPEM := newTestEKCertificate(t)
+	_, otherEKCertPEM := newTestEKCertificate(t)
+
+	signer, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tcgCSR, err := tpm.BuildTCGCSRIDevID([]byte("csr"), "model", "SN-0001", ekCert, []byte("attest"), []byte("signing"), []byte("info"), []byte("signature"), signer)
+	require.NoError(t, err)
+
+	deviceStatus := domain.NewDeviceStatus()
+	deviceStatus.SystemInfo.AdditionalProperties = map[string]string{productSerialSystemInfoKey: "SN-0001", "productModel": "X1"}
+	er := &domain.EnrollmentRequest{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr("device")},
+		Spec: domain.EnrollmentRequestSpec{
+			Csr:          base64.StdEncoding.EncodeToString(tcgCSR),
+			DeviceStatus: &deviceStatus,
+		},
+		Status: &domain.EnrollmentRequestStatus{},
+	}
+	verified := *er
+	verified.Status = &domain.EnrollmentRequestStatus{
+		Conditions: []domain.Condition{{
+			Type:   domain.ConditionTypeEnrollmentRequestTPMVerified,
+			Status: domain.ConditionStatusTrue,
+		}},
+	}
+	nonTPM := *er
+	nonTPM.Spec.Csr = "not a TCG CSR"
+
+	tests := []struct {
+		name               string
+		spec               domain.DeviceRegistrationSpec
+		status             *domain.DeviceRegistrationStatus
+		er                 *domain.EnrollmentRequest
+		requireTPMVerified bool
+		expected           bool
+	}{
+		{
+			name:     "When the EK certificate is the same it should match",
+			spec:     domain.DeviceRegistrationSpec{TpmEkCertificate: &ekCertPEM},
+			er:       er,
+			expected: true,
+		},
+		{
+			name:               "When the TPM must be verified and is it should match",
+			spec:               domain.DeviceRegistrationSpec{TpmEkCertificate: &ekCertPEM},
+			er:                 &verified,
+			requireTPMVerified: true,
+			expected:           true,
+		},
+		{
+			name:               "When the TPM must be verified and is not it should not match",
+			spec:               domain.DeviceRegistrationSpec{TpmEkCertificate: &ekCertPEM},
+			er:                 er,
+			requireTPMVerified: true,
+			expected:           false,
+		},
+		{
+			name:     "When the EK certificate differs it should not match",
+			spec:     domain.DeviceRegistrationSpec{TpmEkCertificate: &otherEKCertPEM},
+			er:       er,
+			expected: false,
+		},
+		{
+			name:     "When the request has no TPM it should not match an EK certificate",
+			spec:     domain.DeviceRegistrationSpec{TpmEkCertificate: &ekCertPEM},
+			er:       &nonTPM,
+			expected: false,
+		},
+		{
+			name:     "When the system info is the same it should match",
+			spec:     domain.DeviceRegistrationSpec{SystemInfo: &map[string]string{productSerialSystemInfoKey: "SN-0001", "productModel": "X1"}},
+			er:       &nonTPM,
+			expected: true,
+		},
+		{
+			name:     "When the system info differs it should not match",
+			spec:     domain.DeviceRegistrationSpec{SystemInfo: &map[string]string{productSerialSystemInfoKey: "SN-0002"}},
+			er:       &nonTPM,
+			expected: false,
+		},
+		{
+			name:     "When the EK certificate is the same but the system info differs it should not match",
+			spec:     domain.DeviceRegistrationSpec{TpmEkCertificate: &ekCertPEM, SystemInfo: &map[string]string{"productModel": "X2"}},
+			er:       er,
+			expected: false,
+		},
+		{
+			name:     "When the registration enrolled the same request it should match",
+			spec:     domain.DeviceRegistrationSpec{SystemInfo: &map[string]string{productSerialSystemInfoKey: "SN-0001"}},
+			status:   &domain.DeviceRegistrationStatus{EnrollmentRequest: lo.ToPtr("device")},
+			er:       er,
+			expected: true,
+		},
+		{
+			name:     "When the registration enrolled a different request it should not match",
+			spec:     domain.DeviceRegistrationSpec{SystemInfo: &map[string]string{productSerialSystemInfoKey: "SN-0001"}},
+			status:   &domain.DeviceRegistrationStatus{EnrollmentRequest: lo.ToPtr("other-device")},
+			er:       er,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registration := &domain.DeviceRegistration{Spec: tt.spec, Status: tt.status}
+			require.Equal(t, tt.expected, deviceRegistrationMatches(registration, tt.er, tt.requireTPMVerified))
+		})
+	}
+}
+
+func TestCreateEnrollmentRequestDeviceRegistration(t *testing.T) {
+	orgId := store.NullOrgId
+	newRegisteredEnrollmentRequest := func(t *testing.T, name string, serial string) domain.EnrollmentRequest {
+		er := newAutoApprovalEnrollmentRequest(t, name, map[string]string{"model": "x1"})
+		er.Spec.DeviceStatus.SystemInfo.AdditionalProperties = map[string]string{productSerialSystemInfoKey: serial}
+		return er
+	}
+
+	t.Run("When a registration matches it should approve the request and record the enrollment", func(t *testing.T) {
+		require := require.New(t)
+		serviceHandler, testStore := newAutoApprovalServiceHandler(t)
+		_, ctx := newTestServiceHandler(t, testStore, nil)
+
+		_, status := serviceHandler.CreateDeviceRegistration(ctx, orgId, domain.DeviceRegistration{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("sn-0001")},
+			Spec: domain.DeviceRegistrationSpec{
+				SystemInfo: &map[string]string{productSerialSystemInfoKey: "SN-0001"},
+				Labels:     &map[string]string{"site": "berlin"},
+			},
+		})
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+		// the registration takes precedence over a policy the request matches as well
+		_, status = serviceHandler.CreateEnrollmentPolicy(ctx, orgId, domain.EnrollmentPolicy{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("models")},
+			Spec: domain.EnrollmentPolicySpec{
+				Match: domain.EnrollmentPolicyMatch{Labels: &map[string]string{"model": "x1"}},
+			},
+		})
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+
+		result, status := serviceHandler.CreateEnrollmentRequest(ctx, orgId, newRegisteredEnrollmentRequest(t, "registered-device-1", "SN-0001"))
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+		require.NotNil(result.Status.Certificate)
+		require.NotNil(result.Status.Approval)
+		require.Equal("sn-0001", lo.FromPtr(result.Status.Approval.DeviceRegistration))
+		require.Nil(result.Status.Approval.EnrollmentPolicy)
+		require.Equal("DeviceRegistration/sn-0001", result.Status.Approval.ApprovedBy)
+		approved := domain.FindStatusCondition(result.Status.Conditions, domain.ConditionTypeEnrollmentRequestApproved)
+		require.NotNil(approved)
+		require.Equal("AutoApproved", approved.Reason)
+
+		device, err := testStore.Device().Get(ctx, orgId, "registered-device-1")
+		require.NoError(err)
+		require.Equal(map[string]string{"model": "x1", "site": "berlin"}, lo.FromPtr(device.Metadata.Labels))
+		require.Equal("sn-0001", lo.FromPtr(device.Metadata.Annotations)[domain.DeviceAnnotationDeviceRegistration])
+
+		registration, err := testStore.DeviceRegistration().Get(ctx, orgId, "sn-0001")
+		require.NoError(err)
+		require.NotNil(registration.Status)
+		require.Equal("registered-device-1", lo.FromPtr(registration.Status.EnrollmentRequest))
+		require.NotNil(registration.Status.EnrolledAt)
+
+		// a second device claiming the same serial number is not approved by the used registration
+		result, status = serviceHandler.CreateEnrollmentRequest(ctx, orgId, newRegisteredEnrollmentRequest(t, "registered-device-2", "SN-0001"))
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+		require.Equal("models", lo.FromPtr(result.Status.Approval.EnrollmentPolicy))
+	})
+
+	t.Run("When another request claimed the registration concurrently it should not approve the request", func(t *testing.T) {
+		require := require.New(t)
+		serviceHandler, testStore := newAutoApprovalServiceHandler(t)
+		_, ctx := newTestServiceHandler(t, testStore, nil)
+
+		_, status := serviceHandler.CreateDeviceRegistration(ctx, orgId, domain.DeviceRegistration{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("sn-0001")},
+			Spec: domain.DeviceRegistrationSpec{
+				SystemInfo: &map[string]string{productSerialSystemInfoKey: "SN-0001"},
+			},
+		})
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+		// the other request claims the registration after this one listed it
+		_, err := testStore.DeviceRegistration().ClaimEnrollment(ctx, orgId, "sn-0001", "registered-device-1", time.Now())
+		require.NoError(err)
+		serviceHandler.store = &staleRegistrationsStore{TestStore: testStore}
+
+		result, status := serviceHandler.CreateEnrollmentRequest(ctx, orgId, newRegisteredEnrollmentRequest(t, "registered-device-2", "SN-0001"))
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+		require.Nil(result.Status.Approval)
+		_, err = testStore.Device().Get(ctx, orgId, "registered-device-2")
+		require.Error(err)
+
+		registration, err := testStore.DeviceRegistration().Get(ctx, orgId, "sn-0001")
+		require.NoError(err)
+		require.Equal("registered-device-1", lo.FromPtr(registration.Status.EnrollmentRequest))
+	})
+
+	t.Run("When registration is required it should reject unregistered agents only", func(t *testing.T) {
+		require := require.New(t)
+		serviceHandler, testStore := newAutoApprovalServiceHandler(t)
+		serviceHandler.requireDeviceRegistration = true
+		serviceHandler.agentGate = semaphore.NewWeighted(1)
+		_, ctx := newTestServiceHandler(t, testStore, nil)
+		agentCtx := context.WithValue(ctx, consts.AgentCtxKey, "true")
+
+		_, status := serviceHandler.CreateDeviceRegistration(ctx, orgId, domain.DeviceRegistration{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("sn-0001")},
+			Spec: domain.DeviceRegistrationSpec{
+				SystemInfo: &map[string]string{productSerialSystemInfoKey: "SN-0001"},
+			},
+		})
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+
+		_, status = serviceHandler.CreateEnrollmentRequest(agentCtx, orgId, newRegisteredEnrollmentRequest(t, "unregistered-device", "SN-0002"))
+		require.Equal(int32(403), status.Code, status.Message)
+		require.Contains(status.Message, "does not match any device registration")
+		_, err := testStore.EnrollmentRequest().Get(ctx, orgId, "unregistered-device")
+		require.Error(err)
+
+		result, status := serviceHandler.CreateEnrollmentRequest(agentCtx, orgId, newRegisteredEnrollmentRequest(t, "registered-device-1", "SN-0001"))
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+		require.Equal("sn-0001", lo.FromPtr(result.Status.Approval.DeviceRegistration))
+
+		// requests that are not sent by an agent are not rejected and stay pending
+		result, status = serviceHandler.CreateEnrollmentRequest(ctx, orgId, newRegisteredEnrollmentRequest(t, "manually-created-device", "SN-0003"))
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+		require.Nil(result.Status.Approval)
+	})
+}
+
+// staleRegistrationsStore lists the device registrations as they were before any of them enrolled a request.
+type staleRegistrationsStore struct {
+	*TestStore
+}
+
+func (s *staleRegistrationsStore) DeviceRegistration() store.DeviceRegistration {
+	return &staleDeviceRegistration{DeviceRegistration: s.TestStore.DeviceRegistration()}
+}
+
+type staleDeviceRegistration struct {
+	store.DeviceRegistration
+}
+
+func (s *staleDeviceRegistration) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.DeviceRegistrationList, error) {
+	list, err := s.DeviceRegistration.List(ctx, orgId, listParams)
+	if err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		list.Items[i].Status = nil
+	}
+	return list, nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func (h *ServiceHandler) CreateEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, policy domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status) {
+	// don't set fields that are managed by the service
+	NilOutManagedObjectMetaProperties(&policy.Metadata)
+
+	if errs := policy.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, err := h.store.EnrollmentPolicy().Create(ctx, orgId, &policy, h.callbackEnrollmentPolicyUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.EnrollmentPolicyKind, policy.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListEnrollmentPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentPoliciesParams) (*domain.EnrollmentPolicyList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.EnrollmentPolicy().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentPolicy, domain.Status) {
+	result, err := h.store.EnrollmentPolicy().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.EnrollmentPolicyKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string, policy domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status) {
+	// don't overwrite fields that are managed by the service for external requests
+	if !IsInternalRequest(ctx) {
+		NilOutManagedObjectMetaProperties(&policy.Metadata)
+	}
+
+	if errs := policy.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *policy.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, created, err := h.store.EnrollmentPolicy().CreateOrUpdate(ctx, orgId, &policy, h.callbackEnrollmentPolicyUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.EnrollmentPolicyKind, &name)
+}
+
+func (h *ServiceHandler) DeleteEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	err := h.store.EnrollmentPolicy().Delete(ctx, orgId, name, h.callbackEnrollmentPolicyDeleted)
+	return StoreErrorToApiStatus(err, false, domain.EnrollmentPolicyKind, &name)
+}
+
+// callbackEnrollmentPolicyUpdated is the enrollment policy-specific callback that handles enrollment policy update events
+func (h *ServiceHandler) callbackEnrollmentPolicyUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleEnrollmentPolicyUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackEnrollmentPolicyDeleted is the enrollment policy-specific callback that handles enrollment policy deletion events
+func (h *ServiceHandler) callbackEnrollmentPolicyDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// productSerialSystemInfoKey is the system info key the agent reports the serial number of the device with.
+const productSerialSystemInfoKey = "productSerial"
+
+// enrollmentPolicyMatches returns whether the enrollment request satisfies every rule of the policy's match.
+// Rules that are not set are not evaluated, and a rule whose input is unknown does not match.
+func enrollmentPolicyMatches(policy *domain.EnrollmentPolicy, er *domain.EnrollmentRequest, now time.Time) bool {
+	match := policy.Spec.Match
+
+	if lo.FromPtr(match.TpmVerified) {
+		if er.Status == nil || !domain.IsStatusConditionTrue(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestTPMVerified) {
+			return false
+		}
+	}
+
+	if match.Labels != nil {
+		requestLabels := lo.FromPtr(er.Spec.Labels)
+		for k, v := range *match.Labels {
+			if value, ok := requestLabels[k]; !ok || value != v {
+				return false
+			}
+		}
+	}
+
+	if match.SerialNumbers != nil {
+		serial := ""
+		if er.Spec.DeviceStatus != nil {
+			serial = er.Spec.DeviceStatus.SystemInfo.AdditionalProperties[productSerialSystemInfoKey]
+		}
+		if serial == "" || !slices.Contains(*match.SerialNumbers, serial) {
+			return false
+		}
+	}
+
+	if match.SourceCidrs != nil {
+		sourceAddress := net.ParseIP(lo.FromPtr(er.Metadata.Annotations)[domain.EnrollmentRequestAnnotationSourceAddress])
+		if sourceAddress == nil {
+			return false
+		}
+		if !lo.ContainsBy(*match.SourceCidrs, func(cidr string) bool {
+			_, network, err := net.ParseCIDR(cidr)
+			return err == nil && network.Contains(sourceAddress)
+		}) {
+			return false
+		}
+	}
+
+	if match.TimeWindow != nil {
+		if match.TimeWindow.NotBefore != nil && now.Before(*match.TimeWindow.NotBefore) {
+			return false
+		}
+		if match.TimeWindow.NotAfter != nil && now.After(*match.TimeWindow.NotAfter) {
+			return false
+		}
+	}
+
+	return true
+}
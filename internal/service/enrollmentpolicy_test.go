@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnrollmentPolicyMatches(t *testing.T) {
+	now := time.Now()
+	deviceStatus := domain.NewDeviceStatus()
+	deviceStatus.SystemInfo.AdditionalProperties = map[string]string{productSerialSystemInfoKey: "SN-0001"}
+	er := &domain.EnrollmentRequest{
+		Metadata: domain.ObjectMeta{
+			Name:        lo.ToPtr("device"),
+			Annotations: &map[string]string{domain.EnrollmentRequestAnnotationSourceAddress: "10.20.1.2"},
+		},
+		Spec: domain.EnrollmentRequestSpec{
+			Labels:       &map[string]string{"site": "berlin", "model": "x1"},
+			DeviceStatus: &deviceStatus,
+		},
+		Status: &domain.EnrollmentRequestStatus{
+			Conditions: []domain.Condition{{
+				Type:   domain.ConditionTypeEnrollmentRequestTPMVerified,
+				Status: domain.ConditionStatusTrue,
+			}},
+		},
+	}
+	unverified := *er
+	unverified.Status = &domain.EnrollmentRequestStatus{}
+	unknownSource := *er
+	unknownSource.Metadata.Annotations = nil
+
+	tests := []struct {
+		name     string
+		match    domain.EnrollmentPolicyMatch
+		er       *domain.EnrollmentRequest
+		expected bool
+	}{
+		{
+			name:     "When the TPM is verified it should match",
+			match:    domain.EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(true), Labels: &map[string]string{"site": "berlin"}},
+			er:       er,
+			expected: true,
+		},
+		{
+			name:     "When the TPM is not verified it should not match",
+			match:    domain.EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(true), Labels: &map[string]string{"site": "berlin"}},
+			er:       &unverified,
+			expected: false,
+		},
+		{
+			name:     "When a label differs it should not match",
+			match:    domain.EnrollmentPolicyMatch{Labels: &map[string]string{"site": "berlin", "model": "x2"}},
+			er:       er,
+			expected: false,
+		},
+		{
+			name:     "When the serial number is allowed it should match",
+			match:    domain.EnrollmentPolicyMatch{SerialNumbers: &[]string{"SN-0002", "SN-0001"}},
+			er:       er,
+			expected: true,
+		},
+		{
+			name:     "When the serial number is not allowed it should not match",
+			match:    domain.EnrollmentPolicyMatch{SerialNumbers: &[]string{"SN-0002"}},
+			er:       er,
+			expected: false,
+		},
+		{
+			name:     "When the source address is in a CIDR it should match",
+			match:    domain.EnrollmentPolicyMatch{SourceCidrs: &[]string{"192.168.0.0/16", "10.20.0.0/16"}},
+			er:       er,
+			expected: true,
+		},
+		{
+			name:     "When the source address is not in a CIDR it should not match",
+			match:    domain.EnrollmentPolicyMatch{SourceCidrs: &[]string{"192.168.0.0/16"}},
+			er:       er,
+			expected: false,
+		},
+		{
+			name:     "When the source address is unknown it should not match",
+			match:    domain.EnrollmentPolicyMatch{SourceCidrs: &[]string{"0.0.0.0/0"}},
+			er:       &unknownSource,
+			expected: false,
+		},
+		{
+			name:     "When now is within the time window it should match",
+			match:    domain.EnrollmentPolicyMatch{TimeWindow: &domain.EnrollmentPolicyTimeWindow{NotBefore: lo.ToPtr(now.Add(-time.Hour)), NotAfter: lo.ToPtr(now.Add(time.Hour))}},
+			er:       er,
+			expected: true,
+		},
+		{
+			name:     "When the time window has ended it should not match",
+			match:    domain.EnrollmentPolicyMatch{TimeWindow: &domain.EnrollmentPolicyTimeWindow{NotAfter: lo.ToPtr(now.Add(-time.Hour))}},
+			er:       er,
+			expected: false,
+		},
+		{
+			name:     "When the time window has not started it should not match",
+			match:    domain.EnrollmentPolicyMatch{TimeWindow: &domain.EnrollmentPolicyTimeWindow{NotBefore: lo.ToPtr(now.Add(time.Hour))}},
+			er:       er,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &domain.EnrollmentPolicy{Spec: domain.EnrollmentPolicySpec{Match: tt.match}}
+			require.Equal(t, tt.expected, enrollmentPolicyMatches(policy, tt.er, now))
+		})
+	}
+}
+
+// newAutoApprovalEnrollmentRequest returns an enrollment request with a valid CSR and the given labels.
+func newAutoApprovalEnrollmentRequest(t *testing.T, name string, labels map[string]string) domain.EnrollmentRequest {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	csrTemplate := x509.CertificateRequest{
+		Subject:            pkix.Name{CommonName: name},
+		SignatureAlgorithm: x509.ECDSAWithSHA256,
+	}
+	csrBytes, err := x509.CreateCertificateRequest(rand.Reader, &csrTemplate, privateKey)
+	require.NoError(t, err)
+	return domain.EnrollmentRequest{
+		ApiVersion: "v1beta1",
+		Kind:       "EnrollmentRequest",
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec: domain.EnrollmentRequestSpec{
+			Csr:          string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrBytes})),
+			Labels:       &labels,
+			DeviceStatus: lo.ToPtr(domain.NewDeviceStatus()),
+		},
+	}
+}
+
+// newAutoApprovalServiceHandler returns a service handler with a CA that can sign enrollment requests.
+func newAutoApprovalServiceHandler(t *testing.T) (*ServiceHandler, *TestStore) {
+	caClient, _, err := crypto.EnsureCA(&ca.Config{
+		InternalConfig: &ca.InternalCfg{
+			CertStore:        t.TempDir(),
+			CertFile:         "ca.crt",
+			KeyFile:          "ca.key",
+			SerialFile:       "ca.serial",
+			SignerCertName:   "flightctl-test-ca",
+			CertValidityDays: 365,
+		},
+		DeviceManagementSignerName: "device-enrollment",
+	})
+	require.NoError(t, err)
+	testStore := &TestStore{}
+	serviceHandler, _ := newTestServiceHandler(t, testStore, caClient)
+	return serviceHandler, testStore
+}
+
+func TestCreateEnrollmentRequestAutoApproval(t *testing.T) {
+	orgId := store.NullOrgId
+
+	t.Run("When a policy matches it should approve the request and record the policy", func(t *testing.T) {
+		require := require.New(t)
+		serviceHandler, testStore := newAutoApprovalServiceHandler(t)
+		_, ctx := newTestServiceHandler(t, testStore, nil)
+		ctx = util.WithRemoteAddr(ctx, "10.20.1.2")
+
+		_, err := testStore.Fleet().Create(ctx, orgId, &domain.Fleet{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("berlin")},
+			Spec: domain.FleetSpec{
+				Selector: &domain.LabelSelector{MatchLabels: &map[string]string{"fleet": "berlin"}},
+			},
+		}, nil)
+		require.NoError(err)
+		for _, policy := range []domain.EnrollmentPolicy{
+			{
+				Metadata: domain.ObjectMeta{Name: lo.ToPtr("b-factory")},
+				Spec: domain.EnrollmentPolicySpec{
+					Match:  domain.EnrollmentPolicyMatch{SourceCidrs: &[]string{"10.20.0.0/16"}},
+					Labels: &map[string]string{"site": "factory"},
+					Fleet:  lo.ToPtr("berlin"),
+				},
+			},
+			{
+				Metadata: domain.ObjectMeta{Name: lo.ToPtr("a-other")},
+				Spec: domain.EnrollmentPolicySpec{
+					Match: domain.EnrollmentPolicyMatch{Labels: &map[string]string{"model": "other"}},
+				},
+			},
+		} {
+			_, status := serviceHandler.CreateEnrollmentPolicy(ctx, orgId, policy)
+			require.Equal(statusCreatedCode, status.Code, status.Message)
+		}
+
+		result, status := serviceHandler.CreateEnrollmentRequest(ctx, orgId, newAutoApprovalEnrollmentRequest(t, "auto-approved-device", map[string]string{"model": "x1"}))
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+		require.NotNil(result.Status)
+		require.NotNil(result.Status.Certificate)
+		require.NotNil(result.Status.Approval)
+		require.Equal("b-factory", lo.FromPtr(result.Status.Approval.EnrollmentPolicy))
+		require.Equal("EnrollmentPolicy/b-factory", result.Status.Approval.ApprovedBy)
+		approved := domain.FindStatusCondition(result.Status.Conditions, domain.ConditionTypeEnrollmentRequestApproved)
+		require.NotNil(approved)
+		require.Equal("AutoApproved", approved.Reason)
+
+		device, err := testStore.Device().Get(ctx, orgId, "auto-approved-device")
+		require.NoError(err)
+		require.Equal(map[string]string{"model": "x1", "site": "factory", "fleet": "berlin"}, lo.FromPtr(device.Metadata.Labels))
+		require.Equal("b-factory", lo.FromPtr(device.Metadata.Annotations)[domain.DeviceAnnotationEnrollmentPolicy])
+	})
+
+	t.Run("When no policy matches it should leave the request pending", func(t *testing.T) {
+		require := require.New(t)
+		serviceHandler, testStore := newAutoApprovalServiceHandler(t)
+		_, ctx := newTestServiceHandler(t, testStore, nil)
+
+		_, status := serviceHandler.CreateEnrollmentPolicy(ctx, orgId, domain.EnrollmentPolicy{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("factory")},
+			Spec: domain.EnrollmentPolicySpec{
+				Match: domain.EnrollmentPolicyMatch{SourceCidrs: &[]string{"10.20.0.0/16"}},
+			},
+		})
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+
+		// requests that are not sent by an agent have no source address and so cannot match source CIDRs
+		result, status := serviceHandler.CreateEnrollmentRequest(ctx, orgId, newAutoApprovalEnrollmentRequest(t, "manually-approved-device", map[string]string{"model": "x1"}))
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+		require.Nil(result.Status.Approval)
+		require.False(domain.IsStatusConditionTrue(result.Status.Conditions, domain.ConditionTypeEnrollmentRequestApproved))
+
+		_, err := testStore.Device().Get(ctx, orgId, "manually-approved-device")
+		require.Error(err)
+	})
+
+	t.Run("When the request carries its own source address it should ignore it", func(t *testing.T) {
+		require := require.New(t)
+		serviceHandler, testStore := newAutoApprovalServiceHandler(t)
+		_, ctx := newTestServiceHandler(t, testStore, nil)
+		ctx = context.WithValue(ctx, consts.InternalRequestCtxKey, true)
+
+		_, status := serviceHandler.CreateEnrollmentPolicy(ctx, orgId, domain.EnrollmentPolicy{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("factory")},
+			Spec: domain.EnrollmentPolicySpec{
+				Match: domain.EnrollmentPolicyMatch{SourceCidrs: &[]string{"10.20.0.0/16"}},
+			},
+		})
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+
+		er := newAutoApprovalEnrollmentRequest(t, "forged-address-device", map[string]string{"model": "x1"})
+		er.Metadata.Annotations = &map[string]string{domain.EnrollmentRequestAnnotationSourceAddress: "10.20.1.2"}
+		result, status := serviceHandler.CreateEnrollmentRequest(ctx, orgId, er)
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+		require.Nil(result.Status.Approval)
+		require.NotContains(lo.FromPtr(result.Metadata.Annotations), domain.EnrollmentRequestAnnotationSourceAddress)
+	})
+
+	t.Run("When the policy's fleet does not exist it should leave the request pending", func(t *testing.T) {
+		require := require.New(t)
+		serviceHandler, testStore := newAutoApprovalServiceHandler(t)
+		_, ctx := newTestServiceHandler(t, testStore, nil)
+
+		_, status := serviceHandler.CreateEnrollmentPolicy(ctx, orgId, domain.EnrollmentPolicy{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("missing-fleet")},
+			Spec: domain.EnrollmentPolicySpec{
+				Match: domain.EnrollmentPolicyMatch{Labels: &map[string]string{"model": "x1"}},
+				Fleet: lo.ToPtr("missing"),
+			},
+		})
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+
+		result, status := serviceHandler.CreateEnrollmentRequest(ctx, orgId, newAutoApprovalEnrollmentRequest(t, "device-without-fleet", map[string]string{"model": "x1"}))
+		require.Equal(statusCreatedCode, status.Code, status.Message)
+		require.Nil(result.Status.Approval)
+		require.Nil(result.Status.Certificate)
+	})
+}
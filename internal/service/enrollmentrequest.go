@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/kvstore"
 	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/flightctl/flightctl/internal/tpm"
 	"github.com/flightctl/flightctl/internal/util"
@@ -104,10 +106,14 @@ func approveAndSignEnrollmentRequest(ctx context.Context, ca *crypto.CAClient, e
 		}
 	}
 
+	reason := "ManuallyApproved"
+	if approval.EnrollmentPolicy != nil || approval.DeviceRegistration != nil {
+		reason = "AutoApproved"
+	}
 	condition := domain.Condition{
 		Type:    domain.ConditionTypeEnrollmentRequestApproved,
 		Status:  domain.ConditionStatusTrue,
-		Reason:  "ManuallyApproved",
+		Reason:  reason,
 		Message: "Approved by " + approval.ApprovedBy,
 	}
 	domain.SetStatusCondition(&enrollmentRequest.Status.Conditions, condition)
@@ -220,6 +226,14 @@ func (h *ServiceHandler) createDeviceFromEnrollmentRequest(ctx context.Context,
 	}
 	if enrollmentRequest.Status != nil && enrollmentRequest.Status.Approval != nil {
 		apiResource.Metadata.Labels = enrollmentRequest.Status.Approval.Labels
+
+		// Record the device registration or enrollment policy that approved the device
+		if registrationName := enrollmentRequest.Status.Approval.DeviceRegistration; registrationName != nil {
+			apiResource.Metadata.Annotations = &map[string]string{domain.DeviceAnnotationDeviceRegistration: *registrationName}
+		}
+		if policyName := enrollmentRequest.Status.Approval.EnrollmentPolicy; policyName != nil {
+			apiResource.Metadata.Annotations = &map[string]string{domain.DeviceAnnotationEnrollmentPolicy: *policyName}
+		}
 	}
 
 	// Transfer awaitingReconnect annotation from enrollment request to device if present
@@ -281,6 +295,17 @@ func (h *ServiceHandler) CreateEnrollmentRequest(ctx context.Context, orgId uuid
 		h.log.Infof("Adding awaitingReconnect annotation for knownRenderedVersion: %s", *er.Spec.KnownRenderedVersion)
 	}
 
+	// Record the address the request came from, so enrollment policies can match on it.  An address that the request
+	// carries itself is never trusted, even if the address of the request is unknown.
+	if er.Metadata.Annotations != nil {
+		delete(*er.Metadata.Annotations, domain.EnrollmentRequestAnnotationSourceAddress)
+	}
+	if remoteAddr, ok := util.GetRemoteAddrFromContext(ctx); ok {
+		annotations := util.EnsureMap(lo.FromPtr(er.Metadata.Annotations))
+		annotations[domain.EnrollmentRequestAnnotationSourceAddress] = remoteAddr
+		er.Metadata.Annotations = &annotations
+	}
+
 	if errs := er.Validate(); len(errs) > 0 {
 		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
 	}
@@ -298,6 +323,11 @@ func (h *ServiceHandler) CreateEnrollmentRequest(ctx context.Context, orgId uuid
 		}
 	}
 	if _, isAgent := ctx.Value(consts.AgentCtxKey).(string); isAgent {
+		if h.requireDeviceRegistration {
+			if status := h.checkDeviceRegistered(ctx, orgId, &er); status != domain.StatusOK() {
+				return nil, status
+			}
+		}
 		if h.agentGate.Acquire(ctx, 1) == nil {
 			defer h.agentGate.Release(1)
 		}
@@ -305,7 +335,10 @@ func (h *ServiceHandler) CreateEnrollmentRequest(ctx context.Context, orgId uuid
 
 	// Use fromAPI=false for internal requests to preserve annotations
 	result, err := h.store.EnrollmentRequest().CreateWithFromAPI(ctx, orgId, &er, false, h.callbackEnrollmentRequestUpdated)
-	return result, StoreErrorToApiStatus(err, true, domain.EnrollmentRequestKind, er.Metadata.Name)
+	if err != nil {
+		return result, StoreErrorToApiStatus(err, true, domain.EnrollmentRequestKind, er.Metadata.Name)
+	}
+	return h.autoApproveEnrollmentRequest(ctx, orgId, result), domain.StatusCreated()
 }
 
 func (h *ServiceHandler) ListEnrollmentRequests(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentRequestsParams) (*domain.EnrollmentRequestList, domain.Status) {
@@ -448,6 +481,9 @@ func (h *ServiceHandler) ApproveEnrollmentRequest(ctx context.Context, orgId uui
 		if domain.IsStatusConditionTrue(enrollmentReq.Status.Conditions, domain.ConditionTypeEnrollmentRequestApproved) {
 			return nil, domain.StatusBadRequest("Enrollment request is already approved")
 		}
+		if domain.IsStatusConditionTrue(enrollmentReq.Status.Conditions, domain.ConditionTypeEnrollmentRequestDenied) {
+			return nil, domain.StatusConflict("Enrollment request has already been denied")
+		}
 
 		identity, ok := contextutil.GetMappedIdentityFromContext(ctx)
 		if !ok {
@@ -494,7 +530,172 @@ func (h *ServiceHandler) ReplaceEnrollmentRequestStatus(ctx context.Context, org
 	addStatusIfNeeded(&er)
 
 	result, err := h.store.EnrollmentRequest().UpdateStatus(ctx, orgId, &er, h.callbackEnrollmentRequestUpdated)
-	return result, StoreErrorToApiStatus(err, false, domain.EnrollmentRequestKind, &name)
+	if err != nil {
+		return result, StoreErrorToApiStatus(err, false, domain.EnrollmentRequestKind, &name)
+	}
+	// the TPM of a device may only have been verified now, so its request can match registrations and policies it did not match before
+	return h.autoApproveEnrollmentRequest(ctx, orgId, result), domain.StatusOK()
+}
+
+// ExpireEnrollmentRequest denies a pending enrollment request that was not approved within the given TTL.
+// The agent stops waiting for approval once it sees the request denied.
+func (h *ServiceHandler) ExpireEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string, ttl time.Duration) domain.Status {
+	er, err := h.store.EnrollmentRequest().Get(ctx, orgId, name)
+	if err != nil {
+		return StoreErrorToApiStatus(err, false, domain.EnrollmentRequestKind, &name)
+	}
+	addStatusIfNeeded(er)
+	if domain.IsStatusConditionTrue(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestApproved) {
+		return domain.StatusConflict("Enrollment request is already approved")
+	}
+	if domain.IsStatusConditionTrue(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestDenied) {
+		return domain.StatusConflict("Enrollment request has already been denied")
+	}
+
+	domain.SetStatusCondition(&er.Status.Conditions, domain.Condition{
+		Type:    domain.ConditionTypeEnrollmentRequestDenied,
+		Status:  domain.ConditionStatusTrue,
+		Reason:  domain.RequestExpiredReason,
+		Message: fmt.Sprintf("Not approved within %s", ttl),
+	})
+	if _, err := h.store.EnrollmentRequest().UpdateStatus(ctx, orgId, er, h.callbackEnrollmentRequestUpdated); err != nil {
+		return StoreErrorToApiStatus(err, false, domain.EnrollmentRequestKind, &name)
+	}
+	h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestExpiredEvent(ctx, name, ttl))
+	return domain.StatusOK()
+}
+
+// checkDeviceRegistered returns a forbidden status unless the enrollment request matches a device registration.
+// The TPM of the device is not verified yet, so the request is only approved once it is.
+func (h *ServiceHandler) checkDeviceRegistered(ctx context.Context, orgId uuid.UUID, er *domain.EnrollmentRequest) domain.Status {
+	registrations, err := h.store.DeviceRegistration().List(ctx, orgId, store.ListParams{})
+	if err != nil {
+		return StoreErrorToApiStatus(err, false, domain.DeviceRegistrationKind, nil)
+	}
+	if !lo.ContainsBy(registrations.Items, func(r domain.DeviceRegistration) bool {
+		return deviceRegistrationMatches(&r, er, false)
+	}) {
+		h.log.Warnf("rejecting enrollment request %s/%s of an unregistered device", orgId, lo.FromPtr(er.Metadata.Name))
+		return domain.StatusForbidden(fmt.Sprintf("enrollment request %s does not match any device registration", lo.FromPtr(er.Metadata.Name)))
+	}
+	return domain.StatusOK()
+}
+
+// autoApproveEnrollmentRequest approves the enrollment request with the device registration of its device or,
+// if the device is not registered, with the first enrollment policy, in order of name, that it matches. It returns
+// the enrollment request as stored afterwards; if nothing matches or the approval fails, the request is returned
+// unchanged and stays pending for manual approval.
+func (h *ServiceHandler) autoApproveEnrollmentRequest(ctx context.Context, orgId uuid.UUID, er *domain.EnrollmentRequest) *domain.EnrollmentRequest {
+	if er.Status != nil && (domain.IsStatusConditionTrue(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestApproved) || domain.IsStatusConditionTrue(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestDenied)) {
+		return er
+	}
+	name := lo.FromPtr(er.Metadata.Name)
+	now := time.Now()
+
+	// a device registration takes precedence over enrollment policies, as it identifies the individual device
+	registrations, err := h.store.DeviceRegistration().List(ctx, orgId, store.ListParams{})
+	if err != nil {
+		h.log.WithError(err).Errorf("failed listing device registrations for enrollment request %s/%s", orgId, name)
+		return er
+	}
+	if registration, found := lo.Find(registrations.Items, func(r domain.DeviceRegistration) bool {
+		return deviceRegistrationMatches(&r, er, true)
+	}); found {
+		registrationName := lo.FromPtr(registration.Metadata.Name)
+		// the registration is claimed before the approval, so that it approves only one of concurrent requests
+		if _, err := h.store.DeviceRegistration().ClaimEnrollment(ctx, orgId, registrationName, name, now); err != nil {
+			if errors.Is(err, flterrors.ErrNoRowsUpdated) {
+				h.log.Warnf("device registration %s/%s enrolled another request than enrollment request %s", orgId, registrationName, name)
+			} else {
+				h.log.WithError(err).Errorf("failed claiming device registration %s/%s for enrollment request %s", orgId, registrationName, name)
+			}
+			return er
+		}
+		approval := domain.EnrollmentRequestApprovalStatus{
+			Approved:           true,
+			Labels:             lo.ToPtr(lo.Assign(lo.FromPtr(registration.Spec.Labels))),
+			ApprovedAt:         now,
+			ApprovedBy:         fmt.Sprintf("%s/%s", domain.DeviceRegistrationKind, registrationName),
+			DeviceRegistration: &registrationName,
+		}
+		result, approved := h.autoApproveEnrollmentRequestWith(ctx, orgId, er, &approval)
+		if !approved {
+			// release the claim, so the registration can approve the request on a later attempt
+			if _, err := h.store.DeviceRegistration().UpdateStatus(ctx, orgId, &registration, nil); err != nil {
+				h.log.WithError(err).Errorf("failed releasing device registration %s/%s claimed by enrollment request %s", orgId, registrationName, name)
+			}
+		}
+		return result
+	}
+
+	policies, err := h.store.EnrollmentPolicy().List(ctx, orgId, store.ListParams{})
+	if err != nil {
+		h.log.WithError(err).Errorf("failed listing enrollment policies for enrollment request %s/%s", orgId, name)
+		return er
+	}
+	policy, found := lo.Find(policies.Items, func(p domain.EnrollmentPolicy) bool {
+		return enrollmentPolicyMatches(&p, er, now)
+	})
+	if !found {
+		return er
+	}
+	policyName := lo.FromPtr(policy.Metadata.Name)
+
+	labels := lo.Assign(lo.FromPtr(policy.Spec.Labels))
+	if policy.Spec.Fleet != nil {
+		// the device must carry the labels the fleet selects its devices by to be owned by it
+		fleet, err := h.store.Fleet().Get(ctx, orgId, *policy.Spec.Fleet)
+		if err != nil {
+			status := StoreErrorToApiStatus(err, false, domain.FleetKind, policy.Spec.Fleet)
+			status.Message = fmt.Sprintf("enrollment policy %s: %s", policyName, status.Message)
+			h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
+			return er
+		}
+		if fleet.Spec.Selector != nil {
+			labels = lo.Assign(labels, lo.FromPtr(fleet.Spec.Selector.MatchLabels))
+		}
+	}
+
+	approval := domain.EnrollmentRequestApprovalStatus{
+		Approved:         true,
+		Labels:           &labels,
+		ApprovedAt:       now,
+		ApprovedBy:       fmt.Sprintf("%s/%s", domain.EnrollmentPolicyKind, policyName),
+		EnrollmentPolicy: &policyName,
+	}
+	result, _ := h.autoApproveEnrollmentRequestWith(ctx, orgId, er, &approval)
+	return result
+}
+
+// autoApproveEnrollmentRequestWith approves the enrollment request with the given approval and creates its device.
+// If the approval fails, the unchanged enrollment request is returned.
+func (h *ServiceHandler) autoApproveEnrollmentRequestWith(ctx context.Context, orgId uuid.UUID, er *domain.EnrollmentRequest, approval *domain.EnrollmentRequestApprovalStatus) (*domain.EnrollmentRequest, bool) {
+	name := lo.FromPtr(er.Metadata.Name)
+
+	// approve a copy, so the stored request is returned unchanged if the approval fails
+	approved := *er
+	if er.Status != nil {
+		status := *er.Status
+		status.Conditions = slices.Clone(er.Status.Conditions)
+		approved.Status = &status
+	}
+	if err := approveAndSignEnrollmentRequest(ctx, h.ca, &approved, approval); err != nil {
+		status := domain.StatusBadRequest(fmt.Sprintf("Error approving and signing enrollment request: %v", err.Error()))
+		h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
+		return er, false
+	}
+	if err := h.createDeviceFromEnrollmentRequest(ctx, orgId, &approved); err != nil {
+		status := domain.StatusInternalServerError(fmt.Sprintf("error creating device from enrollment request: %v", err))
+		h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
+		return er, false
+	}
+
+	result, err := h.store.EnrollmentRequest().UpdateStatus(ctx, orgId, &approved, h.callbackEnrollmentRequestApproved)
+	if err != nil {
+		h.log.WithError(err).Errorf("failed updating status of enrollment request %s/%s approved by %s", orgId, name, approval.ApprovedBy)
+		return er, false
+	}
+	return result, true
 }
 
 func newSignRequestFromEnrollment(cfg *ca.Config, er *domain.EnrollmentRequest) (signer.SignRequest, bool, error) {
@@ -10,6 +10,7 @@ import (
 	"encoding/pem"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/auth/common"
 	"github.com/flightctl/flightctl/internal/config/ca"
@@ -110,6 +111,54 @@ func TestAlreadyApprovedEnrollmentRequestApprove(t *testing.T) {
 	require.Len(event.Items, 0)
 }
 
+func TestExpireEnrollmentRequest(t *testing.T) {
+	t.Run("When the request is pending it should deny it and emit an event", func(t *testing.T) {
+		require := require.New(t)
+		serviceHandler, ctx, testOrgId, _ := createTestEnrollmentRequest(t, "foo", nil)
+
+		status := serviceHandler.ExpireEnrollmentRequest(ctx, testOrgId, "foo", time.Hour)
+		require.Equal(statusSuccessCode, status.Code, status.Message)
+
+		er, err := serviceHandler.store.EnrollmentRequest().Get(ctx, testOrgId, "foo")
+		require.NoError(err)
+		denied := domain.FindStatusCondition(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestDenied)
+		require.NotNil(denied)
+		require.Equal(domain.ConditionStatusTrue, denied.Status)
+		require.Equal(domain.RequestExpiredReason, denied.Reason)
+
+		events, _ := serviceHandler.store.Event().List(ctx, testOrgId, store.ListParams{})
+		require.Len(events.Items, 1)
+		require.Equal(domain.EventReasonEnrollmentRequestExpired, events.Items[0].Reason)
+		require.Equal(domain.EventTypeWarning, events.Items[0].Type)
+
+		// an expired request can neither be expired again nor approved
+		status = serviceHandler.ExpireEnrollmentRequest(ctx, testOrgId, "foo", time.Hour)
+		require.Equal(statusConflictCode, status.Code)
+		_, status = serviceHandler.ApproveEnrollmentRequest(ctx, testOrgId, "foo", domain.EnrollmentRequestApproval{Approved: true})
+		require.Equal(statusConflictCode, status.Code)
+		require.Equal("Enrollment request has already been denied", status.Message)
+	})
+
+	t.Run("When the request is approved it should not deny it", func(t *testing.T) {
+		require := require.New(t)
+		approvedStatus := &domain.EnrollmentRequestStatus{
+			Conditions: []domain.Condition{{
+				Type:   domain.ConditionTypeEnrollmentRequestApproved,
+				Status: domain.ConditionStatusTrue,
+				Reason: "ManuallyApproved",
+			}},
+		}
+		serviceHandler, ctx, testOrgId, _ := createTestEnrollmentRequest(t, "foo", approvedStatus)
+
+		status := serviceHandler.ExpireEnrollmentRequest(ctx, testOrgId, "foo", time.Hour)
+		require.Equal(statusConflictCode, status.Code)
+
+		er, err := serviceHandler.store.EnrollmentRequest().Get(ctx, testOrgId, "foo")
+		require.NoError(err)
+		require.Nil(domain.FindStatusCondition(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestDenied))
+	})
+}
+
 func TestNotFoundReplaceEnrollmentRequestStatus(t *testing.T) {
 	require := require.New(t)
 	serviceHandler, _ := newTestServiceHandler(t, &TestStore{}, nil)
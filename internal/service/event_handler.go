@@ -47,6 +47,7 @@ func (h *EventHandler) CreateEvent(ctx context.Context, orgId uuid.UUID, event *
 	if h.workerClient != nil {
 		h.workerClient.EmitEvent(ctx, orgId, event)
 	}
+	notifyWatchers(ctx, h.log, orgId, domain.EventKind, lo.FromPtr(event.Metadata.Name), domain.WatchEventTypeAdded)
 }
 
 //////////////////////////////////////////////////////
@@ -289,8 +290,10 @@ func (h *EventHandler) emitFleetRolloutFailedEvent(ctx context.Context, orgId uu
 	if newFleet == nil || newFleet.Status == nil {
 		return
 	}
+	// A rollout that was rolled back has failed as well
+	failedReasons := []string{domain.RolloutSuspendedReason, domain.RolloutRolledBackReason}
 	newCondition := domain.FindStatusCondition(newFleet.Status.Conditions, domain.ConditionTypeFleetRolloutInProgress)
-	if newCondition == nil || newCondition.Reason != domain.RolloutSuspendedReason {
+	if newCondition == nil || !lo.Contains(failedReasons, newCondition.Reason) {
 		return
 	}
 	var oldConditions []domain.Condition
@@ -298,7 +301,7 @@ func (h *EventHandler) emitFleetRolloutFailedEvent(ctx context.Context, orgId uu
 		oldConditions = oldFleet.Status.Conditions
 	}
 	oldCondition := domain.FindStatusCondition(oldConditions, domain.ConditionTypeFleetRolloutInProgress)
-	if oldCondition != nil && oldCondition.Reason == domain.RolloutSuspendedReason {
+	if oldCondition != nil && lo.Contains(failedReasons, oldCondition.Reason) {
 		return
 	}
 
@@ -396,6 +399,127 @@ func (h *EventHandler) HandleAuthProviderDeletedEvents(ctx context.Context, reso
 	h.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
 }
 
+//////////////////////////////////////////////////////
+//                   Secret Events                  //
+//////////////////////////////////////////////////////
+
+// HandleSecretUpdatedEvents handles secret update event emission logic. The events name the changed
+// fields only, never the values of the secret.
+func (h *EventHandler) HandleSecretUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, domain.SecretKind, &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, domain.SecretKind, name, status, nil))
+		return
+	}
+
+	if created {
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, domain.SecretKind, name, nil, h.log, nil))
+		return
+	}
+
+	var oldSecret, newSecret *domain.Secret
+	var ok bool
+	if oldSecret, newSecret, ok = castResources[domain.Secret](oldResource, newResource); !ok {
+		return
+	}
+
+	updateDetails := h.computeResourceUpdatedDetails(oldSecret.Metadata, newSecret.Metadata)
+	// Generate ResourceUpdated event if there are spec changes
+	if updateDetails != nil {
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, false, domain.SecretKind, name, updateDetails, h.log, nil))
+	}
+}
+
+//////////////////////////////////////////////////////
+//               DeviceRegistration Events          //
+//////////////////////////////////////////////////////
+
+// HandleDeviceRegistrationUpdatedEvents handles device registration update event emission logic
+func (h *EventHandler) HandleDeviceRegistrationUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, domain.DeviceRegistrationKind, &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, domain.DeviceRegistrationKind, name, status, nil))
+		return
+	}
+
+	if created {
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, domain.DeviceRegistrationKind, name, nil, h.log, nil))
+		return
+	}
+
+	var oldRegistration, newRegistration *domain.DeviceRegistration
+	var ok bool
+	if oldRegistration, newRegistration, ok = castResources[domain.DeviceRegistration](oldResource, newResource); !ok {
+		return
+	}
+
+	updateDetails := h.computeResourceUpdatedDetails(oldRegistration.Metadata, newRegistration.Metadata)
+	// Generate ResourceUpdated event if there are spec changes
+	if updateDetails != nil {
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, false, domain.DeviceRegistrationKind, name, updateDetails, h.log, nil))
+	}
+}
+
+//////////////////////////////////////////////////////
+//               EnrollmentPolicy Events            //
+//////////////////////////////////////////////////////
+
+// HandleEnrollmentPolicyUpdatedEvents handles enrollment policy update event emission logic
+func (h *EventHandler) HandleEnrollmentPolicyUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, domain.EnrollmentPolicyKind, &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, domain.EnrollmentPolicyKind, name, status, nil))
+		return
+	}
+
+	if created {
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, domain.EnrollmentPolicyKind, name, nil, h.log, nil))
+		return
+	}
+
+	var oldPolicy, newPolicy *domain.EnrollmentPolicy
+	var ok bool
+	if oldPolicy, newPolicy, ok = castResources[domain.EnrollmentPolicy](oldResource, newResource); !ok {
+		return
+	}
+
+	updateDetails := h.computeResourceUpdatedDetails(oldPolicy.Metadata, newPolicy.Metadata)
+	// Generate ResourceUpdated event if there are spec changes
+	if updateDetails != nil {
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, false, domain.EnrollmentPolicyKind, name, updateDetails, h.log, nil))
+	}
+}
+
+//////////////////////////////////////////////////////
+//               NotificationSink Events            //
+//////////////////////////////////////////////////////
+
+// HandleNotificationSinkUpdatedEvents handles notification sink update event emission logic
+func (h *EventHandler) HandleNotificationSinkUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, domain.NotificationSinkKind, &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, domain.NotificationSinkKind, name, status, nil))
+		return
+	}
+
+	if created {
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, domain.NotificationSinkKind, name, nil, h.log, nil))
+		return
+	}
+
+	var oldSink, newSink *domain.NotificationSink
+	var ok bool
+	if oldSink, newSink, ok = castResources[domain.NotificationSink](oldResource, newResource); !ok {
+		return
+	}
+
+	updateDetails := h.computeResourceUpdatedDetails(oldSink.Metadata, newSink.Metadata)
+	// Generate ResourceUpdated event if there are spec changes
+	if updateDetails != nil {
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, false, domain.NotificationSinkKind, name, updateDetails, h.log, nil))
+	}
+}
+
 //////////////////////////////////////////////////////
 //               EnrollmentRequest Events           //
 //////////////////////////////////////////////////////
@@ -3,13 +3,20 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/rollout"
+	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 func (h *ServiceHandler) CreateFleet(ctx context.Context, orgId uuid.UUID, fleet domain.Fleet) (*domain.Fleet, domain.Status) {
@@ -131,6 +138,170 @@ func (h *ServiceHandler) PatchFleet(ctx context.Context, orgId uuid.UUID, name s
 	return result, StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
 }
 
+// ApproveFleetRolloutBatch approves the rollout batch of a fleet that is waiting for approval.  The batch number must
+// match the batch that is waiting, so that a stale approval doesn't release a later batch.  The rollout continues once
+// the rollout reconciler picks up the approval.
+func (h *ServiceHandler) ApproveFleetRolloutBatch(ctx context.Context, orgId uuid.UUID, name string, approval domain.FleetRolloutBatchApproval) (*domain.Fleet, domain.Status) {
+	if approval.Batch < 1 {
+		return nil, domain.StatusBadRequest("batch must be a positive number")
+	}
+	fleet, err := h.store.Fleet().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
+	}
+
+	var condition *domain.Condition
+	if fleet.Status != nil {
+		condition = domain.FindStatusCondition(fleet.Status.Conditions, domain.ConditionTypeFleetRolloutInProgress)
+	}
+	if condition == nil || !lo.Contains([]string{domain.RolloutAwaitingApprovalReason, domain.RolloutWaitingReason}, condition.Reason) {
+		return nil, domain.StatusConflict(fmt.Sprintf("fleet %s has no rollout batch waiting for approval", name))
+	}
+	batchNumberStr, exists := fleet.GetAnnotation(domain.FleetAnnotationBatchNumber)
+	if !exists {
+		return nil, domain.StatusConflict(fmt.Sprintf("fleet %s has no rollout batch waiting for approval", name))
+	}
+	batchNumber, err := strconv.Atoi(batchNumberStr)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("invalid batch number annotation: %v", err))
+	}
+
+	// The batch number annotation is zero based while batches are numbered from 1 for users
+	if batchNumber+1 != approval.Batch {
+		return nil, domain.StatusConflict(fmt.Sprintf("batch %d of fleet %s is waiting for approval, not batch %d", batchNumber+1, name, approval.Batch))
+	}
+
+	annotations := map[string]string{
+		domain.FleetAnnotationRolloutApproved: "true",
+	}
+	if status := h.UpdateFleetAnnotations(ctx, orgId, name, annotations, nil); status.Code != http.StatusOK {
+		return nil, status
+	}
+	return h.GetFleet(ctx, orgId, name, domain.GetFleetParams{})
+}
+
+// rolloutInProgress returns true if the RolloutInProgress condition belongs to a rollout that may still select devices
+func rolloutInProgress(condition *domain.Condition) bool {
+	return condition != nil && !lo.Contains([]string{domain.RolloutInactiveReason, domain.RolloutRolledBackReason, domain.RolloutAbortedReason}, condition.Reason)
+}
+
+func (h *ServiceHandler) ControlFleetRollout(ctx context.Context, orgId uuid.UUID, name string, control domain.FleetRolloutControl) (*domain.Fleet, domain.Status) {
+	fleet, err := h.store.Fleet().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
+	}
+	if fleet.Spec.RolloutPolicy == nil || fleet.Spec.RolloutPolicy.DeviceSelection == nil {
+		return nil, domain.StatusConflict(fmt.Sprintf("fleet %s has no device selection in its rollout policy", name))
+	}
+	templateVersion, exists := fleet.GetAnnotation(domain.FleetAnnotationDeployingTemplateVersion)
+	if !exists {
+		return nil, domain.StatusConflict(fmt.Sprintf("fleet %s has no rollout in progress", name))
+	}
+	var condition *domain.Condition
+	if fleet.Status != nil {
+		condition = domain.FindStatusCondition(fleet.Status.Conditions, domain.ConditionTypeFleetRolloutInProgress)
+	}
+
+	var (
+		annotations  = map[string]string{}
+		deleteKeys   []string
+		newCondition domain.Condition
+		event        *domain.Event
+	)
+	switch control.Action {
+	case domain.FleetRolloutControlActionPause:
+		if !rolloutInProgress(condition) {
+			return nil, domain.StatusConflict(fmt.Sprintf("fleet %s has no rollout in progress", name))
+		}
+		if rollout.IsPaused(fleet) {
+			return nil, domain.StatusConflict(fmt.Sprintf("rollout of fleet %s is already paused", name))
+		}
+		annotations[domain.FleetAnnotationRolloutPaused] = "true"
+		newCondition = rollout.PausedCondition()
+		event = common.GetFleetRolloutPausedEvent(ctx, name, templateVersion)
+	case domain.FleetRolloutControlActionResume:
+		if !rollout.IsPaused(fleet) {
+			return nil, domain.StatusConflict(fmt.Sprintf("rollout of fleet %s is not paused", name))
+		}
+		deleteKeys = []string{domain.FleetAnnotationRolloutPaused}
+		newCondition = rollout.ResumedCondition()
+		event = common.GetFleetRolloutResumedEvent(ctx, name, templateVersion)
+	case domain.FleetRolloutControlActionAbort:
+		if !rolloutInProgress(condition) {
+			return nil, domain.StatusConflict(fmt.Sprintf("fleet %s has no rollout in progress", name))
+		}
+
+		// The devices that were selected for the current batch but not rolled out yet must not be rolled out
+		if status := h.UnmarkDevicesRolloutSelection(ctx, orgId, name); status.Code != http.StatusOK {
+			return nil, status
+		}
+		annotations[domain.FleetAnnotationRolloutAborted] = templateVersion
+		deleteKeys = []string{domain.FleetAnnotationRolloutPaused}
+		newCondition = rollout.AbortedCondition(templateVersion)
+		event = common.GetFleetRolloutAbortedEvent(ctx, name, templateVersion)
+		h.endTemplateVersionRolloutRecord(ctx, orgId, name, templateVersion, domain.RolloutOutcomeAborted)
+	default:
+		return nil, domain.StatusBadRequest(fmt.Sprintf("unknown rollout action %q", control.Action))
+	}
+
+	if status := h.UpdateFleetAnnotations(ctx, orgId, name, annotations, deleteKeys); status.Code != http.StatusOK {
+		return nil, status
+	}
+	if status := h.UpdateFleetConditions(ctx, orgId, name, []domain.Condition{newCondition}); status.Code != http.StatusOK {
+		return nil, status
+	}
+	h.CreateEvent(ctx, orgId, event)
+	return h.GetFleet(ctx, orgId, name, domain.GetFleetParams{})
+}
+
+// endTemplateVersionRolloutRecord records the outcome of the rollout of a template version that is still in progress.
+// Failures are only logged, since the record doesn't affect the rollout itself.
+func (h *ServiceHandler) endTemplateVersionRolloutRecord(ctx context.Context, orgId uuid.UUID, fleet string, templateVersion string, outcome domain.RolloutOutcome) {
+	tv, err := h.store.TemplateVersion().Get(ctx, orgId, fleet, templateVersion)
+	if err != nil {
+		h.log.Warnf("failed getting template version %s/%s/%s to record its rollout outcome: %v", orgId, fleet, templateVersion, err)
+		return
+	}
+	if tv.Status == nil || tv.Status.Rollout == nil {
+		return
+	}
+	record := *tv.Status.Rollout
+	if !rollout.EndRolloutRecord(&record, outcome, time.Now()) {
+		return
+	}
+	if status := h.UpdateTemplateVersionRolloutRecord(ctx, orgId, fleet, templateVersion, record); status.Code != http.StatusOK {
+		h.log.Warnf("failed recording the rollout outcome of template version %s/%s/%s: %s", orgId, fleet, templateVersion, status.Message)
+	}
+}
+
+// PreviewFleetRollout returns how a rollout of the given spec would select the devices of the fleet into batches,
+// without persisting anything
+func (h *ServiceHandler) PreviewFleetRollout(ctx context.Context, orgId uuid.UUID, name string, spec domain.FleetSpec) (*domain.RolloutPreview, domain.Status) {
+	if _, err := h.store.Fleet().Get(ctx, orgId, name); err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
+	}
+	candidate := domain.Fleet{
+		ApiVersion: domain.FleetAPIVersion,
+		Kind:       domain.FleetKind,
+		Metadata:   domain.ObjectMeta{Name: &name},
+		Spec:       spec,
+	}
+	if errs := candidate.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	// The preview runs the read-only queries of the rollout device selection.  It covers the devices that the
+	// candidate selector matches and the devices that the fleet owns now.
+	preview, err := rollout.PreviewRollout(ctx, h, orgId, name, spec)
+	if errors.Is(err, rollout.ErrListDevices) {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+	if err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
+	return preview, domain.StatusOK()
+}
+
 func (h *ServiceHandler) ListFleetRolloutDeviceSelection(ctx context.Context, orgId uuid.UUID) (*domain.FleetList, domain.Status) {
 	result, err := h.store.Fleet().ListRolloutDeviceSelection(ctx, orgId)
 	return result, StoreErrorToApiStatus(err, false, domain.FleetKind, nil)
@@ -164,9 +335,15 @@ func (h *ServiceHandler) GetFleetRepositoryRefs(ctx context.Context, orgId uuid.
 // callbackFleetUpdated is the fleet-specific callback that handles fleet events
 func (h *ServiceHandler) callbackFleetUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.eventHandler.HandleFleetUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+	if err == nil {
+		notifyWatchers(ctx, h.log, orgId, domain.FleetKind, name, lo.Ternary(created, domain.WatchEventTypeAdded, domain.WatchEventTypeModified))
+	}
 }
 
 // callbackFleetDeleted is the fleet-specific callback that handles fleet deletion events
 func (h *ServiceHandler) callbackFleetDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+	if err == nil {
+		notifyWatchers(ctx, h.log, orgId, domain.FleetKind, name, domain.WatchEventTypeDeleted)
+	}
 }
@@ -4,10 +4,12 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/rollout"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/google/uuid"
@@ -346,3 +348,200 @@ func TestDeleteFleet(t *testing.T) {
 		})
 	}
 }
+
+func TestApproveFleetRolloutBatch(t *testing.T) {
+	awaitingApproval := []domain.Condition{{
+		Type:   domain.ConditionTypeFleetRolloutInProgress,
+		Status: domain.ConditionStatusFalse,
+		Reason: domain.RolloutAwaitingApprovalReason,
+	}}
+	active := []domain.Condition{{
+		Type:   domain.ConditionTypeFleetRolloutInProgress,
+		Status: domain.ConditionStatusTrue,
+		Reason: domain.RolloutActiveReason,
+	}}
+
+	tests := []struct {
+		name         string
+		conditions   []domain.Condition
+		batch        int
+		expectedCode int32
+	}{
+		{name: "approves the batch awaiting approval", conditions: awaitingApproval, batch: 2, expectedCode: http.StatusOK},
+		{name: "rejects another batch", conditions: awaitingApproval, batch: 3, expectedCode: http.StatusConflict},
+		{name: "rejects a rollout that is not awaiting approval", conditions: active, batch: 2, expectedCode: http.StatusConflict},
+		{name: "rejects a non positive batch", conditions: awaitingApproval, batch: 0, expectedCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			testStore := &TestStore{}
+			serviceHandler := &ServiceHandler{store: testStore}
+			ctx := context.Background()
+			orgId := uuid.New()
+			fleet := domain.Fleet{
+				Metadata: domain.ObjectMeta{
+					Name:        lo.ToPtr("foo"),
+					Annotations: &map[string]string{domain.FleetAnnotationBatchNumber: "1"},
+				},
+				Status: &domain.FleetStatus{Conditions: tt.conditions},
+			}
+			_, err := testStore.Fleet().Create(ctx, orgId, &fleet, nil)
+			require.NoError(err)
+
+			resp, status := serviceHandler.ApproveFleetRolloutBatch(ctx, orgId, "foo", domain.FleetRolloutBatchApproval{Batch: tt.batch})
+			require.Equal(tt.expectedCode, status.Code)
+			approved, exists := lo.FromPtr(lo.FromPtr(resp).Metadata.Annotations)[domain.FleetAnnotationRolloutApproved]
+			if tt.expectedCode == http.StatusOK {
+				require.True(exists)
+				require.Equal("true", approved)
+			} else {
+				require.Nil(resp)
+			}
+		})
+	}
+}
+
+func TestControlFleetRollout(t *testing.T) {
+	active := []domain.Condition{{
+		Type:   domain.ConditionTypeFleetRolloutInProgress,
+		Status: domain.ConditionStatusTrue,
+		Reason: domain.RolloutActiveReason,
+	}}
+	inactive := []domain.Condition{{
+		Type:   domain.ConditionTypeFleetRolloutInProgress,
+		Status: domain.ConditionStatusFalse,
+		Reason: domain.RolloutInactiveReason,
+	}}
+	paused := []domain.Condition{rollout.PausedCondition()}
+
+	tests := []struct {
+		name                string
+		conditions          []domain.Condition
+		annotations         map[string]string
+		action              domain.FleetRolloutControlAction
+		expectedCode        int32
+		expectedReason      string
+		expectedEvent       domain.EventReason
+		expectedAnnotations map[string]string
+	}{
+		{
+			name:                "pauses an active rollout",
+			conditions:          active,
+			action:              domain.FleetRolloutControlActionPause,
+			expectedCode:        http.StatusOK,
+			expectedReason:      domain.RolloutPausedReason,
+			expectedEvent:       domain.EventReasonFleetRolloutPaused,
+			expectedAnnotations: map[string]string{domain.FleetAnnotationRolloutPaused: "true"},
+		},
+		{
+			name:         "rejects pausing an inactive rollout",
+			conditions:   inactive,
+			action:       domain.FleetRolloutControlActionPause,
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:         "rejects pausing a paused rollout",
+			conditions:   paused,
+			annotations:  map[string]string{domain.FleetAnnotationRolloutPaused: "true"},
+			action:       domain.FleetRolloutControlActionPause,
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:           "resumes a paused rollout",
+			conditions:     paused,
+			annotations:    map[string]string{domain.FleetAnnotationRolloutPaused: "true"},
+			action:         domain.FleetRolloutControlActionResume,
+			expectedCode:   http.StatusOK,
+			expectedReason: domain.RolloutActiveReason,
+			expectedEvent:  domain.EventReasonFleetRolloutResumed,
+		},
+		{
+			name:         "rejects resuming a rollout that is not paused",
+			conditions:   active,
+			action:       domain.FleetRolloutControlActionResume,
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:                "aborts a paused rollout",
+			conditions:          paused,
+			annotations:         map[string]string{domain.FleetAnnotationRolloutPaused: "true"},
+			action:              domain.FleetRolloutControlActionAbort,
+			expectedCode:        http.StatusOK,
+			expectedReason:      domain.RolloutAbortedReason,
+			expectedEvent:       domain.EventReasonFleetRolloutAborted,
+			expectedAnnotations: map[string]string{domain.FleetAnnotationRolloutAborted: "1"},
+		},
+		{
+			name:         "rejects aborting an inactive rollout",
+			conditions:   inactive,
+			action:       domain.FleetRolloutControlActionAbort,
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:         "rejects an unknown action",
+			conditions:   active,
+			action:       "Restart",
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			testStore := &TestStore{}
+			wc := &DummyWorkerClient{}
+			serviceHandler := &ServiceHandler{
+				eventHandler: NewEventHandler(testStore, wc, log.InitLogs()),
+				store:        testStore,
+				workerClient: wc,
+				log:          log.InitLogs(),
+			}
+			ctx := context.Background()
+			orgId := uuid.New()
+			deviceSelection := &domain.RolloutDeviceSelection{}
+			require.NoError(deviceSelection.FromBatchSequence(domain.BatchSequence{}))
+			annotations := lo.Assign(map[string]string{domain.FleetAnnotationDeployingTemplateVersion: "1"}, tt.annotations)
+			fleet := domain.Fleet{
+				Metadata: domain.ObjectMeta{
+					Name:        lo.ToPtr("foo"),
+					Annotations: &annotations,
+				},
+				Spec:   domain.FleetSpec{RolloutPolicy: &domain.RolloutPolicy{DeviceSelection: deviceSelection}},
+				Status: &domain.FleetStatus{Conditions: tt.conditions},
+			}
+			_, err := testStore.Fleet().Create(ctx, orgId, &fleet, nil)
+			require.NoError(err)
+			_, err = testStore.TemplateVersion().Create(ctx, orgId, &domain.TemplateVersion{
+				Metadata: domain.ObjectMeta{Name: lo.ToPtr("1")},
+				Spec:     domain.TemplateVersionSpec{Fleet: "foo"},
+				Status:   &domain.TemplateVersionStatus{Rollout: lo.ToPtr(rollout.NewRolloutRecord(time.Now()))},
+			}, nil)
+			require.NoError(err)
+
+			resp, status := serviceHandler.ControlFleetRollout(ctx, orgId, "foo", domain.FleetRolloutControl{Action: tt.action})
+			require.Equal(tt.expectedCode, status.Code)
+			if tt.expectedCode != http.StatusOK {
+				require.Nil(resp)
+				return
+			}
+			condition := domain.FindStatusCondition(resp.Status.Conditions, domain.ConditionTypeFleetRolloutInProgress)
+			require.NotNil(condition)
+			require.Equal(tt.expectedReason, condition.Reason)
+			expectedAnnotations := lo.Assign(map[string]string{domain.FleetAnnotationDeployingTemplateVersion: "1"}, tt.expectedAnnotations)
+			require.Equal(expectedAnnotations, lo.FromPtr(resp.Metadata.Annotations))
+
+			events, err := testStore.Event().List(ctx, orgId, store.ListParams{})
+			require.NoError(err)
+			require.Len(events.Items, 1)
+			require.Equal(tt.expectedEvent, events.Items[0].Reason)
+
+			tv, err := testStore.TemplateVersion().Get(ctx, orgId, "foo", "1")
+			require.NoError(err)
+			expectedOutcome := domain.RolloutOutcomeInProgress
+			if tt.action == domain.FleetRolloutControlActionAbort {
+				expectedOutcome = domain.RolloutOutcomeAborted
+			}
+			require.Equal(expectedOutcome, tv.Status.Rollout.Outcome)
+		})
+	}
+}
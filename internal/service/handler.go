@@ -10,32 +10,34 @@ import (
 )
 
 type ServiceHandler struct {
-	eventHandler         *EventHandler
-	store                store.Store
-	ca                   *crypto.CAClient
-	log                  logrus.FieldLogger
-	workerClient         worker_client.WorkerClient
-	kvStore              kvstore.KVStore
-	agentEndpoint        string
-	uiUrl                string
-	tpmCAPaths           []string
-	agentGate            *semaphore.Weighted
-	vulnerabilityEnabled bool
+	eventHandler              *EventHandler
+	store                     store.Store
+	ca                        *crypto.CAClient
+	log                       logrus.FieldLogger
+	workerClient              worker_client.WorkerClient
+	kvStore                   kvstore.KVStore
+	agentEndpoint             string
+	uiUrl                     string
+	tpmCAPaths                []string
+	agentGate                 *semaphore.Weighted
+	vulnerabilityEnabled      bool
+	requireDeviceRegistration bool
 }
 
-func NewServiceHandler(store store.Store, workerClient worker_client.WorkerClient, kvStore kvstore.KVStore, ca *crypto.CAClient, log logrus.FieldLogger, agentEndpoint string, uiUrl string, tpmCAPaths []string, vulnerabilityEnabled bool) *ServiceHandler {
+func NewServiceHandler(store store.Store, workerClient worker_client.WorkerClient, kvStore kvstore.KVStore, ca *crypto.CAClient, log logrus.FieldLogger, agentEndpoint string, uiUrl string, tpmCAPaths []string, vulnerabilityEnabled bool, requireDeviceRegistration bool) *ServiceHandler {
 	return &ServiceHandler{
-		eventHandler:         NewEventHandler(store, workerClient, log),
-		store:                store,
-		ca:                   ca,
-		log:                  log,
-		workerClient:         workerClient,
-		kvStore:              kvStore,
-		agentEndpoint:        agentEndpoint,
-		uiUrl:                uiUrl,
-		tpmCAPaths:           tpmCAPaths,
-		agentGate:            semaphore.NewWeighted(MaxConcurrentAgents),
-		vulnerabilityEnabled: vulnerabilityEnabled,
+		eventHandler:              NewEventHandler(store, workerClient, log),
+		store:                     store,
+		ca:                        ca,
+		log:                       log,
+		workerClient:              workerClient,
+		kvStore:                   kvStore,
+		agentEndpoint:             agentEndpoint,
+		uiUrl:                     uiUrl,
+		tpmCAPaths:                tpmCAPaths,
+		agentGate:                 semaphore.NewWeighted(MaxConcurrentAgents),
+		vulnerabilityEnabled:      vulnerabilityEnabled,
+		requireDeviceRegistration: requireDeviceRegistration,
 	}
 }
 
@@ -60,6 +60,21 @@ func (mr *MockServiceMockRecorder) ApproveEnrollmentRequest(ctx, orgId, name, ap
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveEnrollmentRequest", reflect.TypeOf((*MockService)(nil).ApproveEnrollmentRequest), ctx, orgId, name, approval)
 }
 
+// ApproveFleetRolloutBatch mocks base method.
+func (m *MockService) ApproveFleetRolloutBatch(ctx context.Context, orgId uuid.UUID, name string, approval domain.FleetRolloutBatchApproval) (*domain.Fleet, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveFleetRolloutBatch", ctx, orgId, name, approval)
+	ret0, _ := ret[0].(*domain.Fleet)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ApproveFleetRolloutBatch indicates an expected call of ApproveFleetRolloutBatch.
+func (mr *MockServiceMockRecorder) ApproveFleetRolloutBatch(ctx, orgId, name, approval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveFleetRolloutBatch", reflect.TypeOf((*MockService)(nil).ApproveFleetRolloutBatch), ctx, orgId, name, approval)
+}
+
 // BulkUpdateSyncStateLastCheckedAt mocks base method.
 func (m *MockService) BulkUpdateSyncStateLastCheckedAt(ctx context.Context, orgId uuid.UUID, resourceKeys []string, t time.Time) domain.Status {
 	m.ctrl.T.Helper()
@@ -132,6 +147,21 @@ func (mr *MockServiceMockRecorder) CheckRepositoryOciTag(ctx, orgId, repositoryN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckRepositoryOciTag", reflect.TypeOf((*MockService)(nil).CheckRepositoryOciTag), ctx, orgId, repositoryName, imageName, tag)
 }
 
+// ControlFleetRollout mocks base method.
+func (m *MockService) ControlFleetRollout(ctx context.Context, orgId uuid.UUID, name string, control domain.FleetRolloutControl) (*domain.Fleet, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControlFleetRollout", ctx, orgId, name, control)
+	ret0, _ := ret[0].(*domain.Fleet)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ControlFleetRollout indicates an expected call of ControlFleetRollout.
+func (mr *MockServiceMockRecorder) ControlFleetRollout(ctx, orgId, name, control any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControlFleetRollout", reflect.TypeOf((*MockService)(nil).ControlFleetRollout), ctx, orgId, name, control)
+}
+
 // CountDevices mocks base method.
 func (m *MockService) CountDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (int64, domain.Status) {
 	m.ctrl.T.Helper()
@@ -237,6 +267,36 @@ func (mr *MockServiceMockRecorder) CreateDevice(ctx, orgId, device any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDevice", reflect.TypeOf((*MockService)(nil).CreateDevice), ctx, orgId, device)
 }
 
+// CreateDeviceRegistration mocks base method.
+func (m *MockService) CreateDeviceRegistration(ctx context.Context, orgId uuid.UUID, registration domain.DeviceRegistration) (*domain.DeviceRegistration, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeviceRegistration", ctx, orgId, registration)
+	ret0, _ := ret[0].(*domain.DeviceRegistration)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateDeviceRegistration indicates an expected call of CreateDeviceRegistration.
+func (mr *MockServiceMockRecorder) CreateDeviceRegistration(ctx, orgId, registration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeviceRegistration", reflect.TypeOf((*MockService)(nil).CreateDeviceRegistration), ctx, orgId, registration)
+}
+
+// CreateEnrollmentPolicy mocks base method.
+func (m *MockService) CreateEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, policy domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEnrollmentPolicy", ctx, orgId, policy)
+	ret0, _ := ret[0].(*domain.EnrollmentPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateEnrollmentPolicy indicates an expected call of CreateEnrollmentPolicy.
+func (mr *MockServiceMockRecorder) CreateEnrollmentPolicy(ctx, orgId, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnrollmentPolicy", reflect.TypeOf((*MockService)(nil).CreateEnrollmentPolicy), ctx, orgId, policy)
+}
+
 // CreateEnrollmentRequest mocks base method.
 func (m *MockService) CreateEnrollmentRequest(ctx context.Context, orgId uuid.UUID, er domain.EnrollmentRequest) (*domain.EnrollmentRequest, domain.Status) {
 	m.ctrl.T.Helper()
@@ -279,6 +339,21 @@ func (mr *MockServiceMockRecorder) CreateFleet(ctx, orgId, fleet any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFleet", reflect.TypeOf((*MockService)(nil).CreateFleet), ctx, orgId, fleet)
 }
 
+// CreateNotificationSink mocks base method.
+func (m *MockService) CreateNotificationSink(ctx context.Context, orgId uuid.UUID, sink domain.NotificationSink) (*domain.NotificationSink, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotificationSink", ctx, orgId, sink)
+	ret0, _ := ret[0].(*domain.NotificationSink)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateNotificationSink indicates an expected call of CreateNotificationSink.
+func (mr *MockServiceMockRecorder) CreateNotificationSink(ctx, orgId, sink any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationSink", reflect.TypeOf((*MockService)(nil).CreateNotificationSink), ctx, orgId, sink)
+}
+
 // CreateRepository mocks base method.
 func (m *MockService) CreateRepository(ctx context.Context, orgId uuid.UUID, repo domain.Repository) (*domain.Repository, domain.Status) {
 	m.ctrl.T.Helper()
@@ -309,6 +384,21 @@ func (mr *MockServiceMockRecorder) CreateResourceSync(ctx, orgId, rs any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceSync", reflect.TypeOf((*MockService)(nil).CreateResourceSync), ctx, orgId, rs)
 }
 
+// CreateSecret mocks base method.
+func (m *MockService) CreateSecret(ctx context.Context, orgId uuid.UUID, secret domain.Secret) (*domain.Secret, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecret", ctx, orgId, secret)
+	ret0, _ := ret[0].(*domain.Secret)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateSecret indicates an expected call of CreateSecret.
+func (mr *MockServiceMockRecorder) CreateSecret(ctx, orgId, secret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockService)(nil).CreateSecret), ctx, orgId, secret)
+}
+
 // CreateTemplateVersion mocks base method.
 func (m *MockService) CreateTemplateVersion(ctx context.Context, orgId uuid.UUID, tv domain.TemplateVersion, immediateRollout bool) (*domain.TemplateVersion, domain.Status) {
 	m.ctrl.T.Helper()
@@ -437,6 +527,34 @@ func (mr *MockServiceMockRecorder) DeleteDevice(ctx, orgId, name any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevice", reflect.TypeOf((*MockService)(nil).DeleteDevice), ctx, orgId, name)
 }
 
+// DeleteDeviceRegistration mocks base method.
+func (m *MockService) DeleteDeviceRegistration(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeviceRegistration", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteDeviceRegistration indicates an expected call of DeleteDeviceRegistration.
+func (mr *MockServiceMockRecorder) DeleteDeviceRegistration(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeviceRegistration", reflect.TypeOf((*MockService)(nil).DeleteDeviceRegistration), ctx, orgId, name)
+}
+
+// DeleteEnrollmentPolicy mocks base method.
+func (m *MockService) DeleteEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnrollmentPolicy", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteEnrollmentPolicy indicates an expected call of DeleteEnrollmentPolicy.
+func (mr *MockServiceMockRecorder) DeleteEnrollmentPolicy(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnrollmentPolicy", reflect.TypeOf((*MockService)(nil).DeleteEnrollmentPolicy), ctx, orgId, name)
+}
+
 // DeleteEnrollmentRequest mocks base method.
 func (m *MockService) DeleteEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
 	m.ctrl.T.Helper()
@@ -480,6 +598,20 @@ func (mr *MockServiceMockRecorder) DeleteFleet(ctx, orgId, name any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFleet", reflect.TypeOf((*MockService)(nil).DeleteFleet), ctx, orgId, name)
 }
 
+// DeleteNotificationSink mocks base method.
+func (m *MockService) DeleteNotificationSink(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationSink", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteNotificationSink indicates an expected call of DeleteNotificationSink.
+func (mr *MockServiceMockRecorder) DeleteNotificationSink(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationSink", reflect.TypeOf((*MockService)(nil).DeleteNotificationSink), ctx, orgId, name)
+}
+
 // DeleteRepository mocks base method.
 func (m *MockService) DeleteRepository(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
 	m.ctrl.T.Helper()
@@ -508,6 +640,20 @@ func (mr *MockServiceMockRecorder) DeleteResourceSync(ctx, orgId, name any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceSync", reflect.TypeOf((*MockService)(nil).DeleteResourceSync), ctx, orgId, name)
 }
 
+// DeleteSecret mocks base method.
+func (m *MockService) DeleteSecret(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MockServiceMockRecorder) DeleteSecret(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockService)(nil).DeleteSecret), ctx, orgId, name)
+}
+
 // DeleteTemplateVersion mocks base method.
 func (m *MockService) DeleteTemplateVersion(ctx context.Context, orgId uuid.UUID, fleet, name string) domain.Status {
 	m.ctrl.T.Helper()
@@ -522,6 +668,49 @@ func (mr *MockServiceMockRecorder) DeleteTemplateVersion(ctx, orgId, fleet, name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplateVersion", reflect.TypeOf((*MockService)(nil).DeleteTemplateVersion), ctx, orgId, fleet, name)
 }
 
+// DiffTemplateVersions mocks base method.
+func (m *MockService) DiffTemplateVersions(ctx context.Context, orgId uuid.UUID, fleet, name, other string) (*domain.TemplateVersionDiff, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffTemplateVersions", ctx, orgId, fleet, name, other)
+	ret0, _ := ret[0].(*domain.TemplateVersionDiff)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// DiffTemplateVersions indicates an expected call of DiffTemplateVersions.
+func (mr *MockServiceMockRecorder) DiffTemplateVersions(ctx, orgId, fleet, name, other any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffTemplateVersions", reflect.TypeOf((*MockService)(nil).DiffTemplateVersions), ctx, orgId, fleet, name, other)
+}
+
+// ExpireCertificateSigningRequest mocks base method.
+func (m *MockService) ExpireCertificateSigningRequest(ctx context.Context, orgId uuid.UUID, name string, ttl time.Duration) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireCertificateSigningRequest", ctx, orgId, name, ttl)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// ExpireCertificateSigningRequest indicates an expected call of ExpireCertificateSigningRequest.
+func (mr *MockServiceMockRecorder) ExpireCertificateSigningRequest(ctx, orgId, name, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireCertificateSigningRequest", reflect.TypeOf((*MockService)(nil).ExpireCertificateSigningRequest), ctx, orgId, name, ttl)
+}
+
+// ExpireEnrollmentRequest mocks base method.
+func (m *MockService) ExpireEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string, ttl time.Duration) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireEnrollmentRequest", ctx, orgId, name, ttl)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// ExpireEnrollmentRequest indicates an expected call of ExpireEnrollmentRequest.
+func (mr *MockServiceMockRecorder) ExpireEnrollmentRequest(ctx, orgId, name, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireEnrollmentRequest", reflect.TypeOf((*MockService)(nil).ExpireEnrollmentRequest), ctx, orgId, name, ttl)
+}
+
 // GetAuthConfig mocks base method.
 func (m *MockService) GetAuthConfig(ctx context.Context, authConfig *domain.AuthConfig) (*domain.AuthConfig, domain.Status) {
 	m.ctrl.T.Helper()
@@ -717,6 +906,21 @@ func (mr *MockServiceMockRecorder) GetDeviceLastSeen(ctx, orgId, name any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceLastSeen", reflect.TypeOf((*MockService)(nil).GetDeviceLastSeen), ctx, orgId, name)
 }
 
+// GetDeviceRegistration mocks base method.
+func (m *MockService) GetDeviceRegistration(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceRegistration, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceRegistration", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.DeviceRegistration)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetDeviceRegistration indicates an expected call of GetDeviceRegistration.
+func (mr *MockServiceMockRecorder) GetDeviceRegistration(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceRegistration", reflect.TypeOf((*MockService)(nil).GetDeviceRegistration), ctx, orgId, name)
+}
+
 // GetDeviceRepositoryRefs mocks base method.
 func (m *MockService) GetDeviceRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string) (*domain.RepositoryList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -807,6 +1011,21 @@ func (mr *MockServiceMockRecorder) GetEnrollmentConfig(ctx, orgId, params any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollmentConfig", reflect.TypeOf((*MockService)(nil).GetEnrollmentConfig), ctx, orgId, params)
 }
 
+// GetEnrollmentPolicy mocks base method.
+func (m *MockService) GetEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnrollmentPolicy", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.EnrollmentPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetEnrollmentPolicy indicates an expected call of GetEnrollmentPolicy.
+func (mr *MockServiceMockRecorder) GetEnrollmentPolicy(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollmentPolicy", reflect.TypeOf((*MockService)(nil).GetEnrollmentPolicy), ctx, orgId, name)
+}
+
 // GetEnrollmentRequest mocks base method.
 func (m *MockService) GetEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentRequest, domain.Status) {
 	m.ctrl.T.Helper()
@@ -927,6 +1146,21 @@ func (mr *MockServiceMockRecorder) GetLatestTemplateVersion(ctx, orgId, fleet an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestTemplateVersion", reflect.TypeOf((*MockService)(nil).GetLatestTemplateVersion), ctx, orgId, fleet)
 }
 
+// GetNotificationSink mocks base method.
+func (m *MockService) GetNotificationSink(ctx context.Context, orgId uuid.UUID, name string) (*domain.NotificationSink, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationSink", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.NotificationSink)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetNotificationSink indicates an expected call of GetNotificationSink.
+func (mr *MockServiceMockRecorder) GetNotificationSink(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationSink", reflect.TypeOf((*MockService)(nil).GetNotificationSink), ctx, orgId, name)
+}
+
 // GetRenderedDevice mocks base method.
 func (m *MockService) GetRenderedDevice(ctx context.Context, orgId uuid.UUID, name string, params domain.GetRenderedDeviceParams) (*domain.Device, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1002,6 +1236,21 @@ func (mr *MockServiceMockRecorder) GetResourceSync(ctx, orgId, name any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceSync", reflect.TypeOf((*MockService)(nil).GetResourceSync), ctx, orgId, name)
 }
 
+// GetSecret mocks base method.
+func (m *MockService) GetSecret(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecret", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.Secret)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret.
+func (mr *MockServiceMockRecorder) GetSecret(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockService)(nil).GetSecret), ctx, orgId, name)
+}
+
 // GetSyncState mocks base method.
 func (m *MockService) GetSyncState(ctx context.Context, orgId uuid.UUID, resourceKey string) (*model.SyncState, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1182,6 +1431,21 @@ func (mr *MockServiceMockRecorder) ListDependencyRefsByRefType(ctx, orgId, refTy
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDependencyRefsByRefType", reflect.TypeOf((*MockService)(nil).ListDependencyRefsByRefType), ctx, orgId, refType)
 }
 
+// ListDeviceRegistrations mocks base method.
+func (m *MockService) ListDeviceRegistrations(ctx context.Context, orgId uuid.UUID, params domain.ListDeviceRegistrationsParams) (*domain.DeviceRegistrationList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeviceRegistrations", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.DeviceRegistrationList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListDeviceRegistrations indicates an expected call of ListDeviceRegistrations.
+func (mr *MockServiceMockRecorder) ListDeviceRegistrations(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeviceRegistrations", reflect.TypeOf((*MockService)(nil).ListDeviceRegistrations), ctx, orgId, params)
+}
+
 // ListDevices mocks base method.
 func (m *MockService) ListDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (*domain.DeviceList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1257,6 +1521,21 @@ func (mr *MockServiceMockRecorder) ListDueHttpDependencies(ctx, orgId, pollInter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueHttpDependencies", reflect.TypeOf((*MockService)(nil).ListDueHttpDependencies), ctx, orgId, pollInterval)
 }
 
+// ListEnrollmentPolicies mocks base method.
+func (m *MockService) ListEnrollmentPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentPoliciesParams) (*domain.EnrollmentPolicyList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnrollmentPolicies", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.EnrollmentPolicyList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListEnrollmentPolicies indicates an expected call of ListEnrollmentPolicies.
+func (mr *MockServiceMockRecorder) ListEnrollmentPolicies(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnrollmentPolicies", reflect.TypeOf((*MockService)(nil).ListEnrollmentPolicies), ctx, orgId, params)
+}
+
 // ListEnrollmentRequests mocks base method.
 func (m *MockService) ListEnrollmentRequests(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentRequestsParams) (*domain.EnrollmentRequestList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1332,6 +1611,21 @@ func (mr *MockServiceMockRecorder) ListLabels(ctx, orgId, params any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLabels", reflect.TypeOf((*MockService)(nil).ListLabels), ctx, orgId, params)
 }
 
+// ListNotificationSinks mocks base method.
+func (m *MockService) ListNotificationSinks(ctx context.Context, orgId uuid.UUID, params domain.ListNotificationSinksParams) (*domain.NotificationSinkList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationSinks", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.NotificationSinkList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListNotificationSinks indicates an expected call of ListNotificationSinks.
+func (mr *MockServiceMockRecorder) ListNotificationSinks(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationSinks", reflect.TypeOf((*MockService)(nil).ListNotificationSinks), ctx, orgId, params)
+}
+
 // ListOrganizations mocks base method.
 func (m *MockService) ListOrganizations(ctx context.Context, params domain.ListOrganizationsParams) (*domain.OrganizationList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1392,6 +1686,21 @@ func (mr *MockServiceMockRecorder) ListSecretDependencyTargets(ctx, secretNamesp
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretDependencyTargets", reflect.TypeOf((*MockService)(nil).ListSecretDependencyTargets), ctx, secretNamespace, secretName, newFingerprint)
 }
 
+// ListSecrets mocks base method.
+func (m *MockService) ListSecrets(ctx context.Context, orgId uuid.UUID, params domain.ListSecretsParams) (*domain.SecretList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.SecretList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MockServiceMockRecorder) ListSecrets(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockService)(nil).ListSecrets), ctx, orgId, params)
+}
+
 // ListTemplateVersions mocks base method.
 func (m *MockService) ListTemplateVersions(ctx context.Context, orgId uuid.UUID, fleet string, params domain.ListTemplateVersionsParams) (*domain.TemplateVersionList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1639,6 +1948,21 @@ func (mr *MockServiceMockRecorder) PatchResourceSync(ctx, orgId, name, patch any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchResourceSync", reflect.TypeOf((*MockService)(nil).PatchResourceSync), ctx, orgId, name, patch)
 }
 
+// PreviewFleetRollout mocks base method.
+func (m *MockService) PreviewFleetRollout(ctx context.Context, orgId uuid.UUID, name string, spec domain.FleetSpec) (*domain.RolloutPreview, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewFleetRollout", ctx, orgId, name, spec)
+	ret0, _ := ret[0].(*domain.RolloutPreview)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// PreviewFleetRollout indicates an expected call of PreviewFleetRollout.
+func (mr *MockServiceMockRecorder) PreviewFleetRollout(ctx, orgId, name, spec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewFleetRollout", reflect.TypeOf((*MockService)(nil).PreviewFleetRollout), ctx, orgId, name, spec)
+}
+
 // ReplaceAuthProvider mocks base method.
 func (m *MockService) ReplaceAuthProvider(ctx context.Context, orgId uuid.UUID, name string, authProvider domain.AuthProvider) (*domain.AuthProvider, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1757,6 +2081,21 @@ func (mr *MockServiceMockRecorder) ReplaceDeviceDependencyRefsByFleet(ctx, orgId
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDeviceDependencyRefsByFleet", reflect.TypeOf((*MockService)(nil).ReplaceDeviceDependencyRefsByFleet), ctx, orgId, fleetName, refs)
 }
 
+// ReplaceDeviceRegistration mocks base method.
+func (m *MockService) ReplaceDeviceRegistration(ctx context.Context, orgId uuid.UUID, name string, registration domain.DeviceRegistration) (*domain.DeviceRegistration, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceDeviceRegistration", ctx, orgId, name, registration)
+	ret0, _ := ret[0].(*domain.DeviceRegistration)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceDeviceRegistration indicates an expected call of ReplaceDeviceRegistration.
+func (mr *MockServiceMockRecorder) ReplaceDeviceRegistration(ctx, orgId, name, registration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDeviceRegistration", reflect.TypeOf((*MockService)(nil).ReplaceDeviceRegistration), ctx, orgId, name, registration)
+}
+
 // ReplaceDeviceStatus mocks base method.
 func (m *MockService) ReplaceDeviceStatus(ctx context.Context, orgId uuid.UUID, name string, device domain.Device) (*domain.Device, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1772,6 +2111,21 @@ func (mr *MockServiceMockRecorder) ReplaceDeviceStatus(ctx, orgId, name, device
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDeviceStatus", reflect.TypeOf((*MockService)(nil).ReplaceDeviceStatus), ctx, orgId, name, device)
 }
 
+// ReplaceEnrollmentPolicy mocks base method.
+func (m *MockService) ReplaceEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string, policy domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceEnrollmentPolicy", ctx, orgId, name, policy)
+	ret0, _ := ret[0].(*domain.EnrollmentPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceEnrollmentPolicy indicates an expected call of ReplaceEnrollmentPolicy.
+func (mr *MockServiceMockRecorder) ReplaceEnrollmentPolicy(ctx, orgId, name, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceEnrollmentPolicy", reflect.TypeOf((*MockService)(nil).ReplaceEnrollmentPolicy), ctx, orgId, name, policy)
+}
+
 // ReplaceEnrollmentRequest mocks base method.
 func (m *MockService) ReplaceEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string, er domain.EnrollmentRequest) (*domain.EnrollmentRequest, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1860,6 +2214,21 @@ func (mr *MockServiceMockRecorder) ReplaceFleetStatus(ctx, orgId, name, fleet an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceFleetStatus", reflect.TypeOf((*MockService)(nil).ReplaceFleetStatus), ctx, orgId, name, fleet)
 }
 
+// ReplaceNotificationSink mocks base method.
+func (m *MockService) ReplaceNotificationSink(ctx context.Context, orgId uuid.UUID, name string, sink domain.NotificationSink) (*domain.NotificationSink, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceNotificationSink", ctx, orgId, name, sink)
+	ret0, _ := ret[0].(*domain.NotificationSink)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceNotificationSink indicates an expected call of ReplaceNotificationSink.
+func (mr *MockServiceMockRecorder) ReplaceNotificationSink(ctx, orgId, name, sink any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceNotificationSink", reflect.TypeOf((*MockService)(nil).ReplaceNotificationSink), ctx, orgId, name, sink)
+}
+
 // ReplaceRepository mocks base method.
 func (m *MockService) ReplaceRepository(ctx context.Context, orgId uuid.UUID, name string, repo domain.Repository) (*domain.Repository, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1920,6 +2289,21 @@ func (mr *MockServiceMockRecorder) ReplaceResourceSyncStatus(ctx, orgId, name, r
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceResourceSyncStatus", reflect.TypeOf((*MockService)(nil).ReplaceResourceSyncStatus), ctx, orgId, name, resourceSync)
 }
 
+// ReplaceSecret mocks base method.
+func (m *MockService) ReplaceSecret(ctx context.Context, orgId uuid.UUID, name string, secret domain.Secret) (*domain.Secret, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceSecret", ctx, orgId, name, secret)
+	ret0, _ := ret[0].(*domain.Secret)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceSecret indicates an expected call of ReplaceSecret.
+func (mr *MockServiceMockRecorder) ReplaceSecret(ctx, orgId, name, secret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceSecret", reflect.TypeOf((*MockService)(nil).ReplaceSecret), ctx, orgId, name, secret)
+}
+
 // ReplaceStandaloneDeviceDependencyRefs mocks base method.
 func (m *MockService) ReplaceStandaloneDeviceDependencyRefs(ctx context.Context, orgId uuid.UUID, deviceName string, refs []model.DependencyRef) domain.Status {
 	m.ctrl.T.Helper()
@@ -2160,3 +2544,62 @@ func (mr *MockServiceMockRecorder) UpdateServiceSideDeviceStatus(ctx, orgId, dev
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceSideDeviceStatus", reflect.TypeOf((*MockService)(nil).UpdateServiceSideDeviceStatus), ctx, orgId, device)
 }
+
+// UpdateTemplateVersionRolloutRecord mocks base method.
+func (m *MockService) UpdateTemplateVersionRolloutRecord(ctx context.Context, orgId uuid.UUID, fleet, name string, record domain.TemplateVersionRolloutRecord) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplateVersionRolloutRecord", ctx, orgId, fleet, name, record)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// UpdateTemplateVersionRolloutRecord indicates an expected call of UpdateTemplateVersionRolloutRecord.
+func (mr *MockServiceMockRecorder) UpdateTemplateVersionRolloutRecord(ctx, orgId, fleet, name, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplateVersionRolloutRecord", reflect.TypeOf((*MockService)(nil).UpdateTemplateVersionRolloutRecord), ctx, orgId, fleet, name, record)
+}
+
+// WatchDevices mocks base method.
+func (m *MockService) WatchDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams) (<-chan domain.WatchEvent, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchDevices", ctx, orgId, params)
+	ret0, _ := ret[0].(<-chan domain.WatchEvent)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// WatchDevices indicates an expected call of WatchDevices.
+func (mr *MockServiceMockRecorder) WatchDevices(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchDevices", reflect.TypeOf((*MockService)(nil).WatchDevices), ctx, orgId, params)
+}
+
+// WatchEvents mocks base method.
+func (m *MockService) WatchEvents(ctx context.Context, orgId uuid.UUID, params domain.ListEventsParams) (<-chan domain.WatchEvent, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchEvents", ctx, orgId, params)
+	ret0, _ := ret[0].(<-chan domain.WatchEvent)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// WatchEvents indicates an expected call of WatchEvents.
+func (mr *MockServiceMockRecorder) WatchEvents(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchEvents", reflect.TypeOf((*MockService)(nil).WatchEvents), ctx, orgId, params)
+}
+
+// WatchFleets mocks base method.
+func (m *MockService) WatchFleets(ctx context.Context, orgId uuid.UUID, params domain.ListFleetsParams) (<-chan domain.WatchEvent, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchFleets", ctx, orgId, params)
+	ret0, _ := ret[0].(<-chan domain.WatchEvent)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// WatchFleets indicates an expected call of WatchFleets.
+func (mr *MockServiceMockRecorder) WatchFleets(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchFleets", reflect.TypeOf((*MockService)(nil).WatchFleets), ctx, orgId, params)
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+)
+
+func (h *ServiceHandler) CreateNotificationSink(ctx context.Context, orgId uuid.UUID, sink domain.NotificationSink) (*domain.NotificationSink, domain.Status) {
+	// don't set fields that are managed by the service
+	NilOutManagedObjectMetaProperties(&sink.Metadata)
+
+	if errs := sink.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, err := h.store.NotificationSink().Create(ctx, orgId, &sink, h.callbackNotificationSinkUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.NotificationSinkKind, sink.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListNotificationSinks(ctx context.Context, orgId uuid.UUID, params domain.ListNotificationSinksParams) (*domain.NotificationSinkList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.NotificationSink().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetNotificationSink(ctx context.Context, orgId uuid.UUID, name string) (*domain.NotificationSink, domain.Status) {
+	result, err := h.store.NotificationSink().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.NotificationSinkKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceNotificationSink(ctx context.Context, orgId uuid.UUID, name string, sink domain.NotificationSink) (*domain.NotificationSink, domain.Status) {
+	// don't overwrite fields that are managed by the service for external requests
+	if !IsInternalRequest(ctx) {
+		NilOutManagedObjectMetaProperties(&sink.Metadata)
+	}
+
+	if errs := sink.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *sink.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, created, err := h.store.NotificationSink().CreateOrUpdate(ctx, orgId, &sink, h.callbackNotificationSinkUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.NotificationSinkKind, &name)
+}
+
+func (h *ServiceHandler) DeleteNotificationSink(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	err := h.store.NotificationSink().Delete(ctx, orgId, name, h.callbackNotificationSinkDeleted)
+	return StoreErrorToApiStatus(err, false, domain.NotificationSinkKind, &name)
+}
+
+// callbackNotificationSinkUpdated is the notification sink-specific callback that handles notification sink update events
+func (h *ServiceHandler) callbackNotificationSinkUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleNotificationSinkUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackNotificationSinkDeleted is the notification sink-specific callback that handles notification sink deletion events
+func (h *ServiceHandler) callbackNotificationSinkDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
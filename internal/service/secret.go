@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+)
+
+func (h *ServiceHandler) CreateSecret(ctx context.Context, orgId uuid.UUID, secret domain.Secret) (*domain.Secret, domain.Status) {
+	// don't set fields that are managed by the service
+	NilOutManagedObjectMetaProperties(&secret.Metadata)
+
+	if errs := secret.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if err := validateSecretValuesNotMasked(&secret); err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
+
+	result, err := h.store.Secret().Create(ctx, orgId, &secret, h.callbackSecretUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.SecretKind, secret.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListSecrets(ctx context.Context, orgId uuid.UUID, params domain.ListSecretsParams) (*domain.SecretList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.Secret().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+// GetSecret returns the secret with its values in plain text. Callers that return it to API clients
+// must hide its values first.
+func (h *ServiceHandler) GetSecret(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, domain.Status) {
+	result, err := h.store.Secret().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.SecretKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceSecret(ctx context.Context, orgId uuid.UUID, name string, secret domain.Secret) (*domain.Secret, domain.Status) {
+	// don't overwrite fields that are managed by the service for external requests
+	if !IsInternalRequest(ctx) {
+		NilOutManagedObjectMetaProperties(&secret.Metadata)
+	}
+
+	if errs := secret.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *secret.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	// Preserve the values of the existing secret that the new one contains as masked placeholders
+	existingSecret, err := h.store.Secret().Get(ctx, orgId, name)
+	if err == nil {
+		if preserveErr := secret.PreserveSensitiveData(existingSecret); preserveErr != nil {
+			return nil, domain.StatusInternalServerError(preserveErr.Error())
+		}
+	}
+	if err := validateSecretValuesNotMasked(&secret); err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
+
+	result, created, err := h.store.Secret().CreateOrUpdate(ctx, orgId, &secret, h.callbackSecretUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.SecretKind, &name)
+}
+
+func (h *ServiceHandler) DeleteSecret(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	err := h.store.Secret().Delete(ctx, orgId, name, h.callbackSecretDeleted)
+	return StoreErrorToApiStatus(err, false, domain.SecretKind, &name)
+}
+
+// validateSecretValuesNotMasked rejects masked placeholders that do not stand for a stored value, so that
+// the placeholder itself is never stored as a value.
+func validateSecretValuesNotMasked(secret *domain.Secret) error {
+	var masked []string
+	for key, value := range secret.Spec.Data {
+		if value == domain.MaskedValuePlaceholder {
+			masked = append(masked, key)
+		}
+	}
+	if len(masked) == 0 {
+		return nil
+	}
+	sort.Strings(masked)
+	return fmt.Errorf("spec.data: keys %v have masked values but no stored value to keep", masked)
+}
+
+// callbackSecretUpdated is the secret-specific callback that handles secret update events
+func (h *ServiceHandler) callbackSecretUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleSecretUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackSecretDeleted is the secret-specific callback that handles secret deletion events
+func (h *ServiceHandler) callbackSecretDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
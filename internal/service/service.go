@@ -20,6 +20,7 @@ type Service interface {
 	PatchCertificateSigningRequest(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.CertificateSigningRequest, domain.Status)
 	ReplaceCertificateSigningRequest(ctx context.Context, orgId uuid.UUID, name string, csr domain.CertificateSigningRequest) (*domain.CertificateSigningRequest, domain.Status)
 	UpdateCertificateSigningRequestApproval(ctx context.Context, orgId uuid.UUID, name string, csr domain.CertificateSigningRequest) (*domain.CertificateSigningRequest, domain.Status)
+	ExpireCertificateSigningRequest(ctx context.Context, orgId uuid.UUID, name string, ttl time.Duration) domain.Status
 
 	// Device
 	CreateDevice(ctx context.Context, orgId uuid.UUID, device domain.Device) (*domain.Device, domain.Status)
@@ -67,6 +68,7 @@ type Service interface {
 	GetEnrollmentRequestStatus(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentRequest, domain.Status)
 	ApproveEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string, approval domain.EnrollmentRequestApproval) (*domain.EnrollmentRequestApprovalStatus, domain.Status)
 	ReplaceEnrollmentRequestStatus(ctx context.Context, orgId uuid.UUID, name string, er domain.EnrollmentRequest) (*domain.EnrollmentRequest, domain.Status)
+	ExpireEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string, ttl time.Duration) domain.Status
 
 	// Fleet
 	CreateFleet(ctx context.Context, orgId uuid.UUID, fleet domain.Fleet) (*domain.Fleet, domain.Status)
@@ -77,6 +79,9 @@ type Service interface {
 	GetFleetStatus(ctx context.Context, orgId uuid.UUID, name string) (*domain.Fleet, domain.Status)
 	ReplaceFleetStatus(ctx context.Context, orgId uuid.UUID, name string, fleet domain.Fleet) (*domain.Fleet, domain.Status)
 	PatchFleet(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.Fleet, domain.Status)
+	ApproveFleetRolloutBatch(ctx context.Context, orgId uuid.UUID, name string, approval domain.FleetRolloutBatchApproval) (*domain.Fleet, domain.Status)
+	ControlFleetRollout(ctx context.Context, orgId uuid.UUID, name string, control domain.FleetRolloutControl) (*domain.Fleet, domain.Status)
+	PreviewFleetRollout(ctx context.Context, orgId uuid.UUID, name string, spec domain.FleetSpec) (*domain.RolloutPreview, domain.Status)
 	ListFleetRolloutDeviceSelection(ctx context.Context, orgId uuid.UUID) (*domain.FleetList, domain.Status)
 	ListDisruptionBudgetFleets(ctx context.Context, orgId uuid.UUID) (*domain.FleetList, domain.Status)
 	UpdateFleetConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []domain.Condition) domain.Status
@@ -111,6 +116,34 @@ type Service interface {
 	PatchAuthProvider(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.AuthProvider, domain.Status)
 	DeleteAuthProvider(ctx context.Context, orgId uuid.UUID, name string) domain.Status
 
+	// DeviceRegistration
+	CreateDeviceRegistration(ctx context.Context, orgId uuid.UUID, registration domain.DeviceRegistration) (*domain.DeviceRegistration, domain.Status)
+	ListDeviceRegistrations(ctx context.Context, orgId uuid.UUID, params domain.ListDeviceRegistrationsParams) (*domain.DeviceRegistrationList, domain.Status)
+	GetDeviceRegistration(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceRegistration, domain.Status)
+	ReplaceDeviceRegistration(ctx context.Context, orgId uuid.UUID, name string, registration domain.DeviceRegistration) (*domain.DeviceRegistration, domain.Status)
+	DeleteDeviceRegistration(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+
+	// EnrollmentPolicy
+	CreateEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, policy domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status)
+	ListEnrollmentPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentPoliciesParams) (*domain.EnrollmentPolicyList, domain.Status)
+	GetEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentPolicy, domain.Status)
+	ReplaceEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string, policy domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status)
+	DeleteEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+
+	// NotificationSink
+	CreateNotificationSink(ctx context.Context, orgId uuid.UUID, sink domain.NotificationSink) (*domain.NotificationSink, domain.Status)
+	ListNotificationSinks(ctx context.Context, orgId uuid.UUID, params domain.ListNotificationSinksParams) (*domain.NotificationSinkList, domain.Status)
+	GetNotificationSink(ctx context.Context, orgId uuid.UUID, name string) (*domain.NotificationSink, domain.Status)
+	ReplaceNotificationSink(ctx context.Context, orgId uuid.UUID, name string, sink domain.NotificationSink) (*domain.NotificationSink, domain.Status)
+	DeleteNotificationSink(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+
+	// Secret
+	CreateSecret(ctx context.Context, orgId uuid.UUID, secret domain.Secret) (*domain.Secret, domain.Status)
+	ListSecrets(ctx context.Context, orgId uuid.UUID, params domain.ListSecretsParams) (*domain.SecretList, domain.Status)
+	GetSecret(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, domain.Status)
+	ReplaceSecret(ctx context.Context, orgId uuid.UUID, name string, secret domain.Secret) (*domain.Secret, domain.Status)
+	DeleteSecret(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+
 	// Auth
 	GetAuthConfig(ctx context.Context, authConfig *domain.AuthConfig) (*domain.AuthConfig, domain.Status)
 
@@ -168,12 +201,19 @@ type Service interface {
 	GetTemplateVersion(ctx context.Context, orgId uuid.UUID, fleet string, name string) (*domain.TemplateVersion, domain.Status)
 	DeleteTemplateVersion(ctx context.Context, orgId uuid.UUID, fleet string, name string) domain.Status
 	GetLatestTemplateVersion(ctx context.Context, orgId uuid.UUID, fleet string) (*domain.TemplateVersion, domain.Status)
+	DiffTemplateVersions(ctx context.Context, orgId uuid.UUID, fleet string, name string, other string) (*domain.TemplateVersionDiff, domain.Status)
+	UpdateTemplateVersionRolloutRecord(ctx context.Context, orgId uuid.UUID, fleet string, name string, record domain.TemplateVersionRolloutRecord) domain.Status
 
 	// Event
 	CreateEvent(ctx context.Context, orgId uuid.UUID, event *domain.Event)
 	ListEvents(ctx context.Context, orgId uuid.UUID, params domain.ListEventsParams) (*domain.EventList, domain.Status)
 	DeleteEventsOlderThan(ctx context.Context, cutoffTime time.Time) (int64, domain.Status)
 
+	// Watch
+	WatchDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams) (<-chan domain.WatchEvent, domain.Status)
+	WatchFleets(ctx context.Context, orgId uuid.UUID, params domain.ListFleetsParams) (<-chan domain.WatchEvent, domain.Status)
+	WatchEvents(ctx context.Context, orgId uuid.UUID, params domain.ListEventsParams) (<-chan domain.WatchEvent, domain.Status)
+
 	// Checkpoint
 	GetCheckpoint(ctx context.Context, consumer string, key string) ([]byte, domain.Status)
 	SetCheckpoint(ctx context.Context, consumer string, key string, value []byte) domain.Status
@@ -7,6 +7,7 @@ import (
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/rollout"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/google/uuid"
@@ -88,6 +89,28 @@ func (h *ServiceHandler) GetLatestTemplateVersion(ctx context.Context, orgId uui
 	return result, StoreErrorToApiStatus(err, false, domain.TemplateVersionKind, nil)
 }
 
+// DiffTemplateVersions returns the changes of the device specification from one template version of a fleet to another
+func (h *ServiceHandler) DiffTemplateVersions(ctx context.Context, orgId uuid.UUID, fleet string, name string, other string) (*domain.TemplateVersionDiff, domain.Status) {
+	from, err := h.store.TemplateVersion().Get(ctx, orgId, fleet, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.TemplateVersionKind, &name)
+	}
+	to, err := h.store.TemplateVersion().Get(ctx, orgId, fleet, other)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.TemplateVersionKind, &other)
+	}
+	diff, err := rollout.DiffTemplateVersions(from, to)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+	return diff, domain.StatusOK()
+}
+
+func (h *ServiceHandler) UpdateTemplateVersionRolloutRecord(ctx context.Context, orgId uuid.UUID, fleet string, name string, record domain.TemplateVersionRolloutRecord) domain.Status {
+	err := h.store.TemplateVersion().UpdateRolloutRecord(ctx, orgId, fleet, name, &record)
+	return StoreErrorToApiStatus(err, false, domain.TemplateVersionKind, &name)
+}
+
 // callbackTemplateVersionUpdated is the template version-specific callback that handles template version events
 func (h *ServiceHandler) callbackTemplateVersionUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.eventHandler.HandleTemplateVersionUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
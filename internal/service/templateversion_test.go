@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffTemplateVersions(t *testing.T) {
+	require := require.New(t)
+	testStore := &TestStore{}
+	serviceHandler := &ServiceHandler{store: testStore}
+	ctx := context.Background()
+	orgId := uuid.New()
+	for name, image := range map[string]string{"1": "quay.io/os:1", "2": "quay.io/os:2"} {
+		_, err := testStore.TemplateVersion().Create(ctx, orgId, &domain.TemplateVersion{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr(name)},
+			Spec:     domain.TemplateVersionSpec{Fleet: "foo"},
+			Status: &domain.TemplateVersionStatus{
+				Os:         &domain.DeviceOsSpec{Image: image},
+				Conditions: []domain.Condition{},
+			},
+		}, nil)
+		require.NoError(err)
+	}
+
+	diff, status := serviceHandler.DiffTemplateVersions(ctx, orgId, "foo", "1", "2")
+	require.Equal(int32(http.StatusOK), status.Code)
+	require.Equal([]domain.TemplateVersionChange{
+		{Path: "os.image", Type: domain.TemplateVersionChangeModified, From: "quay.io/os:1", To: "quay.io/os:2"},
+	}, diff.Changes)
+
+	_, status = serviceHandler.DiffTemplateVersions(ctx, orgId, "foo", "1", "3")
+	require.Equal(int32(http.StatusNotFound), status.Code)
+}
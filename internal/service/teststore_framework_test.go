@@ -26,16 +26,20 @@ const statusCreatedCode = int32(http.StatusCreated)
 const statusFailedCode = int32(http.StatusInternalServerError)
 const statusBadRequestCode = int32(http.StatusBadRequest)
 const statusNotFoundCode = int32(http.StatusNotFound)
+const statusConflictCode = int32(http.StatusConflict)
 
 type TestStore struct {
 	store.Store
 	devices                   *DummyDevice
 	events                    *DummyEvent
 	fleets                    *DummyFleet
+	templateVersions          *DummyTemplateVersion
 	catalogs                  *DummyCatalog
 	repositories              *DummyRepository
 	resourceSyncVals          *DummyResourceSync
 	enrollmentRequests        *DummyEnrollmentRequest
+	enrollmentPolicies        *DummyEnrollmentPolicy
+	deviceRegistrations       *DummyDeviceRegistration
 	organizations             *DummyOrganization
 	dummyVulnerabilityFinding *DummyVulnerabilityFinding
 }
@@ -71,6 +75,11 @@ type DummyFleet struct {
 	fleets *[]domain.Fleet
 }
 
+type DummyTemplateVersion struct {
+	store.TemplateVersion
+	templateVersions *[]domain.TemplateVersion
+}
+
 type DummyRepository struct {
 	store.Repository
 	repositories *[]domain.Repository
@@ -86,6 +95,16 @@ type DummyEnrollmentRequest struct {
 	enrollmentRequests *[]domain.EnrollmentRequest
 }
 
+type DummyEnrollmentPolicy struct {
+	store.EnrollmentPolicy
+	enrollmentPolicies *[]domain.EnrollmentPolicy
+}
+
+type DummyDeviceRegistration struct {
+	store.DeviceRegistration
+	deviceRegistrations *[]domain.DeviceRegistration
+}
+
 type DummyCatalog struct {
 	store.Catalog
 	catalogs *[]domain.Catalog
@@ -109,6 +128,9 @@ func (s *TestStore) init() {
 	if s.fleets == nil {
 		s.fleets = &DummyFleet{fleets: &[]domain.Fleet{}}
 	}
+	if s.templateVersions == nil {
+		s.templateVersions = &DummyTemplateVersion{templateVersions: &[]domain.TemplateVersion{}}
+	}
 	if s.catalogs == nil {
 		s.catalogs = &DummyCatalog{catalogs: &[]domain.Catalog{}, items: &[]domain.CatalogItem{}}
 	}
@@ -121,6 +143,12 @@ func (s *TestStore) init() {
 	if s.enrollmentRequests == nil {
 		s.enrollmentRequests = &DummyEnrollmentRequest{enrollmentRequests: &[]domain.EnrollmentRequest{}}
 	}
+	if s.enrollmentPolicies == nil {
+		s.enrollmentPolicies = &DummyEnrollmentPolicy{enrollmentPolicies: &[]domain.EnrollmentPolicy{}}
+	}
+	if s.deviceRegistrations == nil {
+		s.deviceRegistrations = &DummyDeviceRegistration{deviceRegistrations: &[]domain.DeviceRegistration{}}
+	}
 	if s.organizations == nil {
 		s.organizations = &DummyOrganization{organizations: &[]*model.Organization{}}
 	}
@@ -134,6 +162,11 @@ func (s *TestStore) Fleet() store.Fleet {
 	return s.fleets
 }
 
+func (s *TestStore) TemplateVersion() store.TemplateVersion {
+	s.init()
+	return s.templateVersions
+}
+
 func (s *TestStore) Catalog() store.Catalog {
 	s.init()
 	return s.catalogs
@@ -164,6 +197,16 @@ func (s *TestStore) EnrollmentRequest() store.EnrollmentRequest {
 	return s.enrollmentRequests
 }
 
+func (s *TestStore) EnrollmentPolicy() store.EnrollmentPolicy {
+	s.init()
+	return s.enrollmentPolicies
+}
+
+func (s *TestStore) DeviceRegistration() store.DeviceRegistration {
+	s.init()
+	return s.deviceRegistrations
+}
+
 func (s *TestStore) Organization() store.Organization {
 	s.init()
 	return s.organizations
@@ -272,6 +315,14 @@ func (s *DummyDevice) Create(ctx context.Context, orgId uuid.UUID, device *domai
 	return device, nil
 }
 
+func (s *DummyDevice) UnmarkRolloutSelection(ctx context.Context, orgId uuid.UUID, fleetName string) error {
+	for i := range *s.devices {
+		annotations := lo.OmitByKeys(lo.FromPtr((*s.devices)[i].Metadata.Annotations), []string{domain.DeviceAnnotationSelectedForRollout})
+		(*s.devices)[i].Metadata.Annotations = &annotations
+	}
+	return nil
+}
+
 func (s *DummyDevice) UpdateStatus(ctx context.Context, orgId uuid.UUID, device *domain.Device, callbackEvent store.EventCallback) (*domain.Device, error) {
 	for i, dev := range *s.devices {
 		if *device.Metadata.Name == *dev.Metadata.Name {
@@ -328,6 +379,33 @@ func (s *DummyFleet) Update(ctx context.Context, orgId uuid.UUID, fleet *domain.
 	return nil, flterrors.ErrResourceNotFound
 }
 
+func (s *DummyFleet) UpdateAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string, callbackEvent store.EventCallback) error {
+	for i, flt := range *s.fleets {
+		if name == *flt.Metadata.Name {
+			existing := lo.FromPtr((*s.fleets)[i].Metadata.Annotations)
+			updated := lo.OmitByKeys(lo.Assign(existing, annotations), deleteKeys)
+			(*s.fleets)[i].Metadata.Annotations = &updated
+			return nil
+		}
+	}
+	return flterrors.ErrResourceNotFound
+}
+
+func (s *DummyFleet) UpdateConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []domain.Condition, callbackEvent store.EventCallback) error {
+	for i, flt := range *s.fleets {
+		if name == *flt.Metadata.Name {
+			if (*s.fleets)[i].Status == nil {
+				(*s.fleets)[i].Status = &domain.FleetStatus{}
+			}
+			for _, condition := range conditions {
+				domain.SetStatusCondition(&(*s.fleets)[i].Status.Conditions, condition)
+			}
+			return nil
+		}
+	}
+	return flterrors.ErrResourceNotFound
+}
+
 func (s *DummyFleet) Delete(ctx context.Context, orgId uuid.UUID, name string, callbackEvent store.EventCallback) error {
 	for i, fleet := range *s.fleets {
 		if name == *fleet.Metadata.Name {
@@ -343,6 +421,41 @@ func (s *DummyFleet) Delete(ctx context.Context, orgId uuid.UUID, name string, c
 	return flterrors.ErrResourceNotFound
 }
 
+// --------------------------------------> TemplateVersion
+
+func (s *DummyTemplateVersion) Create(ctx context.Context, orgId uuid.UUID, tv *domain.TemplateVersion, callbackEvent store.EventCallback) (*domain.TemplateVersion, error) {
+	var t domain.TemplateVersion
+	deepCopy(tv, &t)
+	*s.templateVersions = append(*s.templateVersions, t)
+	return tv, nil
+}
+
+func (s *DummyTemplateVersion) Get(ctx context.Context, orgId uuid.UUID, fleet string, name string) (*domain.TemplateVersion, error) {
+	for _, tv := range *s.templateVersions {
+		if fleet == tv.Spec.Fleet && name == lo.FromPtr(tv.Metadata.Name) {
+			var t domain.TemplateVersion
+			deepCopy(tv, &t)
+			return &t, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *DummyTemplateVersion) UpdateRolloutRecord(ctx context.Context, orgId uuid.UUID, fleet string, name string, record *domain.TemplateVersionRolloutRecord) error {
+	for i, tv := range *s.templateVersions {
+		if fleet == tv.Spec.Fleet && name == lo.FromPtr(tv.Metadata.Name) {
+			if (*s.templateVersions)[i].Status == nil {
+				(*s.templateVersions)[i].Status = &domain.TemplateVersionStatus{}
+			}
+			var r domain.TemplateVersionRolloutRecord
+			deepCopy(record, &r)
+			(*s.templateVersions)[i].Status.Rollout = &r
+			return nil
+		}
+	}
+	return flterrors.ErrResourceNotFound
+}
+
 // --------------------------------------> Catalog
 
 func (s *DummyCatalog) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Catalog, error) {
@@ -668,13 +781,90 @@ func (s *DummyEnrollmentRequest) UpdateStatus(ctx context.Context, orgId uuid.UU
 			if callbackEvent != nil {
 				callbackEvent(ctx, domain.EnrollmentRequestKind, orgId, lo.FromPtr(er.Metadata.Name), oldEr, er, false, nil)
 			}
-			oldEr.Status = er.Status
+			(*s.enrollmentRequests)[i].Status = er.Status
 			return er, nil
 		}
 	}
 	return nil, flterrors.ErrResourceNotFound
 }
 
+// --------------------------------------> EnrollmentPolicy
+
+func (s *DummyEnrollmentPolicy) Create(ctx context.Context, orgId uuid.UUID, policy *domain.EnrollmentPolicy, callbackEvent store.EventCallback) (*domain.EnrollmentPolicy, error) {
+	var p domain.EnrollmentPolicy
+	deepCopy(policy, &p)
+	*s.enrollmentPolicies = append(*s.enrollmentPolicies, p)
+	if callbackEvent != nil {
+		callbackEvent(ctx, domain.EnrollmentPolicyKind, orgId, lo.FromPtr(policy.Metadata.Name), nil, &p, true, nil)
+	}
+	return &p, nil
+}
+
+func (s *DummyEnrollmentPolicy) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.EnrollmentPolicyList, error) {
+	items := append([]domain.EnrollmentPolicy{}, *s.enrollmentPolicies...)
+	sort.Slice(items, func(i, j int) bool {
+		return lo.FromPtr(items[i].Metadata.Name) < lo.FromPtr(items[j].Metadata.Name)
+	})
+	return &domain.EnrollmentPolicyList{Items: items}, nil
+}
+
+// --------------------------------------> DeviceRegistration
+
+func (s *DummyDeviceRegistration) Create(ctx context.Context, orgId uuid.UUID, registration *domain.DeviceRegistration, callbackEvent store.EventCallback) (*domain.DeviceRegistration, error) {
+	var r domain.DeviceRegistration
+	deepCopy(registration, &r)
+	*s.deviceRegistrations = append(*s.deviceRegistrations, r)
+	if callbackEvent != nil {
+		callbackEvent(ctx, domain.DeviceRegistrationKind, orgId, lo.FromPtr(registration.Metadata.Name), nil, &r, true, nil)
+	}
+	return &r, nil
+}
+
+func (s *DummyDeviceRegistration) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceRegistration, error) {
+	for _, registration := range *s.deviceRegistrations {
+		if lo.FromPtr(registration.Metadata.Name) == name {
+			var r domain.DeviceRegistration
+			deepCopy(registration, &r)
+			return &r, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *DummyDeviceRegistration) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.DeviceRegistrationList, error) {
+	items := append([]domain.DeviceRegistration{}, *s.deviceRegistrations...)
+	sort.Slice(items, func(i, j int) bool {
+		return lo.FromPtr(items[i].Metadata.Name) < lo.FromPtr(items[j].Metadata.Name)
+	})
+	return &domain.DeviceRegistrationList{Items: items}, nil
+}
+
+func (s *DummyDeviceRegistration) UpdateStatus(ctx context.Context, orgId uuid.UUID, registration *domain.DeviceRegistration, callbackEvent store.EventCallback) (*domain.DeviceRegistration, error) {
+	for i, existing := range *s.deviceRegistrations {
+		if lo.FromPtr(existing.Metadata.Name) == lo.FromPtr(registration.Metadata.Name) {
+			deepCopy(registration.Status, &(*s.deviceRegistrations)[i].Status)
+			return registration, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *DummyDeviceRegistration) ClaimEnrollment(ctx context.Context, orgId uuid.UUID, name string, enrollmentRequest string, enrolledAt time.Time) (*domain.DeviceRegistration, error) {
+	for i, existing := range *s.deviceRegistrations {
+		if lo.FromPtr(existing.Metadata.Name) != name {
+			continue
+		}
+		if existing.Status != nil && existing.Status.EnrollmentRequest != nil && *existing.Status.EnrollmentRequest != enrollmentRequest {
+			return nil, flterrors.ErrNoRowsUpdated
+		}
+		(*s.deviceRegistrations)[i].Status = &domain.DeviceRegistrationStatus{EnrollmentRequest: &enrollmentRequest, EnrolledAt: &enrolledAt}
+		var r domain.DeviceRegistration
+		deepCopy((*s.deviceRegistrations)[i], &r)
+		return &r, nil
+	}
+	return nil, flterrors.ErrNoRowsUpdated
+}
+
 // --------------------------------------> Organization
 
 func (s *DummyOrganization) InitialMigration(ctx context.Context) error {
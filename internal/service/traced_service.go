@@ -89,6 +89,13 @@ func (t *TracedService) UpdateCertificateSigningRequestApproval(ctx context.Cont
 	return resp, st
 }
 
+func (t *TracedService) ExpireCertificateSigningRequest(ctx context.Context, orgId uuid.UUID, name string, ttl time.Duration) domain.Status {
+	ctx, span := startSpan(ctx, "ExpireCertificateSigningRequest")
+	st := t.inner.ExpireCertificateSigningRequest(ctx, orgId, name, ttl)
+	endSpan(span, st)
+	return st
+}
+
 // --- Device ---
 func (t *TracedService) CreateDevice(ctx context.Context, orgId uuid.UUID, d domain.Device) (*domain.Device, domain.Status) {
 	ctx, span := startSpan(ctx, "CreateDevice")
@@ -340,6 +347,13 @@ func (t *TracedService) ReplaceEnrollmentRequestStatus(ctx context.Context, orgI
 	return resp, st
 }
 
+func (t *TracedService) ExpireEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string, ttl time.Duration) domain.Status {
+	ctx, span := startSpan(ctx, "ExpireEnrollmentRequest")
+	st := t.inner.ExpireEnrollmentRequest(ctx, orgId, name, ttl)
+	endSpan(span, st)
+	return st
+}
+
 // --- Fleet ---
 func (t *TracedService) CreateFleet(ctx context.Context, orgId uuid.UUID, fleet domain.Fleet) (*domain.Fleet, domain.Status) {
 	ctx, span := startSpan(ctx, "CreateFleet")
@@ -389,6 +403,24 @@ func (t *TracedService) PatchFleet(ctx context.Context, orgId uuid.UUID, name st
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) ApproveFleetRolloutBatch(ctx context.Context, orgId uuid.UUID, name string, approval domain.FleetRolloutBatchApproval) (*domain.Fleet, domain.Status) {
+	ctx, span := startSpan(ctx, "ApproveFleetRolloutBatch")
+	resp, st := t.inner.ApproveFleetRolloutBatch(ctx, orgId, name, approval)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ControlFleetRollout(ctx context.Context, orgId uuid.UUID, name string, control domain.FleetRolloutControl) (*domain.Fleet, domain.Status) {
+	ctx, span := startSpan(ctx, "ControlFleetRollout")
+	resp, st := t.inner.ControlFleetRollout(ctx, orgId, name, control)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) PreviewFleetRollout(ctx context.Context, orgId uuid.UUID, name string, spec domain.FleetSpec) (*domain.RolloutPreview, domain.Status) {
+	ctx, span := startSpan(ctx, "PreviewFleetRollout")
+	resp, st := t.inner.PreviewFleetRollout(ctx, orgId, name, spec)
+	endSpan(span, st)
+	return resp, st
+}
 func (t *TracedService) ListFleetRolloutDeviceSelection(ctx context.Context, orgId uuid.UUID) (*domain.FleetList, domain.Status) {
 	ctx, span := startSpan(ctx, "ListFleetRolloutDeviceSelection")
 	resp, st := t.inner.ListFleetRolloutDeviceSelection(ctx, orgId)
@@ -787,6 +819,18 @@ func (t *TracedService) GetLatestTemplateVersion(ctx context.Context, orgId uuid
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) DiffTemplateVersions(ctx context.Context, orgId uuid.UUID, fleet string, name string, other string) (*domain.TemplateVersionDiff, domain.Status) {
+	ctx, span := startSpan(ctx, "DiffTemplateVersions")
+	resp, st := t.inner.DiffTemplateVersions(ctx, orgId, fleet, name, other)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) UpdateTemplateVersionRolloutRecord(ctx context.Context, orgId uuid.UUID, fleet string, name string, record domain.TemplateVersionRolloutRecord) domain.Status {
+	ctx, span := startSpan(ctx, "UpdateTemplateVersionRolloutRecord")
+	st := t.inner.UpdateTemplateVersionRolloutRecord(ctx, orgId, fleet, name, record)
+	endSpan(span, st)
+	return st
+}
 
 // --- Event ---
 func (t *TracedService) CreateEvent(ctx context.Context, orgId uuid.UUID, event *domain.Event) {
@@ -807,6 +851,27 @@ func (t *TracedService) DeleteEventsOlderThan(ctx context.Context, cutoffTime ti
 	return resp, st
 }
 
+// --- Watch ---
+// Only the setup of a watch is traced, not the lifetime of the stream.
+func (t *TracedService) WatchDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams) (<-chan domain.WatchEvent, domain.Status) {
+	_, span := startSpan(ctx, "WatchDevices")
+	resp, st := t.inner.WatchDevices(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) WatchFleets(ctx context.Context, orgId uuid.UUID, params domain.ListFleetsParams) (<-chan domain.WatchEvent, domain.Status) {
+	_, span := startSpan(ctx, "WatchFleets")
+	resp, st := t.inner.WatchFleets(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) WatchEvents(ctx context.Context, orgId uuid.UUID, params domain.ListEventsParams) (<-chan domain.WatchEvent, domain.Status) {
+	_, span := startSpan(ctx, "WatchEvents")
+	resp, st := t.inner.WatchEvents(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+
 // --- Checkpoint ---
 func (t *TracedService) GetCheckpoint(ctx context.Context, consumer string, key string) ([]byte, domain.Status) {
 	ctx, span := startSpan(ctx, "GetCheckpoint")
@@ -899,6 +964,150 @@ func (t *TracedService) DeleteAuthProvider(ctx context.Context, orgId uuid.UUID,
 	return st
 }
 
+// --- DeviceRegistration ---
+func (t *TracedService) CreateDeviceRegistration(ctx context.Context, orgId uuid.UUID, registration domain.DeviceRegistration) (*domain.DeviceRegistration, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateDeviceRegistration")
+	resp, st := t.inner.CreateDeviceRegistration(ctx, orgId, registration)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) ListDeviceRegistrations(ctx context.Context, orgId uuid.UUID, params domain.ListDeviceRegistrationsParams) (*domain.DeviceRegistrationList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListDeviceRegistrations")
+	resp, st := t.inner.ListDeviceRegistrations(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) GetDeviceRegistration(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceRegistration, domain.Status) {
+	ctx, span := startSpan(ctx, "GetDeviceRegistration")
+	resp, st := t.inner.GetDeviceRegistration(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) ReplaceDeviceRegistration(ctx context.Context, orgId uuid.UUID, name string, registration domain.DeviceRegistration) (*domain.DeviceRegistration, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceDeviceRegistration")
+	resp, st := t.inner.ReplaceDeviceRegistration(ctx, orgId, name, registration)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) DeleteDeviceRegistration(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteDeviceRegistration")
+	st := t.inner.DeleteDeviceRegistration(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+
+// --- EnrollmentPolicy ---
+func (t *TracedService) CreateEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, policy domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateEnrollmentPolicy")
+	resp, st := t.inner.CreateEnrollmentPolicy(ctx, orgId, policy)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) ListEnrollmentPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentPoliciesParams) (*domain.EnrollmentPolicyList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListEnrollmentPolicies")
+	resp, st := t.inner.ListEnrollmentPolicies(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) GetEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "GetEnrollmentPolicy")
+	resp, st := t.inner.GetEnrollmentPolicy(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) ReplaceEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string, policy domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceEnrollmentPolicy")
+	resp, st := t.inner.ReplaceEnrollmentPolicy(ctx, orgId, name, policy)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) DeleteEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteEnrollmentPolicy")
+	st := t.inner.DeleteEnrollmentPolicy(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+
+// --- NotificationSink ---
+func (t *TracedService) CreateNotificationSink(ctx context.Context, orgId uuid.UUID, sink domain.NotificationSink) (*domain.NotificationSink, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateNotificationSink")
+	resp, st := t.inner.CreateNotificationSink(ctx, orgId, sink)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) ListNotificationSinks(ctx context.Context, orgId uuid.UUID, params domain.ListNotificationSinksParams) (*domain.NotificationSinkList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListNotificationSinks")
+	resp, st := t.inner.ListNotificationSinks(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) GetNotificationSink(ctx context.Context, orgId uuid.UUID, name string) (*domain.NotificationSink, domain.Status) {
+	ctx, span := startSpan(ctx, "GetNotificationSink")
+	resp, st := t.inner.GetNotificationSink(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) ReplaceNotificationSink(ctx context.Context, orgId uuid.UUID, name string, sink domain.NotificationSink) (*domain.NotificationSink, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceNotificationSink")
+	resp, st := t.inner.ReplaceNotificationSink(ctx, orgId, name, sink)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) DeleteNotificationSink(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteNotificationSink")
+	st := t.inner.DeleteNotificationSink(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+
+// --- Secret ---
+func (t *TracedService) CreateSecret(ctx context.Context, orgId uuid.UUID, secret domain.Secret) (*domain.Secret, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateSecret")
+	resp, st := t.inner.CreateSecret(ctx, orgId, secret)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) ListSecrets(ctx context.Context, orgId uuid.UUID, params domain.ListSecretsParams) (*domain.SecretList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListSecrets")
+	resp, st := t.inner.ListSecrets(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) GetSecret(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, domain.Status) {
+	ctx, span := startSpan(ctx, "GetSecret")
+	resp, st := t.inner.GetSecret(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) ReplaceSecret(ctx context.Context, orgId uuid.UUID, name string, secret domain.Secret) (*domain.Secret, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceSecret")
+	resp, st := t.inner.ReplaceSecret(ctx, orgId, name, secret)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) DeleteSecret(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteSecret")
+	st := t.inner.DeleteSecret(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+
 // --- Vulnerability ---
 func (t *TracedService) GetVulnerabilitySummary(ctx context.Context, orgId uuid.UUID) (*domain.VulnerabilitySummaryResponse, domain.Status) {
 	ctx, span := startSpan(ctx, "GetVulnerabilitySummary")
@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/watch"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// watchItem is a resource as seen by a watch, reduced to what the watch loop needs.
+type watchItem struct {
+	name              string
+	resourceVersion   int64
+	creationTimestamp time.Time
+	object            any
+}
+
+// watchSource describes how to list the resources of one kind for a watch.
+type watchSource struct {
+	kind       string
+	apiVersion string
+	// list returns one page of the watched resources, additionally restricted
+	// by fieldSelector when it is not empty.
+	list func(ctx context.Context, fieldSelector string, cont *string) ([]watchItem, *string, domain.Status)
+	// replay returns the resources that are sent when the watch starts. When
+	// it is nil, only the changes after the watch started are sent.
+	replay func(ctx context.Context) ([]watchItem, domain.Status)
+}
+
+// resultWatcher is the part of a watch.Watcher that the watch loop uses.
+type resultWatcher interface {
+	ResultChan() <-chan watch.Notification
+	Stop()
+}
+
+// WatchDevices streams changes to the devices matching params.
+func (h *ServiceHandler) WatchDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams) (<-chan domain.WatchEvent, domain.Status) {
+	if params.SummaryOnly != nil && *params.SummaryOnly {
+		return nil, domain.StatusBadRequest("'summaryOnly' is not supported when 'watch' is true")
+	}
+	source := watchSource{
+		kind:       domain.DeviceKind,
+		apiVersion: fmt.Sprintf("%s/%s", domain.APIGroup, domain.DeviceAPIVersion),
+		list: func(ctx context.Context, fieldSelector string, cont *string) ([]watchItem, *string, domain.Status) {
+			p := params
+			p.Watch, p.Limit, p.Continue = nil, nil, cont
+			p.FieldSelector = joinFieldSelectors(params.FieldSelector, fieldSelector)
+			result, status := h.ListDevices(ctx, orgId, p, nil)
+			if status.Code != http.StatusOK {
+				return nil, nil, status
+			}
+			items := make([]watchItem, 0, len(result.Items))
+			for i := range result.Items {
+				items = append(items, newWatchItem(result.Items[i].Metadata, &result.Items[i]))
+			}
+			return items, result.Metadata.Continue, status
+		},
+	}
+	source.replay = func(ctx context.Context) ([]watchItem, domain.Status) {
+		return source.listAll(ctx, "")
+	}
+	return h.watch(ctx, orgId, source)
+}
+
+// WatchFleets streams changes to the fleets matching params.
+func (h *ServiceHandler) WatchFleets(ctx context.Context, orgId uuid.UUID, params domain.ListFleetsParams) (<-chan domain.WatchEvent, domain.Status) {
+	source := watchSource{
+		kind:       domain.FleetKind,
+		apiVersion: fmt.Sprintf("%s/%s", domain.APIGroup, domain.FleetAPIVersion),
+		list: func(ctx context.Context, fieldSelector string, cont *string) ([]watchItem, *string, domain.Status) {
+			p := params
+			p.Watch, p.Limit, p.Continue = nil, nil, cont
+			p.FieldSelector = joinFieldSelectors(params.FieldSelector, fieldSelector)
+			result, status := h.ListFleets(ctx, orgId, p)
+			if status.Code != http.StatusOK {
+				return nil, nil, status
+			}
+			items := make([]watchItem, 0, len(result.Items))
+			for i := range result.Items {
+				items = append(items, newWatchItem(result.Items[i].Metadata, &result.Items[i]))
+			}
+			return items, result.Metadata.Continue, status
+		},
+	}
+	source.replay = func(ctx context.Context) ([]watchItem, domain.Status) {
+		return source.listAll(ctx, "")
+	}
+	return h.watch(ctx, orgId, source)
+}
+
+// WatchEvents streams the events matching params that are created after the
+// watch starts, preceded by those created after params.ResourceVersion.
+func (h *ServiceHandler) WatchEvents(ctx context.Context, orgId uuid.UUID, params domain.ListEventsParams) (<-chan domain.WatchEvent, domain.Status) {
+	// Nothing is listed before the first event arrives, so validate the selector up front.
+	if _, status := prepareListParams(nil, nil, params.FieldSelector, nil); status.Code != http.StatusOK {
+		return nil, status
+	}
+	cursor, status := parseEventCursor(params.ResourceVersion)
+	if status.Code != http.StatusOK {
+		return nil, status
+	}
+	source := watchSource{
+		kind:       domain.EventKind,
+		apiVersion: fmt.Sprintf("%s/%s", domain.APIGroup, domain.EventAPIVersion),
+		list: func(ctx context.Context, fieldSelector string, cont *string) ([]watchItem, *string, domain.Status) {
+			p := params
+			p.Watch, p.ResourceVersion, p.Limit, p.Continue = nil, nil, nil, cont
+			p.Order = lo.ToPtr(domain.Asc)
+			p.FieldSelector = joinFieldSelectors(params.FieldSelector, fieldSelector)
+			result, status := h.ListEvents(ctx, orgId, p)
+			if status.Code != http.StatusOK {
+				return nil, nil, status
+			}
+			items := make([]watchItem, 0, len(result.Items))
+			for i := range result.Items {
+				event := &result.Items[i]
+				event.Metadata.ResourceVersion = lo.ToPtr(newEventCursor(event.Metadata).String())
+				items = append(items, newWatchItem(event.Metadata, event))
+			}
+			return items, result.Metadata.Continue, status
+		},
+	}
+	if cursor != nil {
+		source.replay = func(ctx context.Context) ([]watchItem, domain.Status) {
+			items, status := source.listAll(ctx, fmt.Sprintf("metadata.creationTimestamp>=%s", cursor.CreatedAt.Format(time.RFC3339Nano)))
+			return lo.Filter(items, func(item watchItem, _ int) bool { return cursor.precedes(item) }), status
+		}
+	}
+	return h.watch(ctx, orgId, source)
+}
+
+// watch subscribes to the changes of the watched kind and starts streaming them.
+func (h *ServiceHandler) watch(ctx context.Context, orgId uuid.UUID, source watchSource) (<-chan domain.WatchEvent, domain.Status) {
+	watcher, err := watch.Bus.Instance().Watch(orgId, source.kind)
+	if err != nil {
+		return nil, domain.StatusNotImplemented(err.Error())
+	}
+	return h.startWatch(ctx, watcher, source)
+}
+
+// startWatch lists the replayed resources and starts a goroutine that sends
+// them followed by the changes reported by watcher. The returned channel is
+// closed when ctx is cancelled or the watch falls behind.
+func (h *ServiceHandler) startWatch(ctx context.Context, watcher resultWatcher, source watchSource) (<-chan domain.WatchEvent, domain.Status) {
+	// The watcher is subscribed before listing so that no change between the
+	// list and the subscription is lost; duplicates are filtered by resourceVersion.
+	var initial []watchItem
+	if source.replay != nil {
+		var status domain.Status
+		initial, status = source.replay(ctx)
+		if status.Code != http.StatusOK {
+			watcher.Stop()
+			return nil, status
+		}
+	}
+
+	ch := make(chan domain.WatchEvent)
+	go func() {
+		defer close(ch)
+		defer watcher.Stop()
+
+		// known tracks the resourceVersion last sent for every resource the
+		// client currently sees, so that it can be told when one disappears.
+		// For events, which never change, it holds the replayed events so that
+		// they are not sent twice.
+		known := make(map[string]int64)
+		for _, item := range initial {
+			known[item.name] = item.resourceVersion
+			if !h.sendWatchEvent(ctx, ch, domain.WatchEventTypeAdded, item.object) {
+				return
+			}
+		}
+
+		for {
+			var notification watch.Notification
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case notification, ok = <-watcher.ResultChan():
+				if !ok {
+					h.sendWatchEvent(ctx, ch, domain.WatchEventTypeError, domain.StatusServiceUnavailable("watch fell behind and was closed"))
+					return
+				}
+			}
+
+			if notification.Type == domain.WatchEventTypeDeleted {
+				if _, found := known[notification.Name]; found {
+					delete(known, notification.Name)
+					if !h.sendWatchEvent(ctx, ch, domain.WatchEventTypeDeleted, source.deletedObject(notification.Name)) {
+						return
+					}
+				}
+				continue
+			}
+
+			items, _, status := source.list(ctx, "metadata.name="+notification.Name, nil)
+			if status.Code != http.StatusOK {
+				h.sendWatchEvent(ctx, ch, domain.WatchEventTypeError, status)
+				return
+			}
+			if len(items) == 0 {
+				// The resource no longer matches the selectors.
+				if _, found := known[notification.Name]; found {
+					delete(known, notification.Name)
+					if !h.sendWatchEvent(ctx, ch, domain.WatchEventTypeDeleted, source.deletedObject(notification.Name)) {
+						return
+					}
+				}
+				continue
+			}
+
+			item := items[0]
+			lastVersion, found := known[item.name]
+			eventType := domain.WatchEventTypeAdded
+			if source.kind == domain.EventKind {
+				if found {
+					continue
+				}
+			} else {
+				if found {
+					if item.resourceVersion != 0 && item.resourceVersion <= lastVersion {
+						continue
+					}
+					eventType = domain.WatchEventTypeModified
+				}
+				known[item.name] = item.resourceVersion
+			}
+			if !h.sendWatchEvent(ctx, ch, eventType, item.object) {
+				return
+			}
+		}
+	}()
+	return ch, domain.StatusOK()
+}
+
+// listAll returns all pages of the watched resources matching fieldSelector.
+func (s watchSource) listAll(ctx context.Context, fieldSelector string) ([]watchItem, domain.Status) {
+	var all []watchItem
+	var cont *string
+	for {
+		items, next, status := s.list(ctx, fieldSelector, cont)
+		if status.Code != http.StatusOK {
+			return nil, status
+		}
+		all = append(all, items...)
+		if next == nil {
+			return all, status
+		}
+		cont = next
+	}
+}
+
+// sendWatchEvent serializes object into a watch event and sends it, returning
+// false if the watch was cancelled or the object could not be serialized.
+func (h *ServiceHandler) sendWatchEvent(ctx context.Context, ch chan<- domain.WatchEvent, eventType domain.WatchEventType, object any) bool {
+	if hider, ok := object.(domain.SensitiveDataHider); ok {
+		if err := hider.HideSensitiveData(); err != nil {
+			h.log.Errorf("failed to hide sensitive data of watched object: %v", err)
+			return false
+		}
+	}
+	b, err := json.Marshal(object)
+	if err != nil {
+		h.log.Errorf("failed to marshal watched object: %v", err)
+		return false
+	}
+	select {
+	case ch <- domain.WatchEvent{Type: eventType, Object: b}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (s watchSource) deletedObject(name string) any {
+	return struct {
+		ApiVersion string            `json:"apiVersion"`
+		Kind       string            `json:"kind"`
+		Metadata   domain.ObjectMeta `json:"metadata"`
+	}{
+		ApiVersion: s.apiVersion,
+		Kind:       s.kind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+	}
+}
+
+func newWatchItem(metadata domain.ObjectMeta, object any) watchItem {
+	item := watchItem{name: lo.FromPtr(metadata.Name), creationTimestamp: lo.FromPtr(metadata.CreationTimestamp), object: object}
+	if metadata.ResourceVersion != nil {
+		item.resourceVersion, _ = strconv.ParseInt(*metadata.ResourceVersion, 10, 64)
+	}
+	return item
+}
+
+// eventCursor is the position of an event in the order events are listed in
+// by an events watch. It is sent to clients as the event's resourceVersion.
+type eventCursor struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Name      string    `json:"name"`
+}
+
+func newEventCursor(metadata domain.ObjectMeta) eventCursor {
+	return eventCursor{CreatedAt: lo.FromPtr(metadata.CreationTimestamp), Name: lo.FromPtr(metadata.Name)}
+}
+
+func (c eventCursor) String() string {
+	b, _ := json.Marshal(c)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// precedes reports whether the cursor is positioned before the event.
+func (c eventCursor) precedes(item watchItem) bool {
+	if !item.creationTimestamp.Equal(c.CreatedAt) {
+		return item.creationTimestamp.After(c.CreatedAt)
+	}
+	return item.name > c.Name
+}
+
+func parseEventCursor(resourceVersion *string) (*eventCursor, domain.Status) {
+	if resourceVersion == nil || *resourceVersion == "" {
+		return nil, domain.StatusOK()
+	}
+	var cursor eventCursor
+	b, err := base64.StdEncoding.DecodeString(*resourceVersion)
+	if err == nil {
+		err = json.Unmarshal(b, &cursor)
+	}
+	if err != nil || cursor.CreatedAt.IsZero() || cursor.Name == "" {
+		return nil, domain.StatusBadRequest(fmt.Sprintf("invalid resourceVersion %q", *resourceVersion))
+	}
+	return &cursor, domain.StatusOK()
+}
+
+func joinFieldSelectors(fieldSelector *string, extra string) *string {
+	selectors := lo.Compact([]string{lo.FromPtr(fieldSelector), extra})
+	if len(selectors) == 0 {
+		return nil
+	}
+	return lo.ToPtr(strings.Join(selectors, ","))
+}
+
+// notifyWatchers publishes a change of a resource to the watchers of its kind.
+func notifyWatchers(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, kind string, name string, eventType domain.WatchEventType) {
+	if err := watch.Bus.Instance().Publish(ctx, orgId, kind, name, eventType); err != nil {
+		log.Warnf("failed to notify watchers of %s %s/%s: %v", kind, orgId, name, err)
+	}
+}
@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/watch"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEventCursor(t *testing.T) {
+	cursor := eventCursor{CreatedAt: time.Date(2026, 1, 2, 3, 4, 5, 6000, time.UTC), Name: "event1"}
+	tests := []struct {
+		name            string
+		resourceVersion *string
+		expected        *eventCursor
+		expectedCode    int32
+	}{
+		{name: "unset", resourceVersion: nil, expectedCode: http.StatusOK},
+		{name: "empty", resourceVersion: lo.ToPtr(""), expectedCode: http.StatusOK},
+		{name: "cursor", resourceVersion: lo.ToPtr(cursor.String()), expected: &cursor, expectedCode: http.StatusOK},
+		{name: "not base64", resourceVersion: lo.ToPtr("42"), expectedCode: http.StatusBadRequest},
+		{name: "no name", resourceVersion: lo.ToPtr(eventCursor{CreatedAt: cursor.CreatedAt}.String()), expectedCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, status := parseEventCursor(tt.resourceVersion)
+			require.Equal(t, tt.expectedCode, status.Code)
+			require.Equal(t, tt.expected, parsed)
+		})
+	}
+}
+
+func TestEventCursorPrecedes(t *testing.T) {
+	require := require.New(t)
+	now := time.Now().UTC()
+	cursor := eventCursor{CreatedAt: now, Name: "b"}
+
+	require.False(cursor.precedes(watchItem{name: "c", creationTimestamp: now.Add(-time.Second)}))
+	require.False(cursor.precedes(watchItem{name: "a", creationTimestamp: now}))
+	require.False(cursor.precedes(watchItem{name: "b", creationTimestamp: now}))
+	require.True(cursor.precedes(watchItem{name: "c", creationTimestamp: now}))
+	require.True(cursor.precedes(watchItem{name: "a", creationTimestamp: now.Add(time.Second)}))
+}
+
+func TestJoinFieldSelectors(t *testing.T) {
+	require := require.New(t)
+
+	require.Nil(joinFieldSelectors(nil, ""))
+	require.Equal("metadata.name=dev1", lo.FromPtr(joinFieldSelectors(nil, "metadata.name=dev1")))
+	require.Equal("metadata.owner=Fleet/f1", lo.FromPtr(joinFieldSelectors(lo.ToPtr("metadata.owner=Fleet/f1"), "")))
+	require.Equal("metadata.owner=Fleet/f1,metadata.name=dev1", lo.FromPtr(joinFieldSelectors(lo.ToPtr("metadata.owner=Fleet/f1"), "metadata.name=dev1")))
+}
+
+// fakeWatcher is a resultWatcher whose notifications are sent by the test.
+type fakeWatcher struct {
+	ch      chan watch.Notification
+	stopped chan struct{}
+}
+
+func newFakeWatcher() *fakeWatcher {
+	return &fakeWatcher{ch: make(chan watch.Notification, 10), stopped: make(chan struct{})}
+}
+
+func (w *fakeWatcher) ResultChan() <-chan watch.Notification {
+	return w.ch
+}
+
+func (w *fakeWatcher) Stop() {
+	close(w.stopped)
+}
+
+// fakeWatchSource serves the devices in objects, as far as they match selected.
+type fakeWatchSource struct {
+	mu       sync.Mutex
+	objects  map[string]*domain.Device
+	selected func(device *domain.Device) bool
+}
+
+func newFakeDevice(name string, resourceVersion string) *domain.Device {
+	return &domain.Device{
+		ApiVersion: "flightctl.io/v1beta1",
+		Kind:       domain.DeviceKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name), ResourceVersion: lo.ToPtr(resourceVersion)},
+	}
+}
+
+func (f *fakeWatchSource) source() watchSource {
+	source := watchSource{
+		kind:       domain.DeviceKind,
+		apiVersion: "flightctl.io/v1beta1",
+		list: func(_ context.Context, fieldSelector string, _ *string) ([]watchItem, *string, domain.Status) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			var items []watchItem
+			for name, device := range f.objects {
+				if fieldSelector != "" && fieldSelector != "metadata.name="+name {
+					continue
+				}
+				if f.selected != nil && !f.selected(device) {
+					continue
+				}
+				items = append(items, newWatchItem(device.Metadata, device))
+			}
+			return items, nil, domain.StatusOK()
+		},
+	}
+	source.replay = func(ctx context.Context) ([]watchItem, domain.Status) {
+		return source.listAll(ctx, "")
+	}
+	return source
+}
+
+func (f *fakeWatchSource) set(name string, device *domain.Device) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if device == nil {
+		delete(f.objects, name)
+		return
+	}
+	f.objects[name] = device
+}
+
+type receivedWatchEvent struct {
+	eventType       domain.WatchEventType
+	name            string
+	resourceVersion string
+	message         string
+}
+
+func receiveWatchEvent(t *testing.T, ch <-chan domain.WatchEvent) receivedWatchEvent {
+	t.Helper()
+	select {
+	case event, ok := <-ch:
+		require.True(t, ok, "watch closed unexpectedly")
+		var object struct {
+			Metadata domain.ObjectMeta `json:"metadata"`
+			Message  string            `json:"message"`
+		}
+		require.NoError(t, json.Unmarshal(event.Object, &object))
+		return receivedWatchEvent{
+			eventType:       event.Type,
+			name:            lo.FromPtr(object.Metadata.Name),
+			resourceVersion: lo.FromPtr(object.Metadata.ResourceVersion),
+			message:         object.Message,
+		}
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for a watch event")
+		return receivedWatchEvent{}
+	}
+}
+
+func TestStartWatch(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h := &ServiceHandler{log: log.InitLogs()}
+
+	fake := &fakeWatchSource{
+		objects: map[string]*domain.Device{"dev1": newFakeDevice("dev1", "3")},
+		selected: func(device *domain.Device) bool {
+			return lo.FromPtr(device.Metadata.Labels)["site"] != "elsewhere"
+		},
+	}
+	watcher := newFakeWatcher()
+	ch, status := h.startWatch(ctx, watcher, fake.source())
+	require.Equal(int32(http.StatusOK), status.Code)
+
+	// The current state is replayed first.
+	require.Equal(receivedWatchEvent{eventType: domain.WatchEventTypeAdded, name: "dev1", resourceVersion: "3"}, receiveWatchEvent(t, ch))
+
+	// A notification for a version that was already sent is dropped.
+	watcher.ch <- watch.Notification{Name: "dev1", Type: domain.WatchEventTypeModified}
+	fake.set("dev2", newFakeDevice("dev2", "1"))
+	watcher.ch <- watch.Notification{Name: "dev2", Type: domain.WatchEventTypeAdded}
+	require.Equal(receivedWatchEvent{eventType: domain.WatchEventTypeAdded, name: "dev2", resourceVersion: "1"}, receiveWatchEvent(t, ch))
+
+	fake.set("dev1", newFakeDevice("dev1", "4"))
+	watcher.ch <- watch.Notification{Name: "dev1", Type: domain.WatchEventTypeModified}
+	require.Equal(receivedWatchEvent{eventType: domain.WatchEventTypeModified, name: "dev1", resourceVersion: "4"}, receiveWatchEvent(t, ch))
+
+	// A resource that leaves the selectors is reported as deleted, and as
+	// added when it matches again.
+	moved := newFakeDevice("dev1", "5")
+	moved.Metadata.Labels = &map[string]string{"site": "elsewhere"}
+	fake.set("dev1", moved)
+	watcher.ch <- watch.Notification{Name: "dev1", Type: domain.WatchEventTypeModified}
+	require.Equal(receivedWatchEvent{eventType: domain.WatchEventTypeDeleted, name: "dev1"}, receiveWatchEvent(t, ch))
+	fake.set("dev1", newFakeDevice("dev1", "6"))
+	watcher.ch <- watch.Notification{Name: "dev1", Type: domain.WatchEventTypeModified}
+	require.Equal(receivedWatchEvent{eventType: domain.WatchEventTypeAdded, name: "dev1", resourceVersion: "6"}, receiveWatchEvent(t, ch))
+
+	// Deletions are only reported for resources the client has seen.
+	watcher.ch <- watch.Notification{Name: "unknown", Type: domain.WatchEventTypeDeleted}
+	fake.set("dev2", nil)
+	watcher.ch <- watch.Notification{Name: "dev2", Type: domain.WatchEventTypeDeleted}
+	require.Equal(receivedWatchEvent{eventType: domain.WatchEventTypeDeleted, name: "dev2"}, receiveWatchEvent(t, ch))
+
+	// A watcher that fell behind ends the watch with an error.
+	close(watcher.ch)
+	event := receiveWatchEvent(t, ch)
+	require.Equal(domain.WatchEventTypeError, event.eventType)
+	require.Contains(event.message, "fell behind")
+	_, ok := <-ch
+	require.False(ok)
+	<-watcher.stopped
+}
+
+func TestStartWatchEventsResume(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h := &ServiceHandler{log: log.InitLogs()}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	newEvent := func(name string, createdAt time.Time) *domain.Event {
+		event := &domain.Event{Metadata: domain.ObjectMeta{Name: lo.ToPtr(name), CreationTimestamp: lo.ToPtr(createdAt)}}
+		event.Metadata.ResourceVersion = lo.ToPtr(newEventCursor(event.Metadata).String())
+		return event
+	}
+	events := []*domain.Event{
+		newEvent("a", now),
+		newEvent("b", now),
+		newEvent("c", now),
+		newEvent("d", now.Add(time.Second)),
+	}
+	cursor := newEventCursor(events[1].Metadata)
+	var mu sync.Mutex
+	source := watchSource{
+		kind:       domain.EventKind,
+		apiVersion: "flightctl.io/v1beta1",
+		list: func(_ context.Context, fieldSelector string, _ *string) ([]watchItem, *string, domain.Status) {
+			mu.Lock()
+			defer mu.Unlock()
+			var items []watchItem
+			for _, event := range events {
+				if strings.HasPrefix(fieldSelector, "metadata.name=") && fieldSelector != "metadata.name="+*event.Metadata.Name {
+					continue
+				}
+				items = append(items, newWatchItem(event.Metadata, event))
+			}
+			return items, nil, domain.StatusOK()
+		},
+	}
+	source.replay = func(ctx context.Context) ([]watchItem, domain.Status) {
+		items, status := source.listAll(ctx, "")
+		return lo.Filter(items, func(item watchItem, _ int) bool { return cursor.precedes(item) }), status
+	}
+
+	watcher := newFakeWatcher()
+	// "d" was created between subscribing and listing, so it is also notified.
+	watcher.ch <- watch.Notification{Name: "d", Type: domain.WatchEventTypeAdded}
+	ch, status := h.startWatch(ctx, watcher, source)
+	require.Equal(int32(http.StatusOK), status.Code)
+
+	require.Equal("c", receiveWatchEvent(t, ch).name)
+	last := receiveWatchEvent(t, ch)
+	require.Equal("d", last.name)
+	require.Equal(lo.FromPtr(events[3].Metadata.ResourceVersion), last.resourceVersion)
+
+	e := newEvent("e", now.Add(2*time.Second))
+	mu.Lock()
+	events = append(events, e)
+	mu.Unlock()
+	watcher.ch <- watch.Notification{Name: "e", Type: domain.WatchEventTypeAdded}
+	require.Equal(receivedWatchEvent{eventType: domain.WatchEventTypeAdded, name: "e", resourceVersion: lo.FromPtr(e.Metadata.ResourceVersion)}, receiveWatchEvent(t, ch))
+
+	cancel()
+	<-watcher.stopped
+}
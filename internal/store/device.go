@@ -646,6 +646,7 @@ func (s *DeviceStore) Count(ctx context.Context, orgId uuid.UUID, listParams Lis
 // - updating_reason: it is the reason field from a condition having type 'Updating'
 // - same_rendered_version: it is the result of comparison for equality between the annotation 'device-controller/renderedVersion' and the field 'status.config.renderedVersion'
 // - update_timed_out: it is a boolean value indicating if the update of the device has been timed out
+// - applications_status: taken from the field 'status.applicationsSummary.status'
 func (s *DeviceStore) CompletionCounts(ctx context.Context, orgId uuid.UUID, owner string, templateVersion string, updateTimeout *time.Duration) ([]domain.DeviceCompletionCount, error) {
 	var (
 		results            []domain.DeviceCompletionCount
@@ -661,7 +662,8 @@ func (s *DeviceStore) CompletionCounts(ctx context.Context, orgId uuid.UUID, own
                                  status -> 'config' ->> 'renderedVersion' = annotations->>'%s' AS same_rendered_version,
                                  elem ->> 'reason' as updating_reason,
                                  annotations->>'%s' = ? as same_template_version,
-								 ? as update_timed_out
+								 ? as update_timed_out,
+                                 status -> 'applicationsSummary' ->> 'status' as applications_status
                           from devices d LEFT JOIN LATERAL (
                             SELECT elem
 						    FROM jsonb_array_elements(d.status->'conditions') AS elem
@@ -670,7 +672,7 @@ func (s *DeviceStore) CompletionCounts(ctx context.Context, orgId uuid.UUID, own
 							) subquery ON TRUE
 						     where
 						        org_id = ? and owner = ? and annotations ? '%s' and deleted_at is null
-						        group by same_rendered_version, updating_reason, same_template_version, update_timed_out`,
+						        group by same_rendered_version, updating_reason, same_template_version, update_timed_out, applications_status`,
 		domain.DeviceAnnotationRenderedVersion, domain.DeviceAnnotationRenderedTemplateVersion, domain.DeviceAnnotationSelectedForRollout),
 		templateVersion,
 		updateTimeoutValue,
@@ -0,0 +1,126 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DeviceRegistration interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, registration *domain.DeviceRegistration, eventCallback EventCallback) (*domain.DeviceRegistration, error)
+	Update(ctx context.Context, orgId uuid.UUID, registration *domain.DeviceRegistration, eventCallback EventCallback) (*domain.DeviceRegistration, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, registration *domain.DeviceRegistration, eventCallback EventCallback) (*domain.DeviceRegistration, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceRegistration, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.DeviceRegistrationList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+	UpdateStatus(ctx context.Context, orgId uuid.UUID, registration *domain.DeviceRegistration, eventCallback EventCallback) (*domain.DeviceRegistration, error)
+	ClaimEnrollment(ctx context.Context, orgId uuid.UUID, name string, enrollmentRequest string, enrolledAt time.Time) (*domain.DeviceRegistration, error)
+}
+
+type DeviceRegistrationStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.DeviceRegistration, model.DeviceRegistration, domain.DeviceRegistration, domain.DeviceRegistrationList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to DeviceRegistration interface
+var _ DeviceRegistration = (*DeviceRegistrationStore)(nil)
+
+func NewDeviceRegistration(db *gorm.DB, log logrus.FieldLogger) DeviceRegistration {
+	genericStore := NewGenericStore[*model.DeviceRegistration, model.DeviceRegistration, domain.DeviceRegistration, domain.DeviceRegistrationList](
+		db,
+		log,
+		model.NewDeviceRegistrationFromApiResource,
+		(*model.DeviceRegistration).ToApiResource,
+		model.DeviceRegistrationsToApiResource,
+	)
+
+	return &DeviceRegistrationStore{
+		dbHandler:           db,
+		log:                 log,
+		genericStore:        genericStore,
+		eventCallbackCaller: CallEventCallback(domain.DeviceRegistrationKind, log),
+	}
+}
+
+func (s *DeviceRegistrationStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *DeviceRegistrationStore) InitialMigration(ctx context.Context) error {
+	return s.getDB(ctx).AutoMigrate(&model.DeviceRegistration{})
+}
+
+func (s *DeviceRegistrationStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.DeviceRegistration, eventCallback EventCallback) (*domain.DeviceRegistration, error) {
+	registration, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, registration, true, err)
+	return registration, err
+}
+
+func (s *DeviceRegistrationStore) Update(ctx context.Context, orgId uuid.UUID, resource *domain.DeviceRegistration, eventCallback EventCallback) (*domain.DeviceRegistration, error) {
+	newRegistration, oldRegistration, err := s.genericStore.Update(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldRegistration, newRegistration, false, err)
+	return newRegistration, err
+}
+
+func (s *DeviceRegistrationStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *domain.DeviceRegistration, eventCallback EventCallback) (*domain.DeviceRegistration, bool, error) {
+	newRegistration, oldRegistration, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldRegistration, newRegistration, created, err)
+	return newRegistration, created, err
+}
+
+func (s *DeviceRegistrationStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceRegistration, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *DeviceRegistrationStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.DeviceRegistrationList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *DeviceRegistrationStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.DeviceRegistration{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, nil)
+	}
+	return err
+}
+
+func (s *DeviceRegistrationStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *domain.DeviceRegistration, eventCallback EventCallback) (*domain.DeviceRegistration, error) {
+	oldRegistration, _ := s.Get(ctx, orgId, lo.FromPtr(resource.Metadata.Name))
+	newRegistration, err := s.genericStore.UpdateStatus(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldRegistration, newRegistration, false, err)
+	return newRegistration, err
+}
+
+// ClaimEnrollment records that the registration enrolled the given enrollment request, unless it already enrolled a
+// different one. The check and the update are a single statement, so concurrent requests cannot both claim the
+// registration; the request that loses gets flterrors.ErrNoRowsUpdated.
+func (s *DeviceRegistrationStore) ClaimEnrollment(ctx context.Context, orgId uuid.UUID, name string, enrollmentRequest string, enrolledAt time.Time) (*domain.DeviceRegistration, error) {
+	status := domain.DeviceRegistrationStatus{EnrollmentRequest: &enrollmentRequest, EnrolledAt: &enrolledAt}
+	var registration model.DeviceRegistration
+	result := s.getDB(ctx).Model(&registration).
+		Where("org_id = ? AND name = ? AND (status->>'enrollmentRequest' IS NULL OR status->>'enrollmentRequest' = ?)", orgId, name, enrollmentRequest).
+		Clauses(clause.Returning{}).
+		Updates(map[string]interface{}{
+			"status":           model.MakeJSONField(status),
+			"resource_version": gorm.Expr("resource_version + 1"),
+		})
+	if err := ErrorFromGormError(result.Error); err != nil {
+		return nil, err
+	}
+	if result.RowsAffected == 0 {
+		return nil, flterrors.ErrNoRowsUpdated
+	}
+	return registration.ToApiResource()
+}
@@ -0,0 +1,147 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type DeviceRegistration struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[domain.DeviceRegistrationSpec] `gorm:"type:jsonb"`
+
+	// The last reported state, stored as opaque JSON object.
+	Status *JSONField[domain.DeviceRegistrationStatus] `gorm:"type:jsonb"`
+}
+
+func (p DeviceRegistration) String() string {
+	val, _ := json.Marshal(p)
+	return string(val)
+}
+
+func NewDeviceRegistrationFromApiResource(resource *domain.DeviceRegistration) (*DeviceRegistration, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &DeviceRegistration{}, nil
+	}
+
+	status := domain.DeviceRegistrationStatus{}
+	if resource.Status != nil {
+		status = *resource.Status
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &DeviceRegistration{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Owner:           resource.Metadata.Owner,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			Generation:      resource.Metadata.Generation,
+			ResourceVersion: resourceVersion,
+		},
+		Spec:   MakeJSONField(resource.Spec),
+		Status: MakeJSONField(status),
+	}, nil
+}
+
+func DeviceRegistrationAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.DeviceRegistrationAPIVersion)
+}
+
+func (p *DeviceRegistration) ToApiResource(opts ...APIResourceOption) (*domain.DeviceRegistration, error) {
+	if p == nil {
+		return &domain.DeviceRegistration{}, nil
+	}
+
+	var spec domain.DeviceRegistrationSpec
+	if p.Spec != nil {
+		spec = p.Spec.Data
+	}
+	status := domain.DeviceRegistrationStatus{}
+	if p.Status != nil {
+		status = p.Status.Data
+	}
+
+	return &domain.DeviceRegistration{
+		ApiVersion: DeviceRegistrationAPIVersion(),
+		Kind:       domain.DeviceRegistrationKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(p.Name),
+			CreationTimestamp: lo.ToPtr(p.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(p.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(p.Resource.Annotations)),
+			Generation:        p.Generation,
+			ResourceVersion:   lo.Ternary(p.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(p.ResourceVersion), 10)), nil),
+		},
+		Spec:   spec,
+		Status: &status,
+	}, nil
+}
+
+func (p *DeviceRegistration) GetKind() string {
+	return domain.DeviceRegistrationKind
+}
+
+func (p *DeviceRegistration) GetStatusAsJson() ([]byte, error) {
+	return p.Status.MarshalJSON()
+}
+
+func (p *DeviceRegistration) HasNilSpec() bool {
+	return p.Spec == nil
+}
+
+func (p *DeviceRegistration) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*DeviceRegistration)
+	if !ok {
+		return false
+	}
+	if other == nil {
+		return false
+	}
+	if p.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (p.Spec == nil && other.Spec != nil) || (p.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(p.Spec.Data, other.Spec.Data)
+}
+
+func DeviceRegistrationsToApiResource(registrations []DeviceRegistration, continueToken *string, numRemaining *int64) (domain.DeviceRegistrationList, error) {
+	items := make([]domain.DeviceRegistration, 0, len(registrations))
+	for i := range registrations {
+		resource, err := registrations[i].ToApiResource()
+		if err != nil {
+			return domain.DeviceRegistrationList{}, err
+		}
+		items = append(items, *resource)
+	}
+
+	registrationList := domain.DeviceRegistrationList{
+		ApiVersion: DeviceRegistrationAPIVersion(),
+		Kind:       domain.DeviceRegistrationListKind,
+		Metadata: domain.ListMeta{
+			Continue: continueToken,
+		},
+		Items: items,
+	}
+	if numRemaining != nil && *numRemaining > 0 {
+		registrationList.Metadata.RemainingItemCount = numRemaining
+	}
+	return registrationList, nil
+}
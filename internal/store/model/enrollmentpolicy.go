@@ -0,0 +1,133 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type EnrollmentPolicy struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[domain.EnrollmentPolicySpec] `gorm:"type:jsonb"`
+}
+
+func (p EnrollmentPolicy) String() string {
+	val, _ := json.Marshal(p)
+	return string(val)
+}
+
+func NewEnrollmentPolicyFromApiResource(resource *domain.EnrollmentPolicy) (*EnrollmentPolicy, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &EnrollmentPolicy{}, nil
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &EnrollmentPolicy{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Owner:           resource.Metadata.Owner,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			Generation:      resource.Metadata.Generation,
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(resource.Spec),
+	}, nil
+}
+
+func EnrollmentPolicyAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.EnrollmentPolicyAPIVersion)
+}
+
+func (p *EnrollmentPolicy) ToApiResource(opts ...APIResourceOption) (*domain.EnrollmentPolicy, error) {
+	if p == nil {
+		return &domain.EnrollmentPolicy{}, nil
+	}
+
+	var spec domain.EnrollmentPolicySpec
+	if p.Spec != nil {
+		spec = p.Spec.Data
+	}
+
+	return &domain.EnrollmentPolicy{
+		ApiVersion: EnrollmentPolicyAPIVersion(),
+		Kind:       domain.EnrollmentPolicyKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(p.Name),
+			CreationTimestamp: lo.ToPtr(p.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(p.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(p.Resource.Annotations)),
+			Generation:        p.Generation,
+			ResourceVersion:   lo.Ternary(p.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(p.ResourceVersion), 10)), nil),
+		},
+		Spec: spec,
+	}, nil
+}
+
+func (p *EnrollmentPolicy) GetKind() string {
+	return domain.EnrollmentPolicyKind
+}
+
+func (p *EnrollmentPolicy) GetStatusAsJson() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+func (p *EnrollmentPolicy) HasNilSpec() bool {
+	return p.Spec == nil
+}
+
+func (p *EnrollmentPolicy) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*EnrollmentPolicy)
+	if !ok {
+		return false
+	}
+	if other == nil {
+		return false
+	}
+	if p.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (p.Spec == nil && other.Spec != nil) || (p.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(p.Spec.Data, other.Spec.Data)
+}
+
+func EnrollmentPoliciesToApiResource(policies []EnrollmentPolicy, continueToken *string, numRemaining *int64) (domain.EnrollmentPolicyList, error) {
+	items := make([]domain.EnrollmentPolicy, 0, len(policies))
+	for i := range policies {
+		resource, err := policies[i].ToApiResource()
+		if err != nil {
+			return domain.EnrollmentPolicyList{}, err
+		}
+		items = append(items, *resource)
+	}
+
+	policyList := domain.EnrollmentPolicyList{
+		ApiVersion: EnrollmentPolicyAPIVersion(),
+		Kind:       domain.EnrollmentPolicyListKind,
+		Metadata: domain.ListMeta{
+			Continue: continueToken,
+		},
+		Items: items,
+	}
+	if numRemaining != nil && *numRemaining > 0 {
+		policyList.Metadata.RemainingItemCount = numRemaining
+	}
+	return policyList, nil
+}
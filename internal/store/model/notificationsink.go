@@ -0,0 +1,133 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type NotificationSink struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[domain.NotificationSinkSpec] `gorm:"type:jsonb"`
+}
+
+func (s NotificationSink) String() string {
+	val, _ := json.Marshal(s)
+	return string(val)
+}
+
+func NewNotificationSinkFromApiResource(resource *domain.NotificationSink) (*NotificationSink, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &NotificationSink{}, nil
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &NotificationSink{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Owner:           resource.Metadata.Owner,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			Generation:      resource.Metadata.Generation,
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(resource.Spec),
+	}, nil
+}
+
+func NotificationSinkAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.NotificationSinkAPIVersion)
+}
+
+func (s *NotificationSink) ToApiResource(opts ...APIResourceOption) (*domain.NotificationSink, error) {
+	if s == nil {
+		return &domain.NotificationSink{}, nil
+	}
+
+	var spec domain.NotificationSinkSpec
+	if s.Spec != nil {
+		spec = s.Spec.Data
+	}
+
+	return &domain.NotificationSink{
+		ApiVersion: NotificationSinkAPIVersion(),
+		Kind:       domain.NotificationSinkKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(s.Name),
+			CreationTimestamp: lo.ToPtr(s.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(s.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(s.Resource.Annotations)),
+			Generation:        s.Generation,
+			ResourceVersion:   lo.Ternary(s.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(s.ResourceVersion), 10)), nil),
+		},
+		Spec: spec,
+	}, nil
+}
+
+func (s *NotificationSink) GetKind() string {
+	return domain.NotificationSinkKind
+}
+
+func (s *NotificationSink) GetStatusAsJson() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+func (s *NotificationSink) HasNilSpec() bool {
+	return s.Spec == nil
+}
+
+func (s *NotificationSink) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*NotificationSink)
+	if !ok {
+		return false
+	}
+	if other == nil {
+		return false
+	}
+	if s.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (s.Spec == nil && other.Spec != nil) || (s.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(s.Spec.Data, other.Spec.Data)
+}
+
+func NotificationSinksToApiResource(sinks []NotificationSink, continueToken *string, numRemaining *int64) (domain.NotificationSinkList, error) {
+	items := make([]domain.NotificationSink, 0, len(sinks))
+	for i := range sinks {
+		resource, err := sinks[i].ToApiResource()
+		if err != nil {
+			return domain.NotificationSinkList{}, err
+		}
+		items = append(items, *resource)
+	}
+
+	sinkList := domain.NotificationSinkList{
+		ApiVersion: NotificationSinkAPIVersion(),
+		Kind:       domain.NotificationSinkListKind,
+		Metadata: domain.ListMeta{
+			Continue: continueToken,
+		},
+		Items: items,
+	}
+	if numRemaining != nil && *numRemaining > 0 {
+		sinkList.Metadata.RemainingItemCount = numRemaining
+	}
+	return sinkList, nil
+}
@@ -0,0 +1,188 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+// SecretCipher encrypts and decrypts the values of secrets at rest.
+type SecretCipher interface {
+	Encrypt(plaintext, additionalData []byte) (string, error)
+	Decrypt(encrypted string, additionalData []byte) ([]byte, error)
+	Digest(data []byte) string
+}
+
+// EncryptedSecretSpec is the stored form of a SecretSpec. Each value is encrypted on its own, and the
+// digest of all values tells whether two specs hold the same data without decrypting them.
+type EncryptedSecretSpec struct {
+	Data   map[string]string `json:"data"`
+	Digest string            `json:"digest"`
+}
+
+type Secret struct {
+	Resource
+
+	// The desired state with encrypted values, stored as opaque JSON object.
+	Spec *JSONField[EncryptedSecretSpec] `gorm:"type:jsonb"`
+}
+
+func (s Secret) String() string {
+	val, _ := json.Marshal(s)
+	return string(val)
+}
+
+// secretAdditionalData binds the ciphertext of a value to the secret and key it belongs to.
+func secretAdditionalData(name, key string) []byte {
+	return []byte(name + "/" + key)
+}
+
+func NewSecretFromApiResource(resource *domain.Secret, cipher SecretCipher) (*Secret, error) {
+	if resource == nil {
+		return nil, fmt.Errorf("resource is nil")
+	}
+	if resource.Metadata.Name == nil {
+		return nil, fmt.Errorf("resource.Metadata.Name is nil")
+	}
+	if cipher == nil {
+		return nil, flterrors.ErrSecretEncryptionNotConfigured
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+
+	name := lo.FromPtr(resource.Metadata.Name)
+	data := util.EnsureMap(resource.Spec.Data)
+	plaintext, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal secret data: %w", err)
+	}
+	spec := EncryptedSecretSpec{
+		Data:   make(map[string]string, len(data)),
+		Digest: cipher.Digest(plaintext),
+	}
+	for key, value := range data {
+		encrypted, err := cipher.Encrypt([]byte(value), secretAdditionalData(name, key))
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt key %q: %w", key, err)
+		}
+		spec.Data[key] = encrypted
+	}
+
+	return &Secret{
+		Resource: Resource{
+			Name:            name,
+			Owner:           resource.Metadata.Owner,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			Generation:      resource.Metadata.Generation,
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(spec),
+	}, nil
+}
+
+func SecretAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.SecretAPIVersion)
+}
+
+func (s *Secret) ToApiResource(cipher SecretCipher, opts ...APIResourceOption) (*domain.Secret, error) {
+	if s == nil {
+		return &domain.Secret{}, nil
+	}
+	if cipher == nil {
+		return nil, flterrors.ErrSecretEncryptionNotConfigured
+	}
+
+	data := map[string]string{}
+	if s.Spec != nil {
+		for key, encrypted := range s.Spec.Data.Data {
+			value, err := cipher.Decrypt(encrypted, secretAdditionalData(s.Name, key))
+			if err != nil {
+				return nil, fmt.Errorf("%w %s: key %q: %w", flterrors.ErrSecretDecryption, s.Name, key, err)
+			}
+			data[key] = string(value)
+		}
+	}
+
+	return &domain.Secret{
+		ApiVersion: SecretAPIVersion(),
+		Kind:       domain.SecretKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(s.Name),
+			CreationTimestamp: lo.ToPtr(s.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(s.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(s.Resource.Annotations)),
+			Generation:        s.Generation,
+			ResourceVersion:   lo.Ternary(s.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(s.ResourceVersion), 10)), nil),
+		},
+		Spec: domain.SecretSpec{
+			Data: data,
+		},
+	}, nil
+}
+
+func (s *Secret) GetKind() string {
+	return domain.SecretKind
+}
+
+func (s *Secret) GetStatusAsJson() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+func (s *Secret) HasNilSpec() bool {
+	return s.Spec == nil
+}
+
+// HasSameSpecAs compares the digests of the specs, as encrypting the same value twice yields different ciphertexts.
+func (s *Secret) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*Secret)
+	if !ok {
+		return false
+	}
+	if s == nil || other == nil {
+		return false
+	}
+	if s.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if s.Spec == nil || other.Spec == nil {
+		return false
+	}
+	return s.Spec.Data.Digest == other.Spec.Data.Digest
+}
+
+func SecretsToApiResource(secrets []Secret, continueToken *string, numRemaining *int64, cipher SecretCipher) (domain.SecretList, error) {
+	items := make([]domain.Secret, 0, len(secrets))
+	for i := range secrets {
+		resource, err := secrets[i].ToApiResource(cipher)
+		if err != nil {
+			return domain.SecretList{}, err
+		}
+		items = append(items, *resource)
+	}
+
+	secretList := domain.SecretList{
+		ApiVersion: SecretAPIVersion(),
+		Kind:       domain.SecretListKind,
+		Metadata: domain.ListMeta{
+			Continue: continueToken,
+		},
+		Items: items,
+	}
+	if numRemaining != nil && *numRemaining > 0 {
+		secretList.Metadata.RemainingItemCount = numRemaining
+	}
+	return secretList, nil
+}
@@ -0,0 +1,92 @@
+package store
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type NotificationSink interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, sink *domain.NotificationSink, eventCallback EventCallback) (*domain.NotificationSink, error)
+	Update(ctx context.Context, orgId uuid.UUID, sink *domain.NotificationSink, eventCallback EventCallback) (*domain.NotificationSink, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, sink *domain.NotificationSink, eventCallback EventCallback) (*domain.NotificationSink, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.NotificationSink, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.NotificationSinkList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+}
+
+type NotificationSinkStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.NotificationSink, model.NotificationSink, domain.NotificationSink, domain.NotificationSinkList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to NotificationSink interface
+var _ NotificationSink = (*NotificationSinkStore)(nil)
+
+func NewNotificationSink(db *gorm.DB, log logrus.FieldLogger) NotificationSink {
+	genericStore := NewGenericStore[*model.NotificationSink, model.NotificationSink, domain.NotificationSink, domain.NotificationSinkList](
+		db,
+		log,
+		model.NewNotificationSinkFromApiResource,
+		(*model.NotificationSink).ToApiResource,
+		model.NotificationSinksToApiResource,
+	)
+
+	return &NotificationSinkStore{
+		dbHandler:           db,
+		log:                 log,
+		genericStore:        genericStore,
+		eventCallbackCaller: CallEventCallback(domain.NotificationSinkKind, log),
+	}
+}
+
+func (s *NotificationSinkStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *NotificationSinkStore) InitialMigration(ctx context.Context) error {
+	return s.getDB(ctx).AutoMigrate(&model.NotificationSink{})
+}
+
+func (s *NotificationSinkStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.NotificationSink, eventCallback EventCallback) (*domain.NotificationSink, error) {
+	sink, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, sink, true, err)
+	return sink, err
+}
+
+func (s *NotificationSinkStore) Update(ctx context.Context, orgId uuid.UUID, resource *domain.NotificationSink, eventCallback EventCallback) (*domain.NotificationSink, error) {
+	newSink, oldSink, err := s.genericStore.Update(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldSink, newSink, false, err)
+	return newSink, err
+}
+
+func (s *NotificationSinkStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *domain.NotificationSink, eventCallback EventCallback) (*domain.NotificationSink, bool, error) {
+	newSink, oldSink, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldSink, newSink, created, err)
+	return newSink, created, err
+}
+
+func (s *NotificationSinkStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.NotificationSink, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *NotificationSinkStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.NotificationSinkList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *NotificationSinkStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.NotificationSink{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, nil)
+	}
+	return err
+}
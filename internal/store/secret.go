@@ -0,0 +1,116 @@
+package store
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Secret interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, secret *domain.Secret, eventCallback EventCallback) (*domain.Secret, error)
+	Update(ctx context.Context, orgId uuid.UUID, secret *domain.Secret, eventCallback EventCallback) (*domain.Secret, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, secret *domain.Secret, eventCallback EventCallback) (*domain.Secret, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.SecretList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+}
+
+// SecretStore stores the values of secrets encrypted with the configured cipher. Without a cipher
+// secrets can neither be written nor read.
+type SecretStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.Secret, model.Secret, domain.Secret, domain.SecretList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to Secret interface
+var _ Secret = (*SecretStore)(nil)
+
+func NewSecret(db *gorm.DB, log logrus.FieldLogger, cipher model.SecretCipher) Secret {
+	genericStore := NewGenericStore[*model.Secret, model.Secret, domain.Secret, domain.SecretList](
+		db,
+		log,
+		func(resource *domain.Secret) (*model.Secret, error) {
+			return model.NewSecretFromApiResource(resource, cipher)
+		},
+		func(secret *model.Secret, opts ...model.APIResourceOption) (*domain.Secret, error) {
+			return secret.ToApiResource(cipher, opts...)
+		},
+		func(secrets []model.Secret, continueToken *string, numRemaining *int64) (domain.SecretList, error) {
+			return model.SecretsToApiResource(secrets, continueToken, numRemaining, cipher)
+		},
+	)
+
+	return &SecretStore{
+		dbHandler:           db,
+		log:                 log,
+		genericStore:        genericStore,
+		eventCallbackCaller: CallEventCallback(domain.SecretKind, log),
+	}
+}
+
+func (s *SecretStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *SecretStore) InitialMigration(ctx context.Context) error {
+	return s.getDB(ctx).AutoMigrate(&model.Secret{})
+}
+
+func (s *SecretStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.Secret, eventCallback EventCallback) (*domain.Secret, error) {
+	secret, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, secret, true, err)
+	return secret, err
+}
+
+func (s *SecretStore) Update(ctx context.Context, orgId uuid.UUID, resource *domain.Secret, eventCallback EventCallback) (*domain.Secret, error) {
+	newSecret, oldSecret, err := s.genericStore.Update(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldSecret, newSecret, false, err)
+	return newSecret, err
+}
+
+func (s *SecretStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *domain.Secret, eventCallback EventCallback) (*domain.Secret, bool, error) {
+	newSecret, oldSecret, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldSecret, newSecret, created, err)
+	return newSecret, created, err
+}
+
+func (s *SecretStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *SecretStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.SecretList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *SecretStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.Secret{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, nil)
+	}
+	return err
+}
+
+// SecretOptionsFromConfig returns the store options that configure the secret cipher from the secret
+// encryption key of the service configuration. Without a configured key no options are returned.
+func SecretOptionsFromConfig(cfg *config.Config) ([]StoreOption, error) {
+	key := cfg.GetSecretsEncryptionKey()
+	if key == "" {
+		return nil, nil
+	}
+	cipher, err := crypto.NewSecretCipher([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	return []StoreOption{WithSecretCipher(cipher)}, nil
+}
@@ -39,6 +39,10 @@ type Store interface {
 	VulnerabilityFinding() VulnerabilityFinding
 	SyncState() SyncState
 	DependencyRef() DependencyRef
+	Secret() Secret
+	EnrollmentPolicy() EnrollmentPolicy
+	DeviceRegistration() DeviceRegistration
+	NotificationSink() NotificationSink
 	RunMigrations(context.Context) error
 	CheckHealth(context.Context) error
 	Close() error
@@ -60,11 +64,32 @@ type DataStore struct {
 	vulnerabilityFinding      VulnerabilityFinding
 	syncState                 SyncState
 	dependencyRef             DependencyRef
+	secret                    Secret
+	enrollmentPolicy          EnrollmentPolicy
+	deviceRegistration        DeviceRegistration
+	notificationSink          NotificationSink
 
 	db *gorm.DB
 }
 
-func NewStore(db *gorm.DB, log logrus.FieldLogger) Store {
+type storeOptions struct {
+	secretCipher model.SecretCipher
+}
+
+type StoreOption func(*storeOptions)
+
+// WithSecretCipher sets the cipher the values of Secret resources are encrypted with.
+func WithSecretCipher(cipher model.SecretCipher) StoreOption {
+	return func(o *storeOptions) {
+		o.secretCipher = cipher
+	}
+}
+
+func NewStore(db *gorm.DB, log logrus.FieldLogger, opts ...StoreOption) Store {
+	options := storeOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
 	return &DataStore{
 		device:                    NewDevice(db, log),
 		enrollmentRequest:         NewEnrollmentRequest(db, log),
@@ -81,6 +106,10 @@ func NewStore(db *gorm.DB, log logrus.FieldLogger) Store {
 		vulnerabilityFinding:      NewVulnerabilityFinding(db, log),
 		syncState:                 NewSyncState(db, log),
 		dependencyRef:             NewDependencyRef(db, log),
+		secret:                    NewSecret(db, log, options.secretCipher),
+		enrollmentPolicy:          NewEnrollmentPolicy(db, log),
+		deviceRegistration:        NewDeviceRegistration(db, log),
+		notificationSink:          NewNotificationSink(db, log),
 		db:                        db,
 	}
 }
@@ -145,6 +174,22 @@ func (s *DataStore) DependencyRef() DependencyRef {
 	return s.dependencyRef
 }
 
+func (s *DataStore) Secret() Secret {
+	return s.secret
+}
+
+func (s *DataStore) EnrollmentPolicy() EnrollmentPolicy {
+	return s.enrollmentPolicy
+}
+
+func (s *DataStore) DeviceRegistration() DeviceRegistration {
+	return s.deviceRegistration
+}
+
+func (s *DataStore) NotificationSink() NotificationSink {
+	return s.notificationSink
+}
+
 // CheckHealth verifies database connectivity and ensures the instance is not in recovery.
 func (s *DataStore) CheckHealth(ctx context.Context) error {
 	if s.db == nil {
@@ -240,6 +285,18 @@ func (s *DataStore) RunMigrations(ctx context.Context) error {
 	if err := s.DependencyRef().InitialMigration(ctx); err != nil {
 		return err
 	}
+	if err := s.Secret().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.EnrollmentPolicy().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.DeviceRegistration().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.NotificationSink().InitialMigration(ctx); err != nil {
+		return err
+	}
 	return s.customizeMigration(ctx)
 }
 
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/flightctl/flightctl/internal/domain"
@@ -24,6 +25,7 @@ type TemplateVersion interface {
 
 	GetLatest(ctx context.Context, orgId uuid.UUID, fleet string) (*domain.TemplateVersion, error)
 	UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *domain.TemplateVersion, valid *bool) error
+	UpdateRolloutRecord(ctx context.Context, orgId uuid.UUID, fleet string, name string, record *domain.TemplateVersionRolloutRecord) error
 }
 
 type TemplateVersionStore struct {
@@ -159,3 +161,31 @@ func (s *TemplateVersionStore) UpdateStatus(ctx context.Context, orgId uuid.UUID
 
 	return nil
 }
+
+// UpdateRolloutRecord replaces the rollout record in the status of the template version, leaving the rest of the
+// status untouched
+func (s *TemplateVersionStore) UpdateRolloutRecord(ctx context.Context, orgId uuid.UUID, fleet string, name string, record *domain.TemplateVersionRolloutRecord) error {
+	if record == nil {
+		return flterrors.ErrResourceIsNil
+	}
+	marshalled, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal rollout record: %w", err)
+	}
+	templateVersion := model.TemplateVersion{
+		OrgID:     orgId,
+		FleetName: fleet,
+		Name:      name,
+	}
+	result := s.getDB(ctx).Model(&templateVersion).Updates(map[string]interface{}{
+		"status":           gorm.Expr(`jsonb_set(COALESCE(status, '{}'::jsonb), '{rollout}', ?::jsonb)`, string(marshalled)),
+		"resource_version": gorm.Expr("resource_version + 1"),
+	})
+	if result.Error != nil {
+		return ErrorFromGormError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return flterrors.ErrResourceNotFound
+	}
+	return nil
+}
@@ -76,6 +76,13 @@ func dispatchTasks(serviceHandler service.Service, k8sClient k8sclient.K8SClient
 			})
 			errorMessages = appendErrorMessage(errorMessages, taskName, err)
 		}
+		if shouldRollbackFleet(ctx, eventWithOrgId.Event, log) {
+			taskName = "fleetRollback"
+			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
+				return fleetRollback(ctx, eventWithOrgId.OrgId, eventWithOrgId.Event, serviceHandler, log)
+			})
+			errorMessages = appendErrorMessage(errorMessages, taskName, err)
+		}
 		if shouldReconcileDeviceOwnership(ctx, eventWithOrgId.Event, log) {
 			taskName = "fleetSelectorMatching"
 			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
@@ -111,6 +118,13 @@ func dispatchTasks(serviceHandler service.Service, k8sClient k8sclient.K8SClient
 			})
 			errorMessages = appendErrorMessage(errorMessages, taskName, err)
 		}
+		if shouldUpdateSecretReferers(ctx, eventWithOrgId.Event, log) {
+			taskName = "secretUpdate"
+			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
+				return secretUpdate(ctx, eventWithOrgId.OrgId, eventWithOrgId.Event, serviceHandler, log)
+			})
+			errorMessages = appendErrorMessage(errorMessages, taskName, err)
+		}
 
 		// Emit InternalTaskFailedEvent for any unhandled task failures
 		// This serves as a safety net while preserving specific error handling within tasks
@@ -198,6 +212,11 @@ func shouldRolloutFleet(ctx context.Context, event domain.Event, log logrus.Fiel
 	return false
 }
 
+func shouldRollbackFleet(ctx context.Context, event domain.Event, log logrus.FieldLogger) bool {
+	// If a fleet rollout was rolled back, return true
+	return event.Reason == domain.EventReasonFleetRolloutRolledBack && event.InvolvedObject.Kind == domain.FleetKind
+}
+
 func shouldReconcileDeviceOwnership(ctx context.Context, event domain.Event, log logrus.FieldLogger) bool {
 	// If a fleet's label selector was updated, return true
 	if event.Reason == domain.EventReasonResourceUpdated && event.InvolvedObject.Kind == domain.FleetKind {
@@ -128,6 +128,38 @@ func TestShouldRolloutFleet(t *testing.T) {
 	}
 }
 
+func TestShouldRollbackFleet(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    domain.Event
+		expected bool
+	}{
+		{
+			name:     "FleetRolloutRolledBack",
+			event:    createTestEvent(domain.FleetKind, domain.EventReasonFleetRolloutRolledBack, "fleet1"),
+			expected: true,
+		},
+		{
+			name:     "FleetRolloutFailed",
+			event:    createTestEvent(domain.FleetKind, domain.EventReasonFleetRolloutFailed, "fleet1"),
+			expected: false,
+		},
+		{
+			name:     "DeviceRolledBack",
+			event:    createTestEvent(domain.DeviceKind, domain.EventReasonFleetRolloutRolledBack, "device1"),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := logrus.New()
+			result := shouldRollbackFleet(context.Background(), tt.event, log)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestShouldReconcileDeviceOwnership(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -386,6 +418,48 @@ func TestShouldUpdateRepositoryReferers(t *testing.T) {
 	}
 }
 
+func TestShouldUpdateSecretReferers(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    domain.Event
+		expected bool
+	}{
+		{
+			name:     "SecretUpdatedWithSpec",
+			event:    createTestEventWithDetails(domain.SecretKind, domain.EventReasonResourceUpdated, "secret1", createResourceUpdatedDetails(t, domain.Spec)),
+			expected: true,
+		},
+		{
+			name:     "SecretUpdatedWithOtherFields",
+			event:    createTestEventWithDetails(domain.SecretKind, domain.EventReasonResourceUpdated, "secret1", createResourceUpdatedDetails(t, domain.Labels)),
+			expected: false,
+		},
+		{
+			name:     "SecretCreated",
+			event:    createTestEvent(domain.SecretKind, domain.EventReasonResourceCreated, "secret1"),
+			expected: true,
+		},
+		{
+			name:     "SecretDeleted",
+			event:    createTestEvent(domain.SecretKind, domain.EventReasonResourceDeleted, "secret1"),
+			expected: false,
+		},
+		{
+			name:     "RepositoryUpdated",
+			event:    createTestEventWithDetails(domain.RepositoryKind, domain.EventReasonResourceUpdated, "repo1", createResourceUpdatedDetails(t, domain.Spec)),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := logrus.New()
+			result := shouldUpdateSecretReferers(context.Background(), tt.event, log)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestHasUpdatedFields(t *testing.T) {
 	tests := []struct {
 		name        string
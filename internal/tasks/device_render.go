@@ -20,6 +20,7 @@ import (
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/internal/util/validation"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/flightctl/flightctl/pkg/ignition"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
 	"github.com/google/uuid"
@@ -35,7 +36,8 @@ import (
 // - If the device spec hasn't changed (as determined by UpdateRenderedDevice), the rendered
 //   version is not bumped.
 // - The rendering process is deterministic, based on the device spec, configuration sources,
-//   and application specs.
+//   and application specs. The only exception are the values of Secret resources, which are encrypted
+//   to the device's key with a fresh ephemeral key on every render.
 // - External inputs (e.g., Git repositories, HTTP endpoints, Kubernetes secrets) are frozen per
 //   fleet/template version using a KV store. Writes to the store use SetNX to prevent changes
 //   after freezing, and to detect inconsistencies.
@@ -73,6 +75,8 @@ type DeviceRenderLogic struct {
 	deviceConfig    *[]domain.ConfigProviderSpec
 	applications    *[]domain.ApplicationProviderSpec
 	vmConverter     VmConverterFn
+	// secretEncryptionKey is the public key that the device reported for the encryption of secrets
+	secretEncryptionKey string
 }
 
 func NewDeviceRenderLogic(log logrus.FieldLogger, serviceHandler service.Service, k8sClient k8sclient.K8SClient, kvStore kvstore.KVStore, cfg *config.Config, orgId uuid.UUID, event domain.Event) DeviceRenderLogic {
@@ -111,6 +115,9 @@ func (t *DeviceRenderLogic) RenderDevice(ctx context.Context) error {
 		t.deviceConfig = device.Spec.Config
 		t.applications = device.Spec.Applications
 	}
+	if device.Status != nil {
+		t.secretEncryptionKey, _ = device.Status.SystemInfo.Get(domain.DeviceSystemInfoSecretEncryptionKey)
+	}
 
 	if device.Metadata.Annotations != nil {
 		annotations := lo.FromPtr(device.Metadata.Annotations)
@@ -344,6 +351,8 @@ func (t *DeviceRenderLogic) renderConfigItem(ctx context.Context, configItem *do
 		return t.renderGitConfig(ctx, configItem, ignitionConfig)
 	case domain.KubernetesSecretProviderType:
 		return t.renderK8sConfig(ctx, configItem, ignitionConfig)
+	case domain.SecretProviderType:
+		return t.renderSecretConfig(ctx, configItem, ignitionConfig)
 	case domain.InlineConfigProviderType:
 		return t.renderInlineConfig(configItem, ignitionConfig)
 	case domain.HttpConfigProviderType:
@@ -561,6 +570,53 @@ func (t *DeviceRenderLogic) renderK8sConfig(ctx context.Context, configItem *dom
 	return &k8sSpec.Name, nil, fingerprint, nil
 }
 
+// renderSecretConfig writes each key of the referenced Secret resource as a file below the mount path. The
+// values are encrypted to the key that the device reported, so that only the agent can decrypt them when it
+// writes the files. Devices that have not reported a key cannot receive secrets.
+func (t *DeviceRenderLogic) renderSecretConfig(ctx context.Context, configItem *domain.ConfigProviderSpec, ignitionConfig **config_latest_types.Config) (*string, *string, *string, error) {
+	secretSpec, err := configItem.AsSecretProviderSpec()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: failed getting config item as SecretProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+
+	if t.secretEncryptionKey == "" {
+		return &secretSpec.Name, nil, nil, fmt.Errorf("device has not reported a key for the encryption of secrets")
+	}
+	deviceKey, err := fccrypto.ParseKeyAgreementPublicKey(t.secretEncryptionKey)
+	if err != nil {
+		return &secretSpec.Name, nil, nil, fmt.Errorf("invalid key for the encryption of secrets reported by device: %w", err)
+	}
+
+	secret, status := t.serviceHandler.GetSecret(ctx, t.orgId, secretSpec.Secret.Name)
+	if status.Code != http.StatusOK {
+		return &secretSpec.Name, nil, nil, fmt.Errorf("failed getting secret %s: %s", secretSpec.Secret.Name, status.Message)
+	}
+
+	ignitionWrapper, err := ignition.NewWrapper()
+	if err != nil {
+		return &secretSpec.Name, nil, nil, fmt.Errorf("failed to create ignition wrapper: %w", err)
+	}
+	base := filepath.Clean(secretSpec.Secret.MountPath)
+	for name, contents := range secret.Spec.Data {
+		if name == "." || name == ".." || strings.ContainsRune(name, '/') {
+			return &secretSpec.Name, nil, nil, fmt.Errorf("invalid secret key %q: must be a single file name", name)
+		}
+		dest := filepath.Join(base, name)
+		if err := validation.DenyForbiddenDevicePath(dest); err != nil {
+			return &secretSpec.Name, nil, nil, fmt.Errorf("invalid secret-derived path %q: %w", dest, err)
+		}
+		// the path is authenticated with the contents so that the agent rejects them at any other path
+		ciphertext, err := fccrypto.EncryptToPublicKey(deviceKey, []byte(contents), []byte(dest))
+		if err != nil {
+			return &secretSpec.Name, nil, nil, fmt.Errorf("failed encrypting secret %s: %w", secretSpec.Secret.Name, err)
+		}
+		ignitionWrapper.SetEncryptedFile(dest, ciphertext, 0o600, secretSpec.Secret.User.String(), secretSpec.Secret.Group)
+	}
+
+	*ignitionConfig = lo.ToPtr(ignitionWrapper.Merge(**ignitionConfig))
+	return &secretSpec.Name, nil, secret.Metadata.ResourceVersion, nil
+}
+
 func (t *DeviceRenderLogic) renderInlineConfig(configItem *domain.ConfigProviderSpec, ignitionConfig **config_latest_types.Config) (*string, *string, *string, error) {
 	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
 	if err != nil {
@@ -843,20 +899,25 @@ func (t *DeviceRenderLogic) cloneCachedGitRepoToIgnition(ctx context.Context, re
 
 // TODO: this is temporary, ignition will be removed in the future
 // ignitionConfigToRenderedConfig converts an ignition config to rendered config bytes
-func ignitionConfigToRenderedConfig(ignition *config_latest_types.Config) ([]byte, error) {
+func ignitionConfigToRenderedConfig(ignitionConfig *config_latest_types.Config) ([]byte, error) {
 	emptyConfig := []byte("[]")
 
-	if ignition == nil || len(ignition.Storage.Files) == 0 {
+	if ignitionConfig == nil || len(ignitionConfig.Storage.Files) == 0 {
 		return emptyConfig, nil
 	}
 
 	var files []domain.FileSpec
-	for _, file := range ignition.Storage.Files {
+	for _, file := range ignitionConfig.Storage.Files {
 		content := lo.FromPtr(file.Contents.Source)
 		encoding := domain.EncodingPlain
 
 		// parse encoding
-		if strings.HasPrefix(content, "data:") {
+		if strings.HasPrefix(content, "data:"+ignition.EncryptedMediaType) {
+			encoding = domain.EncodingEncrypted
+			if commaIndex := strings.Index(content, ","); commaIndex != -1 {
+				content = content[commaIndex+1:]
+			}
+		} else if strings.HasPrefix(content, "data:") {
 			encoding = domain.EncodingBase64
 			if commaIndex := strings.Index(content, ","); commaIndex != -1 {
 				content = content[commaIndex+1:]
@@ -2,6 +2,8 @@ package tasks
 
 import (
 	"context"
+	"crypto/ecdh"
+	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -18,6 +20,7 @@ import (
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/kvstore"
 	"github.com/flightctl/flightctl/internal/service"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -599,6 +602,73 @@ func TestRenderK8sConfig_CacheInvalidation(t *testing.T) {
 	}
 }
 
+// TestRenderSecretConfig_Encryption verifies that the values of Secret resources are only rendered
+// encrypted to the key reported by the device, and that the rendered spec marks them as encrypted.
+func TestRenderSecretConfig_Encryption(t *testing.T) {
+	orgId := uuid.New()
+	secretName := "db-creds"
+	deviceKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	encodedKey, err := fccrypto.EncodeKeyAgreementPublicKey(deviceKey.PublicKey())
+	require.NoError(t, err)
+
+	t.Run("When the device reported a key it should render the values encrypted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+		mockSvc.EXPECT().GetSecret(gomock.Any(), orgId, secretName).Return(&domain.Secret{
+			Metadata: domain.ObjectMeta{Name: &secretName, ResourceVersion: lo.ToPtr("3")},
+			Spec:     domain.SecretSpec{Data: map[string]string{"password": "hunter2"}},
+		}, domain.StatusOK())
+
+		event := createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, "device1")
+		l := NewDeviceRenderLogic(logrus.New(), mockSvc, nil, nil, &config.Config{}, orgId, event)
+		l.secretEncryptionKey = encodedKey
+
+		configItem := makeSecretResourceConfigItem(t, "creds", secretName)
+		empty := emptyIgnitionConfig()
+		ignCfg := &empty
+		_, _, fingerprint, err := l.renderSecretConfig(context.Background(), &configItem, &ignCfg)
+		require.NoError(t, err)
+		assert.Equal(t, "3", lo.FromPtr(fingerprint))
+
+		rendered, err := ignitionConfigToRenderedConfig(ignCfg)
+		require.NoError(t, err)
+		assert.NotContains(t, string(rendered), "hunter2")
+
+		var providers []domain.ConfigProviderSpec
+		require.NoError(t, json.Unmarshal(rendered, &providers))
+		require.Len(t, providers, 1)
+		inline, err := providers[0].AsInlineConfigProviderSpec()
+		require.NoError(t, err)
+		require.Len(t, inline.Inline, 1)
+		file := inline.Inline[0]
+		assert.Equal(t, "/etc/secrets/password", file.Path)
+		assert.Equal(t, domain.EncodingEncrypted, lo.FromPtr(file.ContentEncoding))
+
+		ciphertext, err := base64.StdEncoding.DecodeString(file.Content)
+		require.NoError(t, err)
+		plaintext, err := fccrypto.DecryptWithKeyAgreement(deviceKey, ciphertext, []byte(file.Path))
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", string(plaintext))
+	})
+
+	t.Run("When the device has not reported a key it should fail without fetching the secret", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+
+		event := createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, "device1")
+		l := NewDeviceRenderLogic(logrus.New(), mockSvc, nil, nil, &config.Config{}, orgId, event)
+
+		configItem := makeSecretResourceConfigItem(t, "creds", secretName)
+		empty := emptyIgnitionConfig()
+		ignCfg := &empty
+		_, _, _, err := l.renderSecretConfig(context.Background(), &configItem, &ignCfg)
+		require.ErrorContains(t, err, "has not reported a key")
+	})
+}
+
 // decodeIgnitionFileContent decodes the data URL (data:...;base64,<b64>) that ignition
 // uses to embed file contents and returns the raw bytes as a string.
 func decodeIgnitionFileContent(t *testing.T, source string) string {
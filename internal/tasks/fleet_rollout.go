@@ -86,6 +86,24 @@ func fleetRollout(ctx context.Context, orgId uuid.UUID, event domain.Event, serv
 	}
 }
 
+// The fleet rollback task returns the devices of a fleet whose rollout was rolled back to the
+// template version that preceded the failed one.
+//
+// Behavior:
+// - Iterates over devices that belong to the fleet and were rolled out to the failed template version.
+// - Re-renders each device against the previous template version.
+//
+// Idempotency:
+// - Devices that were already returned to the previous template version are no longer listed.
+func fleetRollback(ctx context.Context, orgId uuid.UUID, event domain.Event, serviceHandler service.Service, log logrus.FieldLogger) error {
+	logic := NewFleetRolloutsLogic(log, serviceHandler, orgId, event)
+	err := logic.RollbackFleet(ctx)
+	if err != nil {
+		log.Errorf("failed rolling back fleet %s/%s: %v", orgId, event.InvolvedObject.Name, err)
+	}
+	return err
+}
+
 type FleetRolloutsLogic struct {
 	log            logrus.FieldLogger
 	serviceHandler service.Service
@@ -116,6 +134,24 @@ func (f FleetRolloutsLogic) RolloutFleet(ctx context.Context) error {
 	}
 	f.log.Infof("Rolling out fleet %s/%s", f.orgId, f.event.InvolvedObject.Name)
 
+	// A batch that is dispatched outside the maintenance windows is held back.  The device selection
+	// reconciler dispatches it again once the next window opens.
+	inWindow, nextWindow, err := rollout.InMaintenanceWindow(fleet, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to evaluate maintenance windows of fleet %s/%s: %w", f.orgId, f.event.InvolvedObject.Name, err)
+	}
+	if !inWindow {
+		f.log.Infof("Fleet %s/%s is outside its maintenance windows. Holding back rollout until %s", f.orgId, f.event.InvolvedObject.Name, nextWindow.Start)
+		return nil
+	}
+
+	// A batch of a paused rollout is dispatched again by the device selection reconciler once the rollout is
+	// resumed.  A batch of an aborted rollout is never dispatched.
+	if rollout.IsPaused(fleet) || rollout.IsAborted(fleet) {
+		f.log.Infof("Rollout of fleet %s/%s is paused or aborted. Holding back rollout", f.orgId, f.event.InvolvedObject.Name)
+		return nil
+	}
+
 	templateVersion, status := f.serviceHandler.GetLatestTemplateVersion(ctx, f.orgId, f.event.InvolvedObject.Name)
 	if status.Code != http.StatusOK {
 		return fmt.Errorf("failed to get templateVersion: %s", status.Message)
@@ -174,6 +210,69 @@ func (f FleetRolloutsLogic) RolloutFleet(ctx context.Context) error {
 	return nil
 }
 
+func (f FleetRolloutsLogic) RollbackFleet(ctx context.Context) error {
+	if f.event.Details == nil {
+		return fmt.Errorf("missing details for fleet rollback event of %s/%s", f.orgId, f.event.InvolvedObject.Name)
+	}
+	details, err := f.event.Details.AsFleetRolloutRolledBackDetails()
+	if err != nil {
+		return fmt.Errorf("failed to convert event details to fleet rollout rolled back details: %w", err)
+	}
+	fleet, status := f.serviceHandler.GetFleet(ctx, f.orgId, f.event.InvolvedObject.Name, domain.GetFleetParams{})
+	if status.Code != http.StatusOK {
+		return fmt.Errorf("failed to get fleet %s/%s: %s", f.orgId, f.event.InvolvedObject.Name, status.Message)
+	}
+	f.log.Infof("Rolling back fleet %s/%s from templateVersion %s to %s", f.orgId, f.event.InvolvedObject.Name, details.TemplateVersion, details.PreviousTemplateVersion)
+
+	templateVersion, status := f.serviceHandler.GetTemplateVersion(ctx, f.orgId, f.event.InvolvedObject.Name, details.PreviousTemplateVersion)
+	if status.Code != http.StatusOK {
+		return fmt.Errorf("failed to get templateVersion %s: %s", details.PreviousTemplateVersion, status.Message)
+	}
+
+	owner := util.SetResourceOwner(domain.FleetKind, f.event.InvolvedObject.Name)
+	f.owner = *owner
+
+	listParams := domain.ListDevicesParams{
+		Limit:         lo.ToPtr(int32(f.itemsPerPage)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", *owner)),
+	}
+	annotationSelector := selector.NewAnnotationSelectorOrDie(domain.MatchExpression{
+		Key:      domain.DeviceAnnotationTemplateVersion,
+		Operator: domain.In,
+		Values:   &[]string{details.TemplateVersion},
+	}.String())
+	delayDeviceRender := fleet.Spec.RolloutPolicy != nil && fleet.Spec.RolloutPolicy.DisruptionBudget != nil
+
+	failureCount := 0
+	for {
+		devices, status := f.serviceHandler.ListDevices(ctx, f.orgId, listParams, annotationSelector)
+		if status.Code != http.StatusOK {
+			return fmt.Errorf("failed fetching devices: %s", status.Message)
+		}
+		for devIndex := range devices.Items {
+			device := &devices.Items[devIndex]
+			refs, updateErr := f.updateDeviceToFleetTemplate(ctx, device, templateVersion, delayDeviceRender)
+			if updateErr != nil {
+				f.log.Errorf("failed to roll back device %s (fleet %s): %v", *device.Metadata.Name, f.event.InvolvedObject.Name, updateErr)
+				failureCount++
+				continue
+			}
+			if st := f.serviceHandler.ReplaceFleetScopedDeviceDependencyRefs(ctx, f.orgId, *device.Metadata.Name, refs); st.Code != http.StatusOK {
+				f.log.Errorf("failed to replace dependency refs for device %s: %s", *device.Metadata.Name, st.Message)
+			}
+		}
+		if devices.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = devices.Metadata.Continue
+	}
+
+	if failureCount != 0 {
+		return fmt.Errorf("failed rolling back %d devices", failureCount)
+	}
+	return nil
+}
+
 // rolloutFleetPage performs one ListDevices call and updates every device in that page.
 // nextContinue is nil when there are no further pages; otherwise it is the token for the next list.
 func (f FleetRolloutsLogic) rolloutFleetPage(
@@ -245,10 +344,49 @@ func (f FleetRolloutsLogic) RolloutDevice(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to find rollout progress stage for fleet: %w", err)
 	}
-	if rolloutProgressStage == rollout.ConfiguredBatch {
+	switch rolloutProgressStage {
+	case rollout.ConfiguredBatch:
 		// If a rollout is in progress, then the device will be rolled out by one of the next batches
 		f.log.Infof("Rollout is in progress for fleet %v/%s. Skipping device %s rollout", f.orgId, lo.FromPtr(fleet.Metadata.Name), f.event.InvolvedObject.Name)
 		return nil
+	case rollout.RolledBack:
+		// If the rollout was rolled back, then the device is rolled out to the template version the fleet was rolled back to
+		rollbackTemplateVersion, _ := fleet.GetAnnotation(domain.FleetAnnotationRollbackTemplateVersion)
+		templateVersion, status = f.serviceHandler.GetTemplateVersion(ctx, f.orgId, ownerName, rollbackTemplateVersion)
+		if status.Code != http.StatusOK {
+			return fmt.Errorf("failed to get templateVersion %s: %s", rollbackTemplateVersion, status.Message)
+		}
+	case rollout.Aborted:
+		// If the rollout was aborted, then the device must not receive the aborted template version.  It is rolled out
+		// to the template version that preceded it, like the devices that the rollout didn't reach yet.
+		abortedTemplateVersion, _ := fleet.GetAnnotation(domain.FleetAnnotationRolloutAborted)
+		previousTemplateVersion, exists, err := rollout.PreviousTemplateVersion(ctx, f.serviceHandler, f.orgId, ownerName, abortedTemplateVersion)
+		if err != nil {
+			return fmt.Errorf("failed to find the template version preceding %s: %w", abortedTemplateVersion, err)
+		}
+		if !exists {
+			f.log.Infof("Rollout of fleet %v/%s was aborted and no template version precedes %s. Skipping device %s rollout", f.orgId, ownerName, abortedTemplateVersion, f.event.InvolvedObject.Name)
+			return nil
+		}
+		templateVersion, status = f.serviceHandler.GetTemplateVersion(ctx, f.orgId, ownerName, previousTemplateVersion)
+		if status.Code != http.StatusOK {
+			return fmt.Errorf("failed to get templateVersion %s: %s", previousTemplateVersion, status.Message)
+		}
+	default:
+		// Outside the maintenance windows the device is selected for the rollout instead.  The device selection
+		// reconciler dispatches it with the rollout's last batch once the next window opens.
+		inWindow, nextWindow, err := rollout.InMaintenanceWindow(fleet, time.Now())
+		if err != nil {
+			return fmt.Errorf("failed to evaluate maintenance windows of fleet %s/%s: %w", f.orgId, ownerName, err)
+		}
+		if !inWindow {
+			f.log.Infof("Fleet %v/%s is outside its maintenance windows. Holding back device %s rollout until %s", f.orgId, ownerName, f.event.InvolvedObject.Name, nextWindow.Start)
+			status = f.serviceHandler.UpdateDeviceAnnotations(ctx, f.orgId, f.event.InvolvedObject.Name, map[string]string{domain.DeviceAnnotationSelectedForRollout: ""}, nil)
+			if status.Code != http.StatusOK {
+				return fmt.Errorf("failed to select device %s for rollout: %s", f.event.InvolvedObject.Name, status.Message)
+			}
+			return nil
+		}
 	}
 	delayDeviceRender := fleet.Spec.RolloutPolicy != nil && fleet.Spec.RolloutPolicy.DisruptionBudget != nil
 	refs, err := f.updateDeviceToFleetTemplate(ctx, device, templateVersion, delayDeviceRender)
@@ -785,6 +923,12 @@ func (f FleetRolloutsLogic) getDeviceConfig(device *domain.Device, templateVersi
 			for _, ref := range refs {
 				depRefs[ref.ResourceKey] = ref
 			}
+		case domain.SecretProviderType:
+			var refs []model.DependencyRef
+			newConfigItem, refs, errs = f.replaceSecretConfigParameters(device, configItem)
+			for _, ref := range refs {
+				depRefs[ref.ResourceKey] = ref
+			}
 		case domain.InlineConfigProviderType:
 			newConfigItem, errs = f.replaceInlineConfigParameters(device, configItem)
 		case domain.HttpConfigProviderType:
@@ -912,6 +1056,52 @@ func (f FleetRolloutsLogic) replaceKubeSecretConfigParameters(device *domain.Dev
 	return &newConfigItem, refs, nil
 }
 
+func (f FleetRolloutsLogic) replaceSecretConfigParameters(device *domain.Device, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []model.DependencyRef, []error) {
+	secretSpec, err := configItem.AsSecretProviderSpec()
+	if err != nil {
+		return nil, nil, []error{fmt.Errorf("failed to convert config to secret config: %w", err)}
+	}
+
+	errs := []error{}
+	originalName := secretSpec.Secret.Name
+
+	secretSpec.Secret.Name, err = ReplaceParametersInString(secretSpec.Secret.Name, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in name in secret config %s: %w", secretSpec.Name, err))
+	}
+
+	secretSpec.Secret.MountPath, err = ReplaceParametersInString(secretSpec.Secret.MountPath, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in mountPath in secret config %s: %w", secretSpec.Name, err))
+	}
+
+	if len(errs) > 0 {
+		return nil, nil, errs
+	}
+
+	var refs []model.DependencyRef
+	if isParameterized(originalName) {
+		deviceName := lo.FromPtr(device.Metadata.Name)
+		ownerFleetName, _, _ := getOwnerFleet(device)
+		refs = append(refs, model.DependencyRef{
+			FleetName:          &ownerFleetName,
+			DeviceName:         &deviceName,
+			RefType:            secretResourceRefType,
+			ResourceKey:        secretResourceKey(secretSpec.Secret.Name),
+			SecretName:         &secretSpec.Secret.Name,
+			ConfigProviderName: secretSpec.Name,
+		})
+	}
+
+	newConfigItem := domain.ConfigProviderSpec{}
+	err = newConfigItem.FromSecretProviderSpec(secretSpec)
+	if err != nil {
+		return nil, nil, []error{fmt.Errorf("failed converting secret config: %w", err)}
+	}
+
+	return &newConfigItem, refs, nil
+}
+
 func (f FleetRolloutsLogic) replaceInlineConfigParameters(device *domain.Device, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []error) {
 	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
 	if err != nil {
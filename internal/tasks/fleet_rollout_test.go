@@ -3,6 +3,7 @@ package tasks
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
@@ -1139,6 +1140,58 @@ func TestRolloutFleetPage_UpsertDeviceRefs(t *testing.T) {
 	})
 }
 
+func TestFleetRolloutsLogic_RollbackFleet(t *testing.T) {
+	orgId := uuid.New()
+	fleetName := "my-fleet"
+	okStatus := domain.Status{Code: http.StatusOK}
+
+	t.Run("When a rollout was rolled back it should return the rolled out devices to the previous template version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+
+		details := domain.EventDetails{}
+		require.NoError(t, details.FromFleetRolloutRolledBackDetails(domain.FleetRolloutRolledBackDetails{
+			DetailType:              domain.FleetRolloutRolledBack,
+			TemplateVersion:         "v2",
+			PreviousTemplateVersion: "v1",
+			Batch:                   "batch 1",
+		}))
+		event := createTestEventWithDetails(domain.FleetKind, domain.EventReasonFleetRolloutRolledBack, fleetName, &details)
+
+		device := createTestDevice("device-1", "Fleet/"+fleetName)
+		device.Metadata.Annotations = &map[string]string{domain.DeviceAnnotationTemplateVersion: "v2"}
+
+		mockSvc.EXPECT().GetFleet(gomock.Any(), orgId, fleetName, gomock.Any()).Return(createTestFleetForRollout(fleetName, nil), okStatus)
+		mockSvc.EXPECT().GetTemplateVersion(gomock.Any(), orgId, fleetName, "v1").Return(createTestTemplateVersion("v1"), okStatus)
+		mockSvc.EXPECT().ListDevices(gomock.Any(), orgId, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, params domain.ListDevicesParams, annotationSelector any) (*domain.DeviceList, domain.Status) {
+				assert.Equal(t, "metadata.owner=Fleet/"+fleetName, lo.FromPtr(params.FieldSelector))
+				return &domain.DeviceList{Items: []domain.Device{*device}}, okStatus
+			})
+		mockSvc.EXPECT().ReplaceDevice(gomock.Any(), orgId, "device-1", gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, _ string, d domain.Device, _ any) (*domain.Device, domain.Status) {
+				assert.Equal(t, "test-image:latest", d.Spec.Os.Image)
+				return &d, okStatus
+			})
+		mockSvc.EXPECT().UpdateDeviceAnnotations(gomock.Any(), orgId, "device-1", map[string]string{domain.DeviceAnnotationTemplateVersion: "v1"}, gomock.Any()).Return(okStatus)
+		mockSvc.EXPECT().ReplaceFleetScopedDeviceDependencyRefs(gomock.Any(), orgId, "device-1", gomock.Any()).Return(okStatus)
+
+		logic := NewFleetRolloutsLogic(logrus.New(), mockSvc, orgId, event)
+		require.NoError(t, logic.RollbackFleet(context.Background()))
+	})
+
+	t.Run("When the event has no details it should fail", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+
+		event := createTestEvent(domain.FleetKind, domain.EventReasonFleetRolloutRolledBack, fleetName)
+		logic := NewFleetRolloutsLogic(logrus.New(), mockSvc, orgId, event)
+		require.ErrorContains(t, logic.RollbackFleet(context.Background()), "missing details")
+	})
+}
+
 func TestDeviceDependencyRefLifecycle(t *testing.T) {
 	fleetName := "fleet-a"
 	owner := util.SetResourceOwner(domain.FleetKind, fleetName)
@@ -1628,3 +1681,119 @@ func TestFleetRolloutIterationContext(t *testing.T) {
 		assert.True(t, errors.Is(iterCtx.Err(), context.Canceled))
 	})
 }
+
+func TestFleetRolloutsLogic_RolloutDeviceAbortedRollout(t *testing.T) {
+	orgId := uuid.New()
+	fleetName := "my-fleet"
+	okStatus := domain.Status{Code: http.StatusOK}
+	rolloutPolicy := &domain.RolloutPolicy{DeviceSelection: &domain.RolloutDeviceSelection{}}
+	require.NoError(t, rolloutPolicy.DeviceSelection.FromBatchSequence(domain.BatchSequence{
+		Strategy: domain.RolloutStrategyBatchSequence,
+		Sequence: &[]domain.Batch{{}},
+	}))
+	abortedFleet := func() *domain.Fleet {
+		fleet := createTestFleetForRollout(fleetName, rolloutPolicy)
+		fleet.Metadata.Annotations = &map[string]string{
+			domain.FleetAnnotationBatchNumber:    "0",
+			domain.FleetAnnotationRolloutAborted: "v2",
+		}
+		return fleet
+	}
+	templateVersions := &domain.TemplateVersionList{Items: []domain.TemplateVersion{
+		*createTestTemplateVersion("v2"),
+		*createTestTemplateVersion("v1"),
+	}}
+
+	t.Run("When the rollout was aborted it should roll out a new device to the previous template version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+
+		mockSvc.EXPECT().GetDevice(gomock.Any(), orgId, "device-1").Return(createTestDevice("device-1", "Fleet/"+fleetName), okStatus)
+		mockSvc.EXPECT().GetLatestTemplateVersion(gomock.Any(), orgId, fleetName).Return(createTestTemplateVersion("v2"), okStatus)
+		mockSvc.EXPECT().GetFleet(gomock.Any(), orgId, fleetName, gomock.Any()).Return(abortedFleet(), okStatus)
+		mockSvc.EXPECT().ListTemplateVersions(gomock.Any(), orgId, fleetName, gomock.Any()).Return(templateVersions, okStatus)
+		mockSvc.EXPECT().GetTemplateVersion(gomock.Any(), orgId, fleetName, "v1").Return(createTestTemplateVersion("v1"), okStatus)
+		mockSvc.EXPECT().ReplaceDevice(gomock.Any(), orgId, "device-1", gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, _ string, d domain.Device, _ any) (*domain.Device, domain.Status) {
+				return &d, okStatus
+			})
+		mockSvc.EXPECT().UpdateDeviceAnnotations(gomock.Any(), orgId, "device-1", map[string]string{domain.DeviceAnnotationTemplateVersion: "v1"}, gomock.Any()).Return(okStatus)
+		mockSvc.EXPECT().ReplaceFleetScopedDeviceDependencyRefs(gomock.Any(), orgId, "device-1", gomock.Any()).Return(okStatus)
+
+		event := createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, "device-1")
+		logic := NewFleetRolloutsLogic(logrus.New(), mockSvc, orgId, event)
+		require.NoError(t, logic.RolloutDevice(context.Background()))
+	})
+
+	t.Run("When the aborted template version is the first one it should not roll out the device", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+
+		mockSvc.EXPECT().GetDevice(gomock.Any(), orgId, "device-1").Return(createTestDevice("device-1", "Fleet/"+fleetName), okStatus)
+		mockSvc.EXPECT().GetLatestTemplateVersion(gomock.Any(), orgId, fleetName).Return(createTestTemplateVersion("v2"), okStatus)
+		mockSvc.EXPECT().GetFleet(gomock.Any(), orgId, fleetName, gomock.Any()).Return(abortedFleet(), okStatus)
+		mockSvc.EXPECT().ListTemplateVersions(gomock.Any(), orgId, fleetName, gomock.Any()).Return(
+			&domain.TemplateVersionList{Items: []domain.TemplateVersion{*createTestTemplateVersion("v2")}}, okStatus)
+
+		event := createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, "device-1")
+		logic := NewFleetRolloutsLogic(logrus.New(), mockSvc, orgId, event)
+		require.NoError(t, logic.RolloutDevice(context.Background()))
+	})
+}
+
+func TestFleetRolloutsLogic_RolloutDeviceMaintenanceWindow(t *testing.T) {
+	orgId := uuid.New()
+	fleetName := "my-fleet"
+	okStatus := domain.Status{Code: http.StatusOK}
+	fleetWithWindow := func(window domain.UpdateSchedule) *domain.Fleet {
+		rolloutPolicy := &domain.RolloutPolicy{
+			DeviceSelection:    &domain.RolloutDeviceSelection{},
+			MaintenanceWindows: &[]domain.UpdateSchedule{window},
+		}
+		require.NoError(t, rolloutPolicy.DeviceSelection.FromBatchSequence(domain.BatchSequence{
+			Strategy: domain.RolloutStrategyBatchSequence,
+			Sequence: &[]domain.Batch{{}},
+		}))
+		return createTestFleetForRollout(fleetName, rolloutPolicy)
+	}
+	now := time.Now().UTC()
+
+	t.Run("When the maintenance window is closed it should select the device for the rollout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+
+		closed := domain.UpdateSchedule{At: fmt.Sprintf("0 %d * * *", (now.Hour()+12)%24), StartGraceDuration: "1h", TimeZone: lo.ToPtr("UTC")}
+		mockSvc.EXPECT().GetDevice(gomock.Any(), orgId, "device-1").Return(createTestDevice("device-1", "Fleet/"+fleetName), okStatus)
+		mockSvc.EXPECT().GetLatestTemplateVersion(gomock.Any(), orgId, fleetName).Return(createTestTemplateVersion("v1"), okStatus)
+		mockSvc.EXPECT().GetFleet(gomock.Any(), orgId, fleetName, gomock.Any()).Return(fleetWithWindow(closed), okStatus)
+		mockSvc.EXPECT().UpdateDeviceAnnotations(gomock.Any(), orgId, "device-1", map[string]string{domain.DeviceAnnotationSelectedForRollout: ""}, gomock.Any()).Return(okStatus)
+
+		event := createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, "device-1")
+		logic := NewFleetRolloutsLogic(logrus.New(), mockSvc, orgId, event)
+		require.NoError(t, logic.RolloutDevice(context.Background()))
+	})
+
+	t.Run("When the maintenance window is open it should roll out the device", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+
+		open := domain.UpdateSchedule{At: "* * * * *", StartGraceDuration: "1h", TimeZone: lo.ToPtr("UTC")}
+		mockSvc.EXPECT().GetDevice(gomock.Any(), orgId, "device-1").Return(createTestDevice("device-1", "Fleet/"+fleetName), okStatus)
+		mockSvc.EXPECT().GetLatestTemplateVersion(gomock.Any(), orgId, fleetName).Return(createTestTemplateVersion("v1"), okStatus)
+		mockSvc.EXPECT().GetFleet(gomock.Any(), orgId, fleetName, gomock.Any()).Return(fleetWithWindow(open), okStatus)
+		mockSvc.EXPECT().ReplaceDevice(gomock.Any(), orgId, "device-1", gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, _ string, d domain.Device, _ any) (*domain.Device, domain.Status) {
+				return &d, okStatus
+			})
+		mockSvc.EXPECT().UpdateDeviceAnnotations(gomock.Any(), orgId, "device-1", map[string]string{domain.DeviceAnnotationTemplateVersion: "v1"}, gomock.Any()).Return(okStatus)
+		mockSvc.EXPECT().ReplaceFleetScopedDeviceDependencyRefs(gomock.Any(), orgId, "device-1", gomock.Any()).Return(okStatus)
+
+		event := createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, "device-1")
+		logic := NewFleetRolloutsLogic(logrus.New(), mockSvc, orgId, event)
+		require.NoError(t, logic.RolloutDevice(context.Background()))
+	})
+}
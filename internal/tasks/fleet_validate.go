@@ -192,6 +192,8 @@ func (t *FleetValidateLogic) validateConfigItem(ctx context.Context, configItem
 		return t.validateGitConfig(ctx, configItem)
 	case domain.KubernetesSecretProviderType:
 		return t.validateK8sConfig(ctx, configItem)
+	case domain.SecretProviderType:
+		return t.validateSecretConfig(ctx, configItem)
 	case domain.InlineConfigProviderType:
 		return t.validateInlineConfig(configItem)
 	case domain.HttpConfigProviderType:
@@ -235,6 +237,24 @@ func (t *FleetValidateLogic) validateK8sConfig(ctx context.Context, configItem *
 	return &k8sSpec.Name, nil, nil
 }
 
+func (t *FleetValidateLogic) validateSecretConfig(ctx context.Context, configItem *domain.ConfigProviderSpec) (*string, *string, error) {
+	secretSpec, err := configItem.AsSecretProviderSpec()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed getting config item as SecretProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+
+	// A parameterized name is only known per device, so it is checked when the device is rendered
+	if isParameterized(secretSpec.Secret.Name) {
+		return &secretSpec.Name, nil, nil
+	}
+	_, status := t.serviceHandler.GetSecret(ctx, t.orgId, secretSpec.Secret.Name)
+	if status.Code != http.StatusOK {
+		return &secretSpec.Name, nil, fmt.Errorf("failed getting secret %s: %s", secretSpec.Secret.Name, status.Message)
+	}
+
+	return &secretSpec.Name, nil, nil
+}
+
 func (t *FleetValidateLogic) validateInlineConfig(configItem *domain.ConfigProviderSpec) (*string, *string, error) {
 	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
 	if err != nil {
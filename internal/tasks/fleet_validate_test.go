@@ -262,3 +262,13 @@ func makeSecretConfigItem(t *testing.T, name, namespace, secretName string) doma
 	require.NoError(t, item.FromKubernetesSecretProviderSpec(*secretSpec))
 	return item
 }
+
+func makeSecretResourceConfigItem(t *testing.T, name, secretName string) domain.ConfigProviderSpec {
+	t.Helper()
+	secretSpec := &domain.SecretProviderSpec{Name: name}
+	secretSpec.Secret.Name = secretName
+	secretSpec.Secret.MountPath = "/etc/secrets"
+	item := domain.ConfigProviderSpec{}
+	require.NoError(t, item.FromSecretProviderSpec(*secretSpec))
+	return item
+}
@@ -0,0 +1,94 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/notification"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// deliverNotification returns the handler of the notification queue, which delivers an event to a notification
+// sink.  Failed deliveries are completed with their error, so that queue maintenance retries them.
+func deliverNotification(serviceHandler service.Service, sender notification.Sender) queues.ConsumeHandler {
+	return func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+		ctx, cancel := context.WithTimeout(ctx, EventProcessingTimeout)
+		defer cancel()
+
+		ackCtx, cancelAck := context.WithTimeout(context.Background(), AckTimeout)
+		defer cancelAck()
+
+		var delivery NotificationDelivery
+		if err := json.Unmarshal(payload, &delivery); err != nil {
+			log.WithError(err).Error("failed to unmarshal notification delivery")
+			// Parsing errors are not retryable
+			if ackErr := consumer.Complete(ackCtx, entryID, payload, nil); ackErr != nil {
+				log.WithError(ackErr).Errorf("failed to complete message %s after unmarshal error", entryID)
+			}
+			return nil
+		}
+
+		err := sendNotification(ctx, serviceHandler, sender, delivery, log)
+		if err != nil {
+			log.WithError(err).Warnf("failed to deliver event %s to notification sink %s", delivery.Event.Reason, delivery.Sink)
+		}
+		if ackErr := consumer.Complete(ackCtx, entryID, payload, err); ackErr != nil {
+			log.WithError(ackErr).Errorf("failed to complete message %s", entryID)
+			return ackErr
+		}
+		return err
+	}
+}
+
+func sendNotification(ctx context.Context, serviceHandler service.Service, sender notification.Sender, delivery NotificationDelivery, log logrus.FieldLogger) error {
+	// The sink is fetched at delivery time so that retries use its current target and credentials
+	sink, status := serviceHandler.GetNotificationSink(ctx, delivery.OrgId, delivery.Sink)
+	switch status.Code {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		log.Debugf("notification sink %s no longer exists, dropping delivery", delivery.Sink)
+		return nil
+	default:
+		return fmt.Errorf("failed to get notification sink %s: %s", delivery.Sink, status.Message)
+	}
+	return sender.Send(ctx, delivery.OrgId, sink, delivery.Event)
+}
+
+// secretResolver resolves references to secrets through the service.
+func secretResolver(serviceHandler service.Service) notification.SecretResolver {
+	return func(ctx context.Context, orgId uuid.UUID, ref domain.SecretKeyReference) (string, error) {
+		secret, status := serviceHandler.GetSecret(ctx, orgId, ref.Name)
+		if status.Code != http.StatusOK {
+			return "", fmt.Errorf("failed to get secret %s: %s", ref.Name, status.Message)
+		}
+		value, ok := secret.Spec.Data[ref.Key]
+		if !ok {
+			return "", fmt.Errorf("secret %s has no key %s", ref.Name, ref.Key)
+		}
+		return value, nil
+	}
+}
+
+// LaunchNotificationConsumers starts the consumers of the notification queue.
+func LaunchNotificationConsumers(ctx context.Context, queuesProvider queues.Provider, serviceHandler service.Service, numConsumers, threadsPerConsumer int) error {
+	sender := notification.NewSender(secretResolver(serviceHandler))
+	for i := 0; i != numConsumers; i++ {
+		consumer, err := queuesProvider.NewQueueConsumer(ctx, consts.NotificationQueue)
+		if err != nil {
+			return err
+		}
+		for j := 0; j != threadsPerConsumer; j++ {
+			if err = consumer.Consume(ctx, deliverNotification(serviceHandler, sender)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
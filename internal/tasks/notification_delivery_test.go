@@ -0,0 +1,84 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+type fakeNotificationSender struct {
+	err  error
+	sent []string
+}
+
+func (f *fakeNotificationSender) Send(_ context.Context, _ uuid.UUID, sink *domain.NotificationSink, event domain.Event) error {
+	f.sent = append(f.sent, lo.FromPtr(sink.Metadata.Name)+"/"+string(event.Reason))
+	return f.err
+}
+
+func TestDeliverNotification(t *testing.T) {
+	orgId := uuid.New()
+	payload, err := json.Marshal(NotificationDelivery{
+		OrgId: orgId,
+		Sink:  "ops",
+		Event: domain.Event{Reason: domain.EventReasonDeviceDisconnected},
+	})
+	require.NoError(t, err)
+	sink := &domain.NotificationSink{Metadata: domain.ObjectMeta{Name: lo.ToPtr("ops")}}
+
+	t.Run("When delivery succeeds it should complete the message", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+		consumer := queues.NewMockQueueConsumer(ctrl)
+		sender := &fakeNotificationSender{}
+
+		mockSvc.EXPECT().GetNotificationSink(gomock.Any(), orgId, "ops").Return(sink, domain.Status{Code: http.StatusOK})
+		consumer.EXPECT().Complete(gomock.Any(), "1-0", payload, nil).Return(nil)
+
+		err := deliverNotification(mockSvc, sender)(context.Background(), payload, "1-0", consumer, logrus.New())
+		require.NoError(t, err)
+		require.Equal(t, []string{"ops/DeviceDisconnected"}, sender.sent)
+	})
+
+	t.Run("When delivery fails it should complete the message with the error for a retry", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+		consumer := queues.NewMockQueueConsumer(ctrl)
+		sendErr := errors.New("endpoint responded with status 503")
+		sender := &fakeNotificationSender{err: sendErr}
+
+		mockSvc.EXPECT().GetNotificationSink(gomock.Any(), orgId, "ops").Return(sink, domain.Status{Code: http.StatusOK})
+		consumer.EXPECT().Complete(gomock.Any(), "1-0", payload, sendErr).Return(nil)
+
+		err := deliverNotification(mockSvc, sender)(context.Background(), payload, "1-0", consumer, logrus.New())
+		require.ErrorIs(t, err, sendErr)
+	})
+
+	t.Run("When the sink was deleted it should drop the delivery", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+		consumer := queues.NewMockQueueConsumer(ctrl)
+		sender := &fakeNotificationSender{}
+
+		mockSvc.EXPECT().GetNotificationSink(gomock.Any(), orgId, "ops").Return(nil, domain.StatusResourceNotFound(domain.NotificationSinkKind, "ops"))
+		consumer.EXPECT().Complete(gomock.Any(), "1-0", payload, nil).Return(nil)
+
+		err := deliverNotification(mockSvc, sender)(context.Background(), payload, "1-0", consumer, logrus.New())
+		require.NoError(t, err)
+		require.Empty(t, sender.sent)
+	})
+}
@@ -0,0 +1,294 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/notification"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// NotificationDispatchPollingInterval is the interval at which new events are dispatched to notification sinks.
+	NotificationDispatchPollingInterval = 30 * time.Second
+	NotificationDispatchTaskName        = "notification-dispatch"
+
+	// The checkpoint of an organization is the position from which its events are dispatched
+	notificationDispatchCheckpointConsumer = "notification-dispatch"
+
+	// notificationLateEventWindow is how long after its creation time an event may still become visible.  Every run
+	// reads the events created within this window before the previous run again, so that events whose creation was
+	// still being committed are not skipped.
+	notificationLateEventWindow = time.Minute
+)
+
+// notificationDispatchCheckpoint is the position from which the events of an organization are dispatched.  It trails
+// the previous run by notificationLateEventWindow, and the names of the events created since then that were already
+// dispatched are kept to skip them.
+type notificationDispatchCheckpoint struct {
+	// Timestamp is the creation time from which events are dispatched.  Events created before it were dispatched.
+	Timestamp time.Time `json:"timestamp"`
+	// Dispatched are the names of the events created at or after Timestamp that were dispatched
+	Dispatched []string `json:"dispatched,omitempty"`
+}
+
+// NotificationDelivery is the message that asks to deliver an event to a notification sink.
+type NotificationDelivery struct {
+	OrgId uuid.UUID    `json:"orgId"`
+	Sink  string       `json:"sink"`
+	Event domain.Event `json:"event"`
+}
+
+// NotificationDispatch matches the events created since its last run against the filters of the notification sinks
+// of an organization, and enqueues a delivery for every sink that an event matches.  Deliveries are processed and
+// retried through the notification queue, so events are delivered at least once.
+type NotificationDispatch struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+	publisher      queues.QueueProducer
+}
+
+func NewNotificationDispatch(log logrus.FieldLogger, serviceHandler service.Service, publisher queues.QueueProducer) *NotificationDispatch {
+	return &NotificationDispatch{
+		log:            log,
+		serviceHandler: serviceHandler,
+		publisher:      publisher,
+	}
+}
+
+// Poll dispatches the events of the organization that were created since the checkpoint.  The first run only sets
+// the checkpoint, so that events from before notifications were set up are not delivered.
+func (t *NotificationDispatch) Poll(ctx context.Context, orgID uuid.UUID) {
+	t.log.Debug("Running NotificationDispatch Polling")
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Events are dispatched up to the current database time
+	until, status := t.serviceHandler.GetDatabaseTime(ctx)
+	if status.Code != http.StatusOK {
+		t.log.Errorf("Failed to get database time: %s", status.Message)
+		return
+	}
+
+	next := &notificationDispatchCheckpoint{Timestamp: until}
+	since, found, err := t.loadCheckpoint(ctx, orgID)
+	if err != nil {
+		t.log.WithError(err).Error("Failed to load notification dispatch checkpoint")
+		return
+	}
+	if found {
+		sinks, err := t.listSinks(ctx, orgID)
+		if err != nil {
+			t.log.WithError(err).Error("Failed to list notification sinks")
+			return
+		}
+		if len(sinks) > 0 {
+			if next, err = t.dispatchEvents(ctx, orgID, sinks, since, until); err != nil {
+				t.log.WithError(err).Error("Failed to dispatch events to notification sinks")
+				return
+			}
+		}
+	}
+
+	t.storeCheckpoint(ctx, orgID, next)
+}
+
+func (t *NotificationDispatch) loadCheckpoint(ctx context.Context, orgID uuid.UUID) (*notificationDispatchCheckpoint, bool, error) {
+	data, status := t.serviceHandler.GetCheckpoint(ctx, notificationDispatchCheckpointConsumer, orgID.String())
+	if status.Code == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if status.Code != http.StatusOK {
+		return nil, false, fmt.Errorf("failed to get checkpoint: %s", status.Message)
+	}
+	var checkpoint notificationDispatchCheckpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil || checkpoint.Timestamp.IsZero() {
+		// Start over from the current time rather than redelivering an unknown range of events
+		t.log.WithError(err).Warnf("Ignoring invalid notification dispatch checkpoint %q", string(data))
+		return nil, false, nil
+	}
+	return &checkpoint, true, nil
+}
+
+func (t *NotificationDispatch) storeCheckpoint(ctx context.Context, orgID uuid.UUID, checkpoint *notificationDispatchCheckpoint) {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		t.log.WithError(err).Error("Failed to marshal notification dispatch checkpoint")
+		return
+	}
+	status := t.serviceHandler.SetCheckpoint(ctx, notificationDispatchCheckpointConsumer, orgID.String(), data)
+	if status.Code != http.StatusOK {
+		t.log.Errorf("Failed to set notification dispatch checkpoint: %s", status.Message)
+	}
+}
+
+func (t *NotificationDispatch) listSinks(ctx context.Context, orgID uuid.UUID) ([]domain.NotificationSink, error) {
+	params := domain.ListNotificationSinksParams{Limit: lo.ToPtr(int32(ItemsPerPage))}
+	var sinks []domain.NotificationSink
+	for {
+		list, status := t.serviceHandler.ListNotificationSinks(ctx, orgID, params)
+		if status.Code != http.StatusOK {
+			return nil, fmt.Errorf("%s", status.Message)
+		}
+		sinks = append(sinks, list.Items...)
+		if list.Metadata.Continue == nil {
+			return sinks, nil
+		}
+		params.Continue = list.Metadata.Continue
+	}
+}
+
+// dispatchEvents enqueues deliveries for the events created in [since.Timestamp, until) that were not dispatched yet,
+// and returns the checkpoint of the next run.  It stops at the first event that cannot be enqueued, so that the
+// checkpoint is not advanced past undelivered events.
+func (t *NotificationDispatch) dispatchEvents(ctx context.Context, orgID uuid.UUID, sinks []domain.NotificationSink, since *notificationDispatchCheckpoint, until time.Time) (*notificationDispatchCheckpoint, error) {
+	next := &notificationDispatchCheckpoint{Timestamp: until.Add(-notificationLateEventWindow)}
+	if next.Timestamp.Before(since.Timestamp) {
+		next.Timestamp = since.Timestamp
+	}
+	dispatched := lo.SliceToMap(since.Dispatched, func(name string) (string, struct{}) { return name, struct{}{} })
+
+	fieldSelector := strings.Join([]string{
+		fmt.Sprintf("metadata.creationTimestamp>=%s", since.Timestamp.Format(time.RFC3339Nano)),
+		fmt.Sprintf("metadata.creationTimestamp<%s", until.Format(time.RFC3339Nano)),
+	}, ",")
+	params := domain.ListEventsParams{
+		FieldSelector: &fieldSelector,
+		Order:         lo.ToPtr(domain.Asc),
+		Limit:         lo.ToPtr(int32(ItemsPerPage)),
+	}
+
+	labelsCache := map[domain.ObjectReference]map[string]string{}
+	enqueued := 0
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		events, status := t.serviceHandler.ListEvents(ctx, orgID, params)
+		if status.Code != http.StatusOK {
+			return nil, fmt.Errorf("failed to list events: %s", status.Message)
+		}
+
+		for _, event := range events.Items {
+			// The events created within the trailing window are read again by the next run, which skips them
+			name := lo.FromPtr(event.Metadata.Name)
+			if !lo.FromPtr(event.Metadata.CreationTimestamp).Before(next.Timestamp) {
+				next.Dispatched = append(next.Dispatched, name)
+			}
+			if _, ok := dispatched[name]; ok {
+				continue
+			}
+			// Failed deliveries are reported as events, which must not cause further deliveries that may fail
+			if event.Reason == domain.EventReasonNotificationDeliveryFailed {
+				continue
+			}
+			for _, sink := range sinks {
+				matches, err := t.matches(ctx, orgID, sink, event, labelsCache)
+				if err != nil {
+					t.log.WithError(err).Warnf("Failed to match event %s against notification sink %s", lo.FromPtr(event.Metadata.Name), lo.FromPtr(sink.Metadata.Name))
+					continue
+				}
+				if !matches {
+					continue
+				}
+				if err := t.enqueue(ctx, orgID, lo.FromPtr(sink.Metadata.Name), event); err != nil {
+					return nil, err
+				}
+				enqueued++
+			}
+		}
+
+		if events.Metadata.Continue == nil {
+			break
+		}
+		params.Continue = events.Metadata.Continue
+	}
+
+	if enqueued > 0 {
+		t.log.Infof("Enqueued %d notification deliveries", enqueued)
+	}
+	return next, nil
+}
+
+func (t *NotificationDispatch) matches(ctx context.Context, orgID uuid.UUID, sink domain.NotificationSink, event domain.Event, labelsCache map[domain.ObjectReference]map[string]string) (bool, error) {
+	var labels map[string]string
+	if notification.NeedsLabels(sink.Spec.Filter) {
+		var ok bool
+		if labels, ok = labelsCache[event.InvolvedObject]; !ok {
+			var err error
+			if labels, err = t.involvedObjectLabels(ctx, orgID, event.InvolvedObject); err != nil {
+				return false, err
+			}
+			labelsCache[event.InvolvedObject] = labels
+		}
+	}
+	return notification.Matches(sink.Spec.Filter, event, labels)
+}
+
+// involvedObjectLabels returns the current labels of the object an event is about.  Objects that have no labels or
+// no longer exist have no labels, so that they only match selectors that do not require any.
+func (t *NotificationDispatch) involvedObjectLabels(ctx context.Context, orgID uuid.UUID, ref domain.ObjectReference) (map[string]string, error) {
+	var metadata *domain.ObjectMeta
+	var status domain.Status
+	switch ref.Kind {
+	case domain.DeviceKind:
+		var device *domain.Device
+		if device, status = t.serviceHandler.GetDevice(ctx, orgID, ref.Name); device != nil {
+			metadata = &device.Metadata
+		}
+	case domain.FleetKind:
+		var fleet *domain.Fleet
+		if fleet, status = t.serviceHandler.GetFleet(ctx, orgID, ref.Name, domain.GetFleetParams{}); fleet != nil {
+			metadata = &fleet.Metadata
+		}
+	case domain.RepositoryKind:
+		var repository *domain.Repository
+		if repository, status = t.serviceHandler.GetRepository(ctx, orgID, ref.Name); repository != nil {
+			metadata = &repository.Metadata
+		}
+	case domain.ResourceSyncKind:
+		var resourceSync *domain.ResourceSync
+		if resourceSync, status = t.serviceHandler.GetResourceSync(ctx, orgID, ref.Name); resourceSync != nil {
+			metadata = &resourceSync.Metadata
+		}
+	default:
+		return nil, nil
+	}
+
+	switch status.Code {
+	case http.StatusOK:
+		return lo.FromPtr(metadata.Labels), nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("failed to get %s %s: %s", ref.Kind, ref.Name, status.Message)
+	}
+}
+
+func (t *NotificationDispatch) enqueue(ctx context.Context, orgID uuid.UUID, sinkName string, event domain.Event) error {
+	payload, err := json.Marshal(NotificationDelivery{
+		OrgId: orgID,
+		Sink:  sinkName,
+		Event: event,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification delivery: %w", err)
+	}
+	timestamp := time.Now().UnixMicro()
+	if event.Metadata.CreationTimestamp != nil {
+		timestamp = event.Metadata.CreationTimestamp.UnixMicro()
+	}
+	if err := t.publisher.Enqueue(ctx, payload, timestamp); err != nil {
+		return fmt.Errorf("failed to enqueue notification delivery: %w", err)
+	}
+	return nil
+}
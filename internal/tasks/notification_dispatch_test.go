@@ -0,0 +1,187 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestNotificationDispatch(t *testing.T) {
+	okStatus := domain.Status{Code: http.StatusOK}
+	orgId := uuid.New()
+	since := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	until := since.Add(NotificationDispatchPollingInterval)
+	marshal := func(checkpoint notificationDispatchCheckpoint) []byte {
+		data, err := json.Marshal(checkpoint)
+		require.NoError(t, err)
+		return data
+	}
+	checkpoint := marshal(notificationDispatchCheckpoint{Timestamp: since})
+	newCheckpoint := marshal(notificationDispatchCheckpoint{Timestamp: until})
+
+	sink := func(name string, filter *domain.NotificationSinkFilter) domain.NotificationSink {
+		return domain.NotificationSink{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr(name)},
+			Spec:     domain.NotificationSinkSpec{Type: domain.NotificationSinkTypeWebhook, Filter: filter},
+		}
+	}
+	event := func(name string, reason domain.EventReason, kind, objectName string) domain.Event {
+		return domain.Event{
+			Metadata:       domain.ObjectMeta{Name: lo.ToPtr(name), CreationTimestamp: lo.ToPtr(since.Add(time.Second))},
+			Reason:         reason,
+			InvolvedObject: domain.ObjectReference{Kind: kind, Name: objectName},
+		}
+	}
+	deliveries := func(t *testing.T, producer *queues.MockQueueProducer) *[]NotificationDelivery {
+		var enqueued []NotificationDelivery
+		producer.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, payload []byte, _ int64) error {
+			var delivery NotificationDelivery
+			require.NoError(t, json.Unmarshal(payload, &delivery))
+			enqueued = append(enqueued, delivery)
+			return nil
+		}).AnyTimes()
+		return &enqueued
+	}
+
+	t.Run("When there is no checkpoint it should only set the checkpoint", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+		producer := queues.NewMockQueueProducer(ctrl)
+
+		mockSvc.EXPECT().GetDatabaseTime(gomock.Any()).Return(until, okStatus)
+		mockSvc.EXPECT().GetCheckpoint(gomock.Any(), notificationDispatchCheckpointConsumer, orgId.String()).Return(nil, domain.StatusResourceNotFound("Checkpoint", "notification-dispatch"))
+		mockSvc.EXPECT().SetCheckpoint(gomock.Any(), notificationDispatchCheckpointConsumer, orgId.String(), newCheckpoint).Return(okStatus)
+
+		NewNotificationDispatch(logrus.New(), mockSvc, producer).Poll(context.Background(), orgId)
+	})
+
+	t.Run("When there are no sinks it should advance the checkpoint without listing events", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+		producer := queues.NewMockQueueProducer(ctrl)
+
+		mockSvc.EXPECT().GetDatabaseTime(gomock.Any()).Return(until, okStatus)
+		mockSvc.EXPECT().GetCheckpoint(gomock.Any(), notificationDispatchCheckpointConsumer, orgId.String()).Return(checkpoint, okStatus)
+		mockSvc.EXPECT().ListNotificationSinks(gomock.Any(), orgId, gomock.Any()).Return(&domain.NotificationSinkList{}, okStatus)
+		mockSvc.EXPECT().SetCheckpoint(gomock.Any(), notificationDispatchCheckpointConsumer, orgId.String(), newCheckpoint).Return(okStatus)
+
+		NewNotificationDispatch(logrus.New(), mockSvc, producer).Poll(context.Background(), orgId)
+	})
+
+	t.Run("When events match sinks it should enqueue a delivery per matching sink", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+		producer := queues.NewMockQueueProducer(ctrl)
+		enqueued := deliveries(t, producer)
+
+		mockSvc.EXPECT().GetDatabaseTime(gomock.Any()).Return(until, okStatus)
+		mockSvc.EXPECT().GetCheckpoint(gomock.Any(), notificationDispatchCheckpointConsumer, orgId.String()).Return(checkpoint, okStatus)
+		mockSvc.EXPECT().ListNotificationSinks(gomock.Any(), orgId, gomock.Any()).Return(&domain.NotificationSinkList{
+			Items: []domain.NotificationSink{
+				sink("all", nil),
+				sink("disconnects", &domain.NotificationSinkFilter{Reasons: &[]domain.EventReason{domain.EventReasonDeviceDisconnected}}),
+				sink("site-a", &domain.NotificationSinkFilter{LabelSelector: &domain.LabelSelector{MatchLabels: &map[string]string{"site": "a"}}}),
+			},
+		}, okStatus)
+		mockSvc.EXPECT().ListEvents(gomock.Any(), orgId, gomock.Any()).DoAndReturn(func(_ context.Context, _ uuid.UUID, params domain.ListEventsParams) (*domain.EventList, domain.Status) {
+			require.Equal(t, "metadata.creationTimestamp>="+since.Format(time.RFC3339Nano)+",metadata.creationTimestamp<"+until.Format(time.RFC3339Nano), lo.FromPtr(params.FieldSelector))
+			return &domain.EventList{Items: []domain.Event{
+				event("e1", domain.EventReasonDeviceDisconnected, domain.DeviceKind, "dev1"),
+				event("e2", domain.EventReasonDeviceConnected, domain.DeviceKind, "dev1"),
+				event("e3", domain.EventReasonDeviceDisconnected, domain.DeviceKind, "dev2"),
+				event("e4", domain.EventReasonNotificationDeliveryFailed, domain.NotificationSinkKind, "all"),
+			}}, okStatus
+		})
+		// The labels of an involved object are looked up once per run
+		mockSvc.EXPECT().GetDevice(gomock.Any(), orgId, "dev1").Return(&domain.Device{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("dev1"), Labels: &map[string]string{"site": "a"}},
+		}, okStatus)
+		mockSvc.EXPECT().GetDevice(gomock.Any(), orgId, "dev2").Return(nil, domain.StatusResourceNotFound(domain.DeviceKind, "dev2"))
+		// The run is within the trailing window of the checkpoint, so the position stays and the events are skipped
+		mockSvc.EXPECT().SetCheckpoint(gomock.Any(), notificationDispatchCheckpointConsumer, orgId.String(),
+			marshal(notificationDispatchCheckpoint{Timestamp: since, Dispatched: []string{"e1", "e2", "e3", "e4"}})).Return(okStatus)
+
+		NewNotificationDispatch(logrus.New(), mockSvc, producer).Poll(context.Background(), orgId)
+
+		got := lo.Map(*enqueued, func(d NotificationDelivery, _ int) string { return lo.FromPtr(d.Event.Metadata.Name) + "->" + d.Sink })
+		require.Equal(t, []string{"e1->all", "e1->disconnects", "e1->site-a", "e2->all", "e2->site-a", "e3->all", "e3->disconnects"}, got)
+		require.Equal(t, orgId, (*enqueued)[0].OrgId)
+	})
+
+	t.Run("When a delivery cannot be enqueued it should not advance the checkpoint", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+		producer := queues.NewMockQueueProducer(ctrl)
+
+		mockSvc.EXPECT().GetDatabaseTime(gomock.Any()).Return(until, okStatus)
+		mockSvc.EXPECT().GetCheckpoint(gomock.Any(), notificationDispatchCheckpointConsumer, orgId.String()).Return(checkpoint, okStatus)
+		mockSvc.EXPECT().ListNotificationSinks(gomock.Any(), orgId, gomock.Any()).Return(&domain.NotificationSinkList{
+			Items: []domain.NotificationSink{sink("all", nil)},
+		}, okStatus)
+		mockSvc.EXPECT().ListEvents(gomock.Any(), orgId, gomock.Any()).Return(&domain.EventList{Items: []domain.Event{
+			event("e1", domain.EventReasonDeviceDisconnected, domain.DeviceKind, "dev1"),
+		}}, okStatus)
+		producer.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("queue unavailable"))
+
+		NewNotificationDispatch(logrus.New(), mockSvc, producer).Poll(context.Background(), orgId)
+	})
+
+	t.Run("When an event becomes visible late it should be dispatched once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+		producer := queues.NewMockQueueProducer(ctrl)
+		enqueued := deliveries(t, producer)
+
+		first := since.Add(2 * notificationLateEventWindow)
+		second := first.Add(NotificationDispatchPollingInterval)
+		early := event("early", domain.EventReasonDeviceDisconnected, domain.DeviceKind, "dev1")
+		early.Metadata.CreationTimestamp = lo.ToPtr(first.Add(-2 * time.Second))
+		late := event("late", domain.EventReasonDeviceDisconnected, domain.DeviceKind, "dev1")
+		late.Metadata.CreationTimestamp = lo.ToPtr(first.Add(-3 * time.Second))
+		afterFirst := marshal(notificationDispatchCheckpoint{Timestamp: first.Add(-notificationLateEventWindow), Dispatched: []string{"early"}})
+
+		mockSvc.EXPECT().ListNotificationSinks(gomock.Any(), orgId, gomock.Any()).Return(&domain.NotificationSinkList{
+			Items: []domain.NotificationSink{sink("all", nil)},
+		}, okStatus).Times(2)
+		gomock.InOrder(
+			// The first run only sees the event that was committed in time
+			mockSvc.EXPECT().GetDatabaseTime(gomock.Any()).Return(first, okStatus),
+			mockSvc.EXPECT().GetCheckpoint(gomock.Any(), notificationDispatchCheckpointConsumer, orgId.String()).Return(checkpoint, okStatus),
+			mockSvc.EXPECT().ListEvents(gomock.Any(), orgId, gomock.Any()).Return(&domain.EventList{Items: []domain.Event{early}}, okStatus),
+			mockSvc.EXPECT().SetCheckpoint(gomock.Any(), notificationDispatchCheckpointConsumer, orgId.String(), afterFirst).Return(okStatus),
+			// The second run reads the trailing window again and sees the event that was created earlier but committed late
+			mockSvc.EXPECT().GetDatabaseTime(gomock.Any()).Return(second, okStatus),
+			mockSvc.EXPECT().GetCheckpoint(gomock.Any(), notificationDispatchCheckpointConsumer, orgId.String()).Return(afterFirst, okStatus),
+			mockSvc.EXPECT().ListEvents(gomock.Any(), orgId, gomock.Any()).DoAndReturn(func(_ context.Context, _ uuid.UUID, params domain.ListEventsParams) (*domain.EventList, domain.Status) {
+				require.Equal(t, "metadata.creationTimestamp>="+first.Add(-notificationLateEventWindow).Format(time.RFC3339Nano)+",metadata.creationTimestamp<"+second.Format(time.RFC3339Nano), lo.FromPtr(params.FieldSelector))
+				return &domain.EventList{Items: []domain.Event{late, early}}, okStatus
+			}),
+			mockSvc.EXPECT().SetCheckpoint(gomock.Any(), notificationDispatchCheckpointConsumer, orgId.String(),
+				marshal(notificationDispatchCheckpoint{Timestamp: second.Add(-notificationLateEventWindow), Dispatched: []string{"late", "early"}})).Return(okStatus),
+		)
+
+		dispatch := NewNotificationDispatch(logrus.New(), mockSvc, producer)
+		dispatch.Poll(context.Background(), orgId)
+		dispatch.Poll(context.Background(), orgId)
+
+		got := lo.Map(*enqueued, func(d NotificationDelivery, _ int) string { return lo.FromPtr(d.Event.Metadata.Name) + "->" + d.Sink })
+		require.Equal(t, []string{"early->all", "late->all"}, got)
+	})
+}
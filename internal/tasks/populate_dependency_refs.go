@@ -206,6 +206,30 @@ func collectConfigRefs(log logrus.FieldLogger, config *[]domain.ConfigProviderSp
 				SecretNamespace:    &k8sSpec.SecretRef.Namespace,
 				ConfigProviderName: k8sSpec.Name,
 			}
+		case domain.SecretProviderType:
+			secretSpec, err := configItem.AsSecretProviderSpec()
+			if err != nil {
+				log.WithError(err).Warn("skipping secret config item that failed to decode")
+				continue
+			}
+			if isParameterized(secretSpec.Secret.Name) {
+				continue
+			}
+			warnDuplicateConfigName(log, seenNames, warnedNames, secretSpec.Name, i, fleetName, deviceName)
+			key := secretResourceKey(secretSpec.Secret.Name)
+			if _, exists := refs[key]; exists {
+				continue
+			}
+			fn := fleetName
+			dn := deviceName
+			refs[key] = model.DependencyRef{
+				FleetName:          &fn,
+				DeviceName:         &dn,
+				RefType:            secretResourceRefType,
+				ResourceKey:        key,
+				SecretName:         &secretSpec.Secret.Name,
+				ConfigProviderName: secretSpec.Name,
+			}
 		}
 	}
 
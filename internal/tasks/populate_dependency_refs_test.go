@@ -673,6 +673,30 @@ func TestCollectConfigRefs_DeduplicatesByResourceKey(t *testing.T) {
 		assert.Equal(t, "secret:prod/db-creds", refs[0].ResourceKey)
 	})
 
+	t.Run("When two configs reference the same Secret resource it should produce one ref", func(t *testing.T) {
+		config := &[]domain.ConfigProviderSpec{
+			makeSecretResourceConfigItem(t, "secret-a", "db-creds"),
+			makeSecretResourceConfigItem(t, "secret-b", "db-creds"),
+		}
+
+		refs := collectConfigRefs(logrus.New(), config, testFleet, "")
+
+		require.Len(t, refs, 1)
+		assert.Equal(t, "flightctlsecret:db-creds", refs[0].ResourceKey)
+		assert.Equal(t, secretResourceRefType, refs[0].RefType)
+		assert.Equal(t, "db-creds", lo.FromPtr(refs[0].SecretName))
+	})
+
+	t.Run("When a Secret resource name is parameterized it should produce no ref", func(t *testing.T) {
+		config := &[]domain.ConfigProviderSpec{
+			makeSecretResourceConfigItem(t, "secret-a", "{{ .metadata.name }}-creds"),
+		}
+
+		refs := collectConfigRefs(logrus.New(), config, testFleet, "")
+
+		assert.Empty(t, refs)
+	})
+
 	t.Run("When configs have distinct resource keys it should produce all refs", func(t *testing.T) {
 		config := &[]domain.ConfigProviderSpec{
 			makeGitConfigItem(t, "cfg-a", "repo-a", "main"),
@@ -11,6 +11,7 @@ import (
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/instrumentation/metrics/worker"
 	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/worker_client"
 	"github.com/flightctl/flightctl/pkg/queues"
 	"github.com/google/uuid"
@@ -67,6 +68,11 @@ func (t *QueueMaintenanceTask) Execute(ctx context.Context) error {
 		log.Debug("No failed messages to retry")
 	}
 
+	// Notification deliveries are tracked in flight like tasks, so they must be retried or failed out as well
+	if err := t.maintainNotificationQueue(ctx, log); err != nil {
+		log.WithError(err).Error("Failed to maintain notification queue")
+	}
+
 	// Advance checkpoint based on in-flight task tracking and handle Redis failure recovery
 	if err := t.advanceCheckpoint(ctx, log); err != nil {
 		log.WithError(err).Error("Failed to advance checkpoint")
@@ -176,6 +182,46 @@ func (t *QueueMaintenanceTask) retryFailedMessages(ctx context.Context, log logr
 	})
 }
 
+// maintainNotificationQueue moves timed out notification deliveries to the failed messages, retries failed
+// deliveries, and emits a NotificationDeliveryFailed event for deliveries that permanently failed
+func (t *QueueMaintenanceTask) maintainNotificationQueue(ctx context.Context, log logrus.FieldLogger) error {
+	log = log.WithField("queue", consts.NotificationQueue)
+
+	timeoutCount, err := t.queuesProvider.ProcessTimedOutMessages(ctx, consts.NotificationQueue, EventProcessingTimeout, func(entryID string, body []byte) error {
+		log.WithField("entryID", entryID).Debug("Processing timed out notification delivery")
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to process timed out notification deliveries: %w", err)
+	}
+	if timeoutCount > 0 {
+		log.WithField("timeoutCount", timeoutCount).Info("Processed timed out notification deliveries")
+	}
+
+	retryCount, err := t.queuesProvider.RetryFailedMessages(ctx, consts.NotificationQueue, queues.DefaultRetryConfig(), func(entryID string, body []byte, retryCount int) error {
+		var delivery NotificationDelivery
+		if err := json.Unmarshal(body, &delivery); err != nil {
+			log.WithField("entryID", entryID).WithError(err).Error("Failed to parse permanently failed notification delivery, skipping event emission")
+			return nil
+		}
+		errorMessage := fmt.Sprintf("delivery permanently failed after %d retry attempts", retryCount)
+		t.serviceHandler.CreateEvent(ctx, delivery.OrgId, common.GetNotificationDeliveryFailedEvent(ctx, delivery.Sink, delivery.Event, errorMessage))
+
+		log.WithField("entryID", entryID).
+			WithField("sink", delivery.Sink).
+			WithField("retryCount", retryCount).
+			Info("Emitted NotificationDeliveryFailed event for permanently failed notification delivery")
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to retry failed notification deliveries: %w", err)
+	}
+	if retryCount > 0 {
+		log.WithField("retryCount", retryCount).Info("Retried failed notification deliveries")
+	}
+	return nil
+}
+
 // advanceCheckpoint advances the global checkpoint based on in-flight task completion tracking
 func (t *QueueMaintenanceTask) advanceCheckpoint(ctx context.Context, log logrus.FieldLogger) error {
 	// Use atomic operation to scan in-flight tasks, advance checkpoint, and cleanup completed tasks
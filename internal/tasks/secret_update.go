@@ -0,0 +1,108 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// secretResourceRefType is the dependency ref type of Secret resources, as opposed to the "secret" type of
+// Kubernetes secrets.
+const secretResourceRefType = "flightctlsecret"
+
+func secretResourceKey(name string) string {
+	return fmt.Sprintf("%s:%s", secretResourceRefType, name)
+}
+
+// The secretUpdate task is triggered when a Secret resource is created or its data changes. It emits a
+// DependencyChangeDetected event for every fleet and device that references the secret, so fleets roll
+// out a new template version and devices are rendered again with the new values.
+//
+// The fingerprint of the events is the resource version of the secret, so re-executing the task for the
+// same change does not create further template versions.
+func secretUpdate(ctx context.Context, orgId uuid.UUID, event domain.Event, serviceHandler service.Service, log logrus.FieldLogger) error {
+	logic := NewSecretUpdateLogic(log, serviceHandler, orgId, event)
+
+	if err := logic.HandleSecretUpdate(ctx); err != nil {
+		log.Errorf("failed to notify associated resources of update to secret %s/%s: %v", orgId, event.InvolvedObject.Name, err)
+		return err
+	}
+
+	return nil
+}
+
+type SecretUpdateLogic struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+	orgId          uuid.UUID
+	event          domain.Event
+}
+
+func NewSecretUpdateLogic(log logrus.FieldLogger, serviceHandler service.Service, orgId uuid.UUID, event domain.Event) SecretUpdateLogic {
+	return SecretUpdateLogic{log: log, serviceHandler: serviceHandler, orgId: orgId, event: event}
+}
+
+func (t *SecretUpdateLogic) HandleSecretUpdate(ctx context.Context) error {
+	name := t.event.InvolvedObject.Name
+	secret, status := t.serviceHandler.GetSecret(ctx, t.orgId, name)
+	if status.Code == http.StatusNotFound {
+		// the secret was deleted in the meantime, the referers fail when they are rendered next
+		return nil
+	}
+	if status.Code != http.StatusOK {
+		return fmt.Errorf("fetching secret: %s", status.Message)
+	}
+	fingerprint := lo.FromPtr(secret.Metadata.ResourceVersion)
+
+	refs, status := t.serviceHandler.ListDependencyRefsByRefType(ctx, t.orgId, secretResourceRefType)
+	if status.Code != http.StatusOK {
+		return fmt.Errorf("listing dependency refs: %s", status.Message)
+	}
+
+	resourceKey := secretResourceKey(name)
+	notified := make(map[string]struct{})
+	for _, ref := range refs {
+		if lo.FromPtr(ref.SecretName) != name {
+			continue
+		}
+		var kind domain.ResourceKind = domain.FleetKind
+		targetName := lo.FromPtr(ref.FleetName)
+		if lo.FromPtr(ref.DeviceName) != "" {
+			kind = domain.DeviceKind
+			targetName = lo.FromPtr(ref.DeviceName)
+		}
+		target := fmt.Sprintf("%s/%s", kind, targetName)
+		if _, ok := notified[target]; ok {
+			continue
+		}
+		notified[target] = struct{}{}
+
+		event := common.GetDependencyChangeDetectedEvent(ctx, kind, targetName, resourceKey, fingerprint)
+		if event != nil {
+			t.serviceHandler.CreateEvent(ctx, t.orgId, event)
+		}
+	}
+
+	return nil
+}
+
+func shouldUpdateSecretReferers(ctx context.Context, event domain.Event, log logrus.FieldLogger) bool {
+	if event.InvolvedObject.Kind != domain.SecretKind {
+		return false
+	}
+
+	// If the data of a secret was updated, return true
+	if event.Reason == domain.EventReasonResourceUpdated {
+		return hasUpdatedFields(event.Details, log, domain.Spec)
+	}
+
+	// If a secret was created, return true
+	return event.Reason == domain.EventReasonResourceCreated
+}
@@ -0,0 +1,79 @@
+package tasks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestSecretUpdate(t *testing.T) {
+	okStatus := domain.Status{Code: http.StatusOK}
+	orgId := uuid.New()
+	secretName := "db-creds"
+	event := createTestEvent(domain.SecretKind, domain.EventReasonResourceUpdated, secretName)
+
+	t.Run("When referers exist it should notify each fleet and device once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+
+		secret := &domain.Secret{
+			Metadata: domain.ObjectMeta{Name: &secretName, ResourceVersion: lo.ToPtr("7")},
+			Spec:     domain.SecretSpec{Data: map[string]string{"password": "hunter2"}},
+		}
+		mockSvc.EXPECT().GetSecret(gomock.Any(), orgId, secretName).Return(secret, okStatus)
+
+		ref := func(fleetName, deviceName, name string) model.DependencyRef {
+			return model.DependencyRef{
+				FleetName:   lo.ToPtr(fleetName),
+				DeviceName:  lo.ToPtr(deviceName),
+				RefType:     secretResourceRefType,
+				ResourceKey: secretResourceKey(name),
+				SecretName:  lo.ToPtr(name),
+			}
+		}
+		refs := []model.DependencyRef{
+			ref("fleet1", "", secretName),
+			ref("fleet1", "", secretName),
+			ref("fleet1", "device1", secretName),
+			ref("", "device2", secretName),
+			ref("fleet2", "", "other-secret"),
+		}
+		mockSvc.EXPECT().ListDependencyRefsByRefType(gomock.Any(), orgId, secretResourceRefType).Return(refs, okStatus)
+
+		var events []*domain.Event
+		mockSvc.EXPECT().CreateEvent(gomock.Any(), orgId, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, e *domain.Event) { events = append(events, e) },
+		).Times(3)
+
+		require.NoError(t, secretUpdate(context.Background(), orgId, event, mockSvc, logrus.New()))
+
+		targets := make([]string, 0, len(events))
+		for _, e := range events {
+			assert.Equal(t, domain.EventReasonDependencyChangeDetected, e.Reason)
+			assert.NotContains(t, e.Message, "hunter2")
+			targets = append(targets, string(e.InvolvedObject.Kind)+"/"+e.InvolvedObject.Name)
+		}
+		assert.ElementsMatch(t, []string{"Fleet/fleet1", "Device/device1", "Device/device2"}, targets)
+	})
+
+	t.Run("When the secret no longer exists it should do nothing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+
+		mockSvc.EXPECT().GetSecret(gomock.Any(), orgId, secretName).Return(nil, domain.StatusResourceNotFound(domain.SecretKind, secretName))
+
+		require.NoError(t, secretUpdate(context.Background(), orgId, event, mockSvc, logrus.New()))
+	})
+}
@@ -0,0 +1,193 @@
+package tasks
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// StaleRequestCleanupPollingInterval is the interval at which the stale request cleanup task runs.
+	StaleRequestCleanupPollingInterval = 10 * time.Minute
+	StaleRequestCleanupTaskName        = "stale-request-cleanup"
+
+	// ExpiredRequestDeletionDelay is how long an expired request is kept after it was denied, so that
+	// a device still waiting for approval learns that its request was denied before it is deleted.
+	ExpiredRequestDeletionDelay = time.Hour
+)
+
+// StaleRequestCleanup denies enrollment requests and certificate signing requests that stay pending
+// for longer than their TTL, and deletes them once they have been denied for ExpiredRequestDeletionDelay.
+type StaleRequestCleanup struct {
+	log                          logrus.FieldLogger
+	serviceHandler               service.Service
+	enrollmentRequestTTL         time.Duration
+	certificateSigningRequestTTL time.Duration
+}
+
+func NewStaleRequestCleanup(log logrus.FieldLogger, serviceHandler service.Service, enrollmentRequestTTL, certificateSigningRequestTTL util.Duration) *StaleRequestCleanup {
+	return &StaleRequestCleanup{
+		log:                          log,
+		serviceHandler:               serviceHandler,
+		enrollmentRequestTTL:         time.Duration(enrollmentRequestTTL),
+		certificateSigningRequestTTL: time.Duration(certificateSigningRequestTTL),
+	}
+}
+
+// Poll expires and deletes stale enrollment and certificate signing requests of the organization.
+// A TTL of 0 disables the cleanup of the respective requests.
+func (t *StaleRequestCleanup) Poll(ctx context.Context, orgID uuid.UUID) {
+	t.log.Infof("Running StaleRequestCleanup Polling (enrollment request TTL: %s, certificate signing request TTL: %s)", t.enrollmentRequestTTL, t.certificateSigningRequestTTL)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	now := time.Now()
+	if t.enrollmentRequestTTL > 0 {
+		t.cleanupEnrollmentRequests(ctx, orgID, now)
+	}
+	if t.certificateSigningRequestTTL > 0 {
+		t.cleanupCertificateSigningRequests(ctx, orgID, now)
+	}
+}
+
+func (t *StaleRequestCleanup) cleanupEnrollmentRequests(ctx context.Context, orgID uuid.UUID, now time.Time) {
+	listParams := domain.ListEnrollmentRequestsParams{
+		Limit: lo.ToPtr(int32(ItemsPerPage)),
+	}
+
+	expired, deleted := 0, 0
+	for {
+		if ctx.Err() != nil {
+			t.log.Warn("Context cancelled during enrollment request cleanup, stopping early")
+			return
+		}
+
+		enrollmentRequests, status := t.serviceHandler.ListEnrollmentRequests(ctx, orgID, listParams)
+		if status.Code != http.StatusOK {
+			t.log.Errorf("Failed to list enrollment requests: %s", status.Message)
+			return
+		}
+
+		for _, er := range enrollmentRequests.Items {
+			name := lo.FromPtr(er.Metadata.Name)
+			var conditions []domain.Condition
+			if er.Status != nil {
+				conditions = er.Status.Conditions
+			}
+
+			switch staleRequestAction(er.Metadata, conditions, domain.ConditionTypeEnrollmentRequestApproved, domain.ConditionTypeEnrollmentRequestDenied, t.enrollmentRequestTTL, now) {
+			case staleRequestExpire:
+				if status := t.serviceHandler.ExpireEnrollmentRequest(ctx, orgID, name, t.enrollmentRequestTTL); status.Code != http.StatusOK {
+					t.log.Errorf("Failed to expire enrollment request %s: %s", name, status.Message)
+					continue
+				}
+				expired++
+			case staleRequestDelete:
+				if status := t.serviceHandler.DeleteEnrollmentRequest(ctx, orgID, name); status.Code != http.StatusOK {
+					t.log.Errorf("Failed to delete expired enrollment request %s: %s", name, status.Message)
+					continue
+				}
+				deleted++
+			}
+		}
+
+		if enrollmentRequests.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = enrollmentRequests.Metadata.Continue
+	}
+
+	if expired > 0 || deleted > 0 {
+		t.log.Infof("Expired %d and deleted %d stale enrollment requests", expired, deleted)
+	}
+}
+
+func (t *StaleRequestCleanup) cleanupCertificateSigningRequests(ctx context.Context, orgID uuid.UUID, now time.Time) {
+	listParams := domain.ListCertificateSigningRequestsParams{
+		Limit: lo.ToPtr(int32(ItemsPerPage)),
+	}
+
+	expired, deleted := 0, 0
+	for {
+		if ctx.Err() != nil {
+			t.log.Warn("Context cancelled during certificate signing request cleanup, stopping early")
+			return
+		}
+
+		csrs, status := t.serviceHandler.ListCertificateSigningRequests(ctx, orgID, listParams)
+		if status.Code != http.StatusOK {
+			t.log.Errorf("Failed to list certificate signing requests: %s", status.Message)
+			return
+		}
+
+		for _, csr := range csrs.Items {
+			name := lo.FromPtr(csr.Metadata.Name)
+			var conditions []domain.Condition
+			if csr.Status != nil {
+				conditions = csr.Status.Conditions
+			}
+			// requests that failed to be signed are left to the user, like approved ones
+			if domain.IsStatusConditionTrue(conditions, domain.ConditionTypeCertificateSigningRequestFailed) {
+				continue
+			}
+
+			switch staleRequestAction(csr.Metadata, conditions, domain.ConditionTypeCertificateSigningRequestApproved, domain.ConditionTypeCertificateSigningRequestDenied, t.certificateSigningRequestTTL, now) {
+			case staleRequestExpire:
+				if status := t.serviceHandler.ExpireCertificateSigningRequest(ctx, orgID, name, t.certificateSigningRequestTTL); status.Code != http.StatusOK {
+					t.log.Errorf("Failed to expire certificate signing request %s: %s", name, status.Message)
+					continue
+				}
+				expired++
+			case staleRequestDelete:
+				if status := t.serviceHandler.DeleteCertificateSigningRequest(ctx, orgID, name); status.Code != http.StatusOK {
+					t.log.Errorf("Failed to delete expired certificate signing request %s: %s", name, status.Message)
+					continue
+				}
+				deleted++
+			}
+		}
+
+		if csrs.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = csrs.Metadata.Continue
+	}
+
+	if expired > 0 || deleted > 0 {
+		t.log.Infof("Expired %d and deleted %d stale certificate signing requests", expired, deleted)
+	}
+}
+
+type staleRequestCleanupAction int
+
+const (
+	staleRequestKeep staleRequestCleanupAction = iota
+	staleRequestExpire
+	staleRequestDelete
+)
+
+// staleRequestAction returns whether a request with the given conditions is kept, expired because it is
+// pending for longer than the TTL, or deleted because it expired more than ExpiredRequestDeletionDelay ago.
+// Requests that were approved or denied for another reason are kept.
+func staleRequestAction(meta domain.ObjectMeta, conditions []domain.Condition, approvedType, deniedType domain.ConditionType, ttl time.Duration, now time.Time) staleRequestCleanupAction {
+	if domain.IsStatusConditionTrue(conditions, approvedType) {
+		return staleRequestKeep
+	}
+	if denied := domain.FindStatusCondition(conditions, deniedType); denied != nil && denied.Status == domain.ConditionStatusTrue {
+		if denied.Reason == domain.RequestExpiredReason && now.Sub(denied.LastTransitionTime) >= ExpiredRequestDeletionDelay {
+			return staleRequestDelete
+		}
+		return staleRequestKeep
+	}
+	if meta.CreationTimestamp != nil && now.Sub(*meta.CreationTimestamp) >= ttl {
+		return staleRequestExpire
+	}
+	return staleRequestKeep
+}
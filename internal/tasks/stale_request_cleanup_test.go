@@ -0,0 +1,76 @@
+package tasks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestStaleRequestCleanup(t *testing.T) {
+	okStatus := domain.Status{Code: http.StatusOK}
+	orgId := uuid.New()
+	ttl := 24 * time.Hour
+	now := time.Now()
+
+	meta := func(name string, age time.Duration) domain.ObjectMeta {
+		return domain.ObjectMeta{Name: lo.ToPtr(name), CreationTimestamp: lo.ToPtr(now.Add(-age))}
+	}
+	condition := func(conditionType domain.ConditionType, reason string, age time.Duration) domain.Condition {
+		return domain.Condition{Type: conditionType, Status: domain.ConditionStatusTrue, Reason: reason, LastTransitionTime: now.Add(-age)}
+	}
+
+	t.Run("When enrollment requests are stale it should expire pending and delete expired ones", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+
+		er := func(name string, age time.Duration, conditions ...domain.Condition) domain.EnrollmentRequest {
+			return domain.EnrollmentRequest{Metadata: meta(name, age), Status: &domain.EnrollmentRequestStatus{Conditions: conditions}}
+		}
+		mockSvc.EXPECT().ListEnrollmentRequests(gomock.Any(), orgId, gomock.Any()).Return(&domain.EnrollmentRequestList{
+			Items: []domain.EnrollmentRequest{
+				er("recent", time.Hour),
+				er("stale", 2*ttl),
+				er("approved", 2*ttl, condition(domain.ConditionTypeEnrollmentRequestApproved, "ManuallyApproved", ttl)),
+				er("just-expired", 2*ttl, condition(domain.ConditionTypeEnrollmentRequestDenied, domain.RequestExpiredReason, time.Minute)),
+				er("expired", 2*ttl, condition(domain.ConditionTypeEnrollmentRequestDenied, domain.RequestExpiredReason, 2*ExpiredRequestDeletionDelay)),
+			},
+		}, okStatus)
+		mockSvc.EXPECT().ExpireEnrollmentRequest(gomock.Any(), orgId, "stale", ttl).Return(okStatus)
+		mockSvc.EXPECT().DeleteEnrollmentRequest(gomock.Any(), orgId, "expired").Return(okStatus)
+
+		NewStaleRequestCleanup(logrus.New(), mockSvc, util.Duration(ttl), 0).Poll(context.Background(), orgId)
+	})
+
+	t.Run("When certificate signing requests are stale it should expire pending and delete expired ones", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := service.NewMockService(ctrl)
+
+		csr := func(name string, age time.Duration, conditions ...domain.Condition) domain.CertificateSigningRequest {
+			return domain.CertificateSigningRequest{Metadata: meta(name, age), Status: &domain.CertificateSigningRequestStatus{Conditions: conditions}}
+		}
+		mockSvc.EXPECT().ListCertificateSigningRequests(gomock.Any(), orgId, gomock.Any()).Return(&domain.CertificateSigningRequestList{
+			Items: []domain.CertificateSigningRequest{
+				csr("recent", time.Hour),
+				csr("stale", 2*ttl),
+				csr("failed", 2*ttl, condition(domain.ConditionTypeCertificateSigningRequestFailed, "SigningFailed", ttl)),
+				csr("denied", 2*ttl, condition(domain.ConditionTypeCertificateSigningRequestDenied, "Denied", 2*ExpiredRequestDeletionDelay)),
+				csr("expired", 2*ttl, condition(domain.ConditionTypeCertificateSigningRequestDenied, domain.RequestExpiredReason, 2*ExpiredRequestDeletionDelay)),
+			},
+		}, okStatus)
+		mockSvc.EXPECT().ExpireCertificateSigningRequest(gomock.Any(), orgId, "stale", ttl).Return(okStatus)
+		mockSvc.EXPECT().DeleteCertificateSigningRequest(gomock.Any(), orgId, "expired").Return(okStatus)
+
+		NewStaleRequestCleanup(logrus.New(), mockSvc, 0, util.Duration(ttl)).Poll(context.Background(), orgId)
+	})
+}
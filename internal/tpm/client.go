@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto"
+	"crypto/ecdh"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/rsa"
@@ -359,6 +360,51 @@ func (c *client) SolveChallenge(credentialBlob, encryptedSecret []byte) ([]byte,
 	return c.session.SolveChallenge(credentialBlob, encryptedSecret)
 }
 
+// KeyAgreement returns the TPM-bound decryption key that content delivered to the device is encrypted to.
+// The key is created on first use.
+func (c *client) KeyAgreement() (fccrypto.KeyAgreement, error) {
+	pub, err := c.session.GetPublicKey(Decryption)
+	if err != nil {
+		return nil, fmt.Errorf("getting decryption public key: %w", err)
+	}
+	pubKey, err := convertTPM2BPublicToPublicKey(pub)
+	if err != nil {
+		return nil, fmt.Errorf("converting decryption public key: %w", err)
+	}
+	ecdsaPubKey, ok := pubKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("unexpected decryption public key type %T", pubKey)
+	}
+	ecdhPubKey, err := ecdsaPubKey.ECDH()
+	if err != nil {
+		return nil, fmt.Errorf("converting decryption public key: %w", err)
+	}
+	return &keyAgreement{session: c.session, publicKey: ecdhPubKey}, nil
+}
+
+// keyAgreement performs key agreement with the decryption key inside the TPM.
+type keyAgreement struct {
+	session   Session
+	publicKey *ecdh.PublicKey
+}
+
+func (k *keyAgreement) PublicKey() *ecdh.PublicKey {
+	return k.publicKey
+}
+
+func (k *keyAgreement) ECDH(remote *ecdh.PublicKey) ([]byte, error) {
+	if remote.Curve() != ecdh.P256() {
+		return nil, fmt.Errorf("remote key must be a P-256 key")
+	}
+	// the uncompressed encoding is 0x04 || X || Y
+	encoded := remote.Bytes()
+	size := (len(encoded) - 1) / 2
+	return k.session.ECDH(Decryption, tpm2.TPMSECCPoint{
+		X: tpm2.TPM2BECCParameter{Buffer: encoded[1 : 1+size]},
+		Y: tpm2.TPM2BECCParameter{Buffer: encoded[1+size:]},
+	})
+}
+
 // convertTPM2BPublicToECDSA converts a TPM2BPublic to a public key.
 func convertTPM2BPublicToPublicKey(pub *tpm2.TPM2BPublic) (crypto.PublicKey, error) {
 	outpub, err := pub.Contents()
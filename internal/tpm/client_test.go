@@ -20,6 +20,7 @@ import (
 
 	agent_config "github.com/flightctl/flightctl/internal/agent/config"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/google/go-tpm-tools/simulator"
 	legacy "github.com/google/go-tpm/legacy/tpm2"
@@ -1579,6 +1580,61 @@ func TestClient_CreateApplicationKeys(t *testing.T) {
 	}
 }
 
+func TestClient_KeyAgreement(t *testing.T) {
+	require := require.New(t)
+
+	sim, err := simulator.Get()
+	require.NoError(err)
+	defer sim.Close()
+
+	err = setupFakeRSAEKCertificate(sim)
+	require.NoError(err)
+
+	tmpDir := t.TempDir()
+	rw := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+	connFactory := func() (io.ReadWriteCloser, error) {
+		return simConn{sim: sim}, nil
+	}
+	config := &agent_config.Config{
+		TPM: agent_config.TPM{
+			Enabled:         true,
+			DevicePath:      agent_config.DefaultTPMDevicePath,
+			StorageFilePath: agent_config.DefaultTPMKeyFile,
+		},
+	}
+
+	c, err := newClientWithConnection(connFactory, log.NewPrefixLogger("test"), rw, config, "test-model", "test-serial")
+	require.NoError(err)
+
+	key, err := c.KeyAgreement()
+	require.NoError(err)
+
+	plaintext := []byte("hunter2")
+	path := []byte("/etc/app/password")
+	envelope, err := fccrypto.EncryptToPublicKey(key.PublicKey(), plaintext, path)
+	require.NoError(err)
+
+	decrypted, err := fccrypto.DecryptWithKeyAgreement(key, envelope, path)
+	require.NoError(err)
+	require.Equal(plaintext, decrypted)
+
+	// the key is persisted, so a new session agrees on the same secret
+	require.NoError(safeCloseSession(c.session))
+	c2, err := newClientWithConnection(connFactory, log.NewPrefixLogger("test"), rw, config, "test-model", "test-serial")
+	require.NoError(err)
+
+	key2, err := c2.KeyAgreement()
+	require.NoError(err)
+	require.True(key.PublicKey().Equal(key2.PublicKey()))
+
+	decrypted, err = fccrypto.DecryptWithKeyAgreement(key2, envelope, path)
+	require.NoError(err)
+	require.Equal(plaintext, decrypted)
+}
+
 // closes the session in a way that doesn't close the underlying connection so that it can be reused
 // for a testing purposes
 func safeCloseSession(session Session) error {
@@ -15,6 +15,7 @@ import (
 	io "io"
 	reflect "reflect"
 
+	crypto0 "github.com/flightctl/flightctl/pkg/crypto"
 	tpm2 "github.com/google/go-tpm/tpm2"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -100,6 +101,21 @@ func (mr *MockClientMockRecorder) GetSigner() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSigner", reflect.TypeOf((*MockClient)(nil).GetSigner))
 }
 
+// KeyAgreement mocks base method.
+func (m *MockClient) KeyAgreement() (crypto0.KeyAgreement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KeyAgreement")
+	ret0, _ := ret[0].(crypto0.KeyAgreement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// KeyAgreement indicates an expected call of KeyAgreement.
+func (mr *MockClientMockRecorder) KeyAgreement() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KeyAgreement", reflect.TypeOf((*MockClient)(nil).KeyAgreement))
+}
+
 // MakeCSR mocks base method.
 func (m *MockClient) MakeCSR(deviceName string, qualifyingData []byte) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -691,6 +707,21 @@ func (mr *MockSessionMockRecorder) CreateKey(keyType any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockSession)(nil).CreateKey), keyType)
 }
 
+// ECDH mocks base method.
+func (m *MockSession) ECDH(keyType KeyType, point tpm2.TPMSECCPoint) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECDH", keyType, point)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECDH indicates an expected call of ECDH.
+func (mr *MockSessionMockRecorder) ECDH(keyType, point any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECDH", reflect.TypeOf((*MockSession)(nil).ECDH), keyType, point)
+}
+
 // GenerateChallenge mocks base method.
 func (m *MockSession) GenerateChallenge(secret []byte) ([]byte, []byte, error) {
 	m.ctrl.T.Helper()
@@ -352,6 +352,64 @@ func (s *tpmSession) Sign(keyType KeyType, digest []byte) ([]byte, error) {
 	return signWithKey(transport.FromReadWriter(s.conn), authHandel, digest)
 }
 
+func (s *tpmSession) ECDH(keyType KeyType, point tpm2.TPMSECCPoint) ([]byte, error) {
+	var sharedSecret []byte
+	err := s.withKey(keyType, func(handle *tpm2.NamedHandle) error {
+		cmd := tpm2.ECDHZGen{
+			KeyHandle: tpm2.AuthHandle{
+				Handle: handle.Handle,
+				Name:   handle.Name,
+				Auth:   tpm2.PasswordAuth(nil),
+			},
+			InPoint: tpm2.New2B(point),
+		}
+		resp, err := cmd.Execute(transport.FromReadWriter(s.conn))
+		if err != nil {
+			return fmt.Errorf("ECDH_ZGen command failed: %w", err)
+		}
+
+		outPoint, err := resp.OutPoint.Contents()
+		if err != nil {
+			return fmt.Errorf("reading ECDH_ZGen output point: %w", err)
+		}
+		// the shared secret is the x-coordinate of the product
+		sharedSecret = outPoint.X.Buffer
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sharedSecret, nil
+}
+
+// withKey loads the key for the duration of fn. Keys that are only used occasionally, such as the decryption
+// key, are not kept loaded, as the transient space of a TPM may be too small for more than the SRK, the LDevID
+// and the LAK. Other keys are flushed to load them and are loaded again on demand afterwards.
+func (s *tpmSession) withKey(keyType KeyType, fn func(handle *tpm2.NamedHandle) error) error {
+	if keyType != Decryption {
+		handle, err := s.LoadKey(keyType)
+		if err != nil {
+			return fmt.Errorf("loading key: %w", err)
+		}
+		return fn(handle)
+	}
+
+	if errs := s.flushKeys(); len(errs) > 0 {
+		return fmt.Errorf("flushing keys to load %s key: %w", keyType, errors.Join(errs...))
+	}
+	defer func() {
+		if errs := s.flushKeys(); len(errs) > 0 {
+			s.log.Errorf("Error flushing keys after using %s key: %v", keyType, errors.Join(errs...))
+		}
+	}()
+
+	handle, err := s.LoadKey(keyType)
+	if err != nil {
+		return fmt.Errorf("loading key: %w", err)
+	}
+	return fn(handle)
+}
+
 func signWithKey(conn transport.TPM, handle tpm2.AuthHandle, digest []byte) ([]byte, error) {
 	cmd := tpm2.Sign{
 		KeyHandle: handle,
@@ -397,19 +455,21 @@ func ConvertTPMSignatureToDER(sig *tpm2.TPMTSignature) ([]byte, error) {
 }
 
 func (s *tpmSession) GetPublicKey(keyType KeyType) (*tpm2.TPM2B[tpm2.TPMTPublic, *tpm2.TPMTPublic], error) {
-	handle, err := s.LoadKey(keyType)
-	if err != nil {
-		return nil, fmt.Errorf("loading key: %w", err)
-	}
-
-	pub, err := tpm2.ReadPublic{
-		ObjectHandle: handle.Handle,
-	}.Execute(transport.FromReadWriter(s.conn))
+	var public *tpm2.TPM2BPublic
+	err := s.withKey(keyType, func(handle *tpm2.NamedHandle) error {
+		pub, err := tpm2.ReadPublic{
+			ObjectHandle: handle.Handle,
+		}.Execute(transport.FromReadWriter(s.conn))
+		if err != nil {
+			return fmt.Errorf("reading public key: %w", err)
+		}
+		public = &pub.OutPublic
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("reading public key: %w", err)
+		return nil, err
 	}
-
-	return &pub.OutPublic, nil
+	return public, nil
 }
 
 func (s *tpmSession) Clear() error {
@@ -523,7 +583,7 @@ func (s *tpmSession) flushKeys() []error {
 func (s *tpmSession) clearStoredKeys() error {
 	// Clear stored keys by removing them from storage
 	// This makes them unrecoverable even if TPM Clear failed
-	keyTypes := []KeyType{LDevID, LAK}
+	keyTypes := []KeyType{LDevID, LAK, Decryption}
 
 	for _, kt := range keyTypes {
 		_ = s.storage.ClearKey(kt)
@@ -935,6 +995,8 @@ func (s *tpmSession) getKeyTemplate(keyType KeyType) (tpm2.TPMTPublic, error) {
 		return AttestationKeyTemplate(s.keyAlgo)
 	case SRK:
 		return StorageKeyTemplate(s.keyAlgo)
+	case Decryption:
+		return DecryptionKeyTemplate(s.keyAlgo)
 	default:
 		return tpm2.TPMTPublic{}, fmt.Errorf("unsupported key type: %s", keyType)
 	}
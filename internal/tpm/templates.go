@@ -163,6 +163,49 @@ func AttestationKeyTemplate(keyAlgo KeyAlgorithm) (tpm2.TPMTPublic, error) {
 	}
 }
 
+// DecryptionKeyTemplate generates the template of an unrestricted ECC decryption key that is used for
+// key agreement with TPM2_ECDH_ZGen. Key agreement requires an ECC key, so only ECDSA is supported.
+func DecryptionKeyTemplate(keyAlgo KeyAlgorithm) (tpm2.TPMTPublic, error) {
+	if keyAlgo != ECDSA {
+		return tpm2.TPMTPublic{}, fmt.Errorf("unsupported key algorithm for decryption key: %s", keyAlgo)
+	}
+	return tpm2.TPMTPublic{
+		Type:    tpm2.TPMAlgECC,
+		NameAlg: tpm2.TPMAlgSHA256,
+		ObjectAttributes: tpm2.TPMAObject{
+			FixedTPM:            true,  // true = must stay in TPM
+			FixedParent:         true,  // true = can't be re-parented
+			SensitiveDataOrigin: true,  // true = TPM generates all sensitive data during creation
+			UserWithAuth:        true,  // true = pw or hmac can be used in addition to authpolicy
+			Restricted:          false, // true = can only decrypt data created by the TPM
+			Decrypt:             true,  // true = can be used to decrypt
+			SignEncrypt:         false, // true = for asymm, may be used to sign
+		},
+		Parameters: tpm2.NewTPMUPublicParms(
+			tpm2.TPMAlgECC,
+			&tpm2.TPMSECCParms{
+				Scheme: tpm2.TPMTECCScheme{
+					Scheme: tpm2.TPMAlgECDH,
+					Details: tpm2.NewTPMUAsymScheme(
+						tpm2.TPMAlgECDH,
+						&tpm2.TPMSKeySchemeECDH{
+							HashAlg: tpm2.TPMAlgSHA256,
+						},
+					),
+				},
+				CurveID: tpm2.TPMECCNistP256,
+			},
+		),
+		Unique: tpm2.NewTPMUPublicID(
+			tpm2.TPMAlgECC,
+			&tpm2.TPMSECCPoint{
+				X: tpm2.TPM2BECCParameter{Buffer: make([]byte, 32)},
+				Y: tpm2.TPM2BECCParameter{Buffer: make([]byte, 32)},
+			},
+		),
+	}, nil
+}
+
 func StorageKeyTemplate(keyAlgo KeyAlgorithm) (tpm2.TPMTPublic, error) {
 	switch keyAlgo {
 	case ECDSA:
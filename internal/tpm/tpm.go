@@ -5,6 +5,7 @@ import (
 	"crypto"
 	"regexp"
 
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	legacy "github.com/google/go-tpm/legacy/tpm2"
 	"github.com/google/go-tpm/tpm2"
 )
@@ -29,6 +30,8 @@ type Client interface {
 	VendorInfoCollector(ctx context.Context) string
 	// CreateApplicationKey generates a TCG CSR IDEVID bundle and a TSS2 PEM encoded file for the specified application
 	CreateApplicationKey(name string) ([]byte, []byte, error)
+	// KeyAgreement returns the TPM-bound key that content delivered to the device is encrypted to
+	KeyAgreement() (fccrypto.KeyAgreement, error)
 }
 
 const (
@@ -54,6 +57,10 @@ const (
 
 	// SRK (Storage Root Key) is a well-known, persistent primary key in the TPM's storage hierarchy.
 	SRK KeyType = "srk"
+
+	// Decryption is an unrestricted ECC decryption key used for key agreement, so that content
+	// delivered to the device can be encrypted to a key that never leaves the TPM.
+	Decryption KeyType = "decryption"
 )
 
 // KeyAlgorithm represents the cryptographic algorithm used for keys
@@ -138,6 +145,8 @@ type Session interface {
 	RemoveApplicationKey(appName string) error
 	// Sign signs data with the specified key
 	Sign(keyType KeyType, digest []byte) ([]byte, error)
+	// ECDH computes the shared secret of the specified key and the given public point
+	ECDH(keyType KeyType, point tpm2.TPMSECCPoint) ([]byte, error)
 	// GetPublicKey gets the public key for a key type
 	GetPublicKey(keyType KeyType) (*tpm2.TPM2BPublic, error)
 	// GetEndorsementKeyCert returns the endorsement key certificate
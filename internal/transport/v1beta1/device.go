@@ -25,6 +25,11 @@ func (h *TransportHandler) CreateDevice(w http.ResponseWriter, r *http.Request)
 // (GET /api/v1/devices)
 func (h *TransportHandler) ListDevices(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListDevicesParams) {
 	domainParams := h.converter.Device().ListParamsToDomain(params)
+	if params.Watch != nil && *params.Watch {
+		events, status := h.serviceHandler.WatchDevices(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+		h.SetWatchResponse(w, events, status)
+		return
+	}
 	body, status := h.serviceHandler.ListDevices(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams, nil)
 	apiResult := h.converter.Device().ListFromDomain(body)
 	h.SetResponse(w, apiResult, status)
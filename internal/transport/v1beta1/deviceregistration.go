@@ -0,0 +1,58 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (POST /api/v1/deviceregistrations)
+func (h *TransportHandler) CreateDeviceRegistration(w http.ResponseWriter, r *http.Request) {
+	var registration apiv1beta1.DeviceRegistration
+	if err := json.NewDecoder(r.Body).Decode(&registration); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainRegistration := h.converter.DeviceRegistration().ToDomain(registration)
+	body, status := h.serviceHandler.CreateDeviceRegistration(r.Context(), transport.OrgIDFromContext(r.Context()), domainRegistration)
+	apiResult := h.converter.DeviceRegistration().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/deviceregistrations)
+func (h *TransportHandler) ListDeviceRegistrations(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListDeviceRegistrationsParams) {
+	domainParams := h.converter.DeviceRegistration().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListDeviceRegistrations(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.DeviceRegistration().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/deviceregistrations/{name})
+func (h *TransportHandler) GetDeviceRegistration(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetDeviceRegistration(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.DeviceRegistration().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (PUT /api/v1/deviceregistrations/{name})
+func (h *TransportHandler) ReplaceDeviceRegistration(w http.ResponseWriter, r *http.Request, name string) {
+	var registration apiv1beta1.DeviceRegistration
+	if err := json.NewDecoder(r.Body).Decode(&registration); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainRegistration := h.converter.DeviceRegistration().ToDomain(registration)
+	body, status := h.serviceHandler.ReplaceDeviceRegistration(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainRegistration)
+	apiResult := h.converter.DeviceRegistration().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/deviceregistrations/{name})
+func (h *TransportHandler) DeleteDeviceRegistration(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteDeviceRegistration(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
@@ -10,6 +10,11 @@ import (
 // (GET /api/v1/events)
 func (h *TransportHandler) ListEvents(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListEventsParams) {
 	domainParams := h.converter.Event().ListParamsToDomain(params)
+	if params.Watch != nil && *params.Watch {
+		events, status := h.serviceHandler.WatchEvents(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+		h.SetWatchResponse(w, events, status)
+		return
+	}
 	body, status := h.serviceHandler.ListEvents(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
 	apiResult := h.converter.Event().ListFromDomain(body)
 	h.SetResponse(w, apiResult, status)
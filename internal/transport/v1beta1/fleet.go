@@ -25,6 +25,11 @@ func (h *TransportHandler) CreateFleet(w http.ResponseWriter, r *http.Request) {
 // (GET /api/v1/fleets)
 func (h *TransportHandler) ListFleets(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListFleetsParams) {
 	domainParams := h.converter.Fleet().ListParamsToDomain(params)
+	if params.Watch != nil && *params.Watch {
+		events, status := h.serviceHandler.WatchFleets(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+		h.SetWatchResponse(w, events, status)
+		return
+	}
 	body, status := h.serviceHandler.ListFleets(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
 	apiResult := h.converter.Fleet().ListFromDomain(body)
 	h.SetResponse(w, apiResult, status)
@@ -93,6 +98,48 @@ func (h *TransportHandler) PatchFleet(w http.ResponseWriter, r *http.Request, na
 	h.SetResponse(w, apiResult, status)
 }
 
+// (PUT /api/v1/fleets/{name}/approval)
+func (h *TransportHandler) ApproveFleetRolloutBatch(w http.ResponseWriter, r *http.Request, name string) {
+	var approval apiv1beta1.FleetRolloutBatchApproval
+	if err := json.NewDecoder(r.Body).Decode(&approval); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainApproval := h.converter.Fleet().BatchApprovalToDomain(approval)
+	body, status := h.serviceHandler.ApproveFleetRolloutBatch(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainApproval)
+	apiResult := h.converter.Fleet().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (PUT /api/v1/fleets/{name}/rollout)
+func (h *TransportHandler) ControlFleetRollout(w http.ResponseWriter, r *http.Request, name string) {
+	var control apiv1beta1.FleetRolloutControl
+	if err := json.NewDecoder(r.Body).Decode(&control); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainControl := h.converter.Fleet().RolloutControlToDomain(control)
+	body, status := h.serviceHandler.ControlFleetRollout(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainControl)
+	apiResult := h.converter.Fleet().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (POST /api/v1/fleets/{name}/rolloutpreview)
+func (h *TransportHandler) PreviewFleetRollout(w http.ResponseWriter, r *http.Request, name string) {
+	var spec apiv1beta1.FleetSpec
+	if err := json.NewDecoder(r.Body).Decode(&spec); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainSpec := h.converter.Fleet().SpecToDomain(spec)
+	body, status := h.serviceHandler.PreviewFleetRollout(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainSpec)
+	apiResult := h.converter.Fleet().RolloutPreviewFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
 // (PATCH /api/v1/fleets/{name}/status)
 func (h *TransportHandler) PatchFleetStatus(w http.ResponseWriter, r *http.Request, name string) {
 	status := apiv1beta1.StatusNotImplemented("not yet implemented")
@@ -0,0 +1,58 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (POST /api/v1/notificationsinks)
+func (h *TransportHandler) CreateNotificationSink(w http.ResponseWriter, r *http.Request) {
+	var sink apiv1beta1.NotificationSink
+	if err := json.NewDecoder(r.Body).Decode(&sink); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainSink := h.converter.NotificationSink().ToDomain(sink)
+	body, status := h.serviceHandler.CreateNotificationSink(r.Context(), transport.OrgIDFromContext(r.Context()), domainSink)
+	apiResult := h.converter.NotificationSink().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/notificationsinks)
+func (h *TransportHandler) ListNotificationSinks(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListNotificationSinksParams) {
+	domainParams := h.converter.NotificationSink().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListNotificationSinks(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.NotificationSink().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/notificationsinks/{name})
+func (h *TransportHandler) GetNotificationSink(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetNotificationSink(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.NotificationSink().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (PUT /api/v1/notificationsinks/{name})
+func (h *TransportHandler) ReplaceNotificationSink(w http.ResponseWriter, r *http.Request, name string) {
+	var sink apiv1beta1.NotificationSink
+	if err := json.NewDecoder(r.Body).Decode(&sink); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainSink := h.converter.NotificationSink().ToDomain(sink)
+	body, status := h.serviceHandler.ReplaceNotificationSink(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainSink)
+	apiResult := h.converter.NotificationSink().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/notificationsinks/{name})
+func (h *TransportHandler) DeleteNotificationSink(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteNotificationSink(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
@@ -2,9 +2,11 @@ package transportv1beta1
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/transport"
@@ -33,3 +35,33 @@ func (h *TransportHandler) SetParseFailureResponse(w http.ResponseWriter, err er
 	// validation gap -- report as 500 so it surfaces for investigation.
 	h.SetResponse(w, nil, domain.StatusInternalServerError(fmt.Sprintf("can't decode JSON body: %v", err)))
 }
+
+// SetWatchResponse streams watch events as newline-delimited JSON until the
+// channel is closed. If the watch could not be started, the status is
+// written as a regular response instead.
+func (h *TransportHandler) SetWatchResponse(w http.ResponseWriter, events <-chan domain.WatchEvent, status domain.Status) {
+	if status.Code != http.StatusOK {
+		h.SetResponse(w, nil, status)
+		return
+	}
+
+	// A watch outlives the server's write timeout, so lift it for this response.
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	_ = rc.Flush()
+
+	// Returning cancels the request context, which stops the watch.
+	encoder := json.NewEncoder(w)
+	for event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
@@ -22,6 +22,13 @@ func (h *TransportHandler) GetTemplateVersion(w http.ResponseWriter, r *http.Req
 	h.SetResponse(w, apiResult, status)
 }
 
+// (GET /api/v1/fleets/{fleet}/templateversions/{name}/diff/{other})
+func (h *TransportHandler) DiffTemplateVersions(w http.ResponseWriter, r *http.Request, fleet string, name string, other string) {
+	body, status := h.serviceHandler.DiffTemplateVersions(r.Context(), transport.OrgIDFromContext(r.Context()), fleet, name, other)
+	apiResult := h.converter.TemplateVersion().DiffFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
 // (DELETE /api/v1/fleets/{fleet}/templateVersions/{name})
 func (h *TransportHandler) DeleteTemplateVersion(w http.ResponseWriter, r *http.Request, fleet string, name string) {
 	status := h.serviceHandler.DeleteTemplateVersion(r.Context(), transport.OrgIDFromContext(r.Context()), fleet, name)
@@ -340,6 +340,15 @@ func WithUserAgent(ctx context.Context, userAgent string) context.Context {
 	return context.WithValue(ctx, consts.UserAgentCtxKey, userAgent)
 }
 
+func WithRemoteAddr(ctx context.Context, remoteAddr string) context.Context {
+	return context.WithValue(ctx, consts.RemoteAddrCtxKey, remoteAddr)
+}
+
+func GetRemoteAddrFromContext(ctx context.Context) (string, bool) {
+	remoteAddr, ok := ctx.Value(consts.RemoteAddrCtxKey).(string)
+	return remoteAddr, ok && remoteAddr != ""
+}
+
 // GetHostname returns the system hostname, or "unknown" if it cannot be determined
 func GetHostname() string {
 	hostname, err := os.Hostname()
@@ -248,3 +248,28 @@ var SystemdNameRegexp = regexp.MustCompile("^" + SystemdNameFmt + "$")
 func ValidateSystemdName(name *string, path string) []error {
 	return ValidateString(name, path, 1, SystemDNameMaxLength, SystemdNameRegexp, SystemdNameFmt)
 }
+
+const (
+	// NetworkInterfaceNameFmt matches the names the kernel accepts for network interfaces
+	NetworkInterfaceNameFmt string = `[^\s/:]+`
+	// NetworkInterfaceNameMaxLength is IFNAMSIZ without the terminating null byte
+	NetworkInterfaceNameMaxLength int = 15
+)
+
+var NetworkInterfaceNameRegexp = regexp.MustCompile("^" + NetworkInterfaceNameFmt + "$")
+
+func ValidateNetworkInterfaceName(name *string, path string) []error {
+	return ValidateString(name, path, 1, NetworkInterfaceNameMaxLength, NetworkInterfaceNameRegexp, NetworkInterfaceNameFmt, "eth0")
+}
+
+const (
+	// ThermalZoneFmt matches the directory names and types of thermal zones under /sys/class/thermal
+	ThermalZoneFmt       string = `[^\s/]+`
+	ThermalZoneMaxLength int    = 64
+)
+
+var ThermalZoneRegexp = regexp.MustCompile("^" + ThermalZoneFmt + "$")
+
+func ValidateThermalZone(zone *string, path string) []error {
+	return ValidateString(zone, path, 1, ThermalZoneMaxLength, ThermalZoneRegexp, ThermalZoneFmt, "thermal_zone0")
+}
@@ -219,3 +219,51 @@ func TestValidateOciImageReferenceWithTemplates(t *testing.T) {
 		assert.NotEmpty(errors, fmt.Sprintf("Expected invalid template reference %q to fail validation", val))
 	}
 }
+
+func TestValidateNetworkInterfaceName(t *testing.T) {
+	assert := assert.New(t)
+
+	goodValues := []string{
+		"eth0",
+		"enp0s31f6",
+		"wlan0.100",
+		strings.Repeat("a", 15),
+	}
+	for _, val := range goodValues {
+		assert.Empty(ValidateNetworkInterfaceName(&val, "good.interface"))
+	}
+
+	badValues := []string{
+		"",
+		"eth 0",
+		"../eth0",
+		"eth0:1",
+		strings.Repeat("a", 16),
+	}
+	for _, val := range badValues {
+		assert.NotEmpty(ValidateNetworkInterfaceName(&val, "bad.interface"), fmt.Sprintf("value: %q", val))
+	}
+}
+
+func TestValidateThermalZone(t *testing.T) {
+	assert := assert.New(t)
+
+	goodValues := []string{
+		"thermal_zone0",
+		"x86_pkg_temp",
+		"cpu-thermal",
+	}
+	for _, val := range goodValues {
+		assert.Empty(ValidateThermalZone(&val, "good.zone"))
+	}
+
+	badValues := []string{
+		"",
+		"thermal zone",
+		"../thermal_zone0",
+		strings.Repeat("a", 65),
+	}
+	for _, val := range badValues {
+		assert.NotEmpty(ValidateThermalZone(&val, "bad.zone"), fmt.Sprintf("value: %q", val))
+	}
+}
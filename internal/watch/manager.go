@@ -0,0 +1,160 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// watcherBufferSize is the number of notifications buffered per watcher.
+	// A watcher that falls further behind is stopped, and its client is
+	// expected to re-establish the watch.
+	watcherBufferSize = 256
+)
+
+// ErrWatchUnavailable is returned when the process was started without a
+// watch manager, e.g. in services that only publish notifications.
+var ErrWatchUnavailable = errors.New("watch is not available")
+
+type watcherKey struct {
+	orgId uuid.UUID
+	kind  string
+}
+
+// Watcher receives the notifications for one resource kind of one organization.
+type Watcher struct {
+	manager *Manager
+	key     watcherKey
+	ch      chan Notification
+	once    sync.Once
+}
+
+// ResultChan returns the channel notifications are delivered on. The channel
+// is closed when the watcher is stopped or falls behind.
+func (w *Watcher) ResultChan() <-chan Notification {
+	return w.ch
+}
+
+// Stop unregisters the watcher and closes its result channel.
+func (w *Watcher) Stop() {
+	w.manager.remove(w)
+}
+
+type Manager struct {
+	broadcaster Publisher
+	subscriber  Subscriber
+	mu          sync.Mutex
+	watchers    map[watcherKey]map[*Watcher]struct{}
+	log         logrus.FieldLogger
+}
+
+type BusType struct {
+	util.Singleton[Manager]
+}
+
+func (b *BusType) Initialize(ctx context.Context, provider queues.Provider, log logrus.FieldLogger) error {
+	m, err := newManager(ctx, provider, log)
+	if err != nil {
+		return err
+	}
+	_ = b.GetOrInit(m)
+	return nil
+}
+
+var Bus BusType
+
+func newManager(ctx context.Context, provider queues.Provider, log logrus.FieldLogger) (*Manager, error) {
+	broadcaster, err := NewBroadcaster(ctx, provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create publisher for resource watch: %w", err)
+	}
+	subscriber, err := NewSubscriber(ctx, provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create subscriber for resource watch: %w", err)
+	}
+	return &Manager{
+		broadcaster: broadcaster,
+		subscriber:  subscriber,
+		watchers:    make(map[watcherKey]map[*Watcher]struct{}),
+		log:         log,
+	}, nil
+}
+
+// Publish broadcasts a change notification to the watchers of all API server
+// instances. It is a no-op if the manager was not initialized.
+func (m *Manager) Publish(ctx context.Context, orgId uuid.UUID, kind string, name string, eventType domain.WatchEventType) error {
+	if m.broadcaster == nil {
+		return nil
+	}
+	return m.broadcaster.Publish(ctx, Notification{
+		OrgId: orgId,
+		Kind:  kind,
+		Name:  name,
+		Type:  eventType,
+	})
+}
+
+// Watch registers a watcher for the resources of the given kind in an organization.
+func (m *Manager) Watch(orgId uuid.UUID, kind string) (*Watcher, error) {
+	if m.subscriber == nil {
+		return nil, ErrWatchUnavailable
+	}
+	w := &Watcher{
+		manager: m,
+		key:     watcherKey{orgId: orgId, kind: kind},
+		ch:      make(chan Notification, watcherBufferSize),
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.watchers[w.key]; !ok {
+		m.watchers[w.key] = make(map[*Watcher]struct{})
+	}
+	m.watchers[w.key][w] = struct{}{}
+	return w, nil
+}
+
+func (m *Manager) remove(w *Watcher) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.removeLocked(w)
+}
+
+func (m *Manager) removeLocked(w *Watcher) {
+	if watchers, ok := m.watchers[w.key]; ok {
+		delete(watchers, w)
+		if len(watchers) == 0 {
+			delete(m.watchers, w.key)
+		}
+	}
+	w.once.Do(func() { close(w.ch) })
+}
+
+func (m *Manager) consumeHandler(_ context.Context, notification Notification) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for w := range m.watchers[watcherKey{orgId: notification.OrgId, kind: notification.Kind}] {
+		select {
+		case w.ch <- notification:
+		default:
+			m.log.Warnf("watcher for %s/%s fell behind, closing it", notification.OrgId, notification.Kind)
+			m.removeLocked(w)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) Start(ctx context.Context) error {
+	if err := m.subscriber.Subscribe(ctx, m.consumeHandler); err != nil {
+		m.log.Errorf("failed to consume resource watch notifications: %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package watch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// loopback delivers published notifications directly to the subscribed handler.
+type loopback struct {
+	handler func(ctx context.Context, notification Notification) error
+}
+
+func (l *loopback) Publish(ctx context.Context, notification Notification) error {
+	if l.handler == nil {
+		return nil
+	}
+	return l.handler(ctx, notification)
+}
+
+func (l *loopback) Subscribe(_ context.Context, handler func(ctx context.Context, notification Notification) error) error {
+	l.handler = handler
+	return nil
+}
+
+func newTestManager(t *testing.T) *Manager {
+	t.Helper()
+	bus := &loopback{}
+	m := &Manager{
+		broadcaster: bus,
+		subscriber:  bus,
+		watchers:    make(map[watcherKey]map[*Watcher]struct{}),
+		log:         log.InitLogs(),
+	}
+	require.NoError(t, m.Start(context.Background()))
+	return m
+}
+
+func TestManagerDeliversToMatchingWatchers(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m := newTestManager(t)
+	orgId := uuid.New()
+
+	devices, err := m.Watch(orgId, domain.DeviceKind)
+	require.NoError(err)
+	defer devices.Stop()
+	fleets, err := m.Watch(orgId, domain.FleetKind)
+	require.NoError(err)
+	defer fleets.Stop()
+	otherOrg, err := m.Watch(uuid.New(), domain.DeviceKind)
+	require.NoError(err)
+	defer otherOrg.Stop()
+
+	require.NoError(m.Publish(ctx, orgId, domain.DeviceKind, "dev1", domain.WatchEventTypeModified))
+
+	require.Len(devices.ResultChan(), 1)
+	n := <-devices.ResultChan()
+	require.Equal(Notification{OrgId: orgId, Kind: domain.DeviceKind, Name: "dev1", Type: domain.WatchEventTypeModified}, n)
+	require.Empty(fleets.ResultChan())
+	require.Empty(otherOrg.ResultChan())
+}
+
+func TestManagerStopClosesChannel(t *testing.T) {
+	require := require.New(t)
+	m := newTestManager(t)
+	orgId := uuid.New()
+
+	w, err := m.Watch(orgId, domain.DeviceKind)
+	require.NoError(err)
+	w.Stop()
+	w.Stop()
+
+	_, ok := <-w.ResultChan()
+	require.False(ok)
+	require.Empty(m.watchers)
+}
+
+func TestManagerClosesWatcherThatFallsBehind(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m := newTestManager(t)
+	orgId := uuid.New()
+
+	w, err := m.Watch(orgId, domain.DeviceKind)
+	require.NoError(err)
+
+	for i := 0; i <= watcherBufferSize; i++ {
+		require.NoError(m.Publish(ctx, orgId, domain.DeviceKind, "dev1", domain.WatchEventTypeModified))
+	}
+
+	received := 0
+	for range w.ResultChan() {
+		received++
+	}
+	require.Equal(watcherBufferSize, received)
+	require.Empty(m.watchers)
+}
+
+func TestUninitializedManager(t *testing.T) {
+	require := require.New(t)
+	m := &Manager{}
+
+	require.NoError(m.Publish(context.Background(), uuid.New(), domain.DeviceKind, "dev1", domain.WatchEventTypeAdded))
+	_, err := m.Watch(uuid.New(), domain.DeviceKind)
+	require.ErrorIs(err, ErrWatchUnavailable)
+}
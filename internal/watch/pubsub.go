@@ -0,0 +1,94 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const channelName = "resource_watch_notifier"
+
+// Notification identifies a resource that was added, modified or deleted.
+// It carries no resource content: watchers re-read the resource so that
+// selectors and authorization are evaluated against the stored state.
+type Notification struct {
+	OrgId uuid.UUID             `json:"org_id"`
+	Kind  string                `json:"kind"`
+	Name  string                `json:"name"`
+	Type  domain.WatchEventType `json:"type"`
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, notification Notification) error
+}
+
+type Subscriber interface {
+	Subscribe(ctx context.Context, handler func(ctx context.Context, notification Notification) error) error
+}
+
+func NewBroadcaster(ctx context.Context, queuesProvider queues.Provider) (Publisher, error) {
+	queuesPublisher, err := queuesProvider.NewPubSubPublisher(ctx, channelName)
+	if err != nil {
+		return nil, err
+	}
+	return &publisher{
+		broadcaster: queuesPublisher,
+	}, nil
+}
+
+func NewSubscriber(ctx context.Context, queuesProvider queues.Provider) (Subscriber, error) {
+	subscriber, err := queuesProvider.NewPubSubSubscriber(ctx, channelName)
+	if err != nil {
+		return nil, err
+	}
+	return &consumer{
+		subscriber: subscriber,
+	}, nil
+}
+
+type publisher struct {
+	broadcaster queues.PubSubPublisher
+}
+
+func (p *publisher) Publish(ctx context.Context, notification Notification) error {
+	b, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+	return p.broadcaster.Publish(ctx, b)
+}
+
+type consumer struct {
+	subscriber    queues.PubSubSubscriber
+	subscriptions []queues.Subscription
+}
+
+func (c *consumer) Subscribe(ctx context.Context, handler func(ctx context.Context, notification Notification) error) error {
+	queuesHandler := func(ctx context.Context, payload []byte, log logrus.FieldLogger) error {
+		var n Notification
+		if err := json.Unmarshal(payload, &n); err != nil {
+			log.WithError(err).Error("failed to unmarshal payload")
+			return err
+		}
+		return handler(ctx, n)
+	}
+
+	subscription, err := c.subscriber.Subscribe(ctx, queuesHandler)
+	if err != nil {
+		return err
+	}
+	c.subscriptions = append(c.subscriptions, subscription)
+	return nil
+}
+
+func (c *consumer) Close() {
+	for _, sub := range c.subscriptions {
+		sub.Close()
+	}
+	c.subscriptions = nil
+	c.subscriber.Close()
+}
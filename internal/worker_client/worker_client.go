@@ -81,6 +81,7 @@ var eventReasons = map[domain.EventReason]struct{}{
 	domain.EventReasonDependencyChangeDetected:    {},
 	domain.EventReasonFleetRolloutDeviceSelected:  {},
 	domain.EventReasonFleetRolloutBatchDispatched: {},
+	domain.EventReasonFleetRolloutRolledBack:      {},
 	domain.EventReasonDeviceConflictResolved:      {},
 	domain.EventReasonDeviceDecommissioned:        {},
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/tasks"
+	"github.com/flightctl/flightctl/internal/watch"
 	"github.com/flightctl/flightctl/internal/worker_client"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
 	"github.com/flightctl/flightctl/pkg/queues"
@@ -69,18 +70,26 @@ func (s *Server) Run(ctx context.Context) error {
 		s.log.WithError(err).Error("failed to create rendered version manager")
 		return err
 	}
+	if err = watch.Bus.Initialize(ctx, s.queuesProvider, s.log); err != nil {
+		s.log.WithError(err).Error("failed to create resource watch manager")
+		return err
+	}
 
 	orgCache := cache.NewOrganizationTTL(cache.DefaultTTL)
 	orgCache.Start()
 	defer orgCache.Stop()
 
 	serviceHandler := service.WrapWithTracing(
-		service.NewServiceHandler(s.store, workerClient, kvStore, nil, s.log, "", "", []string{}, false))
+		service.NewServiceHandler(s.store, workerClient, kvStore, nil, s.log, "", "", []string{}, false, false))
 
 	if err = tasks.LaunchConsumers(ctx, s.queuesProvider, serviceHandler, s.k8sClient, kvStore, s.cfg, 1, 1, s.workerMetrics); err != nil {
 		s.log.WithError(err).Error("failed to launch consumers")
 		return err
 	}
+	if err = tasks.LaunchNotificationConsumers(ctx, s.queuesProvider, serviceHandler, 1, 1); err != nil {
+		s.log.WithError(err).Error("failed to launch notification consumers")
+		return err
+	}
 	sigShutdown := make(chan os.Signal, 1)
 	signal.Notify(sigShutdown, os.Interrupt, syscall.SIGHUP, syscall.SIGTERM, syscall.SIGQUIT)
 	go func() {
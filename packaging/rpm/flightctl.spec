@@ -306,6 +306,7 @@ fi
     mkdir -p %{buildroot}/usr/lib/systemd/system
     mkdir -p %{buildroot}/usr/lib/tmpfiles.d
     mkdir -p %{buildroot}/usr/lib/flightctl/custom-info.d
+    mkdir -p %{buildroot}/usr/lib/flightctl/custom-monitor.d
     mkdir -p %{buildroot}/usr/lib/flightctl/hooks.d/{afterupdating,beforeupdating,afterrebooting,beforerebooting}
     mkdir -p %{buildroot}/usr/lib/greenboot/check/required.d
     mkdir -p %{buildroot}/usr/lib/greenboot/red.d
@@ -0,0 +1,149 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// Content is encrypted to a device with ECIES: a fresh P-256 key is agreed with the device's key, the
+// AES-256-GCM key is derived from the shared secret with HKDF-SHA256, and the envelope carries
+// version || ephemeral public key || nonce || ciphertext.
+const (
+	envelopeVersion = byte(1)
+	envelopeInfo    = "flightctl-encrypted-content"
+)
+
+var ErrInvalidEnvelope = errors.New("invalid encrypted content")
+
+// KeyAgreement is a P-256 private key that can agree on a shared secret without exposing the key itself,
+// such as an *ecdh.PrivateKey or a key held by a TPM.
+type KeyAgreement interface {
+	PublicKey() *ecdh.PublicKey
+	ECDH(remote *ecdh.PublicKey) ([]byte, error)
+}
+
+// EncryptToPublicKey encrypts the plaintext so that only the holder of the recipient's private key can
+// decrypt it. The additional data is authenticated, but not encrypted.
+func EncryptToPublicKey(recipient *ecdh.PublicKey, plaintext, additionalData []byte) ([]byte, error) {
+	if recipient == nil || recipient.Curve() != ecdh.P256() {
+		return nil, fmt.Errorf("recipient must be a P-256 public key")
+	}
+	ephemeral, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generating ephemeral key: %w", err)
+	}
+	shared, err := ephemeral.ECDH(recipient)
+	if err != nil {
+		return nil, fmt.Errorf("agreeing on shared secret: %w", err)
+	}
+	ephemeralPublic := ephemeral.PublicKey().Bytes()
+	aead, err := envelopeAEAD(shared, ephemeralPublic, recipient.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generating nonce: %w", err)
+	}
+
+	envelope := make([]byte, 0, 1+len(ephemeralPublic)+len(nonce)+len(plaintext)+aead.Overhead())
+	envelope = append(envelope, envelopeVersion)
+	envelope = append(envelope, ephemeralPublic...)
+	envelope = append(envelope, nonce...)
+	return aead.Seal(envelope, nonce, plaintext, additionalData), nil
+}
+
+// DecryptWithKeyAgreement decrypts an envelope created by EncryptToPublicKey for the public key of the given key.
+func DecryptWithKeyAgreement(key KeyAgreement, envelope, additionalData []byte) ([]byte, error) {
+	if key == nil {
+		return nil, fmt.Errorf("no key to decrypt with")
+	}
+	publicKeyLen := len(key.PublicKey().Bytes())
+	if len(envelope) < 1+publicKeyLen || envelope[0] != envelopeVersion {
+		return nil, ErrInvalidEnvelope
+	}
+	ephemeralPublic := envelope[1 : 1+publicKeyLen]
+	remote, err := ecdh.P256().NewPublicKey(ephemeralPublic)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEnvelope, err)
+	}
+	shared, err := key.ECDH(remote)
+	if err != nil {
+		return nil, fmt.Errorf("agreeing on shared secret: %w", err)
+	}
+	aead, err := envelopeAEAD(shared, ephemeralPublic, key.PublicKey().Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	rest := envelope[1+publicKeyLen:]
+	if len(rest) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrInvalidEnvelope
+	}
+	plaintext, err := aead.Open(nil, rest[:aead.NonceSize()], rest[aead.NonceSize():], additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEnvelope, err)
+	}
+	return plaintext, nil
+}
+
+func envelopeAEAD(shared, ephemeralPublic, recipientPublic []byte) (cipher.AEAD, error) {
+	salt := make([]byte, 0, len(ephemeralPublic)+len(recipientPublic))
+	salt = append(salt, ephemeralPublic...)
+	salt = append(salt, recipientPublic...)
+	key, err := hkdf.Key(sha256.New, shared, salt, envelopeInfo, 32)
+	if err != nil {
+		return nil, fmt.Errorf("deriving content key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// EncodeKeyAgreementPublicKey encodes the public key as base64 of its PKIX, ASN.1 DER form.
+func EncodeKeyAgreementPublicKey(publicKey *ecdh.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("marshalling public key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(der), nil
+}
+
+// ParseKeyAgreementPublicKey parses a P-256 public key encoded by EncodeKeyAgreementPublicKey.
+func ParseKeyAgreementPublicKey(encoded string) (*ecdh.PublicKey, error) {
+	der, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decoding public key: %w", err)
+	}
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("parsing public key: %w", err)
+	}
+	var publicKey *ecdh.PublicKey
+	switch key := parsed.(type) {
+	case *ecdsa.PublicKey:
+		if publicKey, err = key.ECDH(); err != nil {
+			return nil, fmt.Errorf("converting public key: %w", err)
+		}
+	case *ecdh.PublicKey:
+		publicKey = key
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", parsed)
+	}
+	if publicKey.Curve() != ecdh.P256() {
+		return nil, fmt.Errorf("public key must be a P-256 key")
+	}
+	return publicKey, nil
+}
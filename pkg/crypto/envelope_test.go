@@ -0,0 +1,82 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"errors"
+	"testing"
+)
+
+func TestEncryptDecryptEnvelope(t *testing.T) {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	plaintext := []byte("hunter2")
+	path := []byte("/etc/app/password")
+
+	envelope, err := EncryptToPublicKey(key.PublicKey(), plaintext, path)
+	if err != nil {
+		t.Fatalf("EncryptToPublicKey failed: %v", err)
+	}
+	if bytes.Contains(envelope, plaintext) {
+		t.Fatal("envelope contains the plaintext")
+	}
+
+	decrypted, err := DecryptWithKeyAgreement(key, envelope, path)
+	if err != nil {
+		t.Fatalf("DecryptWithKeyAgreement failed: %v", err)
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		t.Errorf("decrypted %q, want %q", decrypted, plaintext)
+	}
+
+	if _, err := DecryptWithKeyAgreement(key, envelope, []byte("/etc/other")); !errors.Is(err, ErrInvalidEnvelope) {
+		t.Errorf("expected ErrInvalidEnvelope for different additional data, got %v", err)
+	}
+
+	otherKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	if _, err := DecryptWithKeyAgreement(otherKey, envelope, path); !errors.Is(err, ErrInvalidEnvelope) {
+		t.Errorf("expected ErrInvalidEnvelope for a different key, got %v", err)
+	}
+
+	if _, err := DecryptWithKeyAgreement(key, envelope[:10], path); !errors.Is(err, ErrInvalidEnvelope) {
+		t.Errorf("expected ErrInvalidEnvelope for a truncated envelope, got %v", err)
+	}
+}
+
+func TestEncodeParseKeyAgreementPublicKey(t *testing.T) {
+	_, priv, err := NewKeyPair()
+	if err != nil {
+		t.Fatalf("NewKeyPair failed: %v", err)
+	}
+	ecdsaKey, ok := priv.(*ecdsa.PrivateKey)
+	if !ok {
+		t.Fatalf("unexpected private key type %T", priv)
+	}
+	key, err := ecdsaKey.ECDH()
+	if err != nil {
+		t.Fatalf("converting key: %v", err)
+	}
+
+	encoded, err := EncodeKeyAgreementPublicKey(key.PublicKey())
+	if err != nil {
+		t.Fatalf("EncodeKeyAgreementPublicKey failed: %v", err)
+	}
+	parsed, err := ParseKeyAgreementPublicKey(encoded)
+	if err != nil {
+		t.Fatalf("ParseKeyAgreementPublicKey failed: %v", err)
+	}
+	if !parsed.Equal(key.PublicKey()) {
+		t.Error("parsed public key does not match the encoded one")
+	}
+
+	if _, err := ParseKeyAgreementPublicKey("not-base64!"); err == nil {
+		t.Error("expected an error for invalid input")
+	}
+}
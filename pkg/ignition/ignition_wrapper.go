@@ -13,8 +13,12 @@ import (
 
 const initialIgnition = `{"ignition": {"version": "3.4.0"}}`
 
+// EncryptedMediaType is the media type of the data URL of files whose contents are encrypted to the device's key.
+const EncryptedMediaType = "application/vnd.flightctl.encrypted"
+
 type Wrapper interface {
 	SetFile(filePath string, contents []byte, mode int, base64 bool, user string, group string)
+	SetEncryptedFile(filePath string, ciphertext []byte, mode int, user string, group string)
 	ChangeMountPath(mountPath string)
 	AsJson() ([]byte, error)
 	AsMap() (map[string]interface{}, error)
@@ -52,6 +56,24 @@ func NewWrapperFromIgnition(ign config_latest_types.Config) Wrapper {
 }
 
 func (w *wrapper) SetFile(filePath string, contents []byte, mode int, base64 bool, user string, group string) {
+	var source string
+	if base64 {
+		url := dataurl.New(contents, "text/plain;base64")
+		url.Encoding = dataurl.EncodingASCII // Otherwise the library will double base64 encode
+		source = url.String()
+	} else {
+		source = dataurl.New(contents, "text/plain").String()
+	}
+	w.setFile(filePath, source, mode, user, group)
+}
+
+// SetEncryptedFile adds a file whose contents were encrypted to the device's key. The ciphertext is
+// base64 encoded into a data URL of the EncryptedMediaType.
+func (w *wrapper) SetEncryptedFile(filePath string, ciphertext []byte, mode int, user string, group string) {
+	w.setFile(filePath, dataurl.New(ciphertext, EncryptedMediaType).String(), mode, user, group)
+}
+
+func (w *wrapper) setFile(filePath string, source string, mode int, user string, group string) {
 	file := config_latest_types.File{
 		Node: config_latest_types.Node{
 			Path:      filePath,
@@ -60,18 +82,11 @@ func (w *wrapper) SetFile(filePath string, contents []byte, mode int, base64 boo
 			User:      config_latest_types.NodeUser{Name: lo.ToPtr("root")},
 		},
 		FileEmbedded1: config_latest_types.FileEmbedded1{
-			Contents: config_latest_types.Resource{},
+			Contents: config_latest_types.Resource{Source: lo.ToPtr(source)},
 			Mode:     &mode,
 		},
 	}
 
-	if base64 {
-		url := dataurl.New(contents, "text/plain;base64")
-		url.Encoding = dataurl.EncodingASCII // Otherwise the library will double base64 encode
-		file.FileEmbedded1.Contents.Source = lo.ToPtr(url.String())
-	} else {
-		file.FileEmbedded1.Contents.Source = lo.ToPtr(dataurl.New(contents, "text/plain").String())
-	}
 	if user != "" {
 		file.Node.User = userStringToNodeUser(user)
 	}
@@ -41,6 +41,10 @@ var embeddedBuildManifest = []byte(`
           "ref": "quay.io/flightctl/flightctl-db-setup-el10",
           "tag": ""
         },
+        {
+          "ref": "quay.io/flightctl/flightctl-event-exporter-el10",
+          "tag": ""
+        },
         {
           "ref": "quay.io/flightctl/flightctl-imagebuilder-api-el10",
           "tag": ""
@@ -146,6 +150,10 @@ var embeddedBuildManifest = []byte(`
           "ref": "quay.io/flightctl/flightctl-db-setup-el9",
           "tag": ""
         },
+        {
+          "ref": "quay.io/flightctl/flightctl-event-exporter-el9",
+          "tag": ""
+        },
         {
           "ref": "quay.io/flightctl/flightctl-imagebuilder-api-el9",
           "tag": ""
@@ -267,6 +275,10 @@ var embeddedBuildManifest = []byte(`
           "ref": "registry.redhat.io/rhem/flightctl-db-setup-rhel10",
           "tag": ""
         },
+        {
+          "ref": "registry.redhat.io/rhem/flightctl-event-exporter-rhel10",
+          "tag": ""
+        },
         {
           "ref": "registry.redhat.io/rhem/flightctl-imagebuilder-api-rhel10",
           "tag": ""
@@ -368,6 +380,10 @@ var embeddedBuildManifest = []byte(`
           "ref": "registry.redhat.io/rhem/flightctl-db-setup-rhel9",
           "tag": ""
         },
+        {
+          "ref": "registry.redhat.io/rhem/flightctl-event-exporter-rhel9",
+          "tag": ""
+        },
         {
           "ref": "registry.redhat.io/rhem/flightctl-imagebuilder-api-rhel9",
           "tag": ""
@@ -380,7 +380,7 @@ func NewTestHarness(ctx context.Context, testDirPath string, goRoutineErrorHandl
 		return nil, fmt.Errorf("NewTestHarness: failed to create queue publisher: %w", err)
 	}
 	workerClient := worker_client.NewWorkerClient(publisher, serverLog)
-	testHarness.ServiceHandler = service.NewServiceHandler(storeInst, workerClient, kvStore, ca, serverLog, "", "", []string{}, testHarness.vulnerabilityEnabled)
+	testHarness.ServiceHandler = service.NewServiceHandler(storeInst, workerClient, kvStore, ca, serverLog, "", "", []string{}, testHarness.vulnerabilityEnabled, false)
 
 	// Only auto-start agent if not explicitly disabled via WithoutAutoStartAgent()
 	if !testHarness.skipAutoStart {
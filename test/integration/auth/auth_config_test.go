@@ -66,7 +66,7 @@ var _ = Describe("Auth Config Integration Tests", func() {
 		ctx = context.WithValue(ctx, consts.MappedIdentityCtxKey, adminIdentity)
 
 		// Create service handler (it implements AuthProviderService interface)
-		serviceHandler = service.NewServiceHandler(testStore, nil, nil, nil, log, "", "", []string{}, false)
+		serviceHandler = service.NewServiceHandler(testStore, nil, nil, nil, log, "", "", []string{}, false, false)
 
 		// Create a config with static OIDC provider to test production initialization path
 		cfg := config.NewDefault(
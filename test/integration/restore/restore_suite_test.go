@@ -119,7 +119,7 @@ func (s *RestoreTestSuite) Setup() {
 	caClient, _, err := icrypto.EnsureCA(caCfg)
 	Expect(err).ToNot(HaveOccurred())
 
-	s.Handler = service.NewServiceHandler(s.Store, workerClient, kvStore, caClient, s.Log, "", "", []string{}, false)
+	s.Handler = service.NewServiceHandler(s.Store, workerClient, kvStore, caClient, s.Log, "", "", []string{}, false, false)
 }
 
 // Teardown performs common cleanup for restore tests.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"testing"
 	"time"
@@ -179,6 +180,19 @@ var _ = Describe("Rollout batch sequence test", func() {
 			gorm.Expr(`jsonb_set(status, '{config,renderedVersion}', '"5"')`)).Error).ToNot(HaveOccurred())
 	}
 
+	setApplicationsStatus := func(deviceName string, status api.ApplicationsSummaryStatusType) {
+		Expect(db.WithContext(ctx).Model(&model.Device{}).Where("name = ?", deviceName).Update("status",
+			gorm.Expr(`jsonb_set(status, '{applicationsSummary,status}', to_jsonb(?::text))`, string(status))).Error).ToNot(HaveOccurred())
+	}
+
+	// setApplicationProbes declares a liveness probe on an application of the fleet template, which gates the
+	// success of rollout batches on the health of the applications
+	setApplicationProbes := func(fleetName string) {
+		app := `[{"name":"web","appType":"container","image":"quay.io/flightctl/web:v1","livenessProbe":{"tcp":{"port":8080}}}]`
+		Expect(db.WithContext(ctx).Model(&model.Fleet{}).Where("name = ?", fleetName).Update("spec",
+			gorm.Expr(`jsonb_set(spec, '{template,spec,applications}', ?::jsonb)`, app)).Error).ToNot(HaveOccurred())
+	}
+
 	setFailed := func(deviceName string) {
 		device, err := storeInst.Device().Get(ctx, store.NullOrgId, deviceName)
 		Expect(err).ToNot(HaveOccurred())
@@ -368,7 +382,7 @@ var _ = Describe("Rollout batch sequence test", func() {
 		publisher.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 		kvStore, err := kvstore.NewKVStore(ctx, log, redisHost, redisPort, redisPassword)
 		Expect(err).ToNot(HaveOccurred())
-		serviceHandler = service.NewServiceHandler(storeInst, mockWorkerClient, kvStore, nil, log, "", "", []string{}, false)
+		serviceHandler = service.NewServiceHandler(storeInst, mockWorkerClient, kvStore, nil, log, "", "", []string{}, false, false)
 	})
 	AfterEach(func() {
 		_ = storeInst.Close()
@@ -579,6 +593,44 @@ var _ = Describe("Rollout batch sequence test", func() {
 			Entry("multiple devices some selected devices with template version and mixed completion reasons - all complete", 10, bnds(6), bnds(6), bnds(6), bnds(2), bnds(2, 2), bnds(4, 2), 33),
 			Entry("multiple devices some selected devices with template version and mixed completion reasons - one complete out of selected range", 10, bnds(6), bnds(7), bnds(7), bnds(2), bnds(2, 2), bnds(5, 2), 33),
 		)
+		DescribeTable("application health",
+			func(probes bool, errored, degraded, timedOut *Bounds, expectedCompletion bool, expectedReport api.RolloutBatchCompletionReport) {
+				selector := setupCompletion(4, bnds(4), bnds(4), bnds(4), bnds(4), nil, timedOut)
+				if probes {
+					setApplicationProbes(FleetName)
+				}
+				var devices []*model.Device
+				Expect(db.WithContext(ctx).Find(&devices).Error).ToNot(HaveOccurred())
+				for i := lowerBound(errored); i != upperBound(errored); i++ {
+					setApplicationsStatus(devices[i].Name, api.ApplicationsSummaryStatusError)
+				}
+				for i := lowerBound(degraded); i != upperBound(degraded); i++ {
+					setApplicationsStatus(devices[i].Name, api.ApplicationsSummaryStatusDegraded)
+				}
+				selection, err := selector.CurrentSelection(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				isComplete, err := selection.IsComplete(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(isComplete).To(Equal(expectedCompletion))
+				Expect(selection.SetCompletionReport(ctx)).ToNot(HaveOccurred())
+				fleet, err := storeInst.Fleet().Get(ctx, store.NullOrgId, FleetName)
+				Expect(err).ToNot(HaveOccurred())
+				val, exists := util.GetFromMap(lo.FromPtr(fleet.Metadata.Annotations), api.FleetAnnotationLastBatchCompletionReport)
+				Expect(exists).To(BeTrue())
+				var report api.RolloutBatchCompletionReport
+				Expect(json.Unmarshal([]byte(val), &report)).ToNot(HaveOccurred())
+				Expect(report.Total).To(Equal(expectedReport.Total))
+				Expect(report.Successful).To(Equal(expectedReport.Successful))
+				Expect(report.Failed).To(Equal(expectedReport.Failed))
+				Expect(report.TimedOut).To(Equal(expectedReport.TimedOut))
+			},
+			Entry("healthy applications", true, nil, nil, nil, true, api.RolloutBatchCompletionReport{Total: 4, Successful: 4}),
+			Entry("errored applications fail the update", true, bnds(1), nil, nil, true, api.RolloutBatchCompletionReport{Total: 4, Successful: 3, Failed: 1}),
+			Entry("degraded applications keep the update incomplete", true, nil, bnds(1, 1), nil, false, api.RolloutBatchCompletionReport{Total: 4, Successful: 3}),
+			Entry("degraded applications time out", true, nil, bnds(1, 1), bnds(1, 1), true, api.RolloutBatchCompletionReport{Total: 4, Successful: 3, TimedOut: 1}),
+			Entry("errored applications do not fail the update of a fleet without probes", false, bnds(1), nil, nil, true, api.RolloutBatchCompletionReport{Total: 4, Successful: 4}),
+			Entry("degraded applications do not hold the update of a fleet without probes", false, nil, bnds(1, 1), nil, true, api.RolloutBatchCompletionReport{Total: 4, Successful: 4}),
+		)
 	})
 	Context("reconciler", func() {
 		BeforeEach(func() {
@@ -698,6 +750,249 @@ var _ = Describe("Rollout batch sequence test", func() {
 			reconciler.Reconcile(ctx, store.NullOrgId)
 			Expect(getBatchLocation(FleetName)).To(Equal(5))
 		})
+		It("records the rollout history on the template version", func() {
+			getRolloutRecord := func() *api.TemplateVersionRolloutRecord {
+				tv, err := storeInst.TemplateVersion().Get(ctx, store.NullOrgId, FleetName, tvName)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tv.Status).ToNot(BeNil())
+				return tv.Status.Rollout
+			}
+			initFleet(FleetName, batchSequenceWithSelection, 1, true)
+			reconciler := device_selection.NewReconciler(serviceHandler, log)
+			mockWorkerClient.EXPECT().EmitEvent(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			reconciler.Reconcile(ctx, store.NullOrgId)
+			record := getRolloutRecord()
+			Expect(record).ToNot(BeNil())
+			Expect(record.Outcome).To(Equal(api.RolloutOutcomeInProgress))
+			Expect(record.CompletedAt).To(BeNil())
+			setDevicesComplete(FleetName, tvName)
+			reconciler.Reconcile(ctx, store.NullOrgId)
+			record = getRolloutRecord()
+			Expect(record).ToNot(BeNil())
+			Expect(record.Batches).ToNot(BeEmpty())
+			Expect(lo.SumBy(record.Batches, func(b api.RolloutBatchRecord) int64 { return b.Successful })).To(BeNumerically(">", 0))
+		})
+		Context("canary", func() {
+			setApplicationsHealthy := func(fleetName string) {
+				devices, err := storeInst.Device().List(ctx, store.NullOrgId, store.DeviceListParams{
+					ListParams: store.ListParams{
+						FieldSelector: selector.NewFieldSelectorFromMapOrDie(map[string]string{"metadata.owner": util.ResourceOwner(api.FleetKind, fleetName)}),
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				for i := range devices.Items {
+					d := devices.Items[i]
+					d.Status.ApplicationsSummary.Status = api.ApplicationsSummaryStatusHealthy
+					_, err = storeInst.Device().UpdateStatus(ctx, store.NullOrgId, &d, nil)
+					Expect(err).ToNot(HaveOccurred())
+				}
+			}
+			getHealthySince := func(fleetName string) (string, bool) {
+				fleet, err := storeInst.Fleet().Get(ctx, store.NullOrgId, fleetName)
+				Expect(err).ToNot(HaveOccurred())
+				return util.GetFromMap(lo.FromPtr(fleet.Metadata.Annotations), api.FleetAnnotationCanaryHealthySince)
+			}
+			It("promotes the rollout once the canary devices have soaked", func() {
+				var deviceSelection api.RolloutDeviceSelection
+				Expect(deviceSelection.FromCanary(api.Canary{
+					Selector:     api.LabelSelector{MatchLabels: &labels2},
+					SoakDuration: "1h",
+				})).ToNot(HaveOccurred())
+				_, err := storeInst.Fleet().Create(ctx, store.NullOrgId, &api.Fleet{
+					Metadata: api.ObjectMeta{Name: lo.ToPtr(FleetName)},
+					Spec: api.FleetSpec{
+						RolloutPolicy: &api.RolloutPolicy{DeviceSelection: &deviceSelection},
+					},
+				}, nil)
+				Expect(err).ToNot(HaveOccurred())
+				createTestTemplateVersion(FleetName)
+				testutil.CreateTestDevices(ctx, 4, storeInst.Device(), store.NullOrgId, util.SetResourceOwner(api.FleetKind, FleetName), false)
+				setLabels([]map[string]string{labels2}, []int{1})
+				devices, err := storeInst.Device().List(ctx, store.NullOrgId, store.DeviceListParams{})
+				Expect(err).ToNot(HaveOccurred())
+				for i := range devices.Items {
+					d := devices.Items[i]
+					d.Status.Summary.Status = "Online"
+					_, err = storeInst.Device().UpdateStatus(ctx, store.NullOrgId, &d, nil)
+					Expect(err).ToNot(HaveOccurred())
+				}
+
+				reconciler := device_selection.NewReconciler(serviceHandler, log)
+				mockWorkerClient.EXPECT().EmitEvent(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+				reconciler.Reconcile(ctx, store.NullOrgId)
+				Expect(getBatchLocation(FleetName)).To(Equal(0))
+
+				// The canary devices are updated, but their applications are not healthy yet
+				setDevicesComplete(FleetName, tvName)
+				reconciler.Reconcile(ctx, store.NullOrgId)
+				Expect(getBatchLocation(FleetName)).To(Equal(0))
+				_, exists := getHealthySince(FleetName)
+				Expect(exists).To(BeFalse())
+
+				// The canary devices are healthy, and the soak starts
+				setApplicationsHealthy(FleetName)
+				reconciler.Reconcile(ctx, store.NullOrgId)
+				Expect(getBatchLocation(FleetName)).To(Equal(0))
+				_, exists = getHealthySince(FleetName)
+				Expect(exists).To(BeTrue())
+
+				// The soak duration has passed
+				annotations := map[string]string{
+					api.FleetAnnotationCanaryHealthySince: time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339),
+				}
+				Expect(storeInst.Fleet().UpdateAnnotations(ctx, store.NullOrgId, FleetName, annotations, nil, nil)).ToNot(HaveOccurred())
+				reconciler.Reconcile(ctx, store.NullOrgId)
+				Expect(getBatchLocation(FleetName)).To(Equal(1))
+			})
+			It("fails the canary batch when its devices do not soak by the soak deadline", func() {
+				var deviceSelection api.RolloutDeviceSelection
+				Expect(deviceSelection.FromCanary(api.Canary{
+					Selector:     api.LabelSelector{MatchLabels: &labels2},
+					SoakDuration: "1h",
+				})).ToNot(HaveOccurred())
+				_, err := storeInst.Fleet().Create(ctx, store.NullOrgId, &api.Fleet{
+					Metadata: api.ObjectMeta{Name: lo.ToPtr(FleetName)},
+					Spec: api.FleetSpec{
+						RolloutPolicy: &api.RolloutPolicy{DeviceSelection: &deviceSelection},
+					},
+				}, nil)
+				Expect(err).ToNot(HaveOccurred())
+				createTestTemplateVersion(FleetName)
+				testutil.CreateTestDevices(ctx, 4, storeInst.Device(), store.NullOrgId, util.SetResourceOwner(api.FleetKind, FleetName), false)
+				setLabels([]map[string]string{labels2}, []int{1})
+
+				reconciler := device_selection.NewReconciler(serviceHandler, log)
+				mockWorkerClient.EXPECT().EmitEvent(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+				reconciler.Reconcile(ctx, store.NullOrgId)
+				Expect(getBatchLocation(FleetName)).To(Equal(0))
+
+				// The canary devices are updated, but never become healthy, and the soak starts
+				setDevicesComplete(FleetName, tvName)
+				reconciler.Reconcile(ctx, store.NullOrgId)
+				Expect(getBatchLocation(FleetName)).To(Equal(0))
+				fleet, err := storeInst.Fleet().Get(ctx, store.NullOrgId, FleetName)
+				Expect(err).ToNot(HaveOccurred())
+				_, exists := util.GetFromMap(lo.FromPtr(fleet.Metadata.Annotations), api.FleetAnnotationCanarySoakStartedAt)
+				Expect(exists).To(BeTrue())
+
+				// The soak deadline has passed, so the batch fails and the rollout does not continue
+				annotations := map[string]string{
+					api.FleetAnnotationCanarySoakStartedAt: time.Now().Add(-3 * time.Hour).UTC().Format(time.RFC3339),
+				}
+				Expect(storeInst.Fleet().UpdateAnnotations(ctx, store.NullOrgId, FleetName, annotations, nil, nil)).ToNot(HaveOccurred())
+				reconciler.Reconcile(ctx, store.NullOrgId)
+				Expect(getBatchLocation(FleetName)).To(Equal(1))
+				fleet, err = storeInst.Fleet().Get(ctx, store.NullOrgId, FleetName)
+				Expect(err).ToNot(HaveOccurred())
+				val, exists := util.GetFromMap(lo.FromPtr(fleet.Metadata.Annotations), api.FleetAnnotationLastBatchCompletionReport)
+				Expect(exists).To(BeTrue())
+				var report api.RolloutBatchCompletionReport
+				Expect(json.Unmarshal([]byte(val), &report)).ToNot(HaveOccurred())
+				Expect(report.SoakFailed).To(BeTrue())
+				Expect(report.Successful).To(BeZero())
+				_, approved := util.GetFromMap(lo.FromPtr(fleet.Metadata.Annotations), api.FleetAnnotationRolloutApproved)
+				Expect(approved).To(BeFalse())
+			})
+		})
+		Context("manual approval", func() {
+			getRolloutReason := func(fleetName string) string {
+				fleet, err := storeInst.Fleet().Get(ctx, store.NullOrgId, fleetName)
+				Expect(err).ToNot(HaveOccurred())
+				condition := api.FindStatusCondition(fleet.Status.Conditions, api.ConditionTypeFleetRolloutInProgress)
+				Expect(condition).ToNot(BeNil())
+				return condition.Reason
+			}
+			It("waits for the batch that requires approval to be approved", func() {
+				sequence := api.BatchSequence{
+					Sequence: &[]api.Batch{
+						{
+							Selector: &api.LabelSelector{MatchLabels: &labels1},
+						},
+						{
+							Selector:        &api.LabelSelector{MatchLabels: &labels2},
+							RequireApproval: lo.ToPtr(true),
+						},
+					},
+				}
+				initFleet(FleetName, sequence, 4, true)
+				setLabels([]map[string]string{labels1, labels2}, []int{2, 2})
+				reconciler := device_selection.NewReconciler(serviceHandler, log)
+				mockWorkerClient.EXPECT().EmitEvent(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+				reconciler.Reconcile(ctx, store.NullOrgId)
+				Expect(getBatchLocation(FleetName)).To(Equal(0))
+
+				// The first batch succeeded, but the second batch requires approval
+				setDevicesComplete(FleetName, tvName)
+				reconciler.Reconcile(ctx, store.NullOrgId)
+				Expect(getBatchLocation(FleetName)).To(Equal(1))
+				reconciler.Reconcile(ctx, store.NullOrgId)
+				Expect(getBatchLocation(FleetName)).To(Equal(1))
+				Expect(getRolloutReason(FleetName)).To(Equal(api.RolloutAwaitingApprovalReason))
+
+				// Approving another batch is rejected
+				_, status := serviceHandler.ApproveFleetRolloutBatch(ctx, store.NullOrgId, FleetName, api.FleetRolloutBatchApproval{Batch: 3})
+				Expect(status.Code).To(BeEquivalentTo(http.StatusConflict))
+
+				_, status = serviceHandler.ApproveFleetRolloutBatch(ctx, store.NullOrgId, FleetName, api.FleetRolloutBatchApproval{Batch: 2})
+				Expect(status.Code).To(BeEquivalentTo(http.StatusOK))
+				reconciler.Reconcile(ctx, store.NullOrgId)
+				Expect(getRolloutReason(FleetName)).To(Equal(api.RolloutActiveReason))
+				setDevicesComplete(FleetName, tvName)
+				reconciler.Reconcile(ctx, store.NullOrgId)
+				Expect(getBatchLocation(FleetName)).To(BeNumerically(">", 1))
+			})
+		})
+		Context("progressive", func() {
+			getStepStartedAt := func(fleetName string) (string, bool) {
+				fleet, err := storeInst.Fleet().Get(ctx, store.NullOrgId, fleetName)
+				Expect(err).ToNot(HaveOccurred())
+				return util.GetFromMap(lo.FromPtr(fleet.Metadata.Annotations), api.FleetAnnotationProgressiveStepStartedAt)
+			}
+			It("proceeds to the next step once the step interval has passed", func() {
+				var deviceSelection api.RolloutDeviceSelection
+				Expect(deviceSelection.FromProgressive(api.Progressive{
+					Steps:        []api.Percentage{"25%", "100%"},
+					StepInterval: "4h",
+				})).ToNot(HaveOccurred())
+				_, err := storeInst.Fleet().Create(ctx, store.NullOrgId, &api.Fleet{
+					Metadata: api.ObjectMeta{Name: lo.ToPtr(FleetName)},
+					Spec: api.FleetSpec{
+						RolloutPolicy: &api.RolloutPolicy{DeviceSelection: &deviceSelection},
+					},
+				}, nil)
+				Expect(err).ToNot(HaveOccurred())
+				createTestTemplateVersion(FleetName)
+				testutil.CreateTestDevices(ctx, 4, storeInst.Device(), store.NullOrgId, util.SetResourceOwner(api.FleetKind, FleetName), false)
+				devices, err := storeInst.Device().List(ctx, store.NullOrgId, store.DeviceListParams{})
+				Expect(err).ToNot(HaveOccurred())
+				for i := range devices.Items {
+					d := devices.Items[i]
+					d.Status.Summary.Status = "Online"
+					_, err = storeInst.Device().UpdateStatus(ctx, store.NullOrgId, &d, nil)
+					Expect(err).ToNot(HaveOccurred())
+				}
+
+				reconciler := device_selection.NewReconciler(serviceHandler, log)
+				mockWorkerClient.EXPECT().EmitEvent(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+				reconciler.Reconcile(ctx, store.NullOrgId)
+				Expect(getBatchLocation(FleetName)).To(Equal(0))
+				_, exists := getStepStartedAt(FleetName)
+				Expect(exists).To(BeTrue())
+
+				// The devices of the first step are updated, but the step interval has not passed yet
+				setDevicesComplete(FleetName, tvName)
+				reconciler.Reconcile(ctx, store.NullOrgId)
+				Expect(getBatchLocation(FleetName)).To(Equal(0))
+
+				// The step interval has passed
+				annotations := map[string]string{
+					api.FleetAnnotationProgressiveStepStartedAt: time.Now().Add(-5 * time.Hour).UTC().Format(time.RFC3339),
+				}
+				Expect(storeInst.Fleet().UpdateAnnotations(ctx, store.NullOrgId, FleetName, annotations, nil, nil)).ToNot(HaveOccurred())
+				reconciler.Reconcile(ctx, store.NullOrgId)
+				Expect(getBatchLocation(FleetName)).To(Equal(1))
+			})
+		})
 		Context("definition updated", func() {
 			updateDefinition := func(definition *api.RolloutDeviceSelection) {
 				fleet, err := storeInst.Fleet().Get(ctx, store.NullOrgId, FleetName)
@@ -226,7 +226,7 @@ var _ = Describe("Rollout disruption budget test", func() {
 		kvStore, err := kvstore.NewKVStore(ctx, log, redisHost, redisPort, redisPassword)
 		Expect(err).ToNot(HaveOccurred())
 
-		serviceHandler = service.NewServiceHandler(storeInst, mockWorkerClient, kvStore, nil, log, "", "", []string{}, false)
+		serviceHandler = service.NewServiceHandler(storeInst, mockWorkerClient, kvStore, nil, log, "", "", []string{}, false, false)
 		capturedEvents = make([]api.Event, 0)
 	})
 	AfterEach(func() {
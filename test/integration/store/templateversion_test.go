@@ -2,8 +2,10 @@ package store_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/store"
 	flightlog "github.com/flightctl/flightctl/pkg/log"
@@ -195,5 +197,30 @@ var _ = Describe("TemplateVersion", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*tv.Metadata.Name).To(Equal("1.0.2"))
 		})
+
+		It("Update rollout record", func() {
+			testutil.CreateTestFleet(ctx, storeInst.Fleet(), orgId, "myfleet", nil, nil)
+			err := testutil.CreateTestTemplateVersion(ctx, tvStore, orgId, "myfleet", "1.0.1", nil)
+			Expect(err).ToNot(HaveOccurred())
+			before, err := tvStore.Get(ctx, orgId, "myfleet", "1.0.1")
+			Expect(err).ToNot(HaveOccurred())
+
+			record := domain.TemplateVersionRolloutRecord{
+				StartedAt: time.Now().UTC().Truncate(time.Second),
+				Outcome:   domain.RolloutOutcomeInProgress,
+				Batches:   []domain.RolloutBatchRecord{{Name: "batch 1", Total: 2, Successful: 2, SuccessPercentage: 100}},
+			}
+			Expect(tvStore.UpdateRolloutRecord(ctx, orgId, "myfleet", "1.0.1", &record)).To(Succeed())
+			after, err := tvStore.Get(ctx, orgId, "myfleet", "1.0.1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(after.Status.Rollout).ToNot(BeNil())
+			Expect(after.Status.Rollout.Outcome).To(Equal(domain.RolloutOutcomeInProgress))
+			Expect(after.Status.Rollout.StartedAt.Equal(record.StartedAt)).To(BeTrue())
+			Expect(after.Status.Rollout.Batches).To(HaveLen(1))
+			Expect(after.Status.Conditions).To(Equal(before.Status.Conditions))
+
+			err = tvStore.UpdateRolloutRecord(ctx, orgId, "myfleet", "1.0.2", &record)
+			Expect(err).To(Equal(flterrors.ErrResourceNotFound))
+		})
 	})
 })
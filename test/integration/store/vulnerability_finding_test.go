@@ -753,7 +753,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			_, upsertErr := findingStore.UpsertFindings(ctx, findings)
 			Expect(upsertErr).ToNot(HaveOccurred())
 
-			apiHandler := service.NewServiceHandler(storeInst, nil, nil, nil, log, "", "", []string{}, true)
+			apiHandler := service.NewServiceHandler(storeInst, nil, nil, nil, log, "", "", []string{}, true, false)
 			err := apiHandler.SyncDeviceCVELifecycleEvents(ctx)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -58,7 +58,7 @@ var _ = Describe("ResourceSync Task Integration Tests", func() {
 		workerClient = worker_client.NewWorkerClient(mockQueueProducer, log)
 		kvStore, err := kvstore.NewKVStore(ctx, log, redisHost, redisPort, redisPassword)
 		Expect(err).ToNot(HaveOccurred())
-		serviceHandler = service.NewServiceHandler(storeInst, workerClient, kvStore, nil, log, "", "", []string{}, false)
+		serviceHandler = service.NewServiceHandler(storeInst, workerClient, kvStore, nil, log, "", "", []string{}, false, false)
 		resourceSync = tasks.NewResourceSync(serviceHandler, log, nil, nil)
 
 		// Set up mock expectations for the publisher
@@ -93,7 +93,7 @@ var _ = Describe("VmApplicationRender", func() {
 
 		kvStoreInst, err = kvstore.NewKVStore(ctx, log, redisHost, redisPort, redisPassword)
 		Expect(err).ToNot(HaveOccurred())
-		serviceHandler = service.NewServiceHandler(storeInst, workerClient, kvStoreInst, nil, log, "", "", []string{}, false)
+		serviceHandler = service.NewServiceHandler(storeInst, workerClient, kvStoreInst, nil, log, "", "", []string{}, false, false)
 
 		if queuesProvider == nil {
 			processID := fmt.Sprintf("vm-render-test-%s", uuid.New().String())